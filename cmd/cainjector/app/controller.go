@@ -26,6 +26,7 @@ import (
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/cainjector"
 	"github.com/cert-manager/cert-manager/internal/apis/config/shared"
+	cainjectorbundle "github.com/cert-manager/cert-manager/internal/cainjector/bundle"
 	cmscheme "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/scheme"
 	"github.com/cert-manager/cert-manager/pkg/controller/cainjector"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -198,9 +199,18 @@ func Run(opts *config.CAInjectorConfiguration, ctx context.Context) error {
 	}
 
 	// If cainjector has been configured to watch Certificate CRDs (true by default)
-	// (--enable-certificates-data-source=true), poll kubeapiserver for 5 minutes or till
-	// certificate CRD is found.
-	if opts.EnableDataSourceConfig.Certificates {
+	// (--enable-certificates-data-source=true) or Issuer and ClusterIssuer CRDs
+	// (--enable-issuers-data-source=true), poll kubeapiserver for 5 minutes or till
+	// the CRDs are found. The CAs of SelfSigned and Venafi issuers are read using
+	// the Certificates and CertificateRequests they issued.
+	var requiredCRDs []string
+	if opts.EnableDataSourceConfig.Certificates || opts.EnableDataSourceConfig.Issuers {
+		requiredCRDs = append(requiredCRDs, "certificates.cert-manager.io")
+	}
+	if opts.EnableDataSourceConfig.Issuers {
+		requiredCRDs = append(requiredCRDs, "issuers.cert-manager.io", "clusterissuers.cert-manager.io", "certificaterequests.cert-manager.io")
+	}
+	if len(requiredCRDs) > 0 {
 		directClient, err := client.New(mgr.GetConfig(), client.Options{
 			Scheme: mgr.GetScheme(),
 			Mapper: mgr.GetRESTMapper(),
@@ -208,23 +218,25 @@ func Run(opts *config.CAInjectorConfiguration, ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to create client: %w", err)
 		}
-		err = wait.PollUntilContextTimeout(ctx, time.Second, time.Minute*5, true, func(ctx context.Context) (bool, error) {
-			certsCRDName := types.NamespacedName{Name: "certificates.cert-manager.io"}
-			certsCRD := apiext.CustomResourceDefinition{}
-			err := directClient.Get(ctx, certsCRDName, &certsCRD)
-			if apierrors.IsNotFound(err) {
-				log.Info("cainjector has been configured to watch certificates, but certificates.cert-manager.io CRD not found, retrying with a backoff...")
-				return false, nil
-			} else if err != nil {
-				log.Error(err, "error checking if certificates.cert-manager.io CRD is installed")
-				return false, err
+		for _, crdName := range requiredCRDs {
+			log := log.WithValues("crd", crdName)
+			err = wait.PollUntilContextTimeout(ctx, time.Second, time.Minute*5, true, func(ctx context.Context) (bool, error) {
+				crd := apiext.CustomResourceDefinition{}
+				err := directClient.Get(ctx, types.NamespacedName{Name: crdName}, &crd)
+				if apierrors.IsNotFound(err) {
+					log.Info("cainjector has been configured to use a data source, but its CRD was not found, retrying with a backoff...")
+					return false, nil
+				} else if err != nil {
+					log.Error(err, "error checking if CRD is installed")
+					return false, err
+				}
+				log.V(logf.DebugLevel).Info("CRD found")
+				return true, nil
+			})
+			if err != nil {
+				log.Error(err, "error retrieving CRD")
+				return err
 			}
-			log.V(logf.DebugLevel).Info("certificates.cert-manager.io CRD found")
-			return true, nil
-		})
-		if err != nil {
-			log.Error(err, "error retrieving certificate.cert-manager.io CRDs")
-			return err
 		}
 	}
 
@@ -232,12 +244,18 @@ func Run(opts *config.CAInjectorConfiguration, ctx context.Context) error {
 		Namespace:                    opts.Namespace,
 		IgnoreNamespaces:             opts.IgnoreNamespaces,
 		EnableCertificatesDataSource: opts.EnableDataSourceConfig.Certificates,
+		EnableIssuersDataSource:      opts.EnableDataSourceConfig.Issuers,
+		ClusterResourceNamespace:     opts.ClusterResourceNamespace,
 		EnabledReconcilersFor: map[string]bool{
 			cainjector.MutatingWebhookConfigurationName:   opts.EnableInjectableConfig.MutatingWebhookConfigurations,
 			cainjector.ValidatingWebhookConfigurationName: opts.EnableInjectableConfig.ValidatingWebhookConfigurations,
 			cainjector.APIServiceName:                     opts.EnableInjectableConfig.APIServices,
 			cainjector.CustomResourceDefinitionName:       opts.EnableInjectableConfig.CustomResourceDefinitions,
 		},
+		CABundleRetention: cainjectorbundle.RetentionPolicy{
+			MaxCertificates: opts.CABundleRetention.MaxCertificates,
+			GracePeriod:     opts.CABundleRetention.GracePeriod,
+		},
 	}
 
 	err = cainjector.RegisterAllInjectors(ctx, mgr, setupOptions)
@@ -282,12 +300,24 @@ func buildMetricsServerOptions(opts *config.CAInjectorConfiguration, cs cmserver
 		if err != nil {
 			return nil, err
 		}
+		// Clients of the metrics server must present a certificate signed by
+		// the dynamic serving CA if client verification is enabled
+		var metricsClientCAs cmservertls.CertPoolSource
+		if opts.MetricsTLSConfig.Dynamic.VerifyClientCertificates {
+			var ok bool
+			if metricsClientCAs, ok = cs.(cmservertls.CertPoolSource); !ok {
+				return nil, fmt.Errorf("client certificate verification requires the metrics server to use a dynamic TLS config")
+			}
+		}
 		msOptions.SecureServing = true
 		msOptions.TLSOpts = []func(*tls.Config){
 			func(cfg *tls.Config) {
 				cfg.CipherSuites = metricsCipherSuites
 				cfg.MinVersion = metricsMinVersion
 				cfg.GetCertificate = cs.GetCertificate
+				if metricsClientCAs != nil {
+					cmservertls.RequireClientCertificates(cfg, metricsClientCAs)
+				}
 			},
 		}
 	}
@@ -68,6 +68,9 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.CAInjectorConfiguration) {
 	fs.StringSliceVar(&c.IgnoreNamespaces, "ignore-namespaces", c.IgnoreNamespaces, ""+
 		"Comma-separated list of namespaces to ignore secrets from. "+
 		"Should not be used with --namespace.")
+	fs.StringVar(&c.ClusterResourceNamespace, "cluster-resource-namespace", c.ClusterResourceNamespace, ""+
+		"Namespace to read resources owned by cluster scoped resources such as ClusterIssuer from. "+
+		"This should match the --cluster-resource-namespace of the cert-manager controller.")
 	fs.BoolVar(&c.LeaderElectionConfig.Enabled, "leader-elect", c.LeaderElectionConfig.Enabled, ""+
 		"If true, cainjector will perform leader election between instances to ensure no more "+
 		"than one instance of cainjector operates at a time")
@@ -91,6 +94,10 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.CAInjectorConfiguration) {
 		"Enable configuring cert-manager.io Certificate resources as potential sources for CA data. "+
 		"Requires cert-manager.io Certificate CRD to be installed. This data source can be disabled "+
 		"to reduce memory consumption if you only use cainjector as part of cert-manager's installation")
+	fs.BoolVar(&c.EnableDataSourceConfig.Issuers, "enable-issuers-data-source", c.EnableDataSourceConfig.Issuers, ""+
+		"Enable configuring cert-manager.io Issuer and ClusterIssuer resources as potential sources for CA data. "+
+		"Requires cert-manager.io Issuer, ClusterIssuer, Certificate and CertificateRequest CRDs to be installed. "+
+		"The CA can be read from CA, Vault, SelfSigned and Venafi issuers, but not from ACME issuers.")
 	fs.BoolVar(&c.EnableInjectableConfig.ValidatingWebhookConfigurations, "enable-validatingwebhookconfigurations-injectable", c.EnableInjectableConfig.ValidatingWebhookConfigurations, ""+
 		"Inject CA data to annotated ValidatingWebhookConfigurations. This functionality is required "+
 		"for cainjector to correctly function as cert-manager's internal component")
@@ -104,6 +111,13 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.CAInjectorConfiguration) {
 		"Inject CA data to annotated APIServices. This functionality is not required if cainjector is "+
 		"only used as cert-manager's internal component and setting it to false might reduce memory consumption")
 
+	fs.IntVar(&c.CABundleRetention.MaxCertificates, "ca-bundle-retention-max-certificates", c.CABundleRetention.MaxCertificates, ""+
+		"The maximum number of CA certificates in an injected CA bundle. CA certificates which were removed from the "+
+		"CA data source the longest time ago are dropped first. The value 0 means the number is not limited")
+	fs.DurationVar(&c.CABundleRetention.GracePeriod, "ca-bundle-retention-grace-period", c.CABundleRetention.GracePeriod, ""+
+		"How long a CA certificate is retained in an injected CA bundle after it has been removed from the CA data source. "+
+		"The value 0 means CA certificates are retained until they expire")
+
 	fs.BoolVar(&c.EnablePprof, "enable-profiling", c.EnablePprof, ""+
 		"Enable profiling for controller.")
 	fs.StringVar(&c.PprofAddress, "profiler-address", c.PprofAddress,
@@ -122,6 +136,7 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.CAInjectorConfiguration) {
 	fs.StringVar(&c.MetricsTLSConfig.Dynamic.SecretNamespace, "metrics-dynamic-serving-ca-secret-namespace", c.MetricsTLSConfig.Dynamic.SecretNamespace, "namespace of the secret used to store the CA that signs metrics serving certificates")
 	fs.StringVar(&c.MetricsTLSConfig.Dynamic.SecretName, "metrics-dynamic-serving-ca-secret-name", c.MetricsTLSConfig.Dynamic.SecretName, "name of the secret used to store the CA that signs serving certificates")
 	fs.StringSliceVar(&c.MetricsTLSConfig.Dynamic.DNSNames, "metrics-dynamic-serving-dns-names", c.MetricsTLSConfig.Dynamic.DNSNames, "DNS names that should be present on certificates generated by the metrics dynamic serving CA")
+	fs.BoolVar(&c.MetricsTLSConfig.Dynamic.VerifyClientCertificates, "metrics-dynamic-serving-verify-client-certificates", c.MetricsTLSConfig.Dynamic.VerifyClientCertificates, "require clients of the metrics server to present a certificate signed by the CA that signs metrics serving certificates")
 
 	tlsCipherPossibleValues := cliflag.TLSCipherPossibleValues()
 	fs.StringSliceVar(&c.MetricsTLSConfig.CipherSuites, "metrics-tls-cipher-suites", c.MetricsTLSConfig.CipherSuites,
@@ -109,9 +109,20 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 		log.V(logf.InfoLevel).Info("listening for insecure connections", "address", opts.MetricsListenAddress)
 	}
 
+	// Clients of the metrics server must present a certificate signed by the
+	// dynamic serving CA if client verification is enabled
+	var metricsClientCAs tls.CertPoolSource
+	if opts.MetricsTLSConfig.Dynamic.VerifyClientCertificates {
+		var ok bool
+		if metricsClientCAs, ok = certificateSource.(tls.CertPoolSource); !ok {
+			return fmt.Errorf("client certificate verification requires the metrics server to use a dynamic TLS config")
+		}
+	}
+
 	// Start metrics server
 	metricsLn, err := server.Listen(rootCtx, "tcp", opts.MetricsListenAddress,
 		server.WithCertificateSource(certificateSource),
+		server.WithClientCertificateVerification(metricsClientCAs),
 		server.WithTLSCipherSuites(opts.MetricsTLSConfig.CipherSuites),
 		server.WithTLSMinVersion(opts.MetricsTLSConfig.MinTLSVersion),
 	)
@@ -120,7 +131,14 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 	}
 
 	ctx.Metrics.SetupACMECollector(ctx.SharedInformerFactory.Acme().V1().Challenges().Lister())
-	ctx.Metrics.SetupCertificateCollector(ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister())
+	if err := ctx.Metrics.SetupCertificateCollector(
+		ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister(),
+		ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests().Informer(),
+		opts.EnableCertificateSerialMetric,
+	); err != nil {
+		return err
+	}
 	ctx.Metrics.SetupIssuerCollector(ctx.SharedInformerFactory.Certmanager().V1().Issuers().Lister())
 	if enabledControllers.Has(clusterissuerscontroller.ControllerName) {
 		ctx.Metrics.SetupClusterIssuerCollector(ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers().Lister())
@@ -375,12 +393,21 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			CopiedAnnotationPrefixes:                 opts.CopiedAnnotationPrefixes,
 			CertificateRequestMinimumBackoffDuration: opts.CertificateRequestMinimumBackoffDuration,
 			CertificateRequestMaximumBackoffDuration: opts.CertificateRequestMaximumBackoffDuration,
+			IssuanceRateLimitInterval:                opts.IssuanceRateLimitConfig.Interval,
+			IssuanceGlobalLimit:                      opts.IssuanceRateLimitConfig.GlobalLimit,
+			IssuancePerIssuerLimit:                   opts.IssuanceRateLimitConfig.PerIssuerLimit,
+			MaxRenewalJitter:                         opts.IssuanceRateLimitConfig.MaxRenewalJitter,
 		},
 
 		ConfigOptions: controller.ConfigOptions{
 			EnableGatewayAPI:            opts.GatewayAPIConfig.Enabled,
 			EnableGatewayAPIListenerSet: opts.GatewayAPIConfig.EnableListenerSet,
 		},
+
+		TLSSecretExpiryOptions: controller.TLSSecretExpiryOptions{
+			Thresholds: opts.TLSSecretExpiryConfig.Thresholds,
+			WebhookURL: opts.TLSSecretExpiryConfig.WebhookURL,
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -27,6 +27,7 @@ import (
 	configv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
 	shimgatewaycontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
 	listenersetcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/listenerset"
+	"github.com/cert-manager/cert-manager/pkg/controller/tlssecretexpiry"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/spf13/pflag"
@@ -205,6 +206,9 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 
 	fs.StringVar(&c.MetricsListenAddress, "metrics-listen-address", c.MetricsListenAddress, ""+
 		"The host and port that the metrics endpoint should listen on.")
+	fs.BoolVar(&c.EnableCertificateSerialMetric, "enable-certificate-serial-metric", c.EnableCertificateSerialMetric, ""+
+		"Expose the serial number of the certificate stored in the Secret of each Certificate as the certmanager_certificate_serial_info metric. "+
+		"The serial number changes on every renewal, so each renewal creates a new time series.")
 	fs.BoolVar(&c.EnablePprof, "enable-profiling", c.EnablePprof, ""+
 		"Enable profiling for controller.")
 	fs.StringVar(&c.PprofAddress, "profiler-address", c.PprofAddress,
@@ -217,6 +221,7 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 	fs.StringVar(&c.MetricsTLSConfig.Dynamic.SecretNamespace, "metrics-dynamic-serving-ca-secret-namespace", c.MetricsTLSConfig.Dynamic.SecretNamespace, "namespace of the secret used to store the CA that signs serving certificates")
 	fs.StringVar(&c.MetricsTLSConfig.Dynamic.SecretName, "metrics-dynamic-serving-ca-secret-name", c.MetricsTLSConfig.Dynamic.SecretName, "name of the secret used to store the CA that signs serving certificates")
 	fs.StringSliceVar(&c.MetricsTLSConfig.Dynamic.DNSNames, "metrics-dynamic-serving-dns-names", c.MetricsTLSConfig.Dynamic.DNSNames, "DNS names that should be present on certificates generated by the dynamic serving CA")
+	fs.BoolVar(&c.MetricsTLSConfig.Dynamic.VerifyClientCertificates, "metrics-dynamic-serving-verify-client-certificates", c.MetricsTLSConfig.Dynamic.VerifyClientCertificates, "require clients of the metrics server to present a certificate signed by the CA that signs serving certificates")
 	tlsCipherPossibleValues := cliflag.TLSCipherPossibleValues()
 	fs.StringSliceVar(&c.MetricsTLSConfig.CipherSuites, "metrics-tls-cipher-suites", c.MetricsTLSConfig.CipherSuites,
 		"Comma-separated list of cipher suites for the server. "+
@@ -264,6 +269,31 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"The backoff delay starts at the minimum backoff duration and is exponentially increased "+
 		"with each consecutive failure, but will never exceed this maximum (default 32h).")
 
+	fs.DurationVar(&c.IssuanceRateLimitConfig.Interval, "issuance-rate-limit-interval", c.IssuanceRateLimitConfig.Interval, ""+
+		"The period over which the issuance rate limits apply.")
+	fs.IntVar(&c.IssuanceRateLimitConfig.GlobalLimit, "issuance-rate-limit-global", c.IssuanceRateLimitConfig.GlobalLimit, ""+
+		"Maximum number of CertificateRequests created for Certificates across all issuers in each interval. "+
+		"Certificates waiting for the limit have their Issuing condition reason set to IssuanceRateLimited. "+
+		"0 disables the limit.")
+	fs.IntVar(&c.IssuanceRateLimitConfig.PerIssuerLimit, "issuance-rate-limit-per-issuer", c.IssuanceRateLimitConfig.PerIssuerLimit, ""+
+		"Maximum number of CertificateRequests created for Certificates referencing the same issuer in each interval. "+
+		"Certificates waiting for the limit have their Issuing condition reason set to IssuanceRateLimited. "+
+		"0 disables the limit.")
+	fs.DurationVar(&c.IssuanceRateLimitConfig.MaxRenewalJitter, "max-renewal-jitter", c.IssuanceRateLimitConfig.MaxRenewalJitter, ""+
+		"Maximum duration by which the renewal time of a Certificate is brought forward, so that Certificates issued "+
+		"at the same time are not all renewed at the same time. The jitter of each Certificate is derived from its "+
+		"namespace and name. 0 disables jitter.")
+
+	fs.DurationSliceVar(&c.TLSSecretExpiryConfig.Thresholds, "tls-secret-expiry-thresholds", c.TLSSecretExpiryConfig.Thresholds, ""+
+		"Durations before the expiry of a certificate in a kubernetes.io/tls Secret, which is not managed by a Certificate, "+
+		"at which a Warning Event is fired and the notification webhook is called. "+
+		"When the SecretsFilteredCaching feature is enabled, only Secrets labelled with "+
+		"controller.cert-manager.io/fao=true are observed. "+
+		"Only used when the "+tlssecretexpiry.ControllerName+" controller is enabled.")
+	fs.StringVar(&c.TLSSecretExpiryConfig.WebhookURL, "tls-secret-expiry-webhook-url", c.TLSSecretExpiryConfig.WebhookURL, ""+
+		"Optional http or https URL to which a JSON notification is POSTed each time a kubernetes.io/tls Secret crosses "+
+		"an expiry threshold. Only used when the "+tlssecretexpiry.ControllerName+" controller is enabled.")
+
 	logf.AddFlags(&c.Logging, fs)
 }
 
@@ -287,11 +317,6 @@ func EnabledControllers(o *config.ControllerConfiguration) sets.Set[string] {
 		enabled = enabled.Insert(defaults.DefaultEnabledControllers...)
 	}
 
-	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalCertificateSigningRequestControllers) {
-		logf.Log.Info("enabling all experimental certificatesigningrequest controllers")
-		enabled = enabled.Insert(defaults.ExperimentalCertificateSigningRequestControllers...)
-	}
-
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) && o.GatewayAPIConfig.Enabled {
 		logf.Log.Info("enabling the sig-network Gateway API certificate-shim and HTTP-01 solver")
 		enabled = enabled.Insert(shimgatewaycontroller.ControllerName)
@@ -306,6 +331,10 @@ func EnabledControllers(o *config.ControllerConfiguration) sets.Set[string] {
 		logf.Log.Info("the ValidateCAA feature flag has been removed and is now a no-op")
 	}
 
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalCertificateSigningRequestControllers) {
+		logf.Log.Info("the ExperimentalCertificateSigningRequestControllers feature flag has been removed and is now a no-op, the certificatesigningrequest controllers are enabled by default")
+	}
+
 	// If running namespaced, remove all cluster-scoped controllers.
 	if o.Namespace != "" {
 		logf.Log.Info("disabling all cluster-scoped controllers as cert-manager is scoped to a single namespace",
@@ -86,3 +86,23 @@ func TestEnabledControllers(t *testing.T) {
 		})
 	}
 }
+
+func TestEnabledControllersCertificateSigningRequests(t *testing.T) {
+	csrControllers := []string{
+		"certificatesigningrequests-issuer-acme",
+		"certificatesigningrequests-issuer-ca",
+		"certificatesigningrequests-issuer-selfsigned",
+		"certificatesigningrequests-issuer-vault",
+		"certificatesigningrequests-issuer-venafi",
+	}
+
+	got := EnabledControllers(&config.ControllerConfiguration{Controllers: []string{"*"}})
+	if !got.HasAll(csrControllers...) {
+		t.Errorf("expected certificatesigningrequest controllers to be enabled by default, got=%v", sets.List(got))
+	}
+
+	got = EnabledControllers(&config.ControllerConfiguration{Controllers: []string{"*", "-certificatesigningrequests-issuer-acme"}})
+	if got.Has("certificatesigningrequests-issuer-acme") || !got.HasAll(csrControllers[1:]...) {
+		t.Errorf("expected only the acme certificatesigningrequest controller to be disabled, got=%v", sets.List(got))
+	}
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package app
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
 	"github.com/cert-manager/cert-manager/internal/apis/config/webhook/validation"
@@ -34,6 +36,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/webhook/options"
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 const componentWebhook = "webhook"
@@ -41,7 +44,7 @@ const componentWebhook = "webhook"
 func NewServerCommand(ctx context.Context) *cobra.Command {
 	return newServerCommand(
 		ctx,
-		func(ctx context.Context, webhookConfig *config.WebhookConfiguration) error {
+		func(ctx context.Context, webhookConfig *config.WebhookConfiguration, loader *configLoader) error {
 			log := logf.FromContext(ctx, componentWebhook)
 
 			versionInfo := util.VersionInfo()
@@ -51,11 +54,20 @@ func NewServerCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("failed to configure PEM size limits: %w", err)
 			}
 
-			srv, err := cmwebhook.NewCertManagerWebhookServer(log, *webhookConfig)
+			srv, reloader, err := cmwebhook.NewReloadableCertManagerWebhookServer(log, *webhookConfig)
 			if err != nil {
 				return err
 			}
 
+			if loader != nil {
+				go loader.watch(ctx, log, configFileReloadInterval, func(webhookConfig *config.WebhookConfiguration) error {
+					if err := reloader.Reload(*webhookConfig); err != nil {
+						return err
+					}
+					return configurePEMSizeLimits(webhookConfig, log)
+				})
+			}
+
 			return srv.Run(ctx)
 		},
 		os.Args[1:],
@@ -64,7 +76,7 @@ func NewServerCommand(ctx context.Context) *cobra.Command {
 
 func newServerCommand(
 	setupCtx context.Context,
-	run func(context.Context, *config.WebhookConfiguration) error,
+	run func(context.Context, *config.WebhookConfiguration, *configLoader) error,
 	allArgs []string,
 ) *cobra.Command {
 	log := logf.FromContext(setupCtx, componentWebhook)
@@ -119,7 +131,15 @@ functionality for cert-manager.`,
 		},
 		//nolint:contextcheck // False positive
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return run(cmd.Context(), webhookConfig)
+			var loader *configLoader
+			if len(webhookFlags.Config) > 0 {
+				path, err := filepath.Abs(webhookFlags.Config)
+				if err != nil {
+					return fmt.Errorf("failed to load config file %s, error %v", webhookFlags.Config, err)
+				}
+				loader = &configLoader{cmd: cmd, allArgs: allArgs, path: path}
+			}
+			return run(cmd.Context(), webhookConfig, loader)
 		},
 	}
 
@@ -159,17 +179,12 @@ func loadConfigFromFile(
 			return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
 		}
 
-		loader, err := configfile.NewConfigurationFSLoader(nil, webhookConfigFile)
+		webhookConfigFromFile, err := readConfigFile(webhookConfigFile)
 		if err != nil {
-			return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
-		}
-
-		webhookConfigFromFile := webhookconfigfile.New()
-		if err := loader.Load(webhookConfigFromFile); err != nil {
-			return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
+			return err
 		}
 
-		webhookConfigFromFile.Config.DeepCopyInto(cfg)
+		webhookConfigFromFile.DeepCopyInto(cfg)
 
 		_, args, err := cmd.Root().Find(allArgs)
 		if err != nil {
@@ -188,6 +203,111 @@ func loadConfigFromFile(
 	return nil
 }
 
+// readConfigFile reads the configuration from the config file at the
+// provided absolute path.
+func readConfigFile(path string) (*config.WebhookConfiguration, error) {
+	loader, err := configfile.NewConfigurationFSLoader(nil, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config file %s, error %v", path, err)
+	}
+
+	webhookConfigFromFile := webhookconfigfile.New()
+	if err := loader.Load(webhookConfigFromFile); err != nil {
+		return nil, fmt.Errorf("failed to load config file %s, error %v", path, err)
+	}
+
+	return webhookConfigFromFile.Config, nil
+}
+
+// configFileReloadInterval is the interval at which the config file is
+// checked for changes.
+const configFileReloadInterval = 10 * time.Second
+
+// configLoader reloads the configuration when the config file changes.
+type configLoader struct {
+	cmd     *cobra.Command
+	allArgs []string
+	// path is the absolute path of the config file.
+	path string
+}
+
+// load loads the configuration in the same way as on startup, but into a new
+// configuration: the defaults are overridden by the config file, which is
+// overridden by the flags.
+func (l *configLoader) load() (*config.WebhookConfiguration, error) {
+	webhookConfig, err := options.NewWebhookConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	fs := pflag.NewFlagSet(componentWebhook, pflag.ContinueOnError)
+	options.NewWebhookFlags().AddFlags(fs)
+	options.AddConfigFlags(fs, webhookConfig)
+
+	webhookConfigFromFile, err := readConfigFile(l.path)
+	if err != nil {
+		return nil, err
+	}
+	webhookConfigFromFile.DeepCopyInto(webhookConfig)
+
+	_, args, err := l.cmd.Root().Find(l.allArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-parse flags: %w", err)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("failed to re-parse flags: %w", err)
+	}
+
+	if err := validation.ValidateWebhookConfiguration(webhookConfig, nil); len(err) > 0 {
+		return nil, fmt.Errorf("error validating config file: %w", err.ToAggregate())
+	}
+
+	return webhookConfig, nil
+}
+
+// watch polls the config file for changes until the context is done. When
+// the config file changes, the configuration is reloaded and passed to apply.
+// If the configuration cannot be loaded or applied, the error is logged and
+// the current configuration remains in use.
+func (l *configLoader) watch(ctx context.Context, log logr.Logger, interval time.Duration, apply func(*config.WebhookConfiguration) error) {
+	log = log.WithValues("path", l.path)
+	lastData, err := os.ReadFile(l.path)
+	if err != nil {
+		log.Error(err, "failed to read config file")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		data, err := os.ReadFile(l.path)
+		if err != nil {
+			log.Error(err, "failed to read config file")
+			continue
+		}
+		if bytes.Equal(data, lastData) {
+			continue
+		}
+		lastData = data
+
+		webhookConfig, err := l.load()
+		if err != nil {
+			log.Error(err, "failed to reload config file, continuing with the current configuration")
+			continue
+		}
+		if err := apply(webhookConfig); err != nil {
+			log.Error(err, "failed to apply reloaded configuration, continuing with the current configuration")
+			continue
+		}
+		log.V(logf.InfoLevel).Info("reloaded configuration from config file")
+	}
+}
+
 func configurePEMSizeLimits(cfg *config.WebhookConfiguration, log logr.Logger) error {
 	if cfg == nil {
 		return fmt.Errorf("webhook configuration is nil")
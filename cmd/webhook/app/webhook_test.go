@@ -24,6 +24,7 @@ import (
 	"path"
 	"reflect"
 	"testing"
+	"time"
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
 	"github.com/cert-manager/cert-manager/internal/pem"
@@ -55,7 +56,7 @@ func testCmdCommand(t *testing.T, tempDir string, yaml string, args func(string)
 		t.Error(err)
 	}
 
-	cmd := newServerCommand(t.Context(), func(ctx context.Context, cc *config.WebhookConfiguration) error {
+	cmd := newServerCommand(t.Context(), func(ctx context.Context, cc *config.WebhookConfiguration, _ *configLoader) error {
 		finalConfig = cc
 		return nil
 	}, args(tempFilePath))
@@ -293,3 +294,69 @@ func TestConfigurePEMSizeLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigLoaderWatch(t *testing.T) {
+	configFile := path.Join(t.TempDir(), "config.yaml")
+	writeConfig := func(yaml string) {
+		if err := os.WriteFile(configFile, []byte(yaml), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeConfig(`
+apiVersion: webhook.config.cert-manager.io/v1alpha1
+kind: WebhookConfiguration
+kubeConfig: valid
+`)
+
+	if err := logsapi.ResetForTest(nil); err != nil {
+		t.Error(err)
+	}
+
+	var loader *configLoader
+	cmd := newServerCommand(t.Context(), func(ctx context.Context, cc *config.WebhookConfiguration, l *configLoader) error {
+		loader = l
+		return nil
+	}, []string{"--config=" + configFile, "--tls-cert-file=cccc", "--tls-private-key-file=bbbb"})
+	cmd.SetErr(io.Discard)
+	cmd.SetOut(io.Discard)
+	if err := cmd.ExecuteContext(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if loader == nil {
+		t.Fatal("expected a config loader when a config file is provided")
+	}
+
+	reloaded := make(chan *config.WebhookConfiguration)
+	go loader.watch(t.Context(), logr.Discard(), 10*time.Millisecond, func(cc *config.WebhookConfiguration) error {
+		reloaded <- cc
+		return nil
+	})
+
+	// Wait for the watch to read the initial config file before changing it.
+	time.Sleep(50 * time.Millisecond)
+	writeConfig(`
+apiVersion: webhook.config.cert-manager.io/v1alpha1
+kind: WebhookConfiguration
+tlsConfig:
+    filesystem:
+        keyFile: aaaa
+admissionPlugins:
+    CELValidation: false
+`)
+
+	select {
+	case cc := <-reloaded:
+		// The flags override the config file when it is reloaded.
+		if cc.TLSConfig.Filesystem.KeyFile != "bbbb" {
+			t.Errorf("expected the flag to override the config file, got key file %q", cc.TLSConfig.Filesystem.KeyFile)
+		}
+		if cc.KubeConfig != "" {
+			t.Errorf("expected the kubeconfig to be removed, got %q", cc.KubeConfig)
+		}
+		if !reflect.DeepEqual(cc.AdmissionPlugins, map[string]bool{"CELValidation": false}) {
+			t.Errorf("unexpected admission plugins %v", cc.AdmissionPlugins)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the configuration to be reloaded")
+	}
+}
@@ -80,6 +80,11 @@ type OrderSpec struct {
 	// +optional
 	Profile string `json:"profile,omitempty"`
 
+	// PreferredChain is the chain to use if the ACME server outputs multiple,
+	// overriding the preferredChain of the ACME issuer.
+	// +optional
+	PreferredChain string `json:"preferredChain,omitempty"`
+
 	// Replaces is the ARI CertID (RFC 9773 §4.1) of the certificate that
 	// this Order is intended to replace.
 	// +optional
@@ -58,6 +58,26 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []any {
 				s.Spec.Duration = &metav1.Duration{Duration: v1.DefaultCertificateDuration}
 			}
 		},
+		func(s *certmanager.SSHCertificate, c randfill.Continue) {
+			c.FillNoCustom(s) // fuzz self without calling this function again
+
+			if s.Spec.IssuerRef.Group == "" {
+				s.Spec.IssuerRef.Group = "cert-manager.io"
+			}
+			if s.Spec.IssuerRef.Kind == "" {
+				s.Spec.IssuerRef.Kind = v1.IssuerKind
+			}
+		},
+		func(s *certmanager.SSHCertificateRequest, c randfill.Continue) {
+			c.FillNoCustom(s) // fuzz self without calling this function again
+
+			if s.Spec.IssuerRef.Group == "" {
+				s.Spec.IssuerRef.Group = "cert-manager.io"
+			}
+			if s.Spec.IssuerRef.Kind == "" {
+				s.Spec.IssuerRef.Kind = v1.IssuerKind
+			}
+		},
 		func(s *certmanager.VaultAuth, c randfill.Continue) {
 			// VaultAuth is a union type - only one auth method should be set.
 			// Pick one auth method randomly and only fuzz that one.
@@ -47,6 +47,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterIssuerList{},
 		&CertificateRequest{},
 		&CertificateRequestList{},
+		&SSHCertificate{},
+		&SSHCertificateList{},
+		&SSHCertificateRequest{},
+		&SSHCertificateRequestList{},
+		&CertificateRequestPolicy{},
+		&CertificateRequestPolicyList{},
+		&CertificateDefaultPolicy{},
+		&CertificateDefaultPolicyList{},
 	)
 	return nil
 }
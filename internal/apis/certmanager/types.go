@@ -115,6 +115,40 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// SecretDriftPolicyAnnotationKey is an annotation that can be added to
+	// Certificate resources to configure how cert-manager responds when the
+	// Certificate's Secret is modified outside of cert-manager.
+	// A Warning Event and metric are always recorded when drift is detected.
+	// If set to `AlertOnly`, the Certificate will not be re-issued to correct
+	// the drift. If unset or set to `Reissue`, the Certificate is re-issued.
+	SecretDriftPolicyAnnotationKey = "cert-manager.io/secret-drift-policy"
+
+	// AdoptSecretAnnotationKey is an annotation that can be added to
+	// Certificate resources which have not yet been issued. If set to `true`
+	// and the Certificate's Secret already contains a valid certificate and
+	// private key which match the Certificate's spec, the Secret is adopted
+	// as revision 1 of the Certificate rather than a new certificate being
+	// issued. The adopted certificate is renewed at its normal renewal time.
+	AdoptSecretAnnotationKey = "cert-manager.io/adopt-secret"
+
+	// AllowSecretReplicasFromAnnotationKey is an annotation that must be set
+	// on a Namespace for Certificates in other namespaces to replicate their
+	// Secret into it using `spec.secretReplicas`. The value is a comma
+	// separated list of the namespaces that replicas are accepted from, or
+	// `*` to accept replicas from all namespaces.
+	AllowSecretReplicasFromAnnotationKey = "cert-manager.io/allow-secret-replicas-from"
+
+	// SecretReplicaOfAnnotationKey is set on Secrets which are replicas of a
+	// Certificate's Secret. The value is the namespace and name of the
+	// Certificate, in the form `<namespace>/<name>`.
+	SecretReplicaOfAnnotationKey = "cert-manager.io/secret-replica-of"
+)
+
+// Values of the SecretDriftPolicyAnnotationKey annotation.
+const (
+	SecretDriftPolicyReissue   = "Reissue"
+	SecretDriftPolicyAlertOnly = "AlertOnly"
 )
 
 // Common/known resource kinds.
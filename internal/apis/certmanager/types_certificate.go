@@ -87,6 +87,26 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
+type CertificateProfile string
+
+const (
+	// ServerTLSCertificateProfile is for TLS server certificates.
+	ServerTLSCertificateProfile CertificateProfile = "serverTLS"
+
+	// ClientTLSCertificateProfile is for TLS client certificates.
+	ClientTLSCertificateProfile CertificateProfile = "clientTLS"
+
+	// CodeSigningCertificateProfile is for code signing certificates.
+	CodeSigningCertificateProfile CertificateProfile = "codeSigning"
+
+	// SMIMECertificateProfile is for S/MIME certificates.
+	SMIMECertificateProfile CertificateProfile = "smime"
+
+	// OCSPSigningCertificateProfile is for delegated OCSP responder
+	// certificates.
+	OCSPSigningCertificateProfile CertificateProfile = "ocspSigning"
+)
+
 // CertificateSpec defines the desired state of Certificate.
 //
 // NOTE: The specification contains a lot of "requested" certificate attributes, it is
@@ -207,6 +227,42 @@ type CertificateSpec struct {
 	// cert-manager sets on the Certificate's Secret.
 	SecretTemplate *CertificateSecretTemplate
 
+	// Defines other namespaces that the Certificate's Secret will be
+	// replicated to. Replicas have the same name, data, labels and annotations
+	// as the Certificate's Secret, and are only written to namespaces which
+	// have opted in to receiving replicas from the Certificate's namespace
+	// using the `cert-manager.io/allow-secret-replicas-from` annotation.
+	SecretReplicas *CertificateSecretReplicas
+
+	// Defines a ConfigMap that the CA certificate stored in the `ca.crt` key
+	// of the Certificate's Secret, and optionally the certificate stored in
+	// `tls.crt`, is published to. This allows clients which only need to
+	// trust the certificate to do so without read access to the Secret,
+	// which also contains the private key. The ConfigMap lives in the same
+	// namespace as the Certificate resource.
+	TrustConfigMap *CertificateTrustConfigMap
+
+	// Requests a manual renewal of the certificate. Setting this field, or
+	// changing its `id`, causes the certificate to be re-issued once. The
+	// request, including the user who made it, is recorded in
+	// `status.lastRenewalRequest` and surfaced as an Event once it has been
+	// handled, so that manual renewals are auditable.
+	RenewalRequest *CertificateRenewalRequest
+
+	// Configures the certificate to be requested using a PEM encoded
+	// certificate signing request (CSR) that was created outside of
+	// cert-manager, for example by a hardware security module (HSM) which
+	// holds the private key. When set, cert-manager does not generate a
+	// private key. CertificateRequests are created from the referenced CSR,
+	// and the Certificate's Secret only contains the issued certificate
+	// (`tls.crt`) and CA (`ca.crt`), with an empty `tls.key`.
+	//
+	// The CSR must match the rest of the Certificate's spec. Replacing the CSR,
+	// for example after rotating the private key, triggers a re-issuance.
+	// This field cannot be combined with `privateKey`, `keystores` or
+	// `additionalOutputFormats`.
+	ExternalCSR *CertificateExternalCSR
+
 	// Additional keystore output formats to be stored in the Certificate's Secret.
 	Keystores *CertificateKeystores
 
@@ -232,9 +288,23 @@ type CertificateSpec struct {
 	// resources. If `encodeUsagesInRequest` is unset or set to `true`, the usages
 	// will additionally be encoded in the `request` field which contains the CSR blob.
 	//
-	// If unset, defaults to `digital signature` and `key encipherment`.
+	// If unset, defaults to the usages of `profile` if set, or otherwise to
+	// `digital signature` and `key encipherment`.
 	Usages []KeyUsage
 
+	// Profile is a named certificate profile which sets sane defaults for, and
+	// validates, the Certificate's usages, private key size and extensions.
+	// Allowed values are `serverTLS`, `clientTLS`, `codeSigning`, `smime` and
+	// `ocspSigning`.
+	//
+	// If `usages` is unset, the profile's default usages are requested.
+	// If `usages` is set, it must contain the profile's extended key usage and
+	// must not contain extended key usages which the profile does not allow.
+	// The profile may also require other fields to be set, for example the
+	// `smime` profile requires `emailAddresses`. Certificates using a profile
+	// cannot be CAs.
+	Profile CertificateProfile
+
 	// Private key options. These include the key algorithm and size, the used
 	// encoding and the rotation policy.
 	PrivateKey *CertificatePrivateKey
@@ -327,6 +397,18 @@ type CertificatePrivateKey struct {
 	// If `algorithm` is set to `Ed25519`, Size is ignored.
 	// No other values are allowed.
 	Size int
+
+	// RotationOverlap enables staged private key rotation. When set, and a
+	// re-issuance results in a new private key, the new certificate and
+	// private key are first published to the `next.crt` and `next.key`
+	// entries of the Certificate's Secret, alongside the current
+	// certificate and private key. They are promoted to `tls.crt` and
+	// `tls.key` once the overlap period has passed, or when the current
+	// certificate expires if that happens earlier. This gives peers which
+	// pin or pre-load keys time to learn the next key before it is used.
+	// The Certificate remains `Issuing` until the new key is promoted.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	RotationOverlap *metav1.Duration
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -408,6 +490,21 @@ type CertificateKeystores struct {
 	// PKCS12 configures options for storing a PKCS12 keystore in the
 	// `spec.secretName` Secret resource.
 	PKCS12 *PKCS12Keystore
+
+	// PEMTruststore configures options for storing a PEM encoded truststore
+	// in the `spec.secretName` Secret resource.
+	PEMTruststore *PEMTruststore
+}
+
+// PEMTruststore configures options for storing the CA chain of the
+// certificate as a PEM bundle in the target secret.
+type PEMTruststore struct {
+	// Create enables PEM truststore creation for the Certificate.
+	// If true, a file named `truststore.pem` will be created in the target
+	// Secret resource, containing the intermediate certificates of the chain
+	// and the issuing Certificate Authority, if provided by the issuer.
+	// The leaf certificate and private key are not included.
+	Create bool
 }
 
 // JKS configures options for storing a JKS keystore in the target secret.
@@ -474,6 +571,21 @@ type PKCS12Keystore struct {
 	// or with Java using compatible versions of Bouncy Castle. Meets FIPS 140-3 requirements.
 	Profile PKCS12Profile
 
+	// Alias specifies the friendly name of the private key entry, and of its
+	// certificate, in the keystore. If not provided, no friendly name is set.
+	// Can only be set when `profile` is `Modern2023`.
+	// +optional
+	Alias *string
+
+	// KeyPasswordSecretRef is a reference to a non-empty key in a Secret
+	// resource containing the password used to encrypt the private key entry
+	// of the PKCS#12 keystore, for consumers which require the private key
+	// entry to have its own password. If not provided, the private key entry
+	// is encrypted using the keystore password.
+	// Can only be set when `profile` is `Modern2023`.
+	// +optional
+	KeyPasswordSecretRef *cmmeta.SecretKeySelector
+
 	// containing the password used to encrypt the PKCS#12 keystore.
 	// Mutually exclusive with password.
 	// One of password or passwordSecretRef must provide a password with a non-zero length.
@@ -581,6 +693,12 @@ type CertificateStatus struct {
 	// not set or False.
 	NextPrivateKeySecretName *string
 
+	// The time at which the next certificate and private key were published
+	// to the `next.crt` and `next.key` entries of the Secret as part of a
+	// staged private key rotation. It is unset once they are promoted to
+	// `tls.crt` and `tls.key`.
+	NextPrivateKeyPublishedTime *metav1.Time
+
 	// The number of continuous failed issuance attempts up till now. This
 	// field gets removed (if set) on a successful issuance and gets set to
 	// 1 if unset and an issuance has failed. If an issuance has failed, the
@@ -588,6 +706,10 @@ type CertificateStatus struct {
 	// time.Hour * 2 ^ (failedIssuanceAttempts - 1).
 	FailedIssuanceAttempts *int
 
+	// The last renewal request from `spec.renewalRequest` which has been
+	// handled by triggering an issuance.
+	LastRenewalRequest *CertificateRenewalRequestStatus
+
 	// ACME stores information that is fetched from the ACME CA server.
 	// +optional
 	ACME *CertificateACMEStatus
@@ -650,6 +772,12 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// A condition added to Certificate resources by the 'issuing' controller
+	// when the ConfigMap named in `spec.trustConfigMap` already exists and is
+	// not managed by the Certificate, in which case the ConfigMap is not
+	// updated. It is removed once the ConfigMap can be managed.
+	CertificateConditionTrustConfigMapConflict CertificateConditionType = "TrustConfigMapConflict"
 )
 
 // CertificateSecretTemplate defines the default labels and annotations
@@ -664,6 +792,87 @@ type CertificateSecretTemplate struct {
 	Labels map[string]string
 }
 
+// CertificateSecretReplicas defines the namespaces that the Kubernetes Secret
+// resource named in `CertificateSpec.secretName` is replicated to.
+type CertificateSecretReplicas struct {
+	// Namespaces is a list of namespaces to replicate the Secret to.
+	// +optional
+	Namespaces []string
+
+	// NamespaceSelector selects namespaces to replicate the Secret to, in
+	// addition to those listed in `namespaces`.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector
+}
+
+// CertificateTrustConfigMap defines the ConfigMap that the CA certificate of
+// the Certificate's Secret is published to.
+type CertificateTrustConfigMap struct {
+	// Name of the ConfigMap resource that will be automatically created and
+	// managed by this Certificate resource.
+	Name string
+
+	// IncludeCertificate, if true, additionally publishes the certificate
+	// stored in the `tls.crt` key of the Certificate's Secret to the
+	// ConfigMap.
+	IncludeCertificate bool
+}
+
+// CertificateExternalCSR references a certificate signing request stored in a
+// Secret or ConfigMap in the same namespace as the Certificate.
+type CertificateExternalCSR struct {
+	// Kind of the resource containing the CSR, either `Secret` or
+	// `ConfigMap`. Defaults to `Secret`.
+	Kind string
+
+	// Name of the resource containing the CSR.
+	Name string
+
+	// Key of the entry containing the PEM encoded CSR. Defaults to `tls.csr`.
+	Key string
+}
+
+// CertificateRenewalRequest is a request to manually renew a certificate.
+type CertificateRenewalRequest struct {
+	// ID uniquely identifies the renewal request. A new issuance is only
+	// triggered when the ID differs from the ID of the last handled request,
+	// so the ID must be changed to request another renewal.
+	ID string
+
+	// Reason is a human readable explanation of why the renewal was
+	// requested, for example a reference to an incident.
+	Reason string
+
+	// RotatePrivateKey, if true, forces a new private key to be generated
+	// for the renewed certificate, regardless of the
+	// `spec.privateKey.rotationPolicy` of the Certificate.
+	RotatePrivateKey bool
+
+	// RequestedBy is the name of the user who requested the renewal. It is
+	// set by the cert-manager webhook and cannot be set by the user.
+	RequestedBy string
+}
+
+// CertificateRenewalRequestStatus records a renewal request which has been
+// handled.
+type CertificateRenewalRequestStatus struct {
+	// ID of the handled renewal request.
+	ID string
+
+	// Reason given for the handled renewal request.
+	Reason string
+
+	// RequestedBy is the name of the user who requested the renewal.
+	RequestedBy string
+
+	// RotatePrivateKey is true if a new private key was requested.
+	RotatePrivateKey bool
+
+	// HandledTime is the time at which the renewal request was handled by
+	// triggering an issuance.
+	HandledTime *metav1.Time
+}
+
 // NameConstraints is a type to represent x509 NameConstraints
 type NameConstraints struct {
 	// if true then the name constraints are marked critical.
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CertificateDefaultPolicy sets default values on Certificates created in
+// the selected namespaces. Defaults are applied by the cert-manager webhook
+// when a Certificate is created, and only to fields which the Certificate
+// does not set.
+//
+// If multiple CertificateDefaultPolicies select a namespace, they are applied
+// in order of their name, so the first policy which sets a default for a
+// field wins.
+type CertificateDefaultPolicy struct {
+	metav1.TypeMeta
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta
+
+	// Specification of the desired state of the CertificateDefaultPolicy.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec CertificateDefaultPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateDefaultPolicyList is a list of CertificateDefaultPolicies.
+type CertificateDefaultPolicyList struct {
+	metav1.TypeMeta
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	metav1.ListMeta
+
+	// List of CertificateDefaultPolicies
+	Items []CertificateDefaultPolicy
+}
+
+// CertificateDefaultPolicySpec defines the desired state of
+// CertificateDefaultPolicy.
+type CertificateDefaultPolicySpec struct {
+	// NamespaceSelector selects the namespaces whose Certificates are
+	// defaulted by this policy. If unset, Certificates in all namespaces are
+	// defaulted.
+	NamespaceSelector *metav1.LabelSelector
+
+	// PrivateKey sets the defaults for the Certificate's private key options.
+	// Each option is only defaulted if the Certificate does not set it. The
+	// size is only defaulted if the Certificate's private key algorithm is the
+	// same as the default algorithm.
+	// Private key options are not defaulted for Certificates which set
+	// `externalCSR`.
+	PrivateKey *CertificatePrivateKey
+
+	// Duration is the default requested 'duration' (i.e. lifetime) of
+	// Certificates. It is not defaulted for Certificates whose `renewBefore`
+	// is not less than it.
+	Duration *metav1.Duration
+
+	// RenewBeforePercentage is the default `renewBeforePercentage` of
+	// Certificates. It is only defaulted for Certificates which set neither
+	// `renewBefore` nor `renewBeforePercentage`.
+	RenewBeforePercentage *int32
+
+	// SecretTemplate sets the default labels and annotations copied to the
+	// Certificate's Secret. Labels and annotations are merged with those of
+	// the Certificate's `secretTemplate`, which take precedence.
+	SecretTemplate *CertificateSecretTemplate
+
+	// RevisionHistoryLimit is the default `revisionHistoryLimit` of
+	// Certificates.
+	RevisionHistoryLimit *int32
+}
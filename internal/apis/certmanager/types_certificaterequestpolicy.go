@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CertificateRequestPolicy restricts which CertificateRequests referencing
+// the selected issuers are approved by cert-manager's built-in approver.
+//
+// A CertificateRequest referencing an issuer which is selected by at least
+// one CertificateRequestPolicy is approved if it is permitted by one of them,
+// and denied otherwise. CertificateRequests referencing issuers which are not
+// selected by any CertificateRequestPolicy are approved unconditionally.
+//
+// SSHCertificateRequests are approved and denied in the same way, and are
+// only permitted by policies which set `allowed.ssh`.
+type CertificateRequestPolicy struct {
+	metav1.TypeMeta
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta
+
+	// Specification of the desired state of the CertificateRequestPolicy.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec CertificateRequestPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateRequestPolicyList is a list of CertificateRequestPolicies.
+type CertificateRequestPolicyList struct {
+	metav1.TypeMeta
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	metav1.ListMeta
+
+	// List of CertificateRequestPolicies
+	Items []CertificateRequestPolicy
+}
+
+// CertificateRequestPolicySpec defines the desired state of
+// CertificateRequestPolicy.
+type CertificateRequestPolicySpec struct {
+	// IssuerRefs selects the issuers whose CertificateRequests are governed by
+	// this policy. At least one must be specified.
+	IssuerRefs []CertificateRequestPolicyIssuerRef
+
+	// NamespaceSelector restricts the namespaces CertificateRequests permitted
+	// by this policy can be created in. If unset, CertificateRequests in any
+	// namespace are permitted.
+	NamespaceSelector *metav1.LabelSelector
+
+	// Requesters restricts the users and groups that can create
+	// CertificateRequests permitted by this policy. If unset, CertificateRequests
+	// created by anyone are permitted.
+	Requesters *CertificateRequestPolicyRequesters
+
+	// Allowed are the attributes that CertificateRequests permitted by this
+	// policy may request.
+	Allowed CertificateRequestPolicyAllowed
+}
+
+// CertificateRequestPolicyIssuerRef selects issuers by name, kind and group.
+// Each field may contain `*` wildcards, and an empty field matches any value.
+type CertificateRequestPolicyIssuerRef struct {
+	// Name of the selected issuers.
+	Name string
+
+	// Kind of the selected issuers, matched against the defaulted kind
+	// (`Issuer`) of the CertificateRequest's issuerRef.
+	Kind string
+
+	// Group of the selected issuers, matched against the defaulted group
+	// (`cert-manager.io`) of the CertificateRequest's issuerRef.
+	Group string
+}
+
+// CertificateRequestPolicyRequesters restricts the users and groups that can
+// create CertificateRequests. A CertificateRequest matches if it was created
+// by one of the users or by a member of one of the groups.
+type CertificateRequestPolicyRequesters struct {
+	// Usernames which may create CertificateRequests. Entries may contain `*`
+	// wildcards, e.g: "system:serviceaccount:my-namespace:*".
+	Usernames []string
+
+	// Groups whose members may create CertificateRequests.
+	Groups []string
+}
+
+// CertificateRequestPolicyAllowed are the attributes a CertificateRequest may
+// request. Entries of the `commonNames`, `dnsNames`, `uris` and
+// `emailAddresses` lists may contain `*` wildcards, which match any sequence
+// of characters. Unless stated otherwise, a CertificateRequest may not
+// request an attribute whose list is empty. Subject alternative names other
+// than DNS names, IP addresses, URIs and email addresses, such as otherName
+// SANs, may never be requested.
+type CertificateRequestPolicyAllowed struct {
+	// CommonNames which may be requested.
+	CommonNames []string
+
+	// Subject are the subject attributes, other than the common name, which
+	// may be requested.
+	Subject *CertificateRequestPolicyAllowedSubject
+
+	// DNSNames which may be requested, e.g: "*.example.com".
+	DNSNames []string
+
+	// IPRanges are the CIDR ranges of the IP addresses which may be
+	// requested, e.g: "10.0.0.0/8".
+	IPRanges []string
+
+	// URIs which may be requested, e.g: "spiffe://example.com/ns/*".
+	URIs []string
+
+	// EmailAddresses which may be requested, e.g: "*@example.com".
+	EmailAddresses []string
+
+	// Usages which may be requested. CertificateRequests which do not request
+	// any usages request `digital signature` and `key encipherment`.
+	Usages []KeyUsage
+
+	// KeyAlgorithms are the private key algorithms which may be requested.
+	// If unset, any private key algorithm may be requested.
+	KeyAlgorithms []PrivateKeyAlgorithm
+
+	// MaxDuration is the longest duration which may be requested.
+	// CertificateRequests which do not request a duration request 90 days.
+	// If unset, any duration may be requested.
+	MaxDuration *metav1.Duration
+
+	// IsCA is true if CA certificates may be requested.
+	IsCA bool
+
+	// SSH are the attributes that SSHCertificateRequests permitted by this
+	// policy may request. The `namespaceSelector` and `requesters` of the
+	// policy, and the `keyAlgorithms` and `maxDuration` above, also apply to
+	// SSHCertificateRequests. If unset, no SSHCertificateRequest is permitted
+	// by this policy.
+	SSH *CertificateRequestPolicyAllowedSSH
+}
+
+// CertificateRequestPolicyAllowedSSH are the attributes an
+// SSHCertificateRequest may request. Entries may contain `*` wildcards, which
+// match any sequence of characters. SSHCertificateRequests which do not
+// request a duration request 24 hours.
+type CertificateRequestPolicyAllowedSSH struct {
+	// UserPrincipals which `User` SSH certificates may request.
+	UserPrincipals []string
+
+	// HostPrincipals which `Host` SSH certificates may request.
+	HostPrincipals []string
+
+	// Extensions are the names of the extensions which may be requested.
+	// `User` SSHCertificateRequests which do not request any extensions
+	// request the extensions that OpenSSH enables by default:
+	// `permit-X11-forwarding`, `permit-agent-forwarding`,
+	// `permit-port-forwarding`, `permit-pty` and `permit-user-rc`.
+	Extensions []string
+
+	// CriticalOptions are the names of the critical options which may be
+	// requested.
+	CriticalOptions []string
+}
+
+// CertificateRequestPolicyAllowedSubject are the subject attributes, other
+// than the common name, which a CertificateRequest may request. Entries may
+// contain `*` wildcards, which match any sequence of characters. Subject
+// attributes of other types may never be requested.
+type CertificateRequestPolicyAllowedSubject struct {
+	// Organizations (O) which may be requested.
+	Organizations []string
+
+	// OrganizationalUnits (OU) which may be requested.
+	OrganizationalUnits []string
+
+	// Countries (C) which may be requested.
+	Countries []string
+
+	// Provinces (ST) which may be requested.
+	Provinces []string
+
+	// Localities (L) which may be requested.
+	Localities []string
+
+	// StreetAddresses which may be requested.
+	StreetAddresses []string
+
+	// PostalCodes which may be requested.
+	PostalCodes []string
+
+	// SerialNumbers which may be requested.
+	SerialNumbers []string
+}
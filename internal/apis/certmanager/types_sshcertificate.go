@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// An SSHCertificate resource should be created to ensure an up to date and
+// signed OpenSSH certificate is stored in the Kubernetes Secret resource named
+// in `spec.secretName`.
+//
+// SSHCertificates can be signed by CA and Vault issuers. Each issuance
+// creates an SSHCertificateRequest, which must be approved before it is
+// signed. The stored certificate will be renewed before it expires (as
+// configured by `spec.renewBefore` or `spec.renewBeforePercentage`).
+type SSHCertificate struct {
+	metav1.TypeMeta
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta
+
+	// Specification of the desired state of the SSHCertificate resource.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec SSHCertificateSpec
+
+	// Status of the SSHCertificate.
+	// This is set and managed automatically.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Status SSHCertificateStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCertificateList is a list of SSHCertificates.
+type SSHCertificateList struct {
+	metav1.TypeMeta
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	metav1.ListMeta
+
+	// List of SSHCertificates
+	Items []SSHCertificate
+}
+
+type SSHCertificateType string
+
+const (
+	// SSHCertificateTypeUser denotes an SSH user certificate, used by clients
+	// to authenticate to hosts.
+	SSHCertificateTypeUser SSHCertificateType = "User"
+
+	// SSHCertificateTypeHost denotes an SSH host certificate, used by hosts
+	// to authenticate to clients.
+	SSHCertificateTypeHost SSHCertificateType = "Host"
+)
+
+// SSHCertificateSpec defines the desired state of SSHCertificate.
+type SSHCertificateSpec struct {
+	// Type of the SSH certificate, either `User` or `Host`.
+	// Defaults to `User` if not specified.
+	Type SSHCertificateType
+
+	// KeyID is the key identifier of the SSH certificate, which is logged by
+	// the SSH server when the certificate is used for authentication.
+	// Defaults to `<namespace>/<name>` of the SSHCertificate if not specified.
+	KeyID string
+
+	// Principals is the list of user names (for `User` certificates) or host
+	// names (for `Host` certificates) the SSH certificate is valid for.
+	// At least one principal must be specified.
+	Principals []string
+
+	// Requested 'duration' (i.e. lifetime) of the SSH certificate. Note that
+	// the issuer may choose to ignore the requested duration.
+	//
+	// If unset, this defaults to 24 hours.
+	// Minimum accepted duration is 1 hour.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	Duration *metav1.Duration
+
+	// How long before the currently issued SSH certificate's expiry
+	// cert-manager should renew the certificate.
+	//
+	// If unset, this defaults to 1/3 of the issued certificate's lifetime.
+	// Minimum accepted value is 5 minutes.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// Cannot be set if the `renewBeforePercentage` field is set.
+	RenewBefore *metav1.Duration
+
+	// `renewBeforePercentage` is like `renewBefore`, except it is a relative
+	// percentage of the issued certificate's lifetime rather than an absolute
+	// duration.
+	//
+	// Value must be an integer in the range (0,100).
+	// Cannot be set if the `renewBefore` field is set.
+	RenewBeforePercentage *int32
+
+	// Extensions to be included in the SSH certificate, for example
+	// `permit-pty` or `permit-port-forwarding`. Extensions can only be set on
+	// `User` certificates.
+	// If unset, `User` certificates are issued with the extensions that
+	// OpenSSH enables by default: `permit-X11-forwarding`,
+	// `permit-agent-forwarding`, `permit-port-forwarding`, `permit-pty` and
+	// `permit-user-rc`.
+	Extensions map[string]string
+
+	// CriticalOptions to be included in the SSH certificate, for example
+	// `force-command` or `source-address`.
+	CriticalOptions map[string]string
+
+	// Name of the Secret resource that will be automatically created and
+	// managed by this SSHCertificate resource. It will be populated with the
+	// private key, public key and signed SSH certificate, as well as the
+	// public key of the signing CA when known.
+	SecretName string
+
+	// Defines annotations and labels to be copied to the SSHCertificate's
+	// Secret. The Secret is updated whenever it is missing any of them.
+	SecretTemplate *CertificateSecretTemplate
+
+	// Private key options. These include the key algorithm and size and the
+	// rotation policy.
+	PrivateKey *SSHCertificatePrivateKey
+
+	// Reference to the issuer responsible for issuing the SSH certificate.
+	// Only CA issuers with `ssh` set and Vault issuers with `sshPath` set are
+	// supported.
+	// If the issuer is namespace-scoped, it must be in the same namespace as
+	// the SSHCertificate. If the issuer is cluster-scoped, it can be used
+	// from any namespace.
+	//
+	// The `name` field of the reference must always be specified.
+	IssuerRef cmmeta.IssuerReference
+}
+
+// SSHCertificatePrivateKey contains configuration options for the private
+// keys of SSHCertificates.
+type SSHCertificatePrivateKey struct {
+	// RotationPolicy controls how private keys should be regenerated when a
+	// re-issuance is being processed.
+	//
+	// If set to `Never`, a private key will only be generated if one does not
+	// already exist in the target `spec.secretName`, or if the existing one
+	// does not have the configured algorithm and size.
+	// If set to `Always`, a new private key will be generated whenever a
+	// re-issuance occurs.
+	// Default is `Always`.
+	RotationPolicy PrivateKeyRotationPolicy
+
+	// Algorithm is the private key algorithm of the corresponding private key
+	// for this SSH certificate.
+	//
+	// If provided, allowed values are either `RSA`, `ECDSA` or `Ed25519`.
+	// Defaults to `Ed25519` if not specified.
+	Algorithm PrivateKeyAlgorithm
+
+	// Size is the key bit size of the corresponding private key for this SSH
+	// certificate.
+	//
+	// If `algorithm` is set to `RSA`, valid values are `2048`, `4096` or `8192`,
+	// and will default to `2048` if not specified.
+	// If `algorithm` is set to `ECDSA`, valid values are `256`, `384` or `521`,
+	// and will default to `256` if not specified.
+	// If `algorithm` is set to `Ed25519`, Size is ignored.
+	// No other values are allowed.
+	Size int
+}
+
+// SSHCertificateStatus defines the observed state of SSHCertificate.
+type SSHCertificateStatus struct {
+	// List of status conditions to indicate the status of the SSHCertificate.
+	// Known condition types are `Ready`.
+	Conditions []CertificateCondition
+
+	// LastFailureTime is set only if the latest issuance for this
+	// SSHCertificate failed and contains the time of the failure. If an
+	// issuance has failed, the delay till the next issuance will be
+	// calculated using formula time.Hour * 2 ^ (failedIssuanceAttempts - 1).
+	LastFailureTime *metav1.Time
+
+	// The time after which the SSH certificate stored in the secret named by
+	// this resource in `spec.secretName` is valid.
+	NotBefore *metav1.Time
+
+	// The expiration time of the SSH certificate stored in the secret named
+	// by this resource in `spec.secretName`.
+	NotAfter *metav1.Time
+
+	// RenewalTime is the time at which the SSH certificate will be next
+	// renewed.
+	// If not set, no upcoming renewal is scheduled.
+	RenewalTime *metav1.Time
+
+	// The current 'revision' of the SSH certificate.
+	// It is incremented each time a new SSH certificate is issued.
+	Revision *int
+
+	// The number of continuous failed issuance attempts up till now. This
+	// field gets removed (if set) on a successful issuance.
+	FailedIssuanceAttempts *int
+
+	// The name of the Secret resource containing the private key to be used
+	// for the next SSH certificate iteration.
+	// The SSHCertificateRequest for the next iteration requests a
+	// certificate for this private key's public key. This field is removed
+	// once the SSH certificate has been issued.
+	NextPrivateKeySecretName *string
+}
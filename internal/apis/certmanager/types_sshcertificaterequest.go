@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// An SSHCertificateRequest is used to request a signed OpenSSH certificate
+// for a public key from one of the configured issuers. SSHCertificates create
+// an SSHCertificateRequest for each issuance.
+//
+// Like CertificateRequests, SSHCertificateRequests are only signed once they
+// have been approved, and all fields within the `spec` are immutable after
+// creation. An SSHCertificateRequest will either succeed or fail, as denoted
+// by its `Ready` status condition and its `status.failureTime` field.
+type SSHCertificateRequest struct {
+	metav1.TypeMeta
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	metav1.ObjectMeta
+
+	// Specification of the desired state of the SSHCertificateRequest resource.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec SSHCertificateRequestSpec
+
+	// Status of the SSHCertificateRequest.
+	// This is set and managed automatically.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Status SSHCertificateRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCertificateRequestList is a list of SSHCertificateRequests.
+type SSHCertificateRequestList struct {
+	metav1.TypeMeta
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	metav1.ListMeta
+
+	// List of SSHCertificateRequests
+	Items []SSHCertificateRequest
+}
+
+// SSHCertificateRequestSpec defines the desired state of
+// SSHCertificateRequest.
+type SSHCertificateRequestSpec struct {
+	// Type of the SSH certificate, either `User` or `Host`.
+	// Defaults to `User` if not specified.
+	Type SSHCertificateType
+
+	// KeyID is the key identifier of the SSH certificate, which is logged by
+	// the SSH server when the certificate is used for authentication.
+	// Defaults to `<namespace>/<name>` of the SSHCertificateRequest if not
+	// specified.
+	KeyID string
+
+	// Principals is the list of user names (for `User` certificates) or host
+	// names (for `Host` certificates) the SSH certificate is valid for.
+	// At least one principal must be specified.
+	Principals []string
+
+	// Requested 'duration' (i.e. lifetime) of the SSH certificate. Note that
+	// the issuer may choose to ignore the requested duration.
+	//
+	// If unset, this defaults to 24 hours.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	Duration *metav1.Duration
+
+	// Extensions to be included in the SSH certificate. Extensions can only be
+	// set on `User` certificates.
+	// If unset, `User` certificates are issued with the extensions that
+	// OpenSSH enables by default.
+	Extensions map[string]string
+
+	// CriticalOptions to be included in the SSH certificate.
+	CriticalOptions map[string]string
+
+	// The public key to be signed, in the OpenSSH `authorized_keys` format.
+	PublicKey []byte
+
+	// Reference to the issuer responsible for issuing the SSH certificate.
+	// Only CA issuers with `ssh` set and Vault issuers with `sshPath` set are
+	// supported.
+	// If the issuer is namespace-scoped, it must be in the same namespace as
+	// the SSHCertificateRequest. If the issuer is cluster-scoped, it can be
+	// used from any namespace.
+	//
+	// The `name` field of the reference must always be specified.
+	IssuerRef cmmeta.IssuerReference
+
+	// Username contains the name of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	Username string
+	// UID contains the uid of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	UID string
+	// Groups contains group membership of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	Groups []string
+	// Extra contains extra attributes of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	Extra map[string][]string
+}
+
+// SSHCertificateRequestStatus defines the observed state of
+// SSHCertificateRequest and the resulting signed SSH certificate.
+type SSHCertificateRequestStatus struct {
+	// List of status conditions to indicate the status of an
+	// SSHCertificateRequest.
+	// Known condition types are `Ready`, `Approved` and `Denied`.
+	Conditions []CertificateRequestCondition
+
+	// The signed SSH certificate, in the OpenSSH `authorized_keys` format.
+	// If not set, the SSHCertificateRequest has either not been completed or
+	// has failed. More information on failure can be found by checking the
+	// `conditions` field.
+	Certificate []byte
+
+	// FailureTime stores the time that this SSHCertificateRequest failed.
+	FailureTime *metav1.Time
+}
@@ -29,6 +29,12 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&cmapi.CertificateRequestList{}, func(obj any) {
 		SetObjectDefaults_CertificateRequestList(obj.(*cmapi.CertificateRequestList))
 	})
+	scheme.AddTypeDefaultingFunc(&cmapi.SSHCertificate{}, func(obj any) { SetObjectDefaults_SSHCertificate(obj.(*cmapi.SSHCertificate)) })
+	scheme.AddTypeDefaultingFunc(&cmapi.SSHCertificateList{}, func(obj any) { SetObjectDefaults_SSHCertificateList(obj.(*cmapi.SSHCertificateList)) })
+	scheme.AddTypeDefaultingFunc(&cmapi.SSHCertificateRequest{}, func(obj any) { SetObjectDefaults_SSHCertificateRequest(obj.(*cmapi.SSHCertificateRequest)) })
+	scheme.AddTypeDefaultingFunc(&cmapi.SSHCertificateRequestList{}, func(obj any) {
+		SetObjectDefaults_SSHCertificateRequestList(obj.(*cmapi.SSHCertificateRequestList))
+	})
 	return RegisterDefaults(scheme)
 }
 
@@ -91,3 +97,35 @@ func SetObjectDefaults_CertificateRequestList(in *cmapi.CertificateRequestList)
 		SetObjectDefaults_CertificateRequest(a)
 	}
 }
+
+func SetObjectDefaults_SSHCertificate(in *cmapi.SSHCertificate) {
+	if in.Spec.IssuerRef.Kind == "" {
+		in.Spec.IssuerRef.Kind = "Issuer"
+	}
+	if in.Spec.IssuerRef.Group == "" {
+		in.Spec.IssuerRef.Group = "cert-manager.io"
+	}
+}
+
+func SetObjectDefaults_SSHCertificateList(in *cmapi.SSHCertificateList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_SSHCertificate(a)
+	}
+}
+
+func SetObjectDefaults_SSHCertificateRequest(in *cmapi.SSHCertificateRequest) {
+	if in.Spec.IssuerRef.Kind == "" {
+		in.Spec.IssuerRef.Kind = "Issuer"
+	}
+	if in.Spec.IssuerRef.Group == "" {
+		in.Spec.IssuerRef.Group = "cert-manager.io"
+	}
+}
+
+func SetObjectDefaults_SSHCertificateRequestList(in *cmapi.SSHCertificateRequestList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_SSHCertificateRequest(a)
+	}
+}
@@ -64,6 +64,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CAIssuerSSH)(nil), (*certmanager.CAIssuerSSH)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAIssuerSSH_To_certmanager_CAIssuerSSH(a.(*certmanagerv1.CAIssuerSSH), b.(*certmanager.CAIssuerSSH), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAIssuerSSH)(nil), (*certmanagerv1.CAIssuerSSH)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAIssuerSSH_To_v1_CAIssuerSSH(a.(*certmanager.CAIssuerSSH), b.(*certmanagerv1.CAIssuerSSH), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Certificate_To_certmanager_Certificate(a.(*certmanagerv1.Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -114,6 +124,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateDefaultPolicy)(nil), (*certmanager.CertificateDefaultPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateDefaultPolicy_To_certmanager_CertificateDefaultPolicy(a.(*certmanagerv1.CertificateDefaultPolicy), b.(*certmanager.CertificateDefaultPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateDefaultPolicy)(nil), (*certmanagerv1.CertificateDefaultPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateDefaultPolicy_To_v1_CertificateDefaultPolicy(a.(*certmanager.CertificateDefaultPolicy), b.(*certmanagerv1.CertificateDefaultPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateDefaultPolicyList)(nil), (*certmanager.CertificateDefaultPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateDefaultPolicyList_To_certmanager_CertificateDefaultPolicyList(a.(*certmanagerv1.CertificateDefaultPolicyList), b.(*certmanager.CertificateDefaultPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateDefaultPolicyList)(nil), (*certmanagerv1.CertificateDefaultPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateDefaultPolicyList_To_v1_CertificateDefaultPolicyList(a.(*certmanager.CertificateDefaultPolicyList), b.(*certmanagerv1.CertificateDefaultPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateDefaultPolicySpec)(nil), (*certmanager.CertificateDefaultPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateDefaultPolicySpec_To_certmanager_CertificateDefaultPolicySpec(a.(*certmanagerv1.CertificateDefaultPolicySpec), b.(*certmanager.CertificateDefaultPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateDefaultPolicySpec)(nil), (*certmanagerv1.CertificateDefaultPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateDefaultPolicySpec_To_v1_CertificateDefaultPolicySpec(a.(*certmanager.CertificateDefaultPolicySpec), b.(*certmanagerv1.CertificateDefaultPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateExternalCSR)(nil), (*certmanager.CertificateExternalCSR)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateExternalCSR_To_certmanager_CertificateExternalCSR(a.(*certmanagerv1.CertificateExternalCSR), b.(*certmanager.CertificateExternalCSR), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExternalCSR)(nil), (*certmanagerv1.CertificateExternalCSR)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExternalCSR_To_v1_CertificateExternalCSR(a.(*certmanager.CertificateExternalCSR), b.(*certmanagerv1.CertificateExternalCSR), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*certmanagerv1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -154,6 +204,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRenewalRequest)(nil), (*certmanager.CertificateRenewalRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalRequest_To_certmanager_CertificateRenewalRequest(a.(*certmanagerv1.CertificateRenewalRequest), b.(*certmanager.CertificateRenewalRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalRequest)(nil), (*certmanagerv1.CertificateRenewalRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalRequest_To_v1_CertificateRenewalRequest(a.(*certmanager.CertificateRenewalRequest), b.(*certmanagerv1.CertificateRenewalRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRenewalRequestStatus)(nil), (*certmanager.CertificateRenewalRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRenewalRequestStatus_To_certmanager_CertificateRenewalRequestStatus(a.(*certmanagerv1.CertificateRenewalRequestStatus), b.(*certmanager.CertificateRenewalRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRenewalRequestStatus)(nil), (*certmanagerv1.CertificateRenewalRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRenewalRequestStatus_To_v1_CertificateRenewalRequestStatus(a.(*certmanager.CertificateRenewalRequestStatus), b.(*certmanagerv1.CertificateRenewalRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRenewalWindows)(nil), (*certmanager.CertificateRenewalWindows)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(a.(*certmanagerv1.CertificateRenewalWindows), b.(*certmanager.CertificateRenewalWindows), scope)
 	}); err != nil {
@@ -194,6 +264,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicy)(nil), (*certmanager.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(a.(*certmanagerv1.CertificateRequestPolicy), b.(*certmanager.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicy)(nil), (*certmanagerv1.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(a.(*certmanager.CertificateRequestPolicy), b.(*certmanagerv1.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicyAllowed)(nil), (*certmanager.CertificateRequestPolicyAllowed)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(a.(*certmanagerv1.CertificateRequestPolicyAllowed), b.(*certmanager.CertificateRequestPolicyAllowed), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyAllowed)(nil), (*certmanagerv1.CertificateRequestPolicyAllowed)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(a.(*certmanager.CertificateRequestPolicyAllowed), b.(*certmanagerv1.CertificateRequestPolicyAllowed), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicyAllowedSSH)(nil), (*certmanager.CertificateRequestPolicyAllowedSSH)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyAllowedSSH_To_certmanager_CertificateRequestPolicyAllowedSSH(a.(*certmanagerv1.CertificateRequestPolicyAllowedSSH), b.(*certmanager.CertificateRequestPolicyAllowedSSH), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyAllowedSSH)(nil), (*certmanagerv1.CertificateRequestPolicyAllowedSSH)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyAllowedSSH_To_v1_CertificateRequestPolicyAllowedSSH(a.(*certmanager.CertificateRequestPolicyAllowedSSH), b.(*certmanagerv1.CertificateRequestPolicyAllowedSSH), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicyAllowedSubject)(nil), (*certmanager.CertificateRequestPolicyAllowedSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyAllowedSubject_To_certmanager_CertificateRequestPolicyAllowedSubject(a.(*certmanagerv1.CertificateRequestPolicyAllowedSubject), b.(*certmanager.CertificateRequestPolicyAllowedSubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyAllowedSubject)(nil), (*certmanagerv1.CertificateRequestPolicyAllowedSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyAllowedSubject_To_v1_CertificateRequestPolicyAllowedSubject(a.(*certmanager.CertificateRequestPolicyAllowedSubject), b.(*certmanagerv1.CertificateRequestPolicyAllowedSubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicyIssuerRef)(nil), (*certmanager.CertificateRequestPolicyIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(a.(*certmanagerv1.CertificateRequestPolicyIssuerRef), b.(*certmanager.CertificateRequestPolicyIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyIssuerRef)(nil), (*certmanagerv1.CertificateRequestPolicyIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(a.(*certmanager.CertificateRequestPolicyIssuerRef), b.(*certmanagerv1.CertificateRequestPolicyIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicyList)(nil), (*certmanager.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(a.(*certmanagerv1.CertificateRequestPolicyList), b.(*certmanager.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyList)(nil), (*certmanagerv1.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(a.(*certmanager.CertificateRequestPolicyList), b.(*certmanagerv1.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicyRequesters)(nil), (*certmanager.CertificateRequestPolicyRequesters)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyRequesters_To_certmanager_CertificateRequestPolicyRequesters(a.(*certmanagerv1.CertificateRequestPolicyRequesters), b.(*certmanager.CertificateRequestPolicyRequesters), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyRequesters)(nil), (*certmanagerv1.CertificateRequestPolicyRequesters)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyRequesters_To_v1_CertificateRequestPolicyRequesters(a.(*certmanager.CertificateRequestPolicyRequesters), b.(*certmanagerv1.CertificateRequestPolicyRequesters), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestPolicySpec)(nil), (*certmanager.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(a.(*certmanagerv1.CertificateRequestPolicySpec), b.(*certmanager.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySpec)(nil), (*certmanagerv1.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(a.(*certmanager.CertificateRequestPolicySpec), b.(*certmanagerv1.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateRequestSpec)(nil), (*certmanager.CertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(a.(*certmanagerv1.CertificateRequestSpec), b.(*certmanager.CertificateRequestSpec), scope)
 	}); err != nil {
@@ -214,6 +364,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateSecretReplicas)(nil), (*certmanager.CertificateSecretReplicas)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateSecretReplicas_To_certmanager_CertificateSecretReplicas(a.(*certmanagerv1.CertificateSecretReplicas), b.(*certmanager.CertificateSecretReplicas), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretReplicas)(nil), (*certmanagerv1.CertificateSecretReplicas)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretReplicas_To_v1_CertificateSecretReplicas(a.(*certmanager.CertificateSecretReplicas), b.(*certmanagerv1.CertificateSecretReplicas), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*certmanagerv1.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -244,6 +404,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.CertificateTrustConfigMap)(nil), (*certmanager.CertificateTrustConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateTrustConfigMap_To_certmanager_CertificateTrustConfigMap(a.(*certmanagerv1.CertificateTrustConfigMap), b.(*certmanager.CertificateTrustConfigMap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateTrustConfigMap)(nil), (*certmanagerv1.CertificateTrustConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateTrustConfigMap_To_v1_CertificateTrustConfigMap(a.(*certmanager.CertificateTrustConfigMap), b.(*certmanagerv1.CertificateTrustConfigMap), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.ClusterIssuer)(nil), (*certmanager.ClusterIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(a.(*certmanagerv1.ClusterIssuer), b.(*certmanager.ClusterIssuer), scope)
 	}); err != nil {
@@ -364,6 +534,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.PEMTruststore)(nil), (*certmanager.PEMTruststore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PEMTruststore_To_certmanager_PEMTruststore(a.(*certmanagerv1.PEMTruststore), b.(*certmanager.PEMTruststore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.PEMTruststore)(nil), (*certmanagerv1.PEMTruststore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_PEMTruststore_To_v1_PEMTruststore(a.(*certmanager.PEMTruststore), b.(*certmanagerv1.PEMTruststore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.PKCS12Keystore)(nil), (*certmanager.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(a.(*certmanagerv1.PKCS12Keystore), b.(*certmanager.PKCS12Keystore), scope)
 	}); err != nil {
@@ -374,6 +554,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SPIFFEIdentity)(nil), (*certmanager.SPIFFEIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SPIFFEIdentity_To_certmanager_SPIFFEIdentity(a.(*certmanagerv1.SPIFFEIdentity), b.(*certmanager.SPIFFEIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SPIFFEIdentity)(nil), (*certmanagerv1.SPIFFEIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SPIFFEIdentity_To_v1_SPIFFEIdentity(a.(*certmanager.SPIFFEIdentity), b.(*certmanagerv1.SPIFFEIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificate)(nil), (*certmanager.SSHCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificate_To_certmanager_SSHCertificate(a.(*certmanagerv1.SSHCertificate), b.(*certmanager.SSHCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificate)(nil), (*certmanagerv1.SSHCertificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificate_To_v1_SSHCertificate(a.(*certmanager.SSHCertificate), b.(*certmanagerv1.SSHCertificate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateList)(nil), (*certmanager.SSHCertificateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateList_To_certmanager_SSHCertificateList(a.(*certmanagerv1.SSHCertificateList), b.(*certmanager.SSHCertificateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateList)(nil), (*certmanagerv1.SSHCertificateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateList_To_v1_SSHCertificateList(a.(*certmanager.SSHCertificateList), b.(*certmanagerv1.SSHCertificateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificatePrivateKey)(nil), (*certmanager.SSHCertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificatePrivateKey_To_certmanager_SSHCertificatePrivateKey(a.(*certmanagerv1.SSHCertificatePrivateKey), b.(*certmanager.SSHCertificatePrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificatePrivateKey)(nil), (*certmanagerv1.SSHCertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificatePrivateKey_To_v1_SSHCertificatePrivateKey(a.(*certmanager.SSHCertificatePrivateKey), b.(*certmanagerv1.SSHCertificatePrivateKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateRequest)(nil), (*certmanager.SSHCertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateRequest_To_certmanager_SSHCertificateRequest(a.(*certmanagerv1.SSHCertificateRequest), b.(*certmanager.SSHCertificateRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateRequest)(nil), (*certmanagerv1.SSHCertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateRequest_To_v1_SSHCertificateRequest(a.(*certmanager.SSHCertificateRequest), b.(*certmanagerv1.SSHCertificateRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateRequestList)(nil), (*certmanager.SSHCertificateRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateRequestList_To_certmanager_SSHCertificateRequestList(a.(*certmanagerv1.SSHCertificateRequestList), b.(*certmanager.SSHCertificateRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateRequestList)(nil), (*certmanagerv1.SSHCertificateRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateRequestList_To_v1_SSHCertificateRequestList(a.(*certmanager.SSHCertificateRequestList), b.(*certmanagerv1.SSHCertificateRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateRequestSpec)(nil), (*certmanager.SSHCertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateRequestSpec_To_certmanager_SSHCertificateRequestSpec(a.(*certmanagerv1.SSHCertificateRequestSpec), b.(*certmanager.SSHCertificateRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateRequestSpec)(nil), (*certmanagerv1.SSHCertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateRequestSpec_To_v1_SSHCertificateRequestSpec(a.(*certmanager.SSHCertificateRequestSpec), b.(*certmanagerv1.SSHCertificateRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateRequestStatus)(nil), (*certmanager.SSHCertificateRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateRequestStatus_To_certmanager_SSHCertificateRequestStatus(a.(*certmanagerv1.SSHCertificateRequestStatus), b.(*certmanager.SSHCertificateRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateRequestStatus)(nil), (*certmanagerv1.SSHCertificateRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateRequestStatus_To_v1_SSHCertificateRequestStatus(a.(*certmanager.SSHCertificateRequestStatus), b.(*certmanagerv1.SSHCertificateRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateSpec)(nil), (*certmanager.SSHCertificateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateSpec_To_certmanager_SSHCertificateSpec(a.(*certmanagerv1.SSHCertificateSpec), b.(*certmanager.SSHCertificateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateSpec)(nil), (*certmanagerv1.SSHCertificateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateSpec_To_v1_SSHCertificateSpec(a.(*certmanager.SSHCertificateSpec), b.(*certmanagerv1.SSHCertificateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SSHCertificateStatus)(nil), (*certmanager.SSHCertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SSHCertificateStatus_To_certmanager_SSHCertificateStatus(a.(*certmanagerv1.SSHCertificateStatus), b.(*certmanager.SSHCertificateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SSHCertificateStatus)(nil), (*certmanagerv1.SSHCertificateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SSHCertificateStatus_To_v1_SSHCertificateStatus(a.(*certmanager.SSHCertificateStatus), b.(*certmanagerv1.SSHCertificateStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*certmanagerv1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*certmanagerv1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -534,6 +814,8 @@ func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *certmanagerv1.CAIssuer,
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.SPIFFE = (*certmanager.SPIFFEIdentity)(unsafe.Pointer(in.SPIFFE))
+	out.SSH = (*certmanager.CAIssuerSSH)(unsafe.Pointer(in.SSH))
 	return nil
 }
 
@@ -547,6 +829,8 @@ func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, o
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.SPIFFE = (*certmanagerv1.SPIFFEIdentity)(unsafe.Pointer(in.SPIFFE))
+	out.SSH = (*certmanagerv1.CAIssuerSSH)(unsafe.Pointer(in.SSH))
 	return nil
 }
 
@@ -555,6 +839,28 @@ func Convert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *
 	return autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in, out, s)
 }
 
+func autoConvert_v1_CAIssuerSSH_To_certmanager_CAIssuerSSH(in *certmanagerv1.CAIssuerSSH, out *certmanager.CAIssuerSSH, s conversion.Scope) error {
+	out.AllowedUserPrincipals = *(*[]string)(unsafe.Pointer(&in.AllowedUserPrincipals))
+	out.AllowedHostPrincipals = *(*[]string)(unsafe.Pointer(&in.AllowedHostPrincipals))
+	return nil
+}
+
+// Convert_v1_CAIssuerSSH_To_certmanager_CAIssuerSSH is an autogenerated conversion function.
+func Convert_v1_CAIssuerSSH_To_certmanager_CAIssuerSSH(in *certmanagerv1.CAIssuerSSH, out *certmanager.CAIssuerSSH, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuerSSH_To_certmanager_CAIssuerSSH(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuerSSH_To_v1_CAIssuerSSH(in *certmanager.CAIssuerSSH, out *certmanagerv1.CAIssuerSSH, s conversion.Scope) error {
+	out.AllowedUserPrincipals = *(*[]string)(unsafe.Pointer(&in.AllowedUserPrincipals))
+	out.AllowedHostPrincipals = *(*[]string)(unsafe.Pointer(&in.AllowedHostPrincipals))
+	return nil
+}
+
+// Convert_certmanager_CAIssuerSSH_To_v1_CAIssuerSSH is an autogenerated conversion function.
+func Convert_certmanager_CAIssuerSSH_To_v1_CAIssuerSSH(in *certmanager.CAIssuerSSH, out *certmanagerv1.CAIssuerSSH, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuerSSH_To_v1_CAIssuerSSH(in, out, s)
+}
+
 func autoConvert_v1_Certificate_To_certmanager_Certificate(in *certmanagerv1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -685,6 +991,108 @@ func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *cer
 	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1_CertificateDefaultPolicy_To_certmanager_CertificateDefaultPolicy(in *certmanagerv1.CertificateDefaultPolicy, out *certmanager.CertificateDefaultPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateDefaultPolicySpec_To_certmanager_CertificateDefaultPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateDefaultPolicy_To_certmanager_CertificateDefaultPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateDefaultPolicy_To_certmanager_CertificateDefaultPolicy(in *certmanagerv1.CertificateDefaultPolicy, out *certmanager.CertificateDefaultPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateDefaultPolicy_To_certmanager_CertificateDefaultPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateDefaultPolicy_To_v1_CertificateDefaultPolicy(in *certmanager.CertificateDefaultPolicy, out *certmanagerv1.CertificateDefaultPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateDefaultPolicySpec_To_v1_CertificateDefaultPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateDefaultPolicy_To_v1_CertificateDefaultPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateDefaultPolicy_To_v1_CertificateDefaultPolicy(in *certmanager.CertificateDefaultPolicy, out *certmanagerv1.CertificateDefaultPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateDefaultPolicy_To_v1_CertificateDefaultPolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificateDefaultPolicyList_To_certmanager_CertificateDefaultPolicyList(in *certmanagerv1.CertificateDefaultPolicyList, out *certmanager.CertificateDefaultPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CertificateDefaultPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CertificateDefaultPolicyList_To_certmanager_CertificateDefaultPolicyList is an autogenerated conversion function.
+func Convert_v1_CertificateDefaultPolicyList_To_certmanager_CertificateDefaultPolicyList(in *certmanagerv1.CertificateDefaultPolicyList, out *certmanager.CertificateDefaultPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateDefaultPolicyList_To_certmanager_CertificateDefaultPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateDefaultPolicyList_To_v1_CertificateDefaultPolicyList(in *certmanager.CertificateDefaultPolicyList, out *certmanagerv1.CertificateDefaultPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanagerv1.CertificateDefaultPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CertificateDefaultPolicyList_To_v1_CertificateDefaultPolicyList is an autogenerated conversion function.
+func Convert_certmanager_CertificateDefaultPolicyList_To_v1_CertificateDefaultPolicyList(in *certmanager.CertificateDefaultPolicyList, out *certmanagerv1.CertificateDefaultPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateDefaultPolicyList_To_v1_CertificateDefaultPolicyList(in, out, s)
+}
+
+func autoConvert_v1_CertificateDefaultPolicySpec_To_certmanager_CertificateDefaultPolicySpec(in *certmanagerv1.CertificateDefaultPolicySpec, out *certmanager.CertificateDefaultPolicySpec, s conversion.Scope) error {
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	return nil
+}
+
+// Convert_v1_CertificateDefaultPolicySpec_To_certmanager_CertificateDefaultPolicySpec is an autogenerated conversion function.
+func Convert_v1_CertificateDefaultPolicySpec_To_certmanager_CertificateDefaultPolicySpec(in *certmanagerv1.CertificateDefaultPolicySpec, out *certmanager.CertificateDefaultPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateDefaultPolicySpec_To_certmanager_CertificateDefaultPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateDefaultPolicySpec_To_v1_CertificateDefaultPolicySpec(in *certmanager.CertificateDefaultPolicySpec, out *certmanagerv1.CertificateDefaultPolicySpec, s conversion.Scope) error {
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.PrivateKey = (*certmanagerv1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.SecretTemplate = (*certmanagerv1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	return nil
+}
+
+// Convert_certmanager_CertificateDefaultPolicySpec_To_v1_CertificateDefaultPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateDefaultPolicySpec_To_v1_CertificateDefaultPolicySpec(in *certmanager.CertificateDefaultPolicySpec, out *certmanagerv1.CertificateDefaultPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateDefaultPolicySpec_To_v1_CertificateDefaultPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_CertificateExternalCSR_To_certmanager_CertificateExternalCSR(in *certmanagerv1.CertificateExternalCSR, out *certmanager.CertificateExternalCSR, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1_CertificateExternalCSR_To_certmanager_CertificateExternalCSR is an autogenerated conversion function.
+func Convert_v1_CertificateExternalCSR_To_certmanager_CertificateExternalCSR(in *certmanagerv1.CertificateExternalCSR, out *certmanager.CertificateExternalCSR, s conversion.Scope) error {
+	return autoConvert_v1_CertificateExternalCSR_To_certmanager_CertificateExternalCSR(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExternalCSR_To_v1_CertificateExternalCSR(in *certmanager.CertificateExternalCSR, out *certmanagerv1.CertificateExternalCSR, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_certmanager_CertificateExternalCSR_To_v1_CertificateExternalCSR is an autogenerated conversion function.
+func Convert_certmanager_CertificateExternalCSR_To_v1_CertificateExternalCSR(in *certmanager.CertificateExternalCSR, out *certmanagerv1.CertificateExternalCSR, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExternalCSR_To_v1_CertificateExternalCSR(in, out, s)
+}
+
 func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *certmanagerv1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -704,6 +1112,7 @@ func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in
 	} else {
 		out.PKCS12 = nil
 	}
+	out.PEMTruststore = (*certmanager.PEMTruststore)(unsafe.Pointer(in.PEMTruststore))
 	return nil
 }
 
@@ -731,6 +1140,7 @@ func autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in
 	} else {
 		out.PKCS12 = nil
 	}
+	out.PEMTruststore = (*certmanagerv1.PEMTruststore)(unsafe.Pointer(in.PEMTruststore))
 	return nil
 }
 
@@ -786,6 +1196,7 @@ func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(i
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.RotationOverlap = (*metav1.Duration)(unsafe.Pointer(in.RotationOverlap))
 	return nil
 }
 
@@ -799,6 +1210,7 @@ func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(i
 	out.Encoding = certmanagerv1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanagerv1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.RotationOverlap = (*metav1.Duration)(unsafe.Pointer(in.RotationOverlap))
 	return nil
 }
 
@@ -829,32 +1241,86 @@ func Convert_certmanager_CertificateRenewal_To_v1_CertificateRenewal(in *certman
 	return autoConvert_certmanager_CertificateRenewal_To_v1_CertificateRenewal(in, out, s)
 }
 
-func autoConvert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(in *certmanagerv1.CertificateRenewalWindows, out *certmanager.CertificateRenewalWindows, s conversion.Scope) error {
-	out.Timezone = in.Timezone
-	out.WindowDuration = (*metav1.Duration)(unsafe.Pointer(in.WindowDuration))
-	out.Cron = in.Cron
+func autoConvert_v1_CertificateRenewalRequest_To_certmanager_CertificateRenewalRequest(in *certmanagerv1.CertificateRenewalRequest, out *certmanager.CertificateRenewalRequest, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Reason = in.Reason
+	out.RotatePrivateKey = in.RotatePrivateKey
+	out.RequestedBy = in.RequestedBy
 	return nil
 }
 
-// Convert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows is an autogenerated conversion function.
-func Convert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(in *certmanagerv1.CertificateRenewalWindows, out *certmanager.CertificateRenewalWindows, s conversion.Scope) error {
-	return autoConvert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(in, out, s)
+// Convert_v1_CertificateRenewalRequest_To_certmanager_CertificateRenewalRequest is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalRequest_To_certmanager_CertificateRenewalRequest(in *certmanagerv1.CertificateRenewalRequest, out *certmanager.CertificateRenewalRequest, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalRequest_To_certmanager_CertificateRenewalRequest(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows(in *certmanager.CertificateRenewalWindows, out *certmanagerv1.CertificateRenewalWindows, s conversion.Scope) error {
-	out.Timezone = in.Timezone
-	out.WindowDuration = (*metav1.Duration)(unsafe.Pointer(in.WindowDuration))
-	out.Cron = in.Cron
+func autoConvert_certmanager_CertificateRenewalRequest_To_v1_CertificateRenewalRequest(in *certmanager.CertificateRenewalRequest, out *certmanagerv1.CertificateRenewalRequest, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Reason = in.Reason
+	out.RotatePrivateKey = in.RotatePrivateKey
+	out.RequestedBy = in.RequestedBy
 	return nil
 }
 
-// Convert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows is an autogenerated conversion function.
-func Convert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows(in *certmanager.CertificateRenewalWindows, out *certmanagerv1.CertificateRenewalWindows, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows(in, out, s)
+// Convert_certmanager_CertificateRenewalRequest_To_v1_CertificateRenewalRequest is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalRequest_To_v1_CertificateRenewalRequest(in *certmanager.CertificateRenewalRequest, out *certmanagerv1.CertificateRenewalRequest, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalRequest_To_v1_CertificateRenewalRequest(in, out, s)
 }
 
-func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *certmanagerv1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
+func autoConvert_v1_CertificateRenewalRequestStatus_To_certmanager_CertificateRenewalRequestStatus(in *certmanagerv1.CertificateRenewalRequestStatus, out *certmanager.CertificateRenewalRequestStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Reason = in.Reason
+	out.RequestedBy = in.RequestedBy
+	out.RotatePrivateKey = in.RotatePrivateKey
+	out.HandledTime = (*metav1.Time)(unsafe.Pointer(in.HandledTime))
+	return nil
+}
+
+// Convert_v1_CertificateRenewalRequestStatus_To_certmanager_CertificateRenewalRequestStatus is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalRequestStatus_To_certmanager_CertificateRenewalRequestStatus(in *certmanagerv1.CertificateRenewalRequestStatus, out *certmanager.CertificateRenewalRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalRequestStatus_To_certmanager_CertificateRenewalRequestStatus(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalRequestStatus_To_v1_CertificateRenewalRequestStatus(in *certmanager.CertificateRenewalRequestStatus, out *certmanagerv1.CertificateRenewalRequestStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Reason = in.Reason
+	out.RequestedBy = in.RequestedBy
+	out.RotatePrivateKey = in.RotatePrivateKey
+	out.HandledTime = (*metav1.Time)(unsafe.Pointer(in.HandledTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalRequestStatus_To_v1_CertificateRenewalRequestStatus is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalRequestStatus_To_v1_CertificateRenewalRequestStatus(in *certmanager.CertificateRenewalRequestStatus, out *certmanagerv1.CertificateRenewalRequestStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalRequestStatus_To_v1_CertificateRenewalRequestStatus(in, out, s)
+}
+
+func autoConvert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(in *certmanagerv1.CertificateRenewalWindows, out *certmanager.CertificateRenewalWindows, s conversion.Scope) error {
+	out.Timezone = in.Timezone
+	out.WindowDuration = (*metav1.Duration)(unsafe.Pointer(in.WindowDuration))
+	out.Cron = in.Cron
+	return nil
+}
+
+// Convert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows is an autogenerated conversion function.
+func Convert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(in *certmanagerv1.CertificateRenewalWindows, out *certmanager.CertificateRenewalWindows, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRenewalWindows_To_certmanager_CertificateRenewalWindows(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows(in *certmanager.CertificateRenewalWindows, out *certmanagerv1.CertificateRenewalWindows, s conversion.Scope) error {
+	out.Timezone = in.Timezone
+	out.WindowDuration = (*metav1.Duration)(unsafe.Pointer(in.WindowDuration))
+	out.Cron = in.Cron
+	return nil
+}
+
+// Convert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows is an autogenerated conversion function.
+func Convert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows(in *certmanager.CertificateRenewalWindows, out *certmanagerv1.CertificateRenewalWindows, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRenewalWindows_To_v1_CertificateRenewalWindows(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *certmanagerv1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
@@ -955,6 +1421,230 @@ func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in
 	return autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in, out, s)
 }
 
+func autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *certmanagerv1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *certmanagerv1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *certmanagerv1.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *certmanagerv1.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(in *certmanagerv1.CertificateRequestPolicyAllowed, out *certmanager.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	out.CommonNames = *(*[]string)(unsafe.Pointer(&in.CommonNames))
+	out.Subject = (*certmanager.CertificateRequestPolicyAllowedSubject)(unsafe.Pointer(in.Subject))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.KeyAlgorithms = *(*[]certmanager.PrivateKeyAlgorithm)(unsafe.Pointer(&in.KeyAlgorithms))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.IsCA = in.IsCA
+	out.SSH = (*certmanager.CertificateRequestPolicyAllowedSSH)(unsafe.Pointer(in.SSH))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(in *certmanagerv1.CertificateRequestPolicyAllowed, out *certmanager.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(in *certmanager.CertificateRequestPolicyAllowed, out *certmanagerv1.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	out.CommonNames = *(*[]string)(unsafe.Pointer(&in.CommonNames))
+	out.Subject = (*certmanagerv1.CertificateRequestPolicyAllowedSubject)(unsafe.Pointer(in.Subject))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
+	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.Usages = *(*[]certmanagerv1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.KeyAlgorithms = *(*[]certmanagerv1.PrivateKeyAlgorithm)(unsafe.Pointer(&in.KeyAlgorithms))
+	out.MaxDuration = (*metav1.Duration)(unsafe.Pointer(in.MaxDuration))
+	out.IsCA = in.IsCA
+	out.SSH = (*certmanagerv1.CertificateRequestPolicyAllowedSSH)(unsafe.Pointer(in.SSH))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(in *certmanager.CertificateRequestPolicyAllowed, out *certmanagerv1.CertificateRequestPolicyAllowed, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyAllowedSSH_To_certmanager_CertificateRequestPolicyAllowedSSH(in *certmanagerv1.CertificateRequestPolicyAllowedSSH, out *certmanager.CertificateRequestPolicyAllowedSSH, s conversion.Scope) error {
+	out.UserPrincipals = *(*[]string)(unsafe.Pointer(&in.UserPrincipals))
+	out.HostPrincipals = *(*[]string)(unsafe.Pointer(&in.HostPrincipals))
+	out.Extensions = *(*[]string)(unsafe.Pointer(&in.Extensions))
+	out.CriticalOptions = *(*[]string)(unsafe.Pointer(&in.CriticalOptions))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyAllowedSSH_To_certmanager_CertificateRequestPolicyAllowedSSH is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyAllowedSSH_To_certmanager_CertificateRequestPolicyAllowedSSH(in *certmanagerv1.CertificateRequestPolicyAllowedSSH, out *certmanager.CertificateRequestPolicyAllowedSSH, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyAllowedSSH_To_certmanager_CertificateRequestPolicyAllowedSSH(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyAllowedSSH_To_v1_CertificateRequestPolicyAllowedSSH(in *certmanager.CertificateRequestPolicyAllowedSSH, out *certmanagerv1.CertificateRequestPolicyAllowedSSH, s conversion.Scope) error {
+	out.UserPrincipals = *(*[]string)(unsafe.Pointer(&in.UserPrincipals))
+	out.HostPrincipals = *(*[]string)(unsafe.Pointer(&in.HostPrincipals))
+	out.Extensions = *(*[]string)(unsafe.Pointer(&in.Extensions))
+	out.CriticalOptions = *(*[]string)(unsafe.Pointer(&in.CriticalOptions))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyAllowedSSH_To_v1_CertificateRequestPolicyAllowedSSH is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyAllowedSSH_To_v1_CertificateRequestPolicyAllowedSSH(in *certmanager.CertificateRequestPolicyAllowedSSH, out *certmanagerv1.CertificateRequestPolicyAllowedSSH, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyAllowedSSH_To_v1_CertificateRequestPolicyAllowedSSH(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyAllowedSubject_To_certmanager_CertificateRequestPolicyAllowedSubject(in *certmanagerv1.CertificateRequestPolicyAllowedSubject, out *certmanager.CertificateRequestPolicyAllowedSubject, s conversion.Scope) error {
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	out.OrganizationalUnits = *(*[]string)(unsafe.Pointer(&in.OrganizationalUnits))
+	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
+	out.Provinces = *(*[]string)(unsafe.Pointer(&in.Provinces))
+	out.Localities = *(*[]string)(unsafe.Pointer(&in.Localities))
+	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
+	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
+	out.SerialNumbers = *(*[]string)(unsafe.Pointer(&in.SerialNumbers))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyAllowedSubject_To_certmanager_CertificateRequestPolicyAllowedSubject is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyAllowedSubject_To_certmanager_CertificateRequestPolicyAllowedSubject(in *certmanagerv1.CertificateRequestPolicyAllowedSubject, out *certmanager.CertificateRequestPolicyAllowedSubject, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyAllowedSubject_To_certmanager_CertificateRequestPolicyAllowedSubject(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyAllowedSubject_To_v1_CertificateRequestPolicyAllowedSubject(in *certmanager.CertificateRequestPolicyAllowedSubject, out *certmanagerv1.CertificateRequestPolicyAllowedSubject, s conversion.Scope) error {
+	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
+	out.OrganizationalUnits = *(*[]string)(unsafe.Pointer(&in.OrganizationalUnits))
+	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
+	out.Provinces = *(*[]string)(unsafe.Pointer(&in.Provinces))
+	out.Localities = *(*[]string)(unsafe.Pointer(&in.Localities))
+	out.StreetAddresses = *(*[]string)(unsafe.Pointer(&in.StreetAddresses))
+	out.PostalCodes = *(*[]string)(unsafe.Pointer(&in.PostalCodes))
+	out.SerialNumbers = *(*[]string)(unsafe.Pointer(&in.SerialNumbers))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyAllowedSubject_To_v1_CertificateRequestPolicyAllowedSubject is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyAllowedSubject_To_v1_CertificateRequestPolicyAllowedSubject(in *certmanager.CertificateRequestPolicyAllowedSubject, out *certmanagerv1.CertificateRequestPolicyAllowedSubject, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyAllowedSubject_To_v1_CertificateRequestPolicyAllowedSubject(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in *certmanagerv1.CertificateRequestPolicyIssuerRef, out *certmanager.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in *certmanagerv1.CertificateRequestPolicyIssuerRef, out *certmanager.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in *certmanager.CertificateRequestPolicyIssuerRef, out *certmanagerv1.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in *certmanager.CertificateRequestPolicyIssuerRef, out *certmanagerv1.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *certmanagerv1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *certmanagerv1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *certmanagerv1.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanagerv1.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *certmanagerv1.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyRequesters_To_certmanager_CertificateRequestPolicyRequesters(in *certmanagerv1.CertificateRequestPolicyRequesters, out *certmanager.CertificateRequestPolicyRequesters, s conversion.Scope) error {
+	out.Usernames = *(*[]string)(unsafe.Pointer(&in.Usernames))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyRequesters_To_certmanager_CertificateRequestPolicyRequesters is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyRequesters_To_certmanager_CertificateRequestPolicyRequesters(in *certmanagerv1.CertificateRequestPolicyRequesters, out *certmanager.CertificateRequestPolicyRequesters, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyRequesters_To_certmanager_CertificateRequestPolicyRequesters(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyRequesters_To_v1_CertificateRequestPolicyRequesters(in *certmanager.CertificateRequestPolicyRequesters, out *certmanagerv1.CertificateRequestPolicyRequesters, s conversion.Scope) error {
+	out.Usernames = *(*[]string)(unsafe.Pointer(&in.Usernames))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyRequesters_To_v1_CertificateRequestPolicyRequesters is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyRequesters_To_v1_CertificateRequestPolicyRequesters(in *certmanager.CertificateRequestPolicyRequesters, out *certmanagerv1.CertificateRequestPolicyRequesters, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyRequesters_To_v1_CertificateRequestPolicyRequesters(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *certmanagerv1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	out.IssuerRefs = *(*[]certmanager.CertificateRequestPolicyIssuerRef)(unsafe.Pointer(&in.IssuerRefs))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Requesters = (*certmanager.CertificateRequestPolicyRequesters)(unsafe.Pointer(in.Requesters))
+	if err := Convert_v1_CertificateRequestPolicyAllowed_To_certmanager_CertificateRequestPolicyAllowed(&in.Allowed, &out.Allowed, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *certmanagerv1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *certmanagerv1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	out.IssuerRefs = *(*[]certmanagerv1.CertificateRequestPolicyIssuerRef)(unsafe.Pointer(&in.IssuerRefs))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Requesters = (*certmanagerv1.CertificateRequestPolicyRequesters)(unsafe.Pointer(in.Requesters))
+	if err := Convert_certmanager_CertificateRequestPolicyAllowed_To_v1_CertificateRequestPolicyAllowed(&in.Allowed, &out.Allowed, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *certmanagerv1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in, out, s)
+}
+
 func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *certmanagerv1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	if err := internalapismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
@@ -1021,6 +1711,28 @@ func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateSecretReplicas_To_certmanager_CertificateSecretReplicas(in *certmanagerv1.CertificateSecretReplicas, out *certmanager.CertificateSecretReplicas, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_v1_CertificateSecretReplicas_To_certmanager_CertificateSecretReplicas is an autogenerated conversion function.
+func Convert_v1_CertificateSecretReplicas_To_certmanager_CertificateSecretReplicas(in *certmanagerv1.CertificateSecretReplicas, out *certmanager.CertificateSecretReplicas, s conversion.Scope) error {
+	return autoConvert_v1_CertificateSecretReplicas_To_certmanager_CertificateSecretReplicas(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretReplicas_To_v1_CertificateSecretReplicas(in *certmanager.CertificateSecretReplicas, out *certmanagerv1.CertificateSecretReplicas, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretReplicas_To_v1_CertificateSecretReplicas is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretReplicas_To_v1_CertificateSecretReplicas(in *certmanager.CertificateSecretReplicas, out *certmanagerv1.CertificateSecretReplicas, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretReplicas_To_v1_CertificateSecretReplicas(in, out, s)
+}
+
 func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *certmanagerv1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
@@ -1058,6 +1770,10 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *certmanag
 	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
 	out.SecretName = in.SecretName
 	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.SecretReplicas = (*certmanager.CertificateSecretReplicas)(unsafe.Pointer(in.SecretReplicas))
+	out.TrustConfigMap = (*certmanager.CertificateTrustConfigMap)(unsafe.Pointer(in.TrustConfigMap))
+	out.RenewalRequest = (*certmanager.CertificateRenewalRequest)(unsafe.Pointer(in.RenewalRequest))
+	out.ExternalCSR = (*certmanager.CertificateExternalCSR)(unsafe.Pointer(in.ExternalCSR))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanager.CertificateKeystores)
@@ -1072,6 +1788,7 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *certmanag
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Profile = certmanager.CertificateProfile(in.Profile)
 	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
 	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
@@ -1101,6 +1818,10 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.OtherNames = *(*[]certmanagerv1.OtherName)(unsafe.Pointer(&in.OtherNames))
 	out.SecretName = in.SecretName
 	out.SecretTemplate = (*certmanagerv1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.SecretReplicas = (*certmanagerv1.CertificateSecretReplicas)(unsafe.Pointer(in.SecretReplicas))
+	out.TrustConfigMap = (*certmanagerv1.CertificateTrustConfigMap)(unsafe.Pointer(in.TrustConfigMap))
+	out.RenewalRequest = (*certmanagerv1.CertificateRenewalRequest)(unsafe.Pointer(in.RenewalRequest))
+	out.ExternalCSR = (*certmanagerv1.CertificateExternalCSR)(unsafe.Pointer(in.ExternalCSR))
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(certmanagerv1.CertificateKeystores)
@@ -1115,6 +1836,7 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	}
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanagerv1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Profile = certmanagerv1.CertificateProfile(in.Profile)
 	out.PrivateKey = (*certmanagerv1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
 	out.SignatureAlgorithm = certmanagerv1.SignatureAlgorithm(in.SignatureAlgorithm)
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
@@ -1137,7 +1859,9 @@ func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *certm
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.NextPrivateKeyPublishedTime = (*metav1.Time)(unsafe.Pointer(in.NextPrivateKeyPublishedTime))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewalRequest = (*certmanager.CertificateRenewalRequestStatus)(unsafe.Pointer(in.LastRenewalRequest))
 	out.ACME = (*certmanager.CertificateACMEStatus)(unsafe.Pointer(in.ACME))
 	return nil
 }
@@ -1155,7 +1879,9 @@ func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certm
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.NextPrivateKeyPublishedTime = (*metav1.Time)(unsafe.Pointer(in.NextPrivateKeyPublishedTime))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.LastRenewalRequest = (*certmanagerv1.CertificateRenewalRequestStatus)(unsafe.Pointer(in.LastRenewalRequest))
 	out.ACME = (*certmanagerv1.CertificateACMEStatus)(unsafe.Pointer(in.ACME))
 	return nil
 }
@@ -1165,6 +1891,28 @@ func Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanag
 	return autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in, out, s)
 }
 
+func autoConvert_v1_CertificateTrustConfigMap_To_certmanager_CertificateTrustConfigMap(in *certmanagerv1.CertificateTrustConfigMap, out *certmanager.CertificateTrustConfigMap, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IncludeCertificate = in.IncludeCertificate
+	return nil
+}
+
+// Convert_v1_CertificateTrustConfigMap_To_certmanager_CertificateTrustConfigMap is an autogenerated conversion function.
+func Convert_v1_CertificateTrustConfigMap_To_certmanager_CertificateTrustConfigMap(in *certmanagerv1.CertificateTrustConfigMap, out *certmanager.CertificateTrustConfigMap, s conversion.Scope) error {
+	return autoConvert_v1_CertificateTrustConfigMap_To_certmanager_CertificateTrustConfigMap(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateTrustConfigMap_To_v1_CertificateTrustConfigMap(in *certmanager.CertificateTrustConfigMap, out *certmanagerv1.CertificateTrustConfigMap, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IncludeCertificate = in.IncludeCertificate
+	return nil
+}
+
+// Convert_certmanager_CertificateTrustConfigMap_To_v1_CertificateTrustConfigMap is an autogenerated conversion function.
+func Convert_certmanager_CertificateTrustConfigMap_To_v1_CertificateTrustConfigMap(in *certmanager.CertificateTrustConfigMap, out *certmanagerv1.CertificateTrustConfigMap, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateTrustConfigMap_To_v1_CertificateTrustConfigMap(in, out, s)
+}
+
 func autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *certmanagerv1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1567,9 +2315,39 @@ func Convert_certmanager_OtherName_To_v1_OtherName(in *certmanager.OtherName, ou
 	return autoConvert_certmanager_OtherName_To_v1_OtherName(in, out, s)
 }
 
+func autoConvert_v1_PEMTruststore_To_certmanager_PEMTruststore(in *certmanagerv1.PEMTruststore, out *certmanager.PEMTruststore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_v1_PEMTruststore_To_certmanager_PEMTruststore is an autogenerated conversion function.
+func Convert_v1_PEMTruststore_To_certmanager_PEMTruststore(in *certmanagerv1.PEMTruststore, out *certmanager.PEMTruststore, s conversion.Scope) error {
+	return autoConvert_v1_PEMTruststore_To_certmanager_PEMTruststore(in, out, s)
+}
+
+func autoConvert_certmanager_PEMTruststore_To_v1_PEMTruststore(in *certmanager.PEMTruststore, out *certmanagerv1.PEMTruststore, s conversion.Scope) error {
+	out.Create = in.Create
+	return nil
+}
+
+// Convert_certmanager_PEMTruststore_To_v1_PEMTruststore is an autogenerated conversion function.
+func Convert_certmanager_PEMTruststore_To_v1_PEMTruststore(in *certmanager.PEMTruststore, out *certmanagerv1.PEMTruststore, s conversion.Scope) error {
+	return autoConvert_certmanager_PEMTruststore_To_v1_PEMTruststore(in, out, s)
+}
+
 func autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *certmanagerv1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Profile = certmanager.PKCS12Profile(in.Profile)
+	out.Alias = (*string)(unsafe.Pointer(in.Alias))
+	if in.KeyPasswordSecretRef != nil {
+		in, out := &in.KeyPasswordSecretRef, &out.KeyPasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KeyPasswordSecretRef = nil
+	}
 	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
@@ -1585,6 +2363,16 @@ func Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *certmanagerv1.P
 func autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *certmanagerv1.PKCS12Keystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Profile = certmanagerv1.PKCS12Profile(in.Profile)
+	out.Alias = (*string)(unsafe.Pointer(in.Alias))
+	if in.KeyPasswordSecretRef != nil {
+		in, out := &in.KeyPasswordSecretRef, &out.KeyPasswordSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KeyPasswordSecretRef = nil
+	}
 	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
@@ -1597,6 +2385,350 @@ func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKC
 	return autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1_SPIFFEIdentity_To_certmanager_SPIFFEIdentity(in *certmanagerv1.SPIFFEIdentity, out *certmanager.SPIFFEIdentity, s conversion.Scope) error {
+	out.TrustDomain = in.TrustDomain
+	out.Mode = certmanager.SPIFFEIdentityMode(in.Mode)
+	return nil
+}
+
+// Convert_v1_SPIFFEIdentity_To_certmanager_SPIFFEIdentity is an autogenerated conversion function.
+func Convert_v1_SPIFFEIdentity_To_certmanager_SPIFFEIdentity(in *certmanagerv1.SPIFFEIdentity, out *certmanager.SPIFFEIdentity, s conversion.Scope) error {
+	return autoConvert_v1_SPIFFEIdentity_To_certmanager_SPIFFEIdentity(in, out, s)
+}
+
+func autoConvert_certmanager_SPIFFEIdentity_To_v1_SPIFFEIdentity(in *certmanager.SPIFFEIdentity, out *certmanagerv1.SPIFFEIdentity, s conversion.Scope) error {
+	out.TrustDomain = in.TrustDomain
+	out.Mode = certmanagerv1.SPIFFEIdentityMode(in.Mode)
+	return nil
+}
+
+// Convert_certmanager_SPIFFEIdentity_To_v1_SPIFFEIdentity is an autogenerated conversion function.
+func Convert_certmanager_SPIFFEIdentity_To_v1_SPIFFEIdentity(in *certmanager.SPIFFEIdentity, out *certmanagerv1.SPIFFEIdentity, s conversion.Scope) error {
+	return autoConvert_certmanager_SPIFFEIdentity_To_v1_SPIFFEIdentity(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificate_To_certmanager_SSHCertificate(in *certmanagerv1.SSHCertificate, out *certmanager.SSHCertificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SSHCertificateSpec_To_certmanager_SSHCertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_SSHCertificateStatus_To_certmanager_SSHCertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SSHCertificate_To_certmanager_SSHCertificate is an autogenerated conversion function.
+func Convert_v1_SSHCertificate_To_certmanager_SSHCertificate(in *certmanagerv1.SSHCertificate, out *certmanager.SSHCertificate, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificate_To_certmanager_SSHCertificate(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificate_To_v1_SSHCertificate(in *certmanager.SSHCertificate, out *certmanagerv1.SSHCertificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_SSHCertificateSpec_To_v1_SSHCertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SSHCertificateStatus_To_v1_SSHCertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_SSHCertificate_To_v1_SSHCertificate is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificate_To_v1_SSHCertificate(in *certmanager.SSHCertificate, out *certmanagerv1.SSHCertificate, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificate_To_v1_SSHCertificate(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateList_To_certmanager_SSHCertificateList(in *certmanagerv1.SSHCertificateList, out *certmanager.SSHCertificateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.SSHCertificate, len(*in))
+		for i := range *in {
+			if err := Convert_v1_SSHCertificate_To_certmanager_SSHCertificate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_SSHCertificateList_To_certmanager_SSHCertificateList is an autogenerated conversion function.
+func Convert_v1_SSHCertificateList_To_certmanager_SSHCertificateList(in *certmanagerv1.SSHCertificateList, out *certmanager.SSHCertificateList, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateList_To_certmanager_SSHCertificateList(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateList_To_v1_SSHCertificateList(in *certmanager.SSHCertificateList, out *certmanagerv1.SSHCertificateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanagerv1.SSHCertificate, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_SSHCertificate_To_v1_SSHCertificate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateList_To_v1_SSHCertificateList is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateList_To_v1_SSHCertificateList(in *certmanager.SSHCertificateList, out *certmanagerv1.SSHCertificateList, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateList_To_v1_SSHCertificateList(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificatePrivateKey_To_certmanager_SSHCertificatePrivateKey(in *certmanagerv1.SSHCertificatePrivateKey, out *certmanager.SSHCertificatePrivateKey, s conversion.Scope) error {
+	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1_SSHCertificatePrivateKey_To_certmanager_SSHCertificatePrivateKey is an autogenerated conversion function.
+func Convert_v1_SSHCertificatePrivateKey_To_certmanager_SSHCertificatePrivateKey(in *certmanagerv1.SSHCertificatePrivateKey, out *certmanager.SSHCertificatePrivateKey, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificatePrivateKey_To_certmanager_SSHCertificatePrivateKey(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificatePrivateKey_To_v1_SSHCertificatePrivateKey(in *certmanager.SSHCertificatePrivateKey, out *certmanagerv1.SSHCertificatePrivateKey, s conversion.Scope) error {
+	out.RotationPolicy = certmanagerv1.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.Algorithm = certmanagerv1.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_certmanager_SSHCertificatePrivateKey_To_v1_SSHCertificatePrivateKey is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificatePrivateKey_To_v1_SSHCertificatePrivateKey(in *certmanager.SSHCertificatePrivateKey, out *certmanagerv1.SSHCertificatePrivateKey, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificatePrivateKey_To_v1_SSHCertificatePrivateKey(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateRequest_To_certmanager_SSHCertificateRequest(in *certmanagerv1.SSHCertificateRequest, out *certmanager.SSHCertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SSHCertificateRequestSpec_To_certmanager_SSHCertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_SSHCertificateRequestStatus_To_certmanager_SSHCertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SSHCertificateRequest_To_certmanager_SSHCertificateRequest is an autogenerated conversion function.
+func Convert_v1_SSHCertificateRequest_To_certmanager_SSHCertificateRequest(in *certmanagerv1.SSHCertificateRequest, out *certmanager.SSHCertificateRequest, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateRequest_To_certmanager_SSHCertificateRequest(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateRequest_To_v1_SSHCertificateRequest(in *certmanager.SSHCertificateRequest, out *certmanagerv1.SSHCertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_SSHCertificateRequestSpec_To_v1_SSHCertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_SSHCertificateRequestStatus_To_v1_SSHCertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateRequest_To_v1_SSHCertificateRequest is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateRequest_To_v1_SSHCertificateRequest(in *certmanager.SSHCertificateRequest, out *certmanagerv1.SSHCertificateRequest, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateRequest_To_v1_SSHCertificateRequest(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateRequestList_To_certmanager_SSHCertificateRequestList(in *certmanagerv1.SSHCertificateRequestList, out *certmanager.SSHCertificateRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.SSHCertificateRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1_SSHCertificateRequest_To_certmanager_SSHCertificateRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_SSHCertificateRequestList_To_certmanager_SSHCertificateRequestList is an autogenerated conversion function.
+func Convert_v1_SSHCertificateRequestList_To_certmanager_SSHCertificateRequestList(in *certmanagerv1.SSHCertificateRequestList, out *certmanager.SSHCertificateRequestList, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateRequestList_To_certmanager_SSHCertificateRequestList(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateRequestList_To_v1_SSHCertificateRequestList(in *certmanager.SSHCertificateRequestList, out *certmanagerv1.SSHCertificateRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanagerv1.SSHCertificateRequest, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_SSHCertificateRequest_To_v1_SSHCertificateRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateRequestList_To_v1_SSHCertificateRequestList is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateRequestList_To_v1_SSHCertificateRequestList(in *certmanager.SSHCertificateRequestList, out *certmanagerv1.SSHCertificateRequestList, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateRequestList_To_v1_SSHCertificateRequestList(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateRequestSpec_To_certmanager_SSHCertificateRequestSpec(in *certmanagerv1.SSHCertificateRequestSpec, out *certmanager.SSHCertificateRequestSpec, s conversion.Scope) error {
+	out.Type = certmanager.SSHCertificateType(in.Type)
+	out.KeyID = in.KeyID
+	out.Principals = *(*[]string)(unsafe.Pointer(&in.Principals))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Extensions = *(*map[string]string)(unsafe.Pointer(&in.Extensions))
+	out.CriticalOptions = *(*map[string]string)(unsafe.Pointer(&in.CriticalOptions))
+	out.PublicKey = *(*[]byte)(unsafe.Pointer(&in.PublicKey))
+	if err := internalapismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Username = in.Username
+	out.UID = in.UID
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Extra = *(*map[string][]string)(unsafe.Pointer(&in.Extra))
+	return nil
+}
+
+// Convert_v1_SSHCertificateRequestSpec_To_certmanager_SSHCertificateRequestSpec is an autogenerated conversion function.
+func Convert_v1_SSHCertificateRequestSpec_To_certmanager_SSHCertificateRequestSpec(in *certmanagerv1.SSHCertificateRequestSpec, out *certmanager.SSHCertificateRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateRequestSpec_To_certmanager_SSHCertificateRequestSpec(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateRequestSpec_To_v1_SSHCertificateRequestSpec(in *certmanager.SSHCertificateRequestSpec, out *certmanagerv1.SSHCertificateRequestSpec, s conversion.Scope) error {
+	out.Type = certmanagerv1.SSHCertificateType(in.Type)
+	out.KeyID = in.KeyID
+	out.Principals = *(*[]string)(unsafe.Pointer(&in.Principals))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.Extensions = *(*map[string]string)(unsafe.Pointer(&in.Extensions))
+	out.CriticalOptions = *(*map[string]string)(unsafe.Pointer(&in.CriticalOptions))
+	out.PublicKey = *(*[]byte)(unsafe.Pointer(&in.PublicKey))
+	if err := internalapismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Username = in.Username
+	out.UID = in.UID
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Extra = *(*map[string][]string)(unsafe.Pointer(&in.Extra))
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateRequestSpec_To_v1_SSHCertificateRequestSpec is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateRequestSpec_To_v1_SSHCertificateRequestSpec(in *certmanager.SSHCertificateRequestSpec, out *certmanagerv1.SSHCertificateRequestSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateRequestSpec_To_v1_SSHCertificateRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateRequestStatus_To_certmanager_SSHCertificateRequestStatus(in *certmanagerv1.SSHCertificateRequestStatus, out *certmanager.SSHCertificateRequestStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	return nil
+}
+
+// Convert_v1_SSHCertificateRequestStatus_To_certmanager_SSHCertificateRequestStatus is an autogenerated conversion function.
+func Convert_v1_SSHCertificateRequestStatus_To_certmanager_SSHCertificateRequestStatus(in *certmanagerv1.SSHCertificateRequestStatus, out *certmanager.SSHCertificateRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateRequestStatus_To_certmanager_SSHCertificateRequestStatus(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateRequestStatus_To_v1_SSHCertificateRequestStatus(in *certmanager.SSHCertificateRequestStatus, out *certmanagerv1.SSHCertificateRequestStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanagerv1.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateRequestStatus_To_v1_SSHCertificateRequestStatus is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateRequestStatus_To_v1_SSHCertificateRequestStatus(in *certmanager.SSHCertificateRequestStatus, out *certmanagerv1.SSHCertificateRequestStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateRequestStatus_To_v1_SSHCertificateRequestStatus(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateSpec_To_certmanager_SSHCertificateSpec(in *certmanagerv1.SSHCertificateSpec, out *certmanager.SSHCertificateSpec, s conversion.Scope) error {
+	out.Type = certmanager.SSHCertificateType(in.Type)
+	out.KeyID = in.KeyID
+	out.Principals = *(*[]string)(unsafe.Pointer(&in.Principals))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.Extensions = *(*map[string]string)(unsafe.Pointer(&in.Extensions))
+	out.CriticalOptions = *(*map[string]string)(unsafe.Pointer(&in.CriticalOptions))
+	out.SecretName = in.SecretName
+	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.PrivateKey = (*certmanager.SSHCertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	if err := internalapismetav1.Convert_v1_IssuerReference_To_meta_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SSHCertificateSpec_To_certmanager_SSHCertificateSpec is an autogenerated conversion function.
+func Convert_v1_SSHCertificateSpec_To_certmanager_SSHCertificateSpec(in *certmanagerv1.SSHCertificateSpec, out *certmanager.SSHCertificateSpec, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateSpec_To_certmanager_SSHCertificateSpec(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateSpec_To_v1_SSHCertificateSpec(in *certmanager.SSHCertificateSpec, out *certmanagerv1.SSHCertificateSpec, s conversion.Scope) error {
+	out.Type = certmanagerv1.SSHCertificateType(in.Type)
+	out.KeyID = in.KeyID
+	out.Principals = *(*[]string)(unsafe.Pointer(&in.Principals))
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.Extensions = *(*map[string]string)(unsafe.Pointer(&in.Extensions))
+	out.CriticalOptions = *(*map[string]string)(unsafe.Pointer(&in.CriticalOptions))
+	out.SecretName = in.SecretName
+	out.SecretTemplate = (*certmanagerv1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	out.PrivateKey = (*certmanagerv1.SSHCertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	if err := internalapismetav1.Convert_meta_IssuerReference_To_v1_IssuerReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateSpec_To_v1_SSHCertificateSpec is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateSpec_To_v1_SSHCertificateSpec(in *certmanager.SSHCertificateSpec, out *certmanagerv1.SSHCertificateSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateSpec_To_v1_SSHCertificateSpec(in, out, s)
+}
+
+func autoConvert_v1_SSHCertificateStatus_To_certmanager_SSHCertificateStatus(in *certmanagerv1.SSHCertificateStatus, out *certmanager.SSHCertificateStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
+	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	return nil
+}
+
+// Convert_v1_SSHCertificateStatus_To_certmanager_SSHCertificateStatus is an autogenerated conversion function.
+func Convert_v1_SSHCertificateStatus_To_certmanager_SSHCertificateStatus(in *certmanagerv1.SSHCertificateStatus, out *certmanager.SSHCertificateStatus, s conversion.Scope) error {
+	return autoConvert_v1_SSHCertificateStatus_To_certmanager_SSHCertificateStatus(in, out, s)
+}
+
+func autoConvert_certmanager_SSHCertificateStatus_To_v1_SSHCertificateStatus(in *certmanager.SSHCertificateStatus, out *certmanagerv1.SSHCertificateStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanagerv1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
+	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	return nil
+}
+
+// Convert_certmanager_SSHCertificateStatus_To_v1_SSHCertificateStatus is an autogenerated conversion function.
+func Convert_certmanager_SSHCertificateStatus_To_v1_SSHCertificateStatus(in *certmanager.SSHCertificateStatus, out *certmanagerv1.SSHCertificateStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_SSHCertificateStatus_To_v1_SSHCertificateStatus(in, out, s)
+}
+
 func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *certmanagerv1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
@@ -1804,6 +2936,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *certmanagerv1.Vau
 	out.Server = in.Server
 	out.ServerName = in.ServerName
 	out.Path = in.Path
+	out.SSHPath = in.SSHPath
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
@@ -1833,6 +2966,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *certmanagerv1.Vau
 	} else {
 		out.ClientKeySecretRef = nil
 	}
+	out.SPIFFE = (*certmanager.SPIFFEIdentity)(unsafe.Pointer(in.SPIFFE))
 	return nil
 }
 
@@ -1848,6 +2982,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	out.Server = in.Server
 	out.ServerName = in.ServerName
 	out.Path = in.Path
+	out.SSHPath = in.SSHPath
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
 	if in.CABundleSecretRef != nil {
@@ -1877,6 +3012,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	} else {
 		out.ClientKeySecretRef = nil
 	}
+	out.SPIFFE = (*certmanagerv1.SPIFFEIdentity)(unsafe.Pointer(in.SPIFFE))
 	return nil
 }
 
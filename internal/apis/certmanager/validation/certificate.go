@@ -17,6 +17,7 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/mail"
@@ -165,20 +166,7 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	}
 
 	if crt.PrivateKey != nil {
-		switch crt.PrivateKey.Algorithm {
-		case "", internalcmapi.RSAKeyAlgorithm:
-			if crt.PrivateKey.Size > 0 && (crt.PrivateKey.Size < pki.MinRSAKeySize || crt.PrivateKey.Size > pki.MaxRSAKeySize) {
-				el = append(el, field.Invalid(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, fmt.Sprintf("must be between %d and %d for rsa keyAlgorithm", pki.MinRSAKeySize, pki.MaxRSAKeySize)))
-			}
-		case internalcmapi.ECDSAKeyAlgorithm:
-			if crt.PrivateKey.Size > 0 && crt.PrivateKey.Size != 256 && crt.PrivateKey.Size != 384 && crt.PrivateKey.Size != 521 {
-				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"256", "384", "521"}))
-			}
-		case internalcmapi.Ed25519KeyAlgorithm:
-			break
-		default:
-			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
-		}
+		el = append(el, validatePrivateKey(crt.PrivateKey, fldPath.Child("privateKey"))...)
 	}
 
 	if crt.SignatureAlgorithm != "" {
@@ -199,19 +187,51 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	if len(crt.Usages) > 0 {
 		el = append(el, validateUsages(crt, fldPath)...)
 	}
+	if crt.Profile != "" {
+		el = append(el, validateProfile(crt, commonName, fldPath)...)
+	}
 	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
 		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
 	}
 
 	if crt.SecretTemplate != nil {
 		if len(crt.SecretTemplate.Labels) > 0 {
-			el = append(el, validateSecretTemplateLabels(crt, fldPath)...)
+			el = append(el, validateSecretTemplateLabels(crt.SecretTemplate, fldPath)...)
 		}
 		if len(crt.SecretTemplate.Annotations) > 0 {
-			el = append(el, validateSecretTemplateAnnotations(crt, fldPath)...)
+			el = append(el, validateSecretTemplateAnnotations(crt.SecretTemplate, fldPath)...)
+		}
+	}
+
+	if crt.SecretReplicas != nil {
+		el = append(el, validateSecretReplicas(crt, fldPath)...)
+	}
+
+	if crt.TrustConfigMap != nil {
+		trustConfigMapPath := fldPath.Child("trustConfigMap", "name")
+		if crt.TrustConfigMap.Name == "" {
+			el = append(el, field.Required(trustConfigMapPath, "must be specified"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(crt.TrustConfigMap.Name, false) {
+				el = append(el, field.Invalid(trustConfigMapPath, crt.TrustConfigMap.Name, msg))
+			}
 		}
 	}
 
+	if crt.RenewalRequest != nil {
+		renewalRequestPath := fldPath.Child("renewalRequest")
+		if crt.RenewalRequest.ID == "" {
+			el = append(el, field.Required(renewalRequestPath.Child("id"), "must be specified"))
+		}
+		if crt.RenewalRequest.Reason == "" {
+			el = append(el, field.Required(renewalRequestPath.Child("reason"), "must be specified"))
+		}
+	}
+
+	if crt.ExternalCSR != nil {
+		el = append(el, validateExternalCSR(crt, fldPath)...)
+	}
+
 	if crt.NameConstraints != nil {
 		el = append(el, validateNameConstraints(crt, fldPath)...)
 	}
@@ -327,21 +347,164 @@ func validateUsages(a *internalcmapi.CertificateSpec, fldPath *field.Path) field
 	return el
 }
 
-func validateSecretTemplateLabels(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
-	return metavalidation.ValidateLabels(crt.SecretTemplate.Labels, fldPath.Child("secretTemplate", "labels"))
+// validateProfile checks that the Certificate satisfies the constraints of
+// its profile. commonName is the common name from either the commonName or
+// the literalSubject field.
+func validateProfile(crt *internalcmapi.CertificateSpec, commonName string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	profile := cmapi.CertificateProfile(crt.Profile)
+	settings, ok := util.CertificateProfileSettingsFor(profile)
+	if !ok {
+		return append(el, field.NotSupported(fldPath.Child("profile"), crt.Profile, util.CertificateProfiles()))
+	}
+
+	if crt.IsCA {
+		el = append(el, field.Forbidden(fldPath.Child("isCA"), fmt.Sprintf("cannot be set for the %s profile", profile)))
+	}
+
+	if len(crt.Usages) > 0 {
+		hasRequired := false
+		for i, u := range crt.Usages {
+			eku, ok := util.ExtKeyUsageType(cmapi.KeyUsage(u))
+			if !ok {
+				continue
+			}
+			if eku == settings.RequiredExtKeyUsage {
+				hasRequired = true
+			}
+			if !slices.Contains(settings.AllowedExtKeyUsages, eku) {
+				el = append(el, field.Invalid(fldPath.Child("usages").Index(i), u, fmt.Sprintf("not allowed for the %s profile", profile)))
+			}
+		}
+		if !hasRequired {
+			required := util.ExtKeyUsageStrings([]x509.ExtKeyUsage{settings.RequiredExtKeyUsage})[0]
+			el = append(el, field.Invalid(fldPath.Child("usages"), crt.Usages, fmt.Sprintf("must contain %q for the %s profile", required, profile)))
+		}
+	}
+
+	if crt.PrivateKey != nil && (crt.PrivateKey.Algorithm == "" || crt.PrivateKey.Algorithm == internalcmapi.RSAKeyAlgorithm) &&
+		crt.PrivateKey.Size > 0 && crt.PrivateKey.Size < settings.MinimumRSAKeySize {
+		el = append(el, field.Invalid(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, fmt.Sprintf("must be at least %d for rsa keyAlgorithm with the %s profile", settings.MinimumRSAKeySize, profile)))
+	}
+
+	switch profile {
+	case cmapi.ServerTLSCertificateProfile:
+		if len(crt.DNSNames) == 0 && len(crt.IPAddresses) == 0 {
+			el = append(el, field.Required(fldPath.Child("dnsNames"), fmt.Sprintf("at least one of dnsNames or ipAddresses must be set for the %s profile", profile)))
+		}
+	case cmapi.CodeSigningCertificateProfile, cmapi.OCSPSigningCertificateProfile:
+		if len(commonName) == 0 {
+			el = append(el, field.Required(fldPath.Child("commonName"), fmt.Sprintf("commonName (from the commonName field or from a literalSubject) must be set for the %s profile", profile)))
+		}
+	case cmapi.SMIMECertificateProfile:
+		if len(crt.EmailAddresses) == 0 {
+			el = append(el, field.Required(fldPath.Child("emailAddresses"), fmt.Sprintf("must be set for the %s profile", profile)))
+		}
+	}
+
+	return el
 }
 
-func validateSecretTemplateAnnotations(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+func validatePrivateKey(privateKey *internalcmapi.CertificatePrivateKey, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch privateKey.Algorithm {
+	case "", internalcmapi.RSAKeyAlgorithm:
+		if privateKey.Size > 0 && (privateKey.Size < pki.MinRSAKeySize || privateKey.Size > pki.MaxRSAKeySize) {
+			el = append(el, field.Invalid(fldPath.Child("size"), privateKey.Size, fmt.Sprintf("must be between %d and %d for rsa keyAlgorithm", pki.MinRSAKeySize, pki.MaxRSAKeySize)))
+		}
+	case internalcmapi.ECDSAKeyAlgorithm:
+		if privateKey.Size > 0 && privateKey.Size != 256 && privateKey.Size != 384 && privateKey.Size != 521 {
+			el = append(el, field.NotSupported(fldPath.Child("size"), privateKey.Size, []string{"256", "384", "521"}))
+		}
+	case internalcmapi.Ed25519KeyAlgorithm:
+		break
+	default:
+		el = append(el, field.Invalid(fldPath.Child("algorithm"), privateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+	}
+
+	if privateKey.RotationOverlap != nil && privateKey.RotationOverlap.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("rotationOverlap"), privateKey.RotationOverlap.Duration, "must be greater than 0"))
+	}
+
+	return el
+}
+
+func validateSecretTemplateLabels(tmpl *internalcmapi.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
+	return metavalidation.ValidateLabels(tmpl.Labels, fldPath.Child("secretTemplate", "labels"))
+}
+
+func validateSecretTemplateAnnotations(tmpl *internalcmapi.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
 	secretTemplateAnnotationsPath := fldPath.Child("secretTemplate", "annotations")
-	for a := range crt.SecretTemplate.Annotations {
+	for a := range tmpl.Annotations {
 		if strings.HasPrefix(a, "cert-manager.io/") && a != "cert-manager.io/allow-direct-injection" {
 			el = append(el, field.Invalid(secretTemplateAnnotationsPath, a, "cert-manager.io/* annotations are not allowed"))
 		}
 	}
 
-	el = append(el, apivalidation.ValidateAnnotations(crt.SecretTemplate.Annotations, secretTemplateAnnotationsPath)...)
+	el = append(el, apivalidation.ValidateAnnotations(tmpl.Annotations, secretTemplateAnnotationsPath)...)
+	return el
+}
+
+func validateSecretReplicas(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	secretReplicasPath := fldPath.Child("secretReplicas")
+	if len(crt.SecretReplicas.Namespaces) == 0 && crt.SecretReplicas.NamespaceSelector == nil {
+		el = append(el, field.Required(secretReplicasPath, "at least one of namespaces or namespaceSelector must be set"))
+	}
+
+	for i, ns := range crt.SecretReplicas.Namespaces {
+		for _, msg := range apivalidation.ValidateNamespaceName(ns, false) {
+			el = append(el, field.Invalid(secretReplicasPath.Child("namespaces").Index(i), ns, msg))
+		}
+	}
+
+	if crt.SecretReplicas.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(crt.SecretReplicas.NamespaceSelector,
+			metavalidation.LabelSelectorValidationOptions{}, secretReplicasPath.Child("namespaceSelector"))...)
+	}
+
+	return el
+}
+
+func validateExternalCSR(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	externalCSRPath := fldPath.Child("externalCSR")
+	switch crt.ExternalCSR.Kind {
+	case "", "Secret":
+		if crt.ExternalCSR.Name == crt.SecretName {
+			el = append(el, field.Invalid(externalCSRPath.Child("name"), crt.ExternalCSR.Name, "must not be the Certificate's secretName"))
+		}
+	case "ConfigMap":
+	default:
+		el = append(el, field.NotSupported(externalCSRPath.Child("kind"), crt.ExternalCSR.Kind, []string{"Secret", "ConfigMap"}))
+	}
+
+	if crt.ExternalCSR.Name == "" {
+		el = append(el, field.Required(externalCSRPath.Child("name"), "must be specified"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(crt.ExternalCSR.Name, false) {
+			el = append(el, field.Invalid(externalCSRPath.Child("name"), crt.ExternalCSR.Name, msg))
+		}
+	}
+
+	// The private key is held outside of cert-manager, so fields which
+	// configure or require the private key cannot be used.
+	if crt.PrivateKey != nil {
+		el = append(el, field.Forbidden(fldPath.Child("privateKey"), "cannot be set when externalCSR is set"))
+	}
+	if crt.Keystores != nil {
+		el = append(el, field.Forbidden(fldPath.Child("keystores"), "cannot be set when externalCSR is set"))
+	}
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("additionalOutputFormats"), "cannot be set when externalCSR is set"))
+	}
+
 	return el
 }
 
@@ -482,6 +645,15 @@ func validateKeystores(crt *internalcmapi.CertificateSpec, fldPath *field.Path)
 		if crt.Keystores.PKCS12.Password != nil && len(*crt.Keystores.PKCS12.Password) == 0 {
 			el = append(el, field.Forbidden(fldPath.Child("keystores", "pkcs12", "password"), fmt.Sprintf(keystoresLiteralPasswordMustNotBeEmptyFmt, "PKCS#12")))
 		}
+
+		if (crt.Keystores.PKCS12.Alias != nil || crt.Keystores.PKCS12.KeyPasswordSecretRef != nil) &&
+			crt.Keystores.PKCS12.Profile != internalcmapi.Modern2023PKCS12Profile {
+			el = append(el, field.Forbidden(fldPath.Child("keystores", "pkcs12", "profile"), fmt.Sprintf("alias and keyPasswordSecretRef can only be set when profile is %s", internalcmapi.Modern2023PKCS12Profile)))
+		}
+
+		if crt.Keystores.PKCS12.KeyPasswordSecretRef != nil && crt.Keystores.PKCS12.KeyPasswordSecretRef.Name == "" {
+			el = append(el, field.Required(fldPath.Child("keystores", "pkcs12", "keyPasswordSecretRef", "name"), "must be specified"))
+		}
 	}
 
 	return el
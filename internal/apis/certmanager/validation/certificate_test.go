@@ -665,6 +665,205 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
 			},
 		},
+		"valid with secretReplicas": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretReplicas: &internalcmapi.CertificateSecretReplicas{
+						Namespaces: []string{"team-a", "team-b"},
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"shared-certs": "true"},
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with empty secretReplicas": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "testcn",
+					SecretName:     "abc",
+					SecretReplicas: &internalcmapi.CertificateSecretReplicas{},
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("secretReplicas"), "at least one of namespaces or namespaceSelector must be set"),
+			},
+		},
+		"invalid with secretReplicas namespace name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretReplicas: &internalcmapi.CertificateSecretReplicas{
+						Namespaces: []string{"team-a", "Not_Valid"},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretReplicas", "namespaces").Index(1), "Not_Valid", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		"invalid with secretReplicas namespaceSelector": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretReplicas: &internalcmapi.CertificateSecretReplicas{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "shared-certs", Operator: "Unknown"}},
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretReplicas", "namespaceSelector", "matchExpressions").Index(0).Child("operator"), metav1.LabelSelectorOperator("Unknown"), "not a valid selector operator"),
+			},
+		},
+		"valid with trustConfigMap": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "testcn",
+					SecretName:     "abc",
+					TrustConfigMap: &internalcmapi.CertificateTrustConfigMap{Name: "abc-ca", IncludeCertificate: true},
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with empty trustConfigMap name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "testcn",
+					SecretName:     "abc",
+					TrustConfigMap: &internalcmapi.CertificateTrustConfigMap{},
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("trustConfigMap", "name"), "must be specified"),
+			},
+		},
+		"invalid with trustConfigMap name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "testcn",
+					SecretName:     "abc",
+					TrustConfigMap: &internalcmapi.CertificateTrustConfigMap{Name: "Not_Valid"},
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("trustConfigMap", "name"), "Not_Valid", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+		"valid with privateKey rotationOverlap": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					PrivateKey: &internalcmapi.CertificatePrivateKey{RotationOverlap: &metav1.Duration{Duration: time.Hour}},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with negative privateKey rotationOverlap": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					PrivateKey: &internalcmapi.CertificatePrivateKey{RotationOverlap: &metav1.Duration{Duration: -time.Hour}},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "rotationOverlap"), -time.Hour, "must be greater than 0"),
+			},
+		},
+		"valid with renewalRequest": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "testcn",
+					SecretName:     "abc",
+					RenewalRequest: &internalcmapi.CertificateRenewalRequest{ID: "incident-123", Reason: "key compromise", RotatePrivateKey: true},
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with empty renewalRequest": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:     "testcn",
+					SecretName:     "abc",
+					RenewalRequest: &internalcmapi.CertificateRenewalRequest{},
+					IssuerRef:      validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("renewalRequest", "id"), "must be specified"),
+				field.Required(fldPath.Child("renewalRequest", "reason"), "must be specified"),
+			},
+		},
+		"valid with externalCSR": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:  "testcn",
+					SecretName:  "abc",
+					ExternalCSR: &internalcmapi.CertificateExternalCSR{Kind: "ConfigMap", Name: "csr"},
+					IssuerRef:   validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with externalCSR referencing the Certificate's Secret": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:  "testcn",
+					SecretName:  "abc",
+					ExternalCSR: &internalcmapi.CertificateExternalCSR{Name: "abc"},
+					IssuerRef:   validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("externalCSR", "name"), "abc", "must not be the Certificate's secretName"),
+			},
+		},
+		"invalid with externalCSR and private key options": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:  "testcn",
+					SecretName:  "abc",
+					ExternalCSR: &internalcmapi.CertificateExternalCSR{Kind: "Pod", Name: "csr"},
+					PrivateKey:  &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm},
+					AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+						{Type: internalcmapi.CertificateOutputFormatDER},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("externalCSR", "kind"), "Pod", []string{"Secret", "ConfigMap"}),
+				field.Forbidden(fldPath.Child("privateKey"), "cannot be set when externalCSR is set"),
+				field.Forbidden(fldPath.Child("additionalOutputFormats"), "cannot be set when externalCSR is set"),
+			},
+		},
 		"invalid due to too long 'CertificateSecretTemplate' annotations": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -1566,6 +1765,68 @@ func Test_validateKeystores(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 		},
+		"PKCS12 alias and KeyPasswordSecretRef are valid with the Modern2023 profile": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Profile:  internalcmapi.Modern2023PKCS12Profile,
+							Password: &keystorePassword,
+							Alias:    new("friendly-name"),
+							KeyPasswordSecretRef: &cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "secret",
+								},
+							},
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"PKCS12 alias requires the Modern2023 profile": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Profile:  internalcmapi.LegacyDESPKCS12Profile,
+							Password: &keystorePassword,
+							Alias:    new("friendly-name"),
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("keystores", "pkcs12", "profile"), "alias and keyPasswordSecretRef can only be set when profile is Modern2023"),
+			},
+			a: someAdmissionRequest,
+		},
+		"PKCS12 KeyPasswordSecretRef requires a name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					Keystores: &internalcmapi.CertificateKeystores{
+						PKCS12: &internalcmapi.PKCS12Keystore{
+							Profile:              internalcmapi.Modern2023PKCS12Profile,
+							Password:             &keystorePassword,
+							KeyPasswordSecretRef: &cmmeta.SecretKeySelector{},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("keystores", "pkcs12", "keyPasswordSecretRef", "name"), "must be specified"),
+			},
+			a: someAdmissionRequest,
+		},
 	}
 
 	for name, test := range tests {
@@ -1576,3 +1837,155 @@ func Test_validateKeystores(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateProfile(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	tests := map[string]struct {
+		spec internalcmapi.CertificateSpec
+		errs []*field.Error
+	}{
+		"serverTLS with default usages": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:  internalcmapi.ServerTLSCertificateProfile,
+				DNSNames: []string{"example.com"},
+			},
+		},
+		"serverTLS allows client auth": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:  internalcmapi.ServerTLSCertificateProfile,
+				DNSNames: []string{"example.com"},
+				Usages:   []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature, internalcmapi.UsageServerAuth, internalcmapi.UsageClientAuth},
+			},
+		},
+		"serverTLS requires dnsNames or ipAddresses": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.ServerTLSCertificateProfile,
+				CommonName: "example.com",
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("dnsNames"), "at least one of dnsNames or ipAddresses must be set for the serverTLS profile"),
+			},
+		},
+		"serverTLS cannot be a CA": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:  internalcmapi.ServerTLSCertificateProfile,
+				DNSNames: []string{"example.com"},
+				IsCA:     true,
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("isCA"), "cannot be set for the serverTLS profile"),
+			},
+		},
+		"clientTLS with a URI SAN": {
+			spec: internalcmapi.CertificateSpec{
+				Profile: internalcmapi.ClientTLSCertificateProfile,
+				URIs:    []string{"spiffe://cluster.local/ns/default/sa/client"},
+			},
+		},
+		"clientTLS requires an identity": {
+			spec: internalcmapi.CertificateSpec{
+				Profile: internalcmapi.ClientTLSCertificateProfile,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath, "", "at least one of commonName (from the commonName field or from a literalSubject), dnsNames, emailSANs, ipAddresses, otherNames, or uriSANs must be set"),
+			},
+		},
+		"clientTLS forbids server auth": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.ClientTLSCertificateProfile,
+				CommonName: "alice",
+				Usages:     []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature, internalcmapi.UsageClientAuth, internalcmapi.UsageServerAuth},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(2), internalcmapi.UsageServerAuth, "not allowed for the clientTLS profile"),
+			},
+		},
+		"codeSigning forbids server auth": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.CodeSigningCertificateProfile,
+				CommonName: "Example Code Signing",
+				Usages:     []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature, internalcmapi.UsageCodeSigning, internalcmapi.UsageServerAuth},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages").Index(2), internalcmapi.UsageServerAuth, "not allowed for the codeSigning profile"),
+			},
+		},
+		"codeSigning requires a common name": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:  internalcmapi.CodeSigningCertificateProfile,
+				DNSNames: []string{"example.com"},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("commonName"), "commonName (from the commonName field or from a literalSubject) must be set for the codeSigning profile"),
+			},
+		},
+		"codeSigning requires 3072 bit RSA keys": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.CodeSigningCertificateProfile,
+				CommonName: "Example Code Signing",
+				PrivateKey: &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.RSAKeyAlgorithm, Size: 2048},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "size"), 2048, "must be at least 3072 for rsa keyAlgorithm with the codeSigning profile"),
+			},
+		},
+		"codeSigning allows ECDSA keys": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.CodeSigningCertificateProfile,
+				CommonName: "Example Code Signing",
+				PrivateKey: &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 256},
+			},
+		},
+		"smime requires emailAddresses": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.SMIMECertificateProfile,
+				CommonName: "Alice",
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("emailAddresses"), "must be set for the smime profile"),
+			},
+		},
+		"smime accepts the s/mime usage alias": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:        internalcmapi.SMIMECertificateProfile,
+				EmailAddresses: []string{"alice@example.com"},
+				Usages:         []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature, internalcmapi.UsageSMIME},
+			},
+		},
+		"smime usages must include email protection": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:        internalcmapi.SMIMECertificateProfile,
+				EmailAddresses: []string{"alice@example.com"},
+				Usages:         []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usages"), []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature}, `must contain "email protection" for the smime profile`),
+			},
+		},
+		"ocspSigning with default usages": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    internalcmapi.OCSPSigningCertificateProfile,
+				CommonName: "Example OCSP Responder",
+			},
+		},
+		"unknown profile": {
+			spec: internalcmapi.CertificateSpec{
+				Profile:    "timestamping",
+				CommonName: "example",
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("profile"), internalcmapi.CertificateProfile("timestamping"), []cmapi.CertificateProfile{"serverTLS", "clientTLS", "codeSigning", "smime", "ocspSigning"}),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.spec.SecretName = "abc"
+			test.spec.IssuerRef = validIssuerRef
+			errs, warnings := ValidateCertificate(someAdmissionRequest, &internalcmapi.Certificate{Spec: test.spec})
+			assert.ElementsMatch(t, errs, test.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Validation functions for cert-manager CertificateDefaultPolicy types
+
+func ValidateCertificateDefaultPolicySpec(spec *internalcmapi.CertificateDefaultPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if spec.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(spec.NamespaceSelector,
+			metavalidation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	if spec.PrivateKey != nil {
+		el = append(el, validatePrivateKey(spec.PrivateKey, fldPath.Child("privateKey"))...)
+	}
+
+	if spec.Duration != nil && spec.Duration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), spec.Duration.Duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+	}
+
+	if spec.RenewBeforePercentage != nil && (*spec.RenewBeforePercentage <= 0 || *spec.RenewBeforePercentage >= 100) {
+		el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), *spec.RenewBeforePercentage, "must be in the range (0,100)"))
+	}
+
+	if spec.SecretTemplate != nil {
+		el = append(el, validateSecretTemplateLabels(spec.SecretTemplate, fldPath)...)
+		el = append(el, validateSecretTemplateAnnotations(spec.SecretTemplate, fldPath)...)
+	}
+
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 1 {
+		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must not be less than 1"))
+	}
+
+	return el
+}
+
+func ValidateCertificateDefaultPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*internalcmapi.CertificateDefaultPolicy)
+	return ValidateCertificateDefaultPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCertificateDefaultPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*internalcmapi.CertificateDefaultPolicy)
+	return ValidateCertificateDefaultPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCertificateDefaultPolicy(t *testing.T) {
+	fldPath := field.NewPath("spec")
+
+	scenarios := map[string]struct {
+		spec internalcmapi.CertificateDefaultPolicySpec
+		errs []*field.Error
+	}{
+		"empty policy": {},
+		"valid policy": {
+			spec: internalcmapi.CertificateDefaultPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				PrivateKey: &internalcmapi.CertificatePrivateKey{
+					Algorithm:      internalcmapi.ECDSAKeyAlgorithm,
+					Size:           384,
+					RotationPolicy: internalcmapi.RotationPolicyAlways,
+				},
+				Duration:              &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				RenewBeforePercentage: new(int32(25)),
+				SecretTemplate:        &internalcmapi.CertificateSecretTemplate{Labels: map[string]string{"org": "example"}},
+				RevisionHistoryLimit:  new(int32(3)),
+			},
+		},
+		"invalid private key size": {
+			spec: internalcmapi.CertificateDefaultPolicySpec{
+				PrivateKey: &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 128},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "size"), 128, []string{"256", "384", "521"}),
+			},
+		},
+		"duration below the minimum": {
+			spec: internalcmapi.CertificateDefaultPolicySpec{
+				Duration: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("duration"), 30*time.Minute, "certificate duration must be greater than 1h0m0s"),
+			},
+		},
+		"renewBeforePercentage out of range": {
+			spec: internalcmapi.CertificateDefaultPolicySpec{
+				RenewBeforePercentage: new(int32(100)),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), "must be in the range (0,100)"),
+			},
+		},
+		"cert-manager.io annotation in secret template": {
+			spec: internalcmapi.CertificateDefaultPolicySpec{
+				SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+					Annotations: map[string]string{"cert-manager.io/certificate-name": "other"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
+			},
+		},
+		"revisionHistoryLimit less than 1": {
+			spec: internalcmapi.CertificateDefaultPolicySpec{
+				RevisionHistoryLimit: new(int32(0)),
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, warnings := ValidateCertificateDefaultPolicy(someAdmissionRequest, &internalcmapi.CertificateDefaultPolicy{Spec: s.spec})
+			assert.ElementsMatch(t, errs, s.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
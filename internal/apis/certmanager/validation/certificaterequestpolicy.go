@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Validation functions for cert-manager CertificateRequestPolicy types
+
+func ValidateCertificateRequestPolicySpec(spec *internalcmapi.CertificateRequestPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.IssuerRefs) == 0 {
+		el = append(el, field.Required(fldPath.Child("issuerRefs"), "at least one issuerRef must be specified"))
+	}
+
+	if spec.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(spec.NamespaceSelector,
+			metavalidation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	if spec.Requesters != nil && len(spec.Requesters.Usernames) == 0 && len(spec.Requesters.Groups) == 0 {
+		el = append(el, field.Required(fldPath.Child("requesters"), "at least one of usernames or groups must be specified"))
+	}
+
+	allowedPath := fldPath.Child("allowed")
+	for i, ipRange := range spec.Allowed.IPRanges {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			el = append(el, field.Invalid(allowedPath.Child("ipRanges").Index(i), ipRange, "must be a valid CIDR range"))
+		}
+	}
+
+	for i, u := range spec.Allowed.Usages {
+		_, kok := util.KeyUsageType(cmapi.KeyUsage(u))
+		_, ekok := util.ExtKeyUsageType(cmapi.KeyUsage(u))
+		if !kok && !ekok {
+			el = append(el, field.Invalid(allowedPath.Child("usages").Index(i), u, "unknown keyusage"))
+		}
+	}
+
+	for i, algorithm := range spec.Allowed.KeyAlgorithms {
+		switch algorithm {
+		case internalcmapi.RSAKeyAlgorithm, internalcmapi.ECDSAKeyAlgorithm, internalcmapi.Ed25519KeyAlgorithm:
+		default:
+			el = append(el, field.NotSupported(allowedPath.Child("keyAlgorithms").Index(i), algorithm, []internalcmapi.PrivateKeyAlgorithm{
+				internalcmapi.RSAKeyAlgorithm, internalcmapi.ECDSAKeyAlgorithm, internalcmapi.Ed25519KeyAlgorithm,
+			}))
+		}
+	}
+
+	if spec.Allowed.MaxDuration != nil && spec.Allowed.MaxDuration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(allowedPath.Child("maxDuration"), spec.Allowed.MaxDuration.Duration, "must be at least "+cmapi.MinimumCertificateDuration.String()))
+	}
+
+	if ssh := spec.Allowed.SSH; ssh != nil && len(ssh.UserPrincipals) == 0 && len(ssh.HostPrincipals) == 0 {
+		el = append(el, field.Required(allowedPath.Child("ssh"), "at least one of userPrincipals or hostPrincipals must be specified"))
+	}
+
+	return el
+}
+
+func ValidateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*internalcmapi.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*internalcmapi.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
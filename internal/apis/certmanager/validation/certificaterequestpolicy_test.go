@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCertificateRequestPolicy(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	validSpec := func(mod func(*internalcmapi.CertificateRequestPolicySpec)) *internalcmapi.CertificateRequestPolicy {
+		spec := internalcmapi.CertificateRequestPolicySpec{
+			IssuerRefs: []internalcmapi.CertificateRequestPolicyIssuerRef{{Name: "*", Kind: "ClusterIssuer"}},
+			Allowed: internalcmapi.CertificateRequestPolicyAllowed{
+				DNSNames: []string{"*.example.com"},
+				IPRanges: []string{"10.0.0.0/8", "fd00::/8"},
+				Usages:   []internalcmapi.KeyUsage{internalcmapi.UsageDigitalSignature, internalcmapi.UsageServerAuth},
+			},
+		}
+		if mod != nil {
+			mod(&spec)
+		}
+		return &internalcmapi.CertificateRequestPolicy{Spec: spec}
+	}
+
+	scenarios := map[string]struct {
+		cfg  *internalcmapi.CertificateRequestPolicy
+		errs []*field.Error
+	}{
+		"valid policy": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+				spec.Requesters = &internalcmapi.CertificateRequestPolicyRequesters{Groups: []string{"system:authenticated"}}
+				spec.Allowed.KeyAlgorithms = []internalcmapi.PrivateKeyAlgorithm{internalcmapi.ECDSAKeyAlgorithm}
+				spec.Allowed.MaxDuration = &metav1.Duration{Duration: time.Hour * 24}
+				spec.Allowed.SSH = &internalcmapi.CertificateRequestPolicyAllowedSSH{
+					UserPrincipals: []string{"alice"},
+					Extensions:     []string{"permit-pty"},
+				}
+			}),
+		},
+		"missing issuerRefs": {
+			cfg: &internalcmapi.CertificateRequestPolicy{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("issuerRefs"), "at least one issuerRef must be specified"),
+			},
+		},
+		"empty requesters": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.Requesters = &internalcmapi.CertificateRequestPolicyRequesters{}
+			}),
+			errs: []*field.Error{
+				field.Required(fldPath.Child("requesters"), "at least one of usernames or groups must be specified"),
+			},
+		},
+		"invalid ipRange": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.Allowed.IPRanges = []string{"10.0.0.1"}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("allowed", "ipRanges").Index(0), "10.0.0.1", "must be a valid CIDR range"),
+			},
+		},
+		"unknown usage": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.Allowed.Usages = []internalcmapi.KeyUsage{"nonsense"}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("allowed", "usages").Index(0), internalcmapi.KeyUsage("nonsense"), "unknown keyusage"),
+			},
+		},
+		"unsupported key algorithm": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.Allowed.KeyAlgorithms = []internalcmapi.PrivateKeyAlgorithm{"DSA"}
+			}),
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("allowed", "keyAlgorithms").Index(0), internalcmapi.PrivateKeyAlgorithm("DSA"), []internalcmapi.PrivateKeyAlgorithm{
+					internalcmapi.RSAKeyAlgorithm, internalcmapi.ECDSAKeyAlgorithm, internalcmapi.Ed25519KeyAlgorithm,
+				}),
+			},
+		},
+		"maxDuration below the minimum": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.Allowed.MaxDuration = &metav1.Duration{Duration: time.Minute * 30}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("allowed", "maxDuration"), time.Minute*30, "must be at least 1h0m0s"),
+			},
+		},
+		"ssh without principals": {
+			cfg: validSpec(func(spec *internalcmapi.CertificateRequestPolicySpec) {
+				spec.Allowed.SSH = &internalcmapi.CertificateRequestPolicyAllowedSSH{Extensions: []string{"permit-pty"}}
+			}),
+			errs: []*field.Error{
+				field.Required(fldPath.Child("allowed", "ssh"), "at least one of userPrincipals or hostPrincipals must be specified"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, warnings := ValidateCertificateRequestPolicy(someAdmissionRequest, s.cfg)
+			assert.ElementsMatch(t, errs, s.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
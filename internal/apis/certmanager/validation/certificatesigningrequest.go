@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/json"
+	"strings"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cert-manager/cert-manager/internal/webhook/feature"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Validation functions for Kubernetes CertificateSigningRequests referencing
+// cert-manager signers
+
+// certificateSigningRequestRequestAnnotations are the annotations which
+// determine the certificate issued for a CertificateSigningRequest, and so may
+// not be changed after creation.
+var certificateSigningRequestRequestAnnotations = []string{
+	experimentalapi.CertificateSigningRequestDurationAnnotationKey,
+	experimentalapi.CertificateSigningRequestIsCAAnnotationKey,
+	experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey,
+	experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey,
+	experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey,
+	experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey,
+}
+
+var oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+func ValidateCertificateSigningRequest(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	csr := obj.(*certificatesv1.CertificateSigningRequest)
+	if !isCertManagerSignerName(csr.Spec.SignerName) {
+		return nil, nil
+	}
+
+	el := validateCertificateSigningRequestAnnotations(csr.Annotations, field.NewPath("metadata", "annotations"))
+	el = append(el, validateCertificateSigningRequestRequest(csr, field.NewPath("spec", "request"))...)
+	return el, nil
+}
+
+func ValidateUpdateCertificateSigningRequest(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	oldCSR, csr := oldObj.(*certificatesv1.CertificateSigningRequest), obj.(*certificatesv1.CertificateSigningRequest)
+	if !isCertManagerSignerName(csr.Spec.SignerName) {
+		return nil, nil
+	}
+
+	// Enforce that the annotations which determine the issued certificate may
+	// not be modified after creation, to prevent changing the request during
+	// processing. Other annotations, such as the Venafi pickup ID, are set by
+	// the signers themselves.
+	var el field.ErrorList
+	annotationsPath := field.NewPath("metadata", "annotations")
+	for _, key := range certificateSigningRequestRequestAnnotations {
+		oldValue, oldOK := oldCSR.Annotations[key]
+		newValue, newOK := csr.Annotations[key]
+		if oldOK != newOK || oldValue != newValue {
+			el = append(el, field.Forbidden(annotationsPath.Key(key), "cannot change cert-manager annotation after creation"))
+		}
+	}
+
+	return el, nil
+}
+
+// isCertManagerSignerName returns true if the signer name references a
+// cert-manager.io Issuer or ClusterIssuer.
+func isCertManagerSignerName(signerName string) bool {
+	signerType, _, _ := strings.Cut(signerName, "/")
+	return signerType == "issuers."+certmanager.GroupName || signerType == "clusterissuers."+certmanager.GroupName
+}
+
+func validateCertificateSigningRequestAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if value, ok := annotations[experimentalapi.CertificateSigningRequestDurationAnnotationKey]; ok {
+		keyPath := fldPath.Key(experimentalapi.CertificateSigningRequestDurationAnnotationKey)
+		duration, err := time.ParseDuration(value)
+		switch {
+		case err != nil:
+			el = append(el, field.Invalid(keyPath, value, "must be a valid Go duration"))
+		case duration < experimentalapi.CertificateSigningRequestMinimumDuration:
+			el = append(el, field.Invalid(keyPath, value, "must be at least "+experimentalapi.CertificateSigningRequestMinimumDuration.String()))
+		}
+	}
+
+	if value, ok := annotations[experimentalapi.CertificateSigningRequestIsCAAnnotationKey]; ok && value != "true" && value != "false" {
+		el = append(el, field.NotSupported(fldPath.Key(experimentalapi.CertificateSigningRequestIsCAAnnotationKey), value, []string{"true", "false"}))
+	}
+
+	if value, ok := annotations[experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey]; ok && value == "" {
+		el = append(el, field.Invalid(fldPath.Key(experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey), value, "must not be empty"))
+	}
+
+	if value, ok := annotations[experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey]; ok {
+		keyPath := fldPath.Key(experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey)
+		// The chain is copied to the Order, which limits it to 64 characters
+		// like the preferredChain of the ACME issuer.
+		switch {
+		case value == "":
+			el = append(el, field.Invalid(keyPath, value, "must not be empty"))
+		case len(value) > 64:
+			el = append(el, field.TooLong(keyPath, value, 64))
+		}
+	}
+
+	if value, ok := annotations[experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey]; ok {
+		for _, msg := range validation.IsDNS1123Subdomain(value) {
+			el = append(el, field.Invalid(fldPath.Key(experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey), value, msg))
+		}
+	}
+
+	if value, ok := annotations[experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey]; ok && value != "" {
+		var customFields []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		}
+		if err := json.Unmarshal([]byte(value), &customFields); err != nil {
+			el = append(el, field.Invalid(fldPath.Key(experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey), value, "must be a JSON array of objects with name and value keys"))
+		}
+	}
+
+	return el
+}
+
+func validateCertificateSigningRequestRequest(csr *certificatesv1.CertificateSigningRequest, fldPath *field.Path) field.ErrorList {
+	if len(csr.Spec.Request) == 0 {
+		return field.ErrorList{field.Required(fldPath, "must be specified")}
+	}
+
+	keyUsage, extKeyUsage, err := pki.BuildKeyUsagesKube(csr.Spec.Usages)
+	if err != nil {
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "usages"), csr.Spec.Usages, err.Error())}
+	}
+
+	isCA := csr.Annotations[experimentalapi.CertificateSigningRequestIsCAAnnotationKey] == "true"
+	template, err := pki.CertificateTemplateFromCSRPEM(
+		csr.Spec.Request,
+		pki.CertificateTemplateValidateAndOverrideBasicConstraints(isCA, nil),
+		pki.CertificateTemplateValidateAndOverrideKeyUsages(keyUsage, extKeyUsage),
+	)
+	if err != nil {
+		// truncate the request to avoid creating a ridiculously long error message with the whole CSR in it
+		return field.ErrorList{field.Invalid(fldPath, truncateString(string(csr.Spec.Request)), err.Error())}
+	}
+
+	var el field.ErrorList
+
+	if hasNameConstraints(template) {
+		if !utilfeature.DefaultFeatureGate.Enabled(feature.NameConstraints) {
+			el = append(el, field.Forbidden(fldPath, "feature gate NameConstraints must be enabled to request nameConstraints"))
+		} else if !isCA {
+			el = append(el, field.Invalid(fldPath, truncateString(string(csr.Spec.Request)),
+				"the "+experimentalapi.CertificateSigningRequestIsCAAnnotationKey+` annotation must be "true" when nameConstraints are requested`))
+		}
+	}
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.OtherNames) {
+		req, err := pki.DecodeX509CertificateRequestBytes(csr.Spec.Request)
+		if err != nil {
+			return append(el, field.Invalid(fldPath, truncateString(string(csr.Spec.Request)), err.Error()))
+		}
+		for _, ext := range req.Extensions {
+			if !ext.Id.Equal(oidExtensionSubjectAltName) {
+				continue
+			}
+			sans, err := pki.UnmarshalSANs(ext.Value)
+			if err != nil {
+				return append(el, field.Invalid(fldPath, truncateString(string(csr.Spec.Request)), err.Error()))
+			}
+			if len(sans.OtherNames) > 0 {
+				el = append(el, field.Forbidden(fldPath, "feature gate OtherNames must be enabled to request otherNames"))
+			}
+		}
+	}
+
+	return el
+}
+
+func hasNameConstraints(template *x509.Certificate) bool {
+	return len(template.PermittedDNSDomains) > 0 || len(template.ExcludedDNSDomains) > 0 ||
+		len(template.PermittedIPRanges) > 0 || len(template.ExcludedIPRanges) > 0 ||
+		len(template.PermittedEmailAddresses) > 0 || len(template.ExcludedEmailAddresses) > 0 ||
+		len(template.PermittedURIDomains) > 0 || len(template.ExcludedURIDomains) > 0
+}
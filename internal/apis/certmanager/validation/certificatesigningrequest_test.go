@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/component-base/featuregate"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	"github.com/cert-manager/cert-manager/internal/webhook/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestValidateCertificateSigningRequest(t *testing.T) {
+	mustCSR := func(crt *cmapi.Certificate) []byte {
+		csrPEM, _, err := gen.CSRForCertificate(crt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csrPEM
+	}
+	simpleCSR := mustCSR(gen.Certificate("test", gen.SetCertificateDNSNames("example.com")))
+	caNameConstraintsCSR := mustCSR(gen.Certificate("test",
+		gen.SetCertificateCommonName("ca"),
+		gen.SetCertificateIsCA(true),
+		gen.SetCertificateNameConstraints(&cmapi.NameConstraints{Permitted: &cmapi.NameConstraintItem{DNSDomains: []string{"example.com"}}}),
+	))
+	nameConstraintsCSR := mustCSR(gen.Certificate("test",
+		gen.SetCertificateCommonName("leaf"),
+		gen.SetCertificateNameConstraints(&cmapi.NameConstraints{Permitted: &cmapi.NameConstraintItem{DNSDomains: []string{"example.com"}}}),
+	))
+	otherNamesCSR := mustCSR(gen.Certificate("test",
+		gen.SetCertificateOtherNames(cmapi.OtherName{OID: "1.3.6.1.4.1.311.20.2.3", UTF8Value: "user@example.com"}),
+	))
+	ecdsaCSR, _, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csr := func(signerName string, request []byte, annotations map[string]string) *certificatesv1.CertificateSigningRequest {
+		return &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: annotations},
+			Spec: certificatesv1.CertificateSigningRequestSpec{
+				SignerName: signerName,
+				Request:    request,
+				Usages:     []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment},
+			},
+		}
+	}
+	caCSR := func(signerName string, request []byte, annotations map[string]string) *certificatesv1.CertificateSigningRequest {
+		csr := csr(signerName, request, annotations)
+		csr.Spec.Usages = append(csr.Spec.Usages, certificatesv1.UsageCertSign)
+		return csr
+	}
+	annotationsPath := field.NewPath("metadata", "annotations")
+	requestPath := field.NewPath("spec", "request")
+
+	tests := map[string]struct {
+		csr                 *certificatesv1.CertificateSigningRequest
+		disableFeatureGates []featuregate.Feature
+		wantErrs            field.ErrorList
+	}{
+		"CertificateSigningRequest for another signer is not validated": {
+			csr: csr("kubernetes.io/kubelet-serving", ecdsaCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "abc",
+			}),
+		},
+		"valid CertificateSigningRequest with all annotations": {
+			csr: csr("clusterissuers.cert-manager.io/ca", simpleCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey:           "24h",
+				experimentalapi.CertificateSigningRequestIsCAAnnotationKey:               "false",
+				experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey:        "shortlived",
+				experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey: "ISRG Root X1",
+				experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey:         "my-key",
+				experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey: `[{"name": "field", "value": "value"}]`,
+			}),
+		},
+		"invalid annotations": {
+			csr: csr("issuers.cert-manager.io/ns.ca", ecdsaCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey:           "1m",
+				experimentalapi.CertificateSigningRequestIsCAAnnotationKey:               "yes",
+				experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey:        "",
+				experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey: "",
+				experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey:         "My_Key",
+				experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey: `{"name": "field"}`,
+			}),
+			wantErrs: field.ErrorList{
+				field.Invalid(annotationsPath.Key(experimentalapi.CertificateSigningRequestDurationAnnotationKey), "1m", "must be at least 10m0s"),
+				field.NotSupported(annotationsPath.Key(experimentalapi.CertificateSigningRequestIsCAAnnotationKey), "yes", []string{"true", "false"}),
+				field.Invalid(annotationsPath.Key(experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey), "", "must not be empty"),
+				field.Invalid(annotationsPath.Key(experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey), "", "must not be empty"),
+				field.Invalid(annotationsPath.Key(experimentalapi.CertificateSigningRequestPrivateKeyAnnotationKey), "My_Key",
+					"a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+				field.Invalid(annotationsPath.Key(experimentalapi.CertificateSigningRequestVenafiCustomFieldsAnnotationKey), `{"name": "field"}`, "must be a JSON array of objects with name and value keys"),
+			},
+		},
+		"invalid duration annotation": {
+			csr: csr("issuers.cert-manager.io/ns.ca", ecdsaCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "abc",
+			}),
+			wantErrs: field.ErrorList{
+				field.Invalid(annotationsPath.Key(experimentalapi.CertificateSigningRequestDurationAnnotationKey), "abc", "must be a valid Go duration"),
+			},
+		},
+		"too long preferred chain annotation": {
+			csr: csr("issuers.cert-manager.io/ns.acme", ecdsaCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey: strings.Repeat("a", 65),
+			}),
+			wantErrs: field.ErrorList{
+				field.TooLong(annotationsPath.Key(experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey), strings.Repeat("a", 65), 64),
+			},
+		},
+		"missing request": {
+			csr: csr("issuers.cert-manager.io/ns.ca", nil, nil),
+			wantErrs: field.ErrorList{
+				field.Required(requestPath, "must be specified"),
+			},
+		},
+		"request basic constraints must match the is-ca annotation": {
+			csr: caCSR("issuers.cert-manager.io/ns.ca", caNameConstraintsCSR, nil),
+			wantErrs: field.ErrorList{
+				field.Invalid(requestPath, truncateString(string(caNameConstraintsCSR)), "encoded CSR error: IsCA true does not match expected value false"),
+			},
+		},
+		"name constraints are allowed for CA requests": {
+			csr: caCSR("issuers.cert-manager.io/ns.ca", caNameConstraintsCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestIsCAAnnotationKey: "true",
+			}),
+		},
+		"name constraints are not allowed for non-CA requests": {
+			csr: csr("issuers.cert-manager.io/ns.ca", nameConstraintsCSR, nil),
+			wantErrs: field.ErrorList{
+				field.Invalid(requestPath, truncateString(string(nameConstraintsCSR)),
+					`the experimental.cert-manager.io/request-is-ca annotation must be "true" when nameConstraints are requested`),
+			},
+		},
+		"name constraints are not allowed when the NameConstraints feature gate is disabled": {
+			csr: caCSR("issuers.cert-manager.io/ns.ca", caNameConstraintsCSR, map[string]string{
+				experimentalapi.CertificateSigningRequestIsCAAnnotationKey: "true",
+			}),
+			disableFeatureGates: []featuregate.Feature{feature.NameConstraints},
+			wantErrs: field.ErrorList{
+				field.Forbidden(requestPath, "feature gate NameConstraints must be enabled to request nameConstraints"),
+			},
+		},
+		"other names are allowed": {
+			csr: csr("issuers.cert-manager.io/ns.ca", otherNamesCSR, nil),
+		},
+		"other names are not allowed when the OtherNames feature gate is disabled": {
+			csr:                 csr("issuers.cert-manager.io/ns.ca", otherNamesCSR, nil),
+			disableFeatureGates: []featuregate.Feature{feature.OtherNames},
+			wantErrs: field.ErrorList{
+				field.Forbidden(requestPath, "feature gate OtherNames must be enabled to request otherNames"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, gate := range test.disableFeatureGates {
+				featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, gate, false)
+			}
+			errs, warnings := ValidateCertificateSigningRequest(nil, test.csr)
+			assert.ElementsMatch(t, test.wantErrs, errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
+
+func TestValidateUpdateCertificateSigningRequest(t *testing.T) {
+	csr := func(signerName string, annotations map[string]string) *certificatesv1.CertificateSigningRequest {
+		return &certificatesv1.CertificateSigningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: annotations},
+			Spec:       certificatesv1.CertificateSigningRequestSpec{SignerName: signerName},
+		}
+	}
+	durationPath := field.NewPath("metadata", "annotations").Key(experimentalapi.CertificateSigningRequestDurationAnnotationKey)
+
+	tests := map[string]struct {
+		oldCSR, newCSR *certificatesv1.CertificateSigningRequest
+		wantErrs       field.ErrorList
+	}{
+		"the pickup ID annotation may be set by the Venafi signer": {
+			oldCSR: csr("issuers.cert-manager.io/ns.venafi", map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "1h",
+			}),
+			newCSR: csr("issuers.cert-manager.io/ns.venafi", map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey:       "1h",
+				experimentalapi.CertificateSigningRequestVenafiPickupIDAnnotationKey: "abc",
+			}),
+		},
+		"request annotations may not be changed": {
+			oldCSR: csr("issuers.cert-manager.io/ns.ca", map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "1h",
+			}),
+			newCSR: csr("issuers.cert-manager.io/ns.ca", map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "2h",
+			}),
+			wantErrs: field.ErrorList{
+				field.Forbidden(durationPath, "cannot change cert-manager annotation after creation"),
+			},
+		},
+		"request annotations may not be removed": {
+			oldCSR: csr("issuers.cert-manager.io/ns.ca", map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "1h",
+			}),
+			newCSR: csr("issuers.cert-manager.io/ns.ca", nil),
+			wantErrs: field.ErrorList{
+				field.Forbidden(durationPath, "cannot change cert-manager annotation after creation"),
+			},
+		},
+		"CertificateSigningRequest for another signer is not validated": {
+			oldCSR: csr("kubernetes.io/kubelet-serving", nil),
+			newCSR: csr("kubernetes.io/kubelet-serving", map[string]string{
+				experimentalapi.CertificateSigningRequestDurationAnnotationKey: "2h",
+			}),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs, warnings := ValidateUpdateCertificateSigningRequest(nil, test.oldCSR, test.newCSR)
+			assert.ElementsMatch(t, test.wantErrs, errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
@@ -19,6 +19,7 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -279,6 +280,55 @@ func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) fiel
 			el = append(el, field.Invalid(fldPath.Child("issuingCertificateURLs").Index(i), issuerURL, "must be a valid URL"))
 		}
 	}
+	if iss.SPIFFE != nil {
+		el = append(el, ValidateSPIFFEIdentity(iss.SPIFFE, fldPath.Child("spiffe"))...)
+	}
+	if iss.SSH != nil {
+		el = append(el, validateCAIssuerSSH(iss.SSH, fldPath.Child("ssh"))...)
+	}
+	return el
+}
+
+func validateCAIssuerSSH(ssh *certmanager.CAIssuerSSH, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(ssh.AllowedUserPrincipals) == 0 && len(ssh.AllowedHostPrincipals) == 0 {
+		el = append(el, field.Required(fldPath, "at least one of allowedUserPrincipals or allowedHostPrincipals must be set"))
+	}
+	for i, principal := range ssh.AllowedUserPrincipals {
+		if principal == "" {
+			el = append(el, field.Invalid(fldPath.Child("allowedUserPrincipals").Index(i), principal, "must not be empty"))
+		}
+	}
+	for i, principal := range ssh.AllowedHostPrincipals {
+		if principal == "" {
+			el = append(el, field.Invalid(fldPath.Child("allowedHostPrincipals").Index(i), principal, "must not be empty"))
+		}
+	}
+	return el
+}
+
+// spiffeTrustDomainRegexp matches the trust domain names allowed by the SPIFFE
+// ID specification.
+var spiffeTrustDomainRegexp = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
+func ValidateSPIFFEIdentity(spiffe *certmanager.SPIFFEIdentity, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch {
+	case len(spiffe.TrustDomain) == 0:
+		el = append(el, field.Required(fldPath.Child("trustDomain"), ""))
+	case len(spiffe.TrustDomain) > 255:
+		el = append(el, field.TooLong(fldPath.Child("trustDomain"), spiffe.TrustDomain, 255))
+	case !spiffeTrustDomainRegexp.MatchString(spiffe.TrustDomain):
+		el = append(el, field.Invalid(fldPath.Child("trustDomain"), spiffe.TrustDomain, "must consist of lower case alphanumeric characters, '.', '-' or '_'"))
+	}
+
+	switch spiffe.Mode {
+	case "", certmanager.SPIFFEIdentityModeConstrain, certmanager.SPIFFEIdentityModeDerive:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("mode"), spiffe.Mode, []certmanager.SPIFFEIdentityMode{certmanager.SPIFFEIdentityModeConstrain, certmanager.SPIFFEIdentityModeDerive}))
+	}
+
 	return el
 }
 
@@ -320,6 +370,10 @@ func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path
 
 	el = append(el, ValidateVaultIssuerAuth(&iss.Auth, fldPath.Child("auth"))...)
 
+	if iss.SPIFFE != nil {
+		el = append(el, ValidateSPIFFEIdentity(iss.SPIFFE, fldPath.Child("spiffe"))...)
+	}
+
 	return el
 }
 
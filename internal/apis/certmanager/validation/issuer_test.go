@@ -1967,6 +1967,117 @@ func TestValidateSecretKeySelector(t *testing.T) {
 	}
 }
 
+func TestValidateSPIFFEIdentity(t *testing.T) {
+	fldPath := field.NewPath("spiffe")
+
+	scenarios := map[string]struct {
+		spiffe *cmapi.SPIFFEIdentity
+		errs   []*field.Error
+	}{
+		"valid configuration": {
+			spiffe: &cmapi.SPIFFEIdentity{TrustDomain: "cluster.local"},
+		},
+		"valid configuration in the Derive mode": {
+			spiffe: &cmapi.SPIFFEIdentity{TrustDomain: "prod_cluster-1.example.com", Mode: cmapi.SPIFFEIdentityModeDerive},
+		},
+		"missing trust domain": {
+			spiffe: &cmapi.SPIFFEIdentity{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("trustDomain"), ""),
+			},
+		},
+		"invalid trust domain": {
+			spiffe: &cmapi.SPIFFEIdentity{TrustDomain: "Cluster.local:8080"},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("trustDomain"), "Cluster.local:8080", "must consist of lower case alphanumeric characters, '.', '-' or '_'"),
+			},
+		},
+		"unsupported mode": {
+			spiffe: &cmapi.SPIFFEIdentity{TrustDomain: "cluster.local", Mode: "Ignore"},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("mode"), cmapi.SPIFFEIdentityMode("Ignore"), []cmapi.SPIFFEIdentityMode{cmapi.SPIFFEIdentityModeConstrain, cmapi.SPIFFEIdentityModeDerive}),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateSPIFFEIdentity(s.spiffe, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCAIssuerSSH(t *testing.T) {
+	fldPath := field.NewPath("ca")
+
+	scenarios := map[string]struct {
+		ca   *cmapi.CAIssuer
+		errs []*field.Error
+	}{
+		"valid configuration": {
+			ca: &cmapi.CAIssuer{
+				SecretName: "ca",
+				SSH: &cmapi.CAIssuerSSH{
+					AllowedUserPrincipals: []string{"alice", "bob-*"},
+					AllowedHostPrincipals: []string{"*.example.com"},
+				},
+			},
+		},
+		"only host principals": {
+			ca: &cmapi.CAIssuer{
+				SecretName: "ca",
+				SSH:        &cmapi.CAIssuerSSH{AllowedHostPrincipals: []string{"*.example.com"}},
+			},
+		},
+		"missing allowed principals": {
+			ca: &cmapi.CAIssuer{
+				SecretName: "ca",
+				SSH:        &cmapi.CAIssuerSSH{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ssh"), "at least one of allowedUserPrincipals or allowedHostPrincipals must be set"),
+			},
+		},
+		"empty allowed principals": {
+			ca: &cmapi.CAIssuer{
+				SecretName: "ca",
+				SSH: &cmapi.CAIssuerSSH{
+					AllowedUserPrincipals: []string{"alice", ""},
+					AllowedHostPrincipals: []string{""},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ssh", "allowedUserPrincipals").Index(1), "", "must not be empty"),
+				field.Invalid(fldPath.Child("ssh", "allowedHostPrincipals").Index(0), "", "must not be empty"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateCAIssuerConfig(s.ca, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Errorf("Expected %v but got %v", s.errs, errs)
+				return
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateVenafiIssuerConfig(t *testing.T) {
 	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Validation functions for cert-manager SSHCertificate types
+
+func ValidateSSHCertificateSpec(crt *internalcmapi.SSHCertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if crt.SecretName == "" {
+		el = append(el, field.Required(fldPath.Child("secretName"), "must be specified"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(crt.SecretName, false) {
+			el = append(el, field.Invalid(fldPath.Child("secretName"), crt.SecretName, msg))
+		}
+	}
+
+	el = append(el, validateSSHIssuerRef(crt.IssuerRef, fldPath)...)
+	el = append(el, validateSSHCertificateTypeAndPrincipals(crt.Type, crt.Principals, crt.Extensions, fldPath)...)
+
+	if crt.PrivateKey != nil {
+		switch crt.PrivateKey.Algorithm {
+		case "", internalcmapi.Ed25519KeyAlgorithm:
+		case internalcmapi.RSAKeyAlgorithm:
+			if crt.PrivateKey.Size > 0 && (crt.PrivateKey.Size < pki.MinRSAKeySize || crt.PrivateKey.Size > pki.MaxRSAKeySize) {
+				el = append(el, field.Invalid(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, fmt.Sprintf("must be between %d and %d for rsa keyAlgorithm", pki.MinRSAKeySize, pki.MaxRSAKeySize)))
+			}
+		case internalcmapi.ECDSAKeyAlgorithm:
+			if crt.PrivateKey.Size > 0 && crt.PrivateKey.Size != 256 && crt.PrivateKey.Size != 384 && crt.PrivateKey.Size != 521 {
+				el = append(el, field.NotSupported(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, []string{"256", "384", "521"}))
+			}
+		default:
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+		}
+	}
+
+	el = append(el, validateSSHCertificateDuration(crt, fldPath)...)
+
+	if crt.SecretTemplate != nil {
+		if len(crt.SecretTemplate.Labels) > 0 {
+			el = append(el, validateSecretTemplateLabels(crt.SecretTemplate, fldPath)...)
+		}
+		if len(crt.SecretTemplate.Annotations) > 0 {
+			el = append(el, validateSecretTemplateAnnotations(crt.SecretTemplate, fldPath)...)
+		}
+	}
+
+	return el
+}
+
+// validateSSHIssuerRef validates the issuerRef of an SSHCertificate or
+// SSHCertificateRequest, which can only reference cert-manager.io issuers.
+func validateSSHIssuerRef(issuerRef cmmeta.IssuerReference, fldPath *field.Path) field.ErrorList {
+	el := validateIssuerRef(issuerRef, fldPath)
+	if issuerRef.Group != "" && issuerRef.Group != internalcmapi.SchemeGroupVersion.Group {
+		el = append(el, field.Invalid(fldPath.Child("issuerRef", "group"), issuerRef.Group, "only cert-manager.io issuers can sign SSHCertificates"))
+	}
+	return el
+}
+
+// validateSSHCertificateTypeAndPrincipals validates the fields shared by
+// SSHCertificates and SSHCertificateRequests which describe the requested
+// SSH certificate.
+func validateSSHCertificateTypeAndPrincipals(certType internalcmapi.SSHCertificateType, principals []string, extensions map[string]string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	switch certType {
+	case "", internalcmapi.SSHCertificateTypeUser:
+	case internalcmapi.SSHCertificateTypeHost:
+		if len(extensions) > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("extensions"), "cannot be set for Host certificates"))
+		}
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("type"), certType, []string{string(internalcmapi.SSHCertificateTypeUser), string(internalcmapi.SSHCertificateTypeHost)}))
+	}
+
+	if len(principals) == 0 {
+		el = append(el, field.Required(fldPath.Child("principals"), "at least one principal must be specified"))
+	}
+	for i, principal := range principals {
+		if principal == "" {
+			el = append(el, field.Invalid(fldPath.Child("principals").Index(i), principal, "must not be empty"))
+		}
+	}
+	return el
+}
+
+func validateSSHCertificateDuration(crt *internalcmapi.SSHCertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	duration := cmapi.DefaultSSHCertificateDuration
+	if crt.Duration != nil {
+		duration = crt.Duration.Duration
+	}
+	if duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("duration"), duration, fmt.Sprintf("certificate duration must be greater than %s", cmapi.MinimumCertificateDuration)))
+	}
+
+	if crt.RenewBefore != nil && crt.RenewBeforePercentage != nil {
+		el = append(el, field.Invalid(fldPath.Child("renewBefore"), crt.RenewBefore.Duration, "renewBefore and renewBeforePercentage are mutually exclusive and cannot both be set"))
+		el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), *crt.RenewBeforePercentage, "renewBefore and renewBeforePercentage are mutually exclusive and cannot both be set"))
+	}
+
+	if crt.RenewBefore != nil {
+		if crt.RenewBefore.Duration < cmapi.MinimumRenewBefore {
+			el = append(el, field.Invalid(fldPath.Child("renewBefore"), crt.RenewBefore.Duration, fmt.Sprintf("certificate renewBefore must be greater than %s", cmapi.MinimumRenewBefore)))
+		}
+		if crt.RenewBefore.Duration >= duration {
+			el = append(el, field.Invalid(fldPath.Child("renewBefore"), crt.RenewBefore.Duration, fmt.Sprintf("certificate duration %s must be greater than renewBefore %s", duration, crt.RenewBefore.Duration)))
+		}
+	}
+
+	if crt.RenewBeforePercentage != nil {
+		pct := *crt.RenewBeforePercentage
+		if pct <= 0 || pct >= 100 {
+			el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), pct, "must be in the range (0,100)"))
+		} else if renewBefore := time.Duration(float64(duration) * float64(pct) / 100); renewBefore < cmapi.MinimumRenewBefore {
+			el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), pct, fmt.Sprintf("certificate renewBeforePercentage must result in a renewBefore greater than %s", cmapi.MinimumRenewBefore)))
+		}
+	}
+
+	return el
+}
+
+func ValidateSSHCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	crt := obj.(*internalcmapi.SSHCertificate)
+	return ValidateSSHCertificateSpec(&crt.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateSSHCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	crt := obj.(*internalcmapi.SSHCertificate)
+	return ValidateSSHCertificateSpec(&crt.Spec, field.NewPath("spec")), nil
+}
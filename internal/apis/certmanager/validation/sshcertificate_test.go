@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+func TestValidateSSHCertificate(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	validSpec := func(mod func(*internalcmapi.SSHCertificateSpec)) *internalcmapi.SSHCertificate {
+		spec := internalcmapi.SSHCertificateSpec{
+			Principals: []string{"alice"},
+			SecretName: "abc",
+			IssuerRef:  validIssuerRef,
+		}
+		if mod != nil {
+			mod(&spec)
+		}
+		return &internalcmapi.SSHCertificate{Spec: spec}
+	}
+
+	scenarios := map[string]struct {
+		cfg  *internalcmapi.SSHCertificate
+		errs []*field.Error
+	}{
+		"valid basic SSH certificate": {
+			cfg: validSpec(nil),
+		},
+		"valid host certificate with critical options": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.Type = internalcmapi.SSHCertificateTypeHost
+				spec.Principals = []string{"host.example.com"}
+				spec.CriticalOptions = map[string]string{"source-address": "10.0.0.0/8"}
+			}),
+		},
+		"missing secretName, principals and issuerRef name": {
+			cfg: &internalcmapi.SSHCertificate{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("secretName"), "must be specified"),
+				field.Required(fldPath.Child("issuerRef", "name"), "must be specified"),
+				field.Required(fldPath.Child("principals"), "at least one principal must be specified"),
+			},
+		},
+		"empty principal": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.Principals = []string{"alice", ""}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("principals").Index(1), "", "must not be empty"),
+			},
+		},
+		"unsupported type": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.Type = "Robot"
+			}),
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("type"), internalcmapi.SSHCertificateType("Robot"), []string{"User", "Host"}),
+			},
+		},
+		"extensions on a host certificate": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.Type = internalcmapi.SSHCertificateTypeHost
+				spec.Extensions = map[string]string{"permit-pty": ""}
+			}),
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("extensions"), "cannot be set for Host certificates"),
+			},
+		},
+		"external issuer group": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.IssuerRef = cmmeta.IssuerReference{Name: "name", Kind: "MyIssuer", Group: "example.com"}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuerRef", "group"), "example.com", "only cert-manager.io issuers can sign SSHCertificates"),
+			},
+		},
+		"invalid private key size": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.PrivateKey = &internalcmapi.SSHCertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 128}
+			}),
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("privateKey", "size"), 128, []string{"256", "384", "521"}),
+			},
+		},
+		"duration below the minimum": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.Duration = &metav1.Duration{Duration: time.Minute * 30}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("duration"), time.Minute*30, "certificate duration must be greater than 1h0m0s"),
+			},
+		},
+		"renewBefore not less than the default duration": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.RenewBefore = &metav1.Duration{Duration: time.Hour * 24}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewBefore"), time.Hour*24, "certificate duration 24h0m0s must be greater than renewBefore 24h0m0s"),
+			},
+		},
+		"renewBefore and renewBeforePercentage both set": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.RenewBefore = &metav1.Duration{Duration: time.Hour}
+				spec.RenewBeforePercentage = new(int32(25))
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewBefore"), time.Hour, "renewBefore and renewBeforePercentage are mutually exclusive and cannot both be set"),
+				field.Invalid(fldPath.Child("renewBeforePercentage"), int32(25), "renewBefore and renewBeforePercentage are mutually exclusive and cannot both be set"),
+			},
+		},
+		"renewBeforePercentage out of range": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.RenewBeforePercentage = new(int32(100))
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), "must be in the range (0,100)"),
+			},
+		},
+		"cert-manager.io annotation in secret template": {
+			cfg: validSpec(func(spec *internalcmapi.SSHCertificateSpec) {
+				spec.SecretTemplate = &internalcmapi.CertificateSecretTemplate{
+					Annotations: map[string]string{"cert-manager.io/ssh-certificate-name": "other"},
+				}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/ssh-certificate-name", "cert-manager.io/* annotations are not allowed"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, warnings := ValidateSSHCertificate(someAdmissionRequest, s.cfg)
+			assert.ElementsMatch(t, errs, s.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
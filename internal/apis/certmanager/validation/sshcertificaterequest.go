@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+
+	"golang.org/x/crypto/ssh"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager SSHCertificateRequest types
+
+func ValidateSSHCertificateRequestSpec(crSpec *internalcmapi.SSHCertificateRequestSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	el = append(el, validateSSHIssuerRef(crSpec.IssuerRef, fldPath)...)
+	el = append(el, validateSSHCertificateTypeAndPrincipals(crSpec.Type, crSpec.Principals, crSpec.Extensions, fldPath)...)
+
+	if crSpec.Duration != nil && crSpec.Duration.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("duration"), crSpec.Duration.Duration, "must be positive"))
+	}
+
+	if len(crSpec.PublicKey) == 0 {
+		el = append(el, field.Required(fldPath.Child("publicKey"), "must be specified"))
+	} else if pub, _, _, _, err := ssh.ParseAuthorizedKey(crSpec.PublicKey); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("publicKey"), truncateString(string(crSpec.PublicKey)), err.Error()))
+	} else if _, ok := pub.(*ssh.Certificate); ok {
+		el = append(el, field.Invalid(fldPath.Child("publicKey"), truncateString(string(crSpec.PublicKey)), "must be a public key, not an SSH certificate"))
+	}
+
+	return el
+}
+
+func ValidateSSHCertificateRequest(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	cr := obj.(*internalcmapi.SSHCertificateRequest)
+	allErrs := ValidateSSHCertificateRequestSpec(&cr.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs,
+		ValidateCertificateRequestApprovalCondition(cr.Status.Conditions, field.NewPath("status", "conditions"))...)
+
+	return allErrs, nil
+}
+
+func ValidateUpdateSSHCertificateRequest(a *admissionv1.AdmissionRequest, oldObj, newObj runtime.Object) (field.ErrorList, []string) {
+	oldCR, newCR := oldObj.(*internalcmapi.SSHCertificateRequest), newObj.(*internalcmapi.SSHCertificateRequest)
+
+	var el field.ErrorList
+	el = append(el,
+		ValidateUpdateCertificateRequestApprovalCondition(oldCR.Status.Conditions, newCR.Status.Conditions, field.NewPath("status", "conditions"))...)
+
+	if !reflect.DeepEqual(oldCR.Spec, newCR.Spec) {
+		el = append(el, field.Forbidden(field.NewPath("spec"), "cannot change spec after creation"))
+	}
+
+	return el, nil
+}
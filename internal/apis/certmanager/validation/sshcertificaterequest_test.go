@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+)
+
+func TestValidateSSHCertificateRequest(t *testing.T) {
+	fldPath := field.NewPath("spec")
+
+	pk, caKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := ssh.NewPublicKey(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caSigner, err := ssh.NewSignerFromKey(caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := &ssh.Certificate{Key: pub, CertType: ssh.UserCert, ValidPrincipals: []string{"alice"}}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		t.Fatal(err)
+	}
+
+	validRequest := func(mod func(*internalcmapi.SSHCertificateRequestSpec)) *internalcmapi.SSHCertificateRequest {
+		spec := internalcmapi.SSHCertificateRequestSpec{
+			Principals: []string{"alice"},
+			PublicKey:  ssh.MarshalAuthorizedKey(pub),
+			IssuerRef:  validIssuerRef,
+		}
+		if mod != nil {
+			mod(&spec)
+		}
+		return &internalcmapi.SSHCertificateRequest{Spec: spec}
+	}
+
+	scenarios := map[string]struct {
+		cr   *internalcmapi.SSHCertificateRequest
+		errs []*field.Error
+	}{
+		"valid SSH certificate request": {
+			cr: validRequest(nil),
+		},
+		"valid host certificate request": {
+			cr: validRequest(func(spec *internalcmapi.SSHCertificateRequestSpec) {
+				spec.Type = internalcmapi.SSHCertificateTypeHost
+				spec.Principals = []string{"host.example.com"}
+				spec.Duration = &metav1.Duration{Duration: time.Minute * 30}
+			}),
+		},
+		"missing public key, principals and issuerRef name": {
+			cr: &internalcmapi.SSHCertificateRequest{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("issuerRef", "name"), "must be specified"),
+				field.Required(fldPath.Child("principals"), "at least one principal must be specified"),
+				field.Required(fldPath.Child("publicKey"), "must be specified"),
+			},
+		},
+		"invalid public key": {
+			cr: validRequest(func(spec *internalcmapi.SSHCertificateRequestSpec) {
+				spec.PublicKey = []byte("not-a-key")
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("publicKey"), "not-a-key", "ssh: no key found"),
+			},
+		},
+		"SSH certificate as public key": {
+			cr: validRequest(func(spec *internalcmapi.SSHCertificateRequestSpec) {
+				spec.PublicKey = ssh.MarshalAuthorizedKey(cert)
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("publicKey"), truncateString(string(ssh.MarshalAuthorizedKey(cert))), "must be a public key, not an SSH certificate"),
+			},
+		},
+		"extensions on a host certificate": {
+			cr: validRequest(func(spec *internalcmapi.SSHCertificateRequestSpec) {
+				spec.Type = internalcmapi.SSHCertificateTypeHost
+				spec.Extensions = map[string]string{"permit-pty": ""}
+			}),
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("extensions"), "cannot be set for Host certificates"),
+			},
+		},
+		"external issuer group": {
+			cr: validRequest(func(spec *internalcmapi.SSHCertificateRequestSpec) {
+				spec.IssuerRef = cmmeta.IssuerReference{Name: "name", Kind: "MyIssuer", Group: "example.com"}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuerRef", "group"), "example.com", "only cert-manager.io issuers can sign SSHCertificates"),
+			},
+		},
+		"negative duration": {
+			cr: validRequest(func(spec *internalcmapi.SSHCertificateRequestSpec) {
+				spec.Duration = &metav1.Duration{Duration: -time.Hour}
+			}),
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("duration"), -time.Hour, "must be positive"),
+			},
+		},
+		"approved and denied": {
+			cr: func() *internalcmapi.SSHCertificateRequest {
+				cr := validRequest(nil)
+				cr.Status.Conditions = []internalcmapi.CertificateRequestCondition{
+					{Type: internalcmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue},
+					{Type: internalcmapi.CertificateRequestConditionDenied, Status: cmmeta.ConditionTrue},
+				}
+				return cr
+			}(),
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("status", "conditions"), "both 'Denied' and 'Approved' conditions cannot coexist"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, warnings := ValidateSSHCertificateRequest(someAdmissionRequest, s.cr)
+			assert.ElementsMatch(t, errs, s.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
+
+func TestValidateSSHCertificateRequestUpdate(t *testing.T) {
+	base := &internalcmapi.SSHCertificateRequest{
+		Spec: internalcmapi.SSHCertificateRequestSpec{
+			Principals: []string{"alice"},
+			IssuerRef:  validIssuerRef,
+		},
+	}
+	approved := base.DeepCopy()
+	approved.Status.Conditions = []internalcmapi.CertificateRequestCondition{
+		{Type: internalcmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue, Reason: "cert-manager.io"},
+	}
+
+	scenarios := map[string]struct {
+		oldCR, newCR *internalcmapi.SSHCertificateRequest
+		errs         []*field.Error
+	}{
+		"approving the request": {
+			oldCR: base,
+			newCR: approved,
+		},
+		"changing the spec": {
+			oldCR: base,
+			newCR: func() *internalcmapi.SSHCertificateRequest {
+				cr := base.DeepCopy()
+				cr.Spec.Principals = []string{"root"}
+				return cr
+			}(),
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("spec"), "cannot change spec after creation"),
+			},
+		},
+		"removing the approved condition": {
+			oldCR: approved,
+			newCR: base,
+			errs: []*field.Error{
+				field.Forbidden(field.NewPath("status", "conditions"), "'Approved' condition may not be modified once set"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs, warnings := ValidateUpdateSSHCertificateRequest(someAdmissionRequest, s.oldCR, s.newCR)
+			assert.ElementsMatch(t, errs, s.errs)
+			assert.Empty(t, warnings)
+		})
+	}
+}
@@ -70,6 +70,16 @@ func (in *CAIssuer) DeepCopyInto(out *CAIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SPIFFE != nil {
+		in, out := &in.SPIFFE, &out.SPIFFE
+		*out = new(SPIFFEIdentity)
+		**out = **in
+	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = new(CAIssuerSSH)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -83,6 +93,32 @@ func (in *CAIssuer) DeepCopy() *CAIssuer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CAIssuerSSH) DeepCopyInto(out *CAIssuerSSH) {
+	*out = *in
+	if in.AllowedUserPrincipals != nil {
+		in, out := &in.AllowedUserPrincipals, &out.AllowedUserPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedHostPrincipals != nil {
+		in, out := &in.AllowedHostPrincipals, &out.AllowedHostPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CAIssuerSSH.
+func (in *CAIssuerSSH) DeepCopy() *CAIssuerSSH {
+	if in == nil {
+		return nil
+	}
+	out := new(CAIssuerSSH)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
@@ -197,6 +233,128 @@ func (in *CertificateCondition) DeepCopy() *CertificateCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateDefaultPolicy) DeepCopyInto(out *CertificateDefaultPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDefaultPolicy.
+func (in *CertificateDefaultPolicy) DeepCopy() *CertificateDefaultPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateDefaultPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateDefaultPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateDefaultPolicyList) DeepCopyInto(out *CertificateDefaultPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificateDefaultPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDefaultPolicyList.
+func (in *CertificateDefaultPolicyList) DeepCopy() *CertificateDefaultPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateDefaultPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateDefaultPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateDefaultPolicySpec) DeepCopyInto(out *CertificateDefaultPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(CertificatePrivateKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(CertificateSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateDefaultPolicySpec.
+func (in *CertificateDefaultPolicySpec) DeepCopy() *CertificateDefaultPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateDefaultPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateExternalCSR) DeepCopyInto(out *CertificateExternalCSR) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalCSR.
+func (in *CertificateExternalCSR) DeepCopy() *CertificateExternalCSR {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateExternalCSR)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateKeystores) DeepCopyInto(out *CertificateKeystores) {
 	*out = *in
@@ -210,6 +368,11 @@ func (in *CertificateKeystores) DeepCopyInto(out *CertificateKeystores) {
 		*out = new(PKCS12Keystore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PEMTruststore != nil {
+		in, out := &in.PEMTruststore, &out.PEMTruststore
+		*out = new(PEMTruststore)
+		**out = **in
+	}
 	return
 }
 
@@ -259,6 +422,11 @@ func (in *CertificateList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
 	*out = *in
+	if in.RotationOverlap != nil {
+		in, out := &in.RotationOverlap, &out.RotationOverlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -295,6 +463,42 @@ func (in *CertificateRenewal) DeepCopy() *CertificateRenewal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalRequest) DeepCopyInto(out *CertificateRenewalRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalRequest.
+func (in *CertificateRenewalRequest) DeepCopy() *CertificateRenewalRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewalRequestStatus) DeepCopyInto(out *CertificateRenewalRequestStatus) {
+	*out = *in
+	if in.HandledTime != nil {
+		in, out := &in.HandledTime, &out.HandledTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewalRequestStatus.
+func (in *CertificateRenewalRequestStatus) DeepCopy() *CertificateRenewalRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewalRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateRenewalWindows) DeepCopyInto(out *CertificateRenewalWindows) {
 	*out = *in
@@ -398,17 +602,63 @@ func (in *CertificateRequestList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
+func (in *CertificateRequestPolicy) DeepCopyInto(out *CertificateRequestPolicy) {
 	*out = *in
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(v1.Duration)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicy.
+func (in *CertificateRequestPolicy) DeepCopy() *CertificateRequestPolicy {
+	if in == nil {
+		return nil
 	}
-	out.IssuerRef = in.IssuerRef
-	if in.Request != nil {
-		in, out := &in.Request, &out.Request
-		*out = make([]byte, len(*in))
+	out := new(CertificateRequestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRequestPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowed) DeepCopyInto(out *CertificateRequestPolicyAllowed) {
+	*out = *in
+	if in.CommonNames != nil {
+		in, out := &in.CommonNames, &out.CommonNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(CertificateRequestPolicyAllowedSubject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPRanges != nil {
+		in, out := &in.IPRanges, &out.IPRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	if in.Usages != nil {
@@ -416,68 +666,314 @@ func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
 		*out = make([]KeyUsage, len(*in))
 		copy(*out, *in)
 	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
+	if in.KeyAlgorithms != nil {
+		in, out := &in.KeyAlgorithms, &out.KeyAlgorithms
+		*out = make([]PrivateKeyAlgorithm, len(*in))
 		copy(*out, *in)
 	}
-	if in.Extra != nil {
-		in, out := &in.Extra, &out.Extra
-		*out = make(map[string][]string, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = new(CertificateRequestPolicyAllowedSSH)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestSpec.
-func (in *CertificateRequestSpec) DeepCopy() *CertificateRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowed.
+func (in *CertificateRequestPolicyAllowed) DeepCopy() *CertificateRequestPolicyAllowed {
 	if in == nil {
 		return nil
 	}
-	out := new(CertificateRequestSpec)
+	out := new(CertificateRequestPolicyAllowed)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CertificateRequestStatus) DeepCopyInto(out *CertificateRequestStatus) {
+func (in *CertificateRequestPolicyAllowedSSH) DeepCopyInto(out *CertificateRequestPolicyAllowedSSH) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]CertificateRequestCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.UserPrincipals != nil {
+		in, out := &in.UserPrincipals, &out.UserPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Certificate != nil {
-		in, out := &in.Certificate, &out.Certificate
-		*out = make([]byte, len(*in))
+	if in.HostPrincipals != nil {
+		in, out := &in.HostPrincipals, &out.HostPrincipals
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.CA != nil {
-		in, out := &in.CA, &out.CA
-		*out = make([]byte, len(*in))
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.FailureTime != nil {
-		in, out := &in.FailureTime, &out.FailureTime
-		*out = (*in).DeepCopy()
+	if in.CriticalOptions != nil {
+		in, out := &in.CriticalOptions, &out.CriticalOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestStatus.
-func (in *CertificateRequestStatus) DeepCopy() *CertificateRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedSSH.
+func (in *CertificateRequestPolicyAllowedSSH) DeepCopy() *CertificateRequestPolicyAllowedSSH {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedSSH)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyAllowedSubject) DeepCopyInto(out *CertificateRequestPolicyAllowedSubject) {
+	*out = *in
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganizationalUnits != nil {
+		in, out := &in.OrganizationalUnits, &out.OrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Countries != nil {
+		in, out := &in.Countries, &out.Countries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provinces != nil {
+		in, out := &in.Provinces, &out.Provinces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Localities != nil {
+		in, out := &in.Localities, &out.Localities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StreetAddresses != nil {
+		in, out := &in.StreetAddresses, &out.StreetAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostalCodes != nil {
+		in, out := &in.PostalCodes, &out.PostalCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SerialNumbers != nil {
+		in, out := &in.SerialNumbers, &out.SerialNumbers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyAllowedSubject.
+func (in *CertificateRequestPolicyAllowedSubject) DeepCopy() *CertificateRequestPolicyAllowedSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyAllowedSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyIssuerRef) DeepCopyInto(out *CertificateRequestPolicyIssuerRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyIssuerRef.
+func (in *CertificateRequestPolicyIssuerRef) DeepCopy() *CertificateRequestPolicyIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyList) DeepCopyInto(out *CertificateRequestPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificateRequestPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyList.
+func (in *CertificateRequestPolicyList) DeepCopy() *CertificateRequestPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRequestPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicyRequesters) DeepCopyInto(out *CertificateRequestPolicyRequesters) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicyRequesters.
+func (in *CertificateRequestPolicyRequesters) DeepCopy() *CertificateRequestPolicyRequesters {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicyRequesters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestPolicySpec) DeepCopyInto(out *CertificateRequestPolicySpec) {
+	*out = *in
+	if in.IssuerRefs != nil {
+		in, out := &in.IssuerRefs, &out.IssuerRefs
+		*out = make([]CertificateRequestPolicyIssuerRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Requesters != nil {
+		in, out := &in.Requesters, &out.Requesters
+		*out = new(CertificateRequestPolicyRequesters)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Allowed.DeepCopyInto(&out.Allowed)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestPolicySpec.
+func (in *CertificateRequestPolicySpec) DeepCopy() *CertificateRequestPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestSpec) DeepCopyInto(out *CertificateRequestSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	out.IssuerRef = in.IssuerRef
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestSpec.
+func (in *CertificateRequestSpec) DeepCopy() *CertificateRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestStatus) DeepCopyInto(out *CertificateRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CertificateRequestCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureTime != nil {
+		in, out := &in.FailureTime, &out.FailureTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestStatus.
+func (in *CertificateRequestStatus) DeepCopy() *CertificateRequestStatus {
 	if in == nil {
 		return nil
 	}
@@ -486,6 +982,32 @@ func (in *CertificateRequestStatus) DeepCopy() *CertificateRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSecretReplicas) DeepCopyInto(out *CertificateSecretReplicas) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSecretReplicas.
+func (in *CertificateSecretReplicas) DeepCopy() *CertificateSecretReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSecretReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSecretTemplate) DeepCopyInto(out *CertificateSecretTemplate) {
 	*out = *in
@@ -574,6 +1096,26 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = new(CertificateSecretTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SecretReplicas != nil {
+		in, out := &in.SecretReplicas, &out.SecretReplicas
+		*out = new(CertificateSecretReplicas)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustConfigMap != nil {
+		in, out := &in.TrustConfigMap, &out.TrustConfigMap
+		*out = new(CertificateTrustConfigMap)
+		**out = **in
+	}
+	if in.RenewalRequest != nil {
+		in, out := &in.RenewalRequest, &out.RenewalRequest
+		*out = new(CertificateRenewalRequest)
+		**out = **in
+	}
+	if in.ExternalCSR != nil {
+		in, out := &in.ExternalCSR, &out.ExternalCSR
+		*out = new(CertificateExternalCSR)
+		**out = **in
+	}
 	if in.Keystores != nil {
 		in, out := &in.Keystores, &out.Keystores
 		*out = new(CertificateKeystores)
@@ -588,7 +1130,7 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	if in.PrivateKey != nil {
 		in, out := &in.PrivateKey, &out.PrivateKey
 		*out = new(CertificatePrivateKey)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.EncodeUsagesInRequest != nil {
 		in, out := &in.EncodeUsagesInRequest, &out.EncodeUsagesInRequest
@@ -659,11 +1201,20 @@ func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NextPrivateKeyPublishedTime != nil {
+		in, out := &in.NextPrivateKeyPublishedTime, &out.NextPrivateKeyPublishedTime
+		*out = (*in).DeepCopy()
+	}
 	if in.FailedIssuanceAttempts != nil {
 		in, out := &in.FailedIssuanceAttempts, &out.FailedIssuanceAttempts
 		*out = new(int)
 		**out = **in
 	}
+	if in.LastRenewalRequest != nil {
+		in, out := &in.LastRenewalRequest, &out.LastRenewalRequest
+		*out = new(CertificateRenewalRequestStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ACME != nil {
 		in, out := &in.ACME, &out.ACME
 		*out = new(CertificateACMEStatus)
@@ -682,6 +1233,22 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateTrustConfigMap) DeepCopyInto(out *CertificateTrustConfigMap) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateTrustConfigMap.
+func (in *CertificateTrustConfigMap) DeepCopy() *CertificateTrustConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateTrustConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterIssuer) DeepCopyInto(out *ClusterIssuer) {
 	*out = *in
@@ -822,24 +1389,299 @@ func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerConfig.
-func (in *IssuerConfig) DeepCopy() *IssuerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerConfig.
+func (in *IssuerConfig) DeepCopy() *IssuerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerList) DeepCopyInto(out *IssuerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Issuer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerList.
+func (in *IssuerList) DeepCopy() *IssuerList {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssuerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
+	*out = *in
+	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerSpec.
+func (in *IssuerSpec) DeepCopy() *IssuerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerStatus) DeepCopyInto(out *IssuerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]IssuerCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(acme.ACMEIssuerStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerStatus.
+func (in *IssuerStatus) DeepCopy() *IssuerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JKSKeystore) DeepCopyInto(out *JKSKeystore) {
+	*out = *in
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
+		**out = **in
+	}
+	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JKSKeystore.
+func (in *JKSKeystore) DeepCopy() *JKSKeystore {
+	if in == nil {
+		return nil
+	}
+	out := new(JKSKeystore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NameConstraintItem) DeepCopyInto(out *NameConstraintItem) {
+	*out = *in
+	if in.DNSDomains != nil {
+		in, out := &in.DNSDomains, &out.DNSDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPRanges != nil {
+		in, out := &in.IPRanges, &out.IPRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URIDomains != nil {
+		in, out := &in.URIDomains, &out.URIDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameConstraintItem.
+func (in *NameConstraintItem) DeepCopy() *NameConstraintItem {
+	if in == nil {
+		return nil
+	}
+	out := new(NameConstraintItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NameConstraints) DeepCopyInto(out *NameConstraints) {
+	*out = *in
+	if in.Permitted != nil {
+		in, out := &in.Permitted, &out.Permitted
+		*out = new(NameConstraintItem)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Excluded != nil {
+		in, out := &in.Excluded, &out.Excluded
+		*out = new(NameConstraintItem)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameConstraints.
+func (in *NameConstraints) DeepCopy() *NameConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(NameConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OtherName) DeepCopyInto(out *OtherName) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtherName.
+func (in *OtherName) DeepCopy() *OtherName {
+	if in == nil {
+		return nil
+	}
+	out := new(OtherName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PEMTruststore) DeepCopyInto(out *PEMTruststore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PEMTruststore.
+func (in *PEMTruststore) DeepCopy() *PEMTruststore {
+	if in == nil {
+		return nil
+	}
+	out := new(PEMTruststore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PKCS12Keystore) DeepCopyInto(out *PKCS12Keystore) {
+	*out = *in
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPasswordSecretRef != nil {
+		in, out := &in.KeyPasswordSecretRef, &out.KeyPasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		**out = **in
+	}
+	out.PasswordSecretRef = in.PasswordSecretRef
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKCS12Keystore.
+func (in *PKCS12Keystore) DeepCopy() *PKCS12Keystore {
+	if in == nil {
+		return nil
+	}
+	out := new(PKCS12Keystore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SPIFFEIdentity) DeepCopyInto(out *SPIFFEIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPIFFEIdentity.
+func (in *SPIFFEIdentity) DeepCopy() *SPIFFEIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(SPIFFEIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificate) DeepCopyInto(out *SSHCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificate.
+func (in *SSHCertificate) DeepCopy() *SSHCertificate {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerConfig)
+	out := new(SSHCertificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerList) DeepCopyInto(out *IssuerList) {
+func (in *SSHCertificateList) DeepCopyInto(out *SSHCertificateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Issuer, len(*in))
+		*out = make([]SSHCertificate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -847,18 +1689,18 @@ func (in *IssuerList) DeepCopyInto(out *IssuerList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerList.
-func (in *IssuerList) DeepCopy() *IssuerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateList.
+func (in *SSHCertificateList) DeepCopy() *SSHCertificateList {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerList)
+	out := new(SSHCertificateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IssuerList) DeepCopyObject() runtime.Object {
+func (in *SSHCertificateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -866,173 +1708,291 @@ func (in *IssuerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
+func (in *SSHCertificatePrivateKey) DeepCopyInto(out *SSHCertificatePrivateKey) {
 	*out = *in
-	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerSpec.
-func (in *IssuerSpec) DeepCopy() *IssuerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificatePrivateKey.
+func (in *SSHCertificatePrivateKey) DeepCopy() *SSHCertificatePrivateKey {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerSpec)
+	out := new(SSHCertificatePrivateKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IssuerStatus) DeepCopyInto(out *IssuerStatus) {
+func (in *SSHCertificateRequest) DeepCopyInto(out *SSHCertificateRequest) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]IssuerCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(acme.ACMEIssuerStatus)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerStatus.
-func (in *IssuerStatus) DeepCopy() *IssuerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequest.
+func (in *SSHCertificateRequest) DeepCopy() *SSHCertificateRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(IssuerStatus)
+	out := new(SSHCertificateRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHCertificateRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JKSKeystore) DeepCopyInto(out *JKSKeystore) {
+func (in *SSHCertificateRequestList) DeepCopyInto(out *SSHCertificateRequestList) {
 	*out = *in
-	if in.Alias != nil {
-		in, out := &in.Alias, &out.Alias
-		*out = new(string)
-		**out = **in
-	}
-	out.PasswordSecretRef = in.PasswordSecretRef
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHCertificateRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JKSKeystore.
-func (in *JKSKeystore) DeepCopy() *JKSKeystore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequestList.
+func (in *SSHCertificateRequestList) DeepCopy() *SSHCertificateRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(JKSKeystore)
+	out := new(SSHCertificateRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHCertificateRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NameConstraintItem) DeepCopyInto(out *NameConstraintItem) {
+func (in *SSHCertificateRequestSpec) DeepCopyInto(out *SSHCertificateRequestSpec) {
 	*out = *in
-	if in.DNSDomains != nil {
-		in, out := &in.DNSDomains, &out.DNSDomains
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.IPRanges != nil {
-		in, out := &in.IPRanges, &out.IPRanges
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	if in.EmailAddresses != nil {
-		in, out := &in.EmailAddresses, &out.EmailAddresses
-		*out = make([]string, len(*in))
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CriticalOptions != nil {
+		in, out := &in.CriticalOptions, &out.CriticalOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
-	if in.URIDomains != nil {
-		in, out := &in.URIDomains, &out.URIDomains
+	out.IssuerRef = in.IssuerRef
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameConstraintItem.
-func (in *NameConstraintItem) DeepCopy() *NameConstraintItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequestSpec.
+func (in *SSHCertificateRequestSpec) DeepCopy() *SSHCertificateRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NameConstraintItem)
+	out := new(SSHCertificateRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NameConstraints) DeepCopyInto(out *NameConstraints) {
+func (in *SSHCertificateRequestStatus) DeepCopyInto(out *SSHCertificateRequestStatus) {
 	*out = *in
-	if in.Permitted != nil {
-		in, out := &in.Permitted, &out.Permitted
-		*out = new(NameConstraintItem)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CertificateRequestCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Excluded != nil {
-		in, out := &in.Excluded, &out.Excluded
-		*out = new(NameConstraintItem)
-		(*in).DeepCopyInto(*out)
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureTime != nil {
+		in, out := &in.FailureTime, &out.FailureTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameConstraints.
-func (in *NameConstraints) DeepCopy() *NameConstraints {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateRequestStatus.
+func (in *SSHCertificateRequestStatus) DeepCopy() *SSHCertificateRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NameConstraints)
+	out := new(SSHCertificateRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OtherName) DeepCopyInto(out *OtherName) {
+func (in *SSHCertificateSpec) DeepCopyInto(out *SSHCertificateSpec) {
 	*out = *in
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CriticalOptions != nil {
+		in, out := &in.CriticalOptions, &out.CriticalOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(CertificateSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = new(SSHCertificatePrivateKey)
+		**out = **in
+	}
+	out.IssuerRef = in.IssuerRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OtherName.
-func (in *OtherName) DeepCopy() *OtherName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateSpec.
+func (in *SSHCertificateSpec) DeepCopy() *SSHCertificateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OtherName)
+	out := new(SSHCertificateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PKCS12Keystore) DeepCopyInto(out *PKCS12Keystore) {
+func (in *SSHCertificateStatus) DeepCopyInto(out *SSHCertificateStatus) {
 	*out = *in
-	out.PasswordSecretRef = in.PasswordSecretRef
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]CertificateCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(int)
+		**out = **in
+	}
+	if in.FailedIssuanceAttempts != nil {
+		in, out := &in.FailedIssuanceAttempts, &out.FailedIssuanceAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.NextPrivateKeySecretName != nil {
+		in, out := &in.NextPrivateKeySecretName, &out.NextPrivateKeySecretName
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PKCS12Keystore.
-func (in *PKCS12Keystore) DeepCopy() *PKCS12Keystore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateStatus.
+func (in *SSHCertificateStatus) DeepCopy() *SSHCertificateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PKCS12Keystore)
+	out := new(SSHCertificateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1198,6 +2158,11 @@ func (in *VaultIssuer) DeepCopyInto(out *VaultIssuer) {
 		*out = new(meta.SecretKeySelector)
 		**out = **in
 	}
+	if in.SPIFFE != nil {
+		in, out := &in.SPIFFE, &out.SPIFFE
+		*out = new(SPIFFEIdentity)
+		**out = **in
+	}
 	return
 }
 
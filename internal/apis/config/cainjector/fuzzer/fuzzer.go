@@ -34,6 +34,10 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []any {
 				s.PprofAddress = "something:1234"
 			}
 
+			if s.ClusterResourceNamespace == "" {
+				s.ClusterResourceNamespace = "something"
+			}
+
 			if s.LeaderElectionConfig.Namespace == "" {
 				s.LeaderElectionConfig.Namespace = "something"
 			}
@@ -17,6 +17,8 @@ limitations under the License.
 package cainjector
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -40,6 +42,10 @@ type CAInjectorConfiguration struct {
 	// Should not be used with --namespace.
 	IgnoreNamespaces []string
 
+	// Namespace to read resources owned by cluster scoped resources such as
+	// ClusterIssuer from.
+	ClusterResourceNamespace string
+
 	// LeaderElectionConfig configures the behaviour of the leader election
 	LeaderElectionConfig shared.LeaderElectionConfig
 
@@ -51,6 +57,10 @@ type CAInjectorConfiguration struct {
 	// cert-manager resources as potential targets for CA data injection.
 	EnableInjectableConfig EnableInjectableConfig
 
+	// CABundleRetention configures which CA certificates are retained in
+	// injected CA bundles after they have been removed from the CA data source.
+	CABundleRetention CABundleRetentionConfig
+
 	// Enable profiling for cainjector.
 	EnablePprof bool
 
@@ -79,6 +89,11 @@ type EnableDataSourceConfig struct {
 	// Certificates determines whether cainjector's control loops will watch
 	// cert-manager Certificate resources as potential sources of CA data.
 	Certificates bool
+
+	// Issuers determines whether cainjector's control loops will watch
+	// cert-manager Issuer and ClusterIssuer resources as potential sources of
+	// CA data.
+	Issuers bool
 }
 
 type EnableInjectableConfig struct {
@@ -102,3 +117,14 @@ type EnableInjectableConfig struct {
 	// APIServices
 	APIServices bool
 }
+
+type CABundleRetentionConfig struct {
+	// MaxCertificates is the maximum number of CA certificates in an injected
+	// CA bundle. If 0, the number of CA certificates is not limited.
+	MaxCertificates int
+
+	// GracePeriod is how long a CA certificate is retained in an injected CA
+	// bundle after it has been removed from the CA data source. If 0, CA
+	// certificates are retained until they expire.
+	GracePeriod time.Duration
+}
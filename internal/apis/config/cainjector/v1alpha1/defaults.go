@@ -17,13 +17,25 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
 	"github.com/cert-manager/cert-manager/pkg/apis/config/cainjector/v1alpha1"
+	sharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
+)
+
+const (
+	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
+
+	defaultClusterResourceNamespace = "kube-system"
 )
 
-const defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
+var (
+	defaultCABundleRetentionMaxCertificates int32 = 0
+	defaultCABundleRetentionGracePeriod           = time.Duration(0)
+)
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
@@ -34,6 +46,10 @@ func SetDefaults_CAInjectorConfiguration(obj *v1alpha1.CAInjectorConfiguration)
 		obj.PprofAddress = "localhost:6060"
 	}
 
+	if obj.ClusterResourceNamespace == "" {
+		obj.ClusterResourceNamespace = defaultClusterResourceNamespace
+	}
+
 	if obj.MetricsListenAddress == "" {
 		obj.MetricsListenAddress = defaultPrometheusMetricsServerAddress
 	}
@@ -45,6 +61,9 @@ func SetDefaults_EnableDataSourceConfig(obj *v1alpha1.EnableDataSourceConfig) {
 	if obj.Certificates == nil {
 		obj.Certificates = new(true)
 	}
+	if obj.Issuers == nil {
+		obj.Issuers = new(false)
+	}
 }
 
 func SetDefaults_EnableInjectableConfig(obj *v1alpha1.EnableInjectableConfig) {
@@ -61,3 +80,12 @@ func SetDefaults_EnableInjectableConfig(obj *v1alpha1.EnableInjectableConfig) {
 		obj.APIServices = new(true)
 	}
 }
+
+func SetDefaults_CABundleRetentionConfig(obj *v1alpha1.CABundleRetentionConfig) {
+	if obj.MaxCertificates == nil {
+		obj.MaxCertificates = &defaultCABundleRetentionMaxCertificates
+	}
+	if obj.GracePeriod == nil {
+		obj.GracePeriod = sharedv1alpha1.DurationFromTime(defaultCABundleRetentionGracePeriod)
+	}
+}
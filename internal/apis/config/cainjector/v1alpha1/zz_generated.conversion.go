@@ -39,6 +39,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*cainjectorv1alpha1.CABundleRetentionConfig)(nil), (*cainjector.CABundleRetentionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CABundleRetentionConfig_To_cainjector_CABundleRetentionConfig(a.(*cainjectorv1alpha1.CABundleRetentionConfig), b.(*cainjector.CABundleRetentionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*cainjector.CABundleRetentionConfig)(nil), (*cainjectorv1alpha1.CABundleRetentionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_cainjector_CABundleRetentionConfig_To_v1alpha1_CABundleRetentionConfig(a.(*cainjector.CABundleRetentionConfig), b.(*cainjectorv1alpha1.CABundleRetentionConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*cainjectorv1alpha1.CAInjectorConfiguration)(nil), (*cainjector.CAInjectorConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CAInjectorConfiguration_To_cainjector_CAInjectorConfiguration(a.(*cainjectorv1alpha1.CAInjectorConfiguration), b.(*cainjector.CAInjectorConfiguration), scope)
 	}); err != nil {
@@ -72,10 +82,41 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_CABundleRetentionConfig_To_cainjector_CABundleRetentionConfig(in *cainjectorv1alpha1.CABundleRetentionConfig, out *cainjector.CABundleRetentionConfig, s conversion.Scope) error {
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxCertificates, &out.MaxCertificates, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.GracePeriod, &out.GracePeriod, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CABundleRetentionConfig_To_cainjector_CABundleRetentionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CABundleRetentionConfig_To_cainjector_CABundleRetentionConfig(in *cainjectorv1alpha1.CABundleRetentionConfig, out *cainjector.CABundleRetentionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CABundleRetentionConfig_To_cainjector_CABundleRetentionConfig(in, out, s)
+}
+
+func autoConvert_cainjector_CABundleRetentionConfig_To_v1alpha1_CABundleRetentionConfig(in *cainjector.CABundleRetentionConfig, out *cainjectorv1alpha1.CABundleRetentionConfig, s conversion.Scope) error {
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxCertificates, &out.MaxCertificates, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.GracePeriod, &out.GracePeriod, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_cainjector_CABundleRetentionConfig_To_v1alpha1_CABundleRetentionConfig is an autogenerated conversion function.
+func Convert_cainjector_CABundleRetentionConfig_To_v1alpha1_CABundleRetentionConfig(in *cainjector.CABundleRetentionConfig, out *cainjectorv1alpha1.CABundleRetentionConfig, s conversion.Scope) error {
+	return autoConvert_cainjector_CABundleRetentionConfig_To_v1alpha1_CABundleRetentionConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CAInjectorConfiguration_To_cainjector_CAInjectorConfiguration(in *cainjectorv1alpha1.CAInjectorConfiguration, out *cainjector.CAInjectorConfiguration, s conversion.Scope) error {
 	out.KubeConfig = in.KubeConfig
 	out.Namespace = in.Namespace
 	out.IgnoreNamespaces = *(*[]string)(unsafe.Pointer(&in.IgnoreNamespaces))
+	out.ClusterResourceNamespace = in.ClusterResourceNamespace
 	if err := sharedv1alpha1.Convert_v1alpha1_LeaderElectionConfig_To_shared_LeaderElectionConfig(&in.LeaderElectionConfig, &out.LeaderElectionConfig, s); err != nil {
 		return err
 	}
@@ -85,6 +126,9 @@ func autoConvert_v1alpha1_CAInjectorConfiguration_To_cainjector_CAInjectorConfig
 	if err := Convert_v1alpha1_EnableInjectableConfig_To_cainjector_EnableInjectableConfig(&in.EnableInjectableConfig, &out.EnableInjectableConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_CABundleRetentionConfig_To_cainjector_CABundleRetentionConfig(&in.CABundleRetention, &out.CABundleRetention, s); err != nil {
+		return err
+	}
 	out.EnablePprof = in.EnablePprof
 	out.PprofAddress = in.PprofAddress
 	out.Logging = in.Logging
@@ -105,6 +149,7 @@ func autoConvert_cainjector_CAInjectorConfiguration_To_v1alpha1_CAInjectorConfig
 	out.KubeConfig = in.KubeConfig
 	out.Namespace = in.Namespace
 	out.IgnoreNamespaces = *(*[]string)(unsafe.Pointer(&in.IgnoreNamespaces))
+	out.ClusterResourceNamespace = in.ClusterResourceNamespace
 	if err := sharedv1alpha1.Convert_shared_LeaderElectionConfig_To_v1alpha1_LeaderElectionConfig(&in.LeaderElectionConfig, &out.LeaderElectionConfig, s); err != nil {
 		return err
 	}
@@ -114,6 +159,9 @@ func autoConvert_cainjector_CAInjectorConfiguration_To_v1alpha1_CAInjectorConfig
 	if err := Convert_cainjector_EnableInjectableConfig_To_v1alpha1_EnableInjectableConfig(&in.EnableInjectableConfig, &out.EnableInjectableConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_cainjector_CABundleRetentionConfig_To_v1alpha1_CABundleRetentionConfig(&in.CABundleRetention, &out.CABundleRetention, s); err != nil {
+		return err
+	}
 	out.EnablePprof = in.EnablePprof
 	out.PprofAddress = in.PprofAddress
 	out.Logging = in.Logging
@@ -134,6 +182,9 @@ func autoConvert_v1alpha1_EnableDataSourceConfig_To_cainjector_EnableDataSourceC
 	if err := v1.Convert_Pointer_bool_To_bool(&in.Certificates, &out.Certificates, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.Issuers, &out.Issuers, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,6 +197,9 @@ func autoConvert_cainjector_EnableDataSourceConfig_To_v1alpha1_EnableDataSourceC
 	if err := v1.Convert_bool_To_Pointer_bool(&in.Certificates, &out.Certificates, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.Issuers, &out.Issuers, s); err != nil {
+		return err
+	}
 	return nil
 }
 
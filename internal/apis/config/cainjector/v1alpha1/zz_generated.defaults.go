@@ -42,5 +42,6 @@ func SetObjectDefaults_CAInjectorConfiguration(in *cainjectorv1alpha1.CAInjector
 	sharedv1alpha1.SetDefaults_LeaderElectionConfig(&in.LeaderElectionConfig)
 	SetDefaults_EnableDataSourceConfig(&in.EnableDataSourceConfig)
 	SetDefaults_EnableInjectableConfig(&in.EnableInjectableConfig)
+	SetDefaults_CABundleRetentionConfig(&in.CABundleRetention)
 	sharedv1alpha1.SetDefaults_DynamicServingConfig(&in.MetricsTLSConfig.Dynamic)
 }
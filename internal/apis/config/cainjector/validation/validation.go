@@ -29,6 +29,7 @@ func ValidateCAInjectorConfiguration(cfg *config.CAInjectorConfiguration, fldPat
 
 	allErrors = append(allErrors, logsapi.Validate(&cfg.Logging, nil, fldPath.Child("logging"))...)
 	allErrors = append(allErrors, sharedvalidation.ValidateLeaderElectionConfig(&cfg.LeaderElectionConfig, fldPath.Child("leaderElectionConfig"))...)
+	allErrors = append(allErrors, sharedvalidation.ValidateTLSClientVerification(&cfg.MetricsTLSConfig, fldPath.Child("metricsTLSConfig"))...)
 
 	if cfg.Namespace != "" && len(cfg.IgnoreNamespaces) > 0 {
 		allErrors = append(allErrors, field.Forbidden(
@@ -37,5 +38,20 @@ func ValidateCAInjectorConfiguration(cfg *config.CAInjectorConfiguration, fldPat
 		))
 	}
 
+	allErrors = append(allErrors, validateCABundleRetentionConfig(&cfg.CABundleRetention, fldPath.Child("caBundleRetention"))...)
+
+	return allErrors
+}
+
+func validateCABundleRetentionConfig(cfg *config.CABundleRetentionConfig, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if cfg.MaxCertificates < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("maxCertificates"), cfg.MaxCertificates, "must not be negative"))
+	}
+	if cfg.GracePeriod < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("gracePeriod"), cfg.GracePeriod, "must not be negative"))
+	}
+
 	return allErrors
 }
@@ -18,6 +18,7 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -73,6 +74,24 @@ func TestValidateCAInjectorConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with invalid ca bundle retention config",
+			&config.CAInjectorConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				CABundleRetention: config.CABundleRetentionConfig{
+					MaxCertificates: -1,
+					GracePeriod:     -time.Hour,
+				},
+			},
+			func(cc *config.CAInjectorConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("caBundleRetention.maxCertificates"), cc.CABundleRetention.MaxCertificates, "must not be negative"),
+					field.Invalid(field.NewPath("caBundleRetention.gracePeriod"), cc.CABundleRetention.GracePeriod, "must not be negative"),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
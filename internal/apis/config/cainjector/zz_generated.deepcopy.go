@@ -25,6 +25,22 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleRetentionConfig) DeepCopyInto(out *CABundleRetentionConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleRetentionConfig.
+func (in *CABundleRetentionConfig) DeepCopy() *CABundleRetentionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleRetentionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CAInjectorConfiguration) DeepCopyInto(out *CAInjectorConfiguration) {
 	*out = *in
@@ -37,6 +53,7 @@ func (in *CAInjectorConfiguration) DeepCopyInto(out *CAInjectorConfiguration) {
 	out.LeaderElectionConfig = in.LeaderElectionConfig
 	out.EnableDataSourceConfig = in.EnableDataSourceConfig
 	out.EnableInjectableConfig = in.EnableInjectableConfig
+	out.CABundleRetention = in.CABundleRetention
 	in.Logging.DeepCopyInto(&out.Logging)
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
@@ -119,6 +119,14 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []any {
 				s.ACMEDNS01Config.CheckRetryPeriod = time.Second * 8875
 			}
 
+			if len(s.TLSSecretExpiryConfig.Thresholds) == 0 {
+				s.TLSSecretExpiryConfig.Thresholds = []time.Duration{time.Second * 8875}
+			}
+
+			if s.IssuanceRateLimitConfig.Interval == time.Duration(0) {
+				s.IssuanceRateLimitConfig.Interval = time.Second * 8875
+			}
+
 			// The deprecated top-level fields are always overwritten by the defaulter
 			// to mirror the canonical GatewayAPIConfig fields, so keep them in sync here
 			// to ensure the round-trip produces an identical object.
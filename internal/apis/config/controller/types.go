@@ -115,6 +115,12 @@ type ControllerConfiguration struct {
 	// Metrics endpoint TLS config
 	MetricsTLSConfig shared.TLSConfig
 
+	// Expose the serial number of the certificate stored in the Secret of
+	// each Certificate as the certmanager_certificate_serial_info metric. The
+	// serial number changes on every renewal, so each renewal creates a new
+	// time series.
+	EnableCertificateSerialMetric bool
+
 	// The host and port address, separated by a ':', that the healthz server
 	// should listen on.
 	HealthzListenAddress string
@@ -149,6 +155,14 @@ type ControllerConfiguration struct {
 	// GatewayAPIConfig configures the behaviour of the Gateway API integration
 	GatewayAPIConfig GatewayAPIConfig
 
+	// TLSSecretExpiryConfig configures the behaviour of the tls-secret-expiry
+	// controller
+	TLSSecretExpiryConfig TLSSecretExpiryConfig
+
+	// IssuanceRateLimitConfig configures the rate at which CertificateRequests
+	// are created for Certificates, and the smoothing of renewal times.
+	IssuanceRateLimitConfig IssuanceRateLimitConfig
+
 	// CertificateRequestMinimumBackoffDuration configures the minimum backoff duration
 	// when a certificate request fails (default 1h). The backoff delay starts at
 	// this value and is exponentially increased with each consecutive failure,
@@ -296,3 +310,37 @@ type PEMSizeLimitsConfig struct {
 	// Defaults to 330000 bytes.
 	MaxBundleSize int
 }
+
+type IssuanceRateLimitConfig struct {
+	// Interval is the period over which the global and per-issuer limits
+	// apply.
+	Interval time.Duration
+
+	// GlobalLimit is the maximum number of CertificateRequests which are
+	// created for Certificates across all issuers in each interval.
+	// 0 disables the global limit.
+	GlobalLimit int
+
+	// PerIssuerLimit is the maximum number of CertificateRequests which are
+	// created for Certificates referencing the same issuer in each interval.
+	// 0 disables the per-issuer limit.
+	PerIssuerLimit int
+
+	// MaxRenewalJitter is the maximum duration by which the renewal time of a
+	// Certificate is brought forward, so that Certificates issued at the same
+	// time are not all renewed at the same time. 0 disables jitter.
+	MaxRenewalJitter time.Duration
+}
+
+type TLSSecretExpiryConfig struct {
+	// Thresholds is the list of durations before the expiry of a certificate
+	// stored in a kubernetes.io/tls Secret, which is not managed by a
+	// Certificate, at which a Warning Event is fired and the webhook is
+	// notified.
+	// +k8s:conversion-gen=false
+	Thresholds []time.Duration
+
+	// WebhookURL is an optional http or https URL to which a JSON notification
+	// is POSTed each time a Secret crosses a threshold or expires.
+	WebhookURL string
+}
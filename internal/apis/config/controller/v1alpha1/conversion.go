@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/conversion"
+
+	"github.com/cert-manager/cert-manager/internal/apis/config/controller"
+	sharedv1alpha1 "github.com/cert-manager/cert-manager/internal/apis/config/shared/v1alpha1"
+	"github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
+	configsharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
+)
+
+func Convert_v1alpha1_TLSSecretExpiryConfig_To_controller_TLSSecretExpiryConfig(in *v1alpha1.TLSSecretExpiryConfig, out *controller.TLSSecretExpiryConfig, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_TLSSecretExpiryConfig_To_controller_TLSSecretExpiryConfig(in, out, s); err != nil {
+		return err
+	}
+
+	out.Thresholds = nil
+	if in.Thresholds != nil {
+		out.Thresholds = make([]time.Duration, len(in.Thresholds))
+		for i := range in.Thresholds {
+			if err := sharedv1alpha1.Convert_v1alpha1_Duration_To_time_Duration(&in.Thresholds[i], &out.Thresholds[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func Convert_controller_TLSSecretExpiryConfig_To_v1alpha1_TLSSecretExpiryConfig(in *controller.TLSSecretExpiryConfig, out *v1alpha1.TLSSecretExpiryConfig, s conversion.Scope) error {
+	if err := autoConvert_controller_TLSSecretExpiryConfig_To_v1alpha1_TLSSecretExpiryConfig(in, out, s); err != nil {
+		return err
+	}
+
+	out.Thresholds = nil
+	if in.Thresholds != nil {
+		out.Thresholds = make([]configsharedv1alpha1.Duration, len(in.Thresholds))
+		for i := range in.Thresholds {
+			if err := sharedv1alpha1.Convert_time_Duration_To_v1alpha1_Duration(&in.Thresholds[i], &out.Thresholds[i], s); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -50,6 +50,9 @@ import (
 	csrvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/venafi"
 	clusterissuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
 	issuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/issuers"
+	"github.com/cert-manager/cert-manager/pkg/controller/sshcertificaterequests"
+	"github.com/cert-manager/cert-manager/pkg/controller/sshcertificates"
+	"github.com/cert-manager/cert-manager/pkg/controller/tlssecretexpiry"
 	"github.com/cert-manager/cert-manager/pkg/util"
 )
 
@@ -65,6 +68,8 @@ var (
 	defaultEnableProfiling = false
 	defaultProfilerAddr    = "localhost:6060"
 
+	defaultEnableCertificateSerialMetric = false
+
 	defaultClusterIssuerAmbientCredentials = true
 	defaultIssuerAmbientCredentials        = false
 
@@ -103,6 +108,17 @@ var (
 	defaultCertificateRequestMinimumBackoffDuration = 1 * time.Hour
 	defaultCertificateRequestMaximumBackoffDuration = 32 * time.Hour
 
+	defaultTLSSecretExpiryThresholds = []sharedv1alpha1.Duration{
+		*sharedv1alpha1.DurationFromTime(30 * 24 * time.Hour),
+		*sharedv1alpha1.DurationFromTime(7 * 24 * time.Hour),
+		*sharedv1alpha1.DurationFromTime(24 * time.Hour),
+	}
+
+	defaultIssuanceRateLimitInterval             = time.Minute
+	defaultIssuanceRateLimitGlobalLimit    int32 = 0
+	defaultIssuanceRateLimitPerIssuerLimit int32 = 0
+	defaultMaxRenewalJitter                      = time.Duration(0)
+
 	defaultAutoCertificateAnnotations  = []string{"kubernetes.io/tls-acme"}
 	defaultExtraCertificateAnnotations = []string{}
 
@@ -133,7 +149,12 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
-		// experimental CSR controllers
+		// optional controllers
+		tlssecretexpiry.ControllerName,
+		sshcertificates.ControllerName,
+		sshcertificaterequests.ControllerName,
+		crapprovercontroller.SSHControllerName,
+		// certificatesigningrequest controllers
 		csracmecontroller.CSRControllerName,
 		csrcacontroller.CSRControllerName,
 		csrselfsignedcontroller.CSRControllerName,
@@ -161,9 +182,7 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
-	}
-
-	ExperimentalCertificateSigningRequestControllers = []string{
+		// certificatesigningrequest controllers
 		csracmecontroller.CSRControllerName,
 		csrcacontroller.CSRControllerName,
 		csrselfsignedcontroller.CSRControllerName,
@@ -280,6 +299,10 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.HealthzListenAddress = defaultHealthzServerAddress
 	}
 
+	if obj.EnableCertificateSerialMetric == nil {
+		obj.EnableCertificateSerialMetric = &defaultEnableCertificateSerialMetric
+	}
+
 	if obj.EnablePprof == nil {
 		obj.EnablePprof = &defaultEnableProfiling
 	}
@@ -395,3 +418,27 @@ func SetDefaults_PEMSizeLimitsConfig(obj *v1alpha1.PEMSizeLimitsConfig) {
 		obj.MaxBundleSize = &defaultMaxBundleSize
 	}
 }
+
+func SetDefaults_IssuanceRateLimitConfig(obj *v1alpha1.IssuanceRateLimitConfig) {
+	if obj.Interval.IsZero() {
+		obj.Interval = sharedv1alpha1.DurationFromTime(defaultIssuanceRateLimitInterval)
+	}
+
+	if obj.GlobalLimit == nil {
+		obj.GlobalLimit = &defaultIssuanceRateLimitGlobalLimit
+	}
+
+	if obj.PerIssuerLimit == nil {
+		obj.PerIssuerLimit = &defaultIssuanceRateLimitPerIssuerLimit
+	}
+
+	if obj.MaxRenewalJitter == nil {
+		obj.MaxRenewalJitter = sharedv1alpha1.DurationFromTime(defaultMaxRenewalJitter)
+	}
+}
+
+func SetDefaults_TLSSecretExpiryConfig(obj *v1alpha1.TLSSecretExpiryConfig) {
+	if len(obj.Thresholds) == 0 {
+		obj.Thresholds = defaultTLSSecretExpiryThresholds
+	}
+}
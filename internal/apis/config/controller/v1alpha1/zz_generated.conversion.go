@@ -89,6 +89,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*controllerv1alpha1.IssuanceRateLimitConfig)(nil), (*controller.IssuanceRateLimitConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IssuanceRateLimitConfig_To_controller_IssuanceRateLimitConfig(a.(*controllerv1alpha1.IssuanceRateLimitConfig), b.(*controller.IssuanceRateLimitConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.IssuanceRateLimitConfig)(nil), (*controllerv1alpha1.IssuanceRateLimitConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_IssuanceRateLimitConfig_To_v1alpha1_IssuanceRateLimitConfig(a.(*controller.IssuanceRateLimitConfig), b.(*controllerv1alpha1.IssuanceRateLimitConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*controllerv1alpha1.LeaderElectionConfig)(nil), (*controller.LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LeaderElectionConfig_To_controller_LeaderElectionConfig(a.(*controllerv1alpha1.LeaderElectionConfig), b.(*controller.LeaderElectionConfig), scope)
 	}); err != nil {
@@ -109,6 +119,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*controller.TLSSecretExpiryConfig)(nil), (*controllerv1alpha1.TLSSecretExpiryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_TLSSecretExpiryConfig_To_v1alpha1_TLSSecretExpiryConfig(a.(*controller.TLSSecretExpiryConfig), b.(*controllerv1alpha1.TLSSecretExpiryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*controllerv1alpha1.TLSSecretExpiryConfig)(nil), (*controller.TLSSecretExpiryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSSecretExpiryConfig_To_controller_TLSSecretExpiryConfig(a.(*controllerv1alpha1.TLSSecretExpiryConfig), b.(*controller.TLSSecretExpiryConfig), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -225,6 +245,9 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := sharedv1alpha1.Convert_v1alpha1_TLSConfig_To_shared_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCertificateSerialMetric, &out.EnableCertificateSerialMetric, s); err != nil {
+		return err
+	}
 	out.HealthzListenAddress = in.HealthzListenAddress
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnablePprof, &out.EnablePprof, s); err != nil {
 		return err
@@ -247,6 +270,12 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := Convert_v1alpha1_GatewayAPIConfig_To_controller_GatewayAPIConfig(&in.GatewayAPIConfig, &out.GatewayAPIConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_TLSSecretExpiryConfig_To_controller_TLSSecretExpiryConfig(&in.TLSSecretExpiryConfig, &out.TLSSecretExpiryConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_IssuanceRateLimitConfig_To_controller_IssuanceRateLimitConfig(&in.IssuanceRateLimitConfig, &out.IssuanceRateLimitConfig, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CertificateRequestMinimumBackoffDuration, &out.CertificateRequestMinimumBackoffDuration, s); err != nil {
 		return err
 	}
@@ -302,6 +331,9 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := sharedv1alpha1.Convert_shared_TLSConfig_To_v1alpha1_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
 	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCertificateSerialMetric, &out.EnableCertificateSerialMetric, s); err != nil {
+		return err
+	}
 	out.HealthzListenAddress = in.HealthzListenAddress
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnablePprof, &out.EnablePprof, s); err != nil {
 		return err
@@ -324,6 +356,12 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := Convert_controller_GatewayAPIConfig_To_v1alpha1_GatewayAPIConfig(&in.GatewayAPIConfig, &out.GatewayAPIConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_controller_TLSSecretExpiryConfig_To_v1alpha1_TLSSecretExpiryConfig(&in.TLSSecretExpiryConfig, &out.TLSSecretExpiryConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_controller_IssuanceRateLimitConfig_To_v1alpha1_IssuanceRateLimitConfig(&in.IssuanceRateLimitConfig, &out.IssuanceRateLimitConfig, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CertificateRequestMinimumBackoffDuration, &out.CertificateRequestMinimumBackoffDuration, s); err != nil {
 		return err
 	}
@@ -398,6 +436,48 @@ func Convert_controller_IngressShimConfig_To_v1alpha1_IngressShimConfig(in *cont
 	return autoConvert_controller_IngressShimConfig_To_v1alpha1_IngressShimConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_IssuanceRateLimitConfig_To_controller_IssuanceRateLimitConfig(in *controllerv1alpha1.IssuanceRateLimitConfig, out *controller.IssuanceRateLimitConfig, s conversion.Scope) error {
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.Interval, &out.Interval, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.GlobalLimit, &out.GlobalLimit, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.PerIssuerLimit, &out.PerIssuerLimit, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.MaxRenewalJitter, &out.MaxRenewalJitter, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IssuanceRateLimitConfig_To_controller_IssuanceRateLimitConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IssuanceRateLimitConfig_To_controller_IssuanceRateLimitConfig(in *controllerv1alpha1.IssuanceRateLimitConfig, out *controller.IssuanceRateLimitConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IssuanceRateLimitConfig_To_controller_IssuanceRateLimitConfig(in, out, s)
+}
+
+func autoConvert_controller_IssuanceRateLimitConfig_To_v1alpha1_IssuanceRateLimitConfig(in *controller.IssuanceRateLimitConfig, out *controllerv1alpha1.IssuanceRateLimitConfig, s conversion.Scope) error {
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.Interval, &out.Interval, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.GlobalLimit, &out.GlobalLimit, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.PerIssuerLimit, &out.PerIssuerLimit, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.MaxRenewalJitter, &out.MaxRenewalJitter, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_controller_IssuanceRateLimitConfig_To_v1alpha1_IssuanceRateLimitConfig is an autogenerated conversion function.
+func Convert_controller_IssuanceRateLimitConfig_To_v1alpha1_IssuanceRateLimitConfig(in *controller.IssuanceRateLimitConfig, out *controllerv1alpha1.IssuanceRateLimitConfig, s conversion.Scope) error {
+	return autoConvert_controller_IssuanceRateLimitConfig_To_v1alpha1_IssuanceRateLimitConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_LeaderElectionConfig_To_controller_LeaderElectionConfig(in *controllerv1alpha1.LeaderElectionConfig, out *controller.LeaderElectionConfig, s conversion.Scope) error {
 	if err := sharedv1alpha1.Convert_v1alpha1_LeaderElectionConfig_To_shared_LeaderElectionConfig(&in.LeaderElectionConfig, &out.LeaderElectionConfig, s); err != nil {
 		return err
@@ -469,3 +549,15 @@ func autoConvert_controller_PEMSizeLimitsConfig_To_v1alpha1_PEMSizeLimitsConfig(
 func Convert_controller_PEMSizeLimitsConfig_To_v1alpha1_PEMSizeLimitsConfig(in *controller.PEMSizeLimitsConfig, out *controllerv1alpha1.PEMSizeLimitsConfig, s conversion.Scope) error {
 	return autoConvert_controller_PEMSizeLimitsConfig_To_v1alpha1_PEMSizeLimitsConfig(in, out, s)
 }
+
+func autoConvert_v1alpha1_TLSSecretExpiryConfig_To_controller_TLSSecretExpiryConfig(in *controllerv1alpha1.TLSSecretExpiryConfig, out *controller.TLSSecretExpiryConfig, s conversion.Scope) error {
+	// INFO: in.Thresholds opted out of conversion generation
+	out.WebhookURL = in.WebhookURL
+	return nil
+}
+
+func autoConvert_controller_TLSSecretExpiryConfig_To_v1alpha1_TLSSecretExpiryConfig(in *controller.TLSSecretExpiryConfig, out *controllerv1alpha1.TLSSecretExpiryConfig, s conversion.Scope) error {
+	// INFO: in.Thresholds opted out of conversion generation
+	out.WebhookURL = in.WebhookURL
+	return nil
+}
@@ -18,6 +18,7 @@ package validation
 
 import (
 	"net"
+	"net/url"
 	"strings"
 	"time"
 
@@ -88,6 +89,57 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 
 	allErrors = append(allErrors, validateCertificateRequestBackoffConfig(&cfg.CertificateRequestMinimumBackoffDuration, &cfg.CertificateRequestMaximumBackoffDuration, fldPath)...)
 
+	allErrors = append(allErrors, validateTLSSecretExpiryConfig(&cfg.TLSSecretExpiryConfig, fldPath.Child("tlsSecretExpiryConfig"))...)
+
+	allErrors = append(allErrors, validateIssuanceRateLimitConfig(&cfg.IssuanceRateLimitConfig, fldPath.Child("issuanceRateLimitConfig"))...)
+
+	return allErrors
+}
+
+func validateIssuanceRateLimitConfig(cfg *config.IssuanceRateLimitConfig, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	// The interval is only used when one of the limits is enabled.
+	if (cfg.GlobalLimit > 0 || cfg.PerIssuerLimit > 0) && cfg.Interval <= 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("interval"), cfg.Interval.String(), "must be greater than 0"))
+	}
+
+	if cfg.GlobalLimit < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("globalLimit"), cfg.GlobalLimit, "must be greater than or equal to 0"))
+	}
+
+	if cfg.PerIssuerLimit < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("perIssuerLimit"), cfg.PerIssuerLimit, "must be greater than or equal to 0"))
+	}
+
+	if cfg.MaxRenewalJitter < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("maxRenewalJitter"), cfg.MaxRenewalJitter.String(), "must be greater than or equal to 0"))
+	}
+
+	return allErrors
+}
+
+func validateTLSSecretExpiryConfig(cfg *config.TLSSecretExpiryConfig, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	for i, threshold := range cfg.Thresholds {
+		if threshold <= 0 {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("thresholds").Index(i), threshold.String(), "must be greater than 0"))
+		}
+	}
+
+	if cfg.WebhookURL != "" {
+		u, err := url.Parse(cfg.WebhookURL)
+		switch {
+		case err != nil:
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("webhookURL"), cfg.WebhookURL, err.Error()))
+		case u.Scheme != "http" && u.Scheme != "https":
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("webhookURL"), cfg.WebhookURL, "must be an http or https URL"))
+		case u.Host == "":
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("webhookURL"), cfg.WebhookURL, "must include a host"))
+		}
+	}
+
 	return allErrors
 }
 
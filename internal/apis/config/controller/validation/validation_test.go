@@ -535,6 +535,111 @@ func TestValidatePEMSizeLimitsConfig(t *testing.T) {
 	}
 }
 
+func TestValidateIssuanceRateLimitConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *config.IssuanceRateLimitConfig
+		errs   field.ErrorList
+	}{
+		{
+			"with valid issuance rate limit config",
+			&config.IssuanceRateLimitConfig{
+				Interval:         time.Minute,
+				GlobalLimit:      100,
+				PerIssuerLimit:   10,
+				MaxRenewalJitter: time.Hour,
+			},
+			nil,
+		},
+		{
+			"with limits disabled and no interval",
+			&config.IssuanceRateLimitConfig{},
+			nil,
+		},
+		{
+			"with zero interval",
+			&config.IssuanceRateLimitConfig{
+				PerIssuerLimit: 10,
+			},
+			field.ErrorList{
+				field.Invalid(field.NewPath("").Child("interval"), "0s", "must be greater than 0"),
+			},
+		},
+		{
+			"with negative limits and jitter",
+			&config.IssuanceRateLimitConfig{
+				Interval:         time.Minute,
+				GlobalLimit:      -1,
+				PerIssuerLimit:   -1,
+				MaxRenewalJitter: -time.Second,
+			},
+			field.ErrorList{
+				field.Invalid(field.NewPath("").Child("globalLimit"), -1, "must be greater than or equal to 0"),
+				field.Invalid(field.NewPath("").Child("perIssuerLimit"), -1, "must be greater than or equal to 0"),
+				field.Invalid(field.NewPath("").Child("maxRenewalJitter"), "-1s", "must be greater than or equal to 0"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateIssuanceRateLimitConfig(test.config, field.NewPath(""))
+			assert.ElementsMatch(t, test.errs, errs)
+		})
+	}
+}
+
+func TestValidateTLSSecretExpiryConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *config.TLSSecretExpiryConfig
+		errs   field.ErrorList
+	}{
+		{
+			"with valid thresholds and webhook URL",
+			&config.TLSSecretExpiryConfig{
+				Thresholds: []time.Duration{24 * time.Hour},
+				WebhookURL: "https://alerts.example.com/hook",
+			},
+			nil,
+		},
+		{
+			"with zero threshold",
+			&config.TLSSecretExpiryConfig{
+				Thresholds: []time.Duration{24 * time.Hour, 0},
+			},
+			field.ErrorList{
+				field.Invalid(field.NewPath("").Child("thresholds").Index(1), "0s", "must be greater than 0"),
+			},
+		},
+		{
+			"with non-http webhook URL",
+			&config.TLSSecretExpiryConfig{
+				WebhookURL: "ftp://alerts.example.com",
+			},
+			field.ErrorList{
+				field.Invalid(field.NewPath("").Child("webhookURL"), "ftp://alerts.example.com", "must be an http or https URL"),
+			},
+		},
+		{
+			"with webhook URL without a host",
+			&config.TLSSecretExpiryConfig{
+				WebhookURL: "https:///hook",
+			},
+			field.ErrorList{
+				field.Invalid(field.NewPath("").Child("webhookURL"), "https:///hook", "must include a host"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validateTLSSecretExpiryConfig(test.config, field.NewPath(""))
+			assert.ElementsMatch(t, test.errs, errs)
+		})
+	}
+}
+
 func TestValidateCertificateRequestBackoffConfig(t *testing.T) {
 	tests := []struct {
 		name       string
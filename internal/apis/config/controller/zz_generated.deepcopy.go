@@ -22,6 +22,8 @@ limitations under the License.
 package controller
 
 import (
+	time "time"
+
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -103,6 +105,8 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	in.ACMEDNS01Config.DeepCopyInto(&out.ACMEDNS01Config)
 	out.PEMSizeLimitsConfig = in.PEMSizeLimitsConfig
 	in.GatewayAPIConfig.DeepCopyInto(&out.GatewayAPIConfig)
+	in.TLSSecretExpiryConfig.DeepCopyInto(&out.TLSSecretExpiryConfig)
+	out.IssuanceRateLimitConfig = in.IssuanceRateLimitConfig
 	return
 }
 
@@ -171,6 +175,22 @@ func (in *IngressShimConfig) DeepCopy() *IngressShimConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuanceRateLimitConfig) DeepCopyInto(out *IssuanceRateLimitConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuanceRateLimitConfig.
+func (in *IssuanceRateLimitConfig) DeepCopy() *IssuanceRateLimitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuanceRateLimitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfig) DeepCopyInto(out *LeaderElectionConfig) {
 	*out = *in
@@ -203,3 +223,24 @@ func (in *PEMSizeLimitsConfig) DeepCopy() *PEMSizeLimitsConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecretExpiryConfig) DeepCopyInto(out *TLSSecretExpiryConfig) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]time.Duration, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretExpiryConfig.
+func (in *TLSSecretExpiryConfig) DeepCopy() *TLSSecretExpiryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecretExpiryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
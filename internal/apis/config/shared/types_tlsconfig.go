@@ -72,6 +72,11 @@ type DynamicServingConfig struct {
 
 	// LeafDuration is a customizable duration on serving certificates signed by the CA.
 	LeafDuration time.Duration
+
+	// VerifyClientCertificates requires clients to present a certificate
+	// signed by the CA, e.g. issued by a CA Issuer which references the CA
+	// Secret. Only supported by the metrics servers.
+	VerifyClientCertificates bool
 }
 
 // FilesystemServingConfig enables using a certificate and private key found on the local filesystem.
@@ -98,3 +98,13 @@ func Convert_time_Duration_To_Pointer_v1alpha1_Duration(in *time.Duration, out *
 	*out = v1alpha1.DurationFromTime(*in)
 	return nil
 }
+
+func Convert_v1alpha1_Duration_To_time_Duration(in *v1alpha1.Duration, out *time.Duration, s conversion.Scope) error {
+	*out = in.Duration.Duration
+	return nil
+}
+
+func Convert_time_Duration_To_v1alpha1_Duration(in *time.Duration, out *v1alpha1.Duration, s conversion.Scope) error {
+	*out = *v1alpha1.DurationFromTime(*in)
+	return nil
+}
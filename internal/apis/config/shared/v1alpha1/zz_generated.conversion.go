@@ -99,6 +99,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*time.Duration)(nil), (*sharedv1alpha1.Duration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_time_Duration_To_v1alpha1_Duration(a.(*time.Duration), b.(*sharedv1alpha1.Duration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*sharedv1alpha1.Duration)(nil), (*time.Duration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Duration_To_time_Duration(a.(*sharedv1alpha1.Duration), b.(*time.Duration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*sharedv1alpha1.LeaderElectionConfig)(nil), (*shared.LeaderElectionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LeaderElectionConfig_To_shared_LeaderElectionConfig(a.(*sharedv1alpha1.LeaderElectionConfig), b.(*shared.LeaderElectionConfig), scope)
 	}); err != nil {
@@ -119,6 +129,7 @@ func autoConvert_v1alpha1_DynamicServingConfig_To_shared_DynamicServingConfig(in
 	if err := Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.LeafDuration, &out.LeafDuration, s); err != nil {
 		return err
 	}
+	out.VerifyClientCertificates = in.VerifyClientCertificates
 	return nil
 }
 
@@ -134,6 +145,7 @@ func autoConvert_shared_DynamicServingConfig_To_v1alpha1_DynamicServingConfig(in
 	if err := Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.LeafDuration, &out.LeafDuration, s); err != nil {
 		return err
 	}
+	out.VerifyClientCertificates = in.VerifyClientCertificates
 	return nil
 }
 
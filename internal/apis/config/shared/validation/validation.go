@@ -50,6 +50,21 @@ func ValidateTLSConfig(tlsConfig *shared.TLSConfig, fldPath *field.Path) field.E
 		}
 	}
 
+	allErrors = append(allErrors, ValidateTLSClientVerification(tlsConfig, fldPath)...)
+
+	return allErrors
+}
+
+// ValidateTLSClientVerification checks that client certificate verification
+// is only enabled together with dynamic TLS config, as client certificates are
+// verified against the dynamic serving CA.
+func ValidateTLSClientVerification(tlsConfig *shared.TLSConfig, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if tlsConfig.Dynamic.VerifyClientCertificates && (!tlsConfig.DynamicConfigProvided() || tlsConfig.FilesystemConfigProvided()) {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("dynamic", "verifyClientCertificates"), true, "can only be enabled when using dynamic TLS config"))
+	}
+
 	return allErrors
 }
 
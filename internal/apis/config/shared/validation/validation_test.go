@@ -145,6 +145,35 @@ func TestValidateTLSConfig(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with client verification and dynamic tls config",
+			&shared.TLSConfig{
+				Dynamic: shared.DynamicServingConfig{
+					SecretNamespace:          "cert-manager",
+					SecretName:               "test",
+					DNSNames:                 []string{"example.com"},
+					VerifyClientCertificates: true,
+				},
+			},
+			nil,
+		},
+		{
+			"with client verification and filesystem tls config",
+			&shared.TLSConfig{
+				Filesystem: shared.FilesystemServingConfig{
+					CertFile: "/test.crt",
+					KeyFile:  "/test.key",
+				},
+				Dynamic: shared.DynamicServingConfig{
+					VerifyClientCertificates: true,
+				},
+			},
+			func(cc *shared.TLSConfig) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("dynamic.verifyClientCertificates"), true, "can only be enabled when using dynamic TLS config"),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
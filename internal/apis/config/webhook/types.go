@@ -88,6 +88,42 @@ type WebhookConfiguration struct {
 
 	// PEMSizeLimitsConfig configures the maximum sizes for PEM-encoded data
 	PEMSizeLimitsConfig PEMSizeLimitsConfig
+
+	// validationRules are CEL expressions which are evaluated against
+	// cert-manager resources when they are created or updated, in addition to
+	// the built-in validation.
+	ValidationRules []ValidationRule
+
+	// admissionPlugins is a map of admission plugin names to bools that enable
+	// or disable the admission plugins run by the webhook. All admission plugins
+	// are enabled by default. The plugins that can be disabled are
+	// `CertificateDefaults`, `CertificateRenewalRequest` and `CELValidation`.
+	AdmissionPlugins map[string]bool
+}
+
+// ValidationRule is a CEL expression which must evaluate to true for a
+// resource to be admitted.
+type ValidationRule struct {
+	// resources are the cert-manager.io resources the rule is evaluated
+	// against. Supported values are `certificates`, `certificaterequests`,
+	// `issuers` and `clusterissuers`.
+	Resources []string
+
+	// namespaceSelector restricts the rule to resources in namespaces matching
+	// the selector. Cluster scoped resources are only evaluated by rules without
+	// a namespaceSelector.
+	// If unset, the rule is evaluated in all namespaces.
+	NamespaceSelector *metav1.LabelSelector
+
+	// expression is the CEL expression to evaluate, which must return a bool.
+	// The resource is available as `object`, and the resource before an update
+	// as `oldObject`, which is null when the resource is created.
+	Expression string
+
+	// message is returned to the client when the expression evaluates to
+	// false.
+	// Defaults to "failed rule: <expression>".
+	Message string
 }
 
 type PEMSizeLimitsConfig struct {
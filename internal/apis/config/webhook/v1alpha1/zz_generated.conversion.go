@@ -49,6 +49,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*webhookv1alpha1.ValidationRule)(nil), (*webhook.ValidationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValidationRule_To_webhook_ValidationRule(a.(*webhookv1alpha1.ValidationRule), b.(*webhook.ValidationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*webhook.ValidationRule)(nil), (*webhookv1alpha1.ValidationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_webhook_ValidationRule_To_v1alpha1_ValidationRule(a.(*webhook.ValidationRule), b.(*webhookv1alpha1.ValidationRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*webhookv1alpha1.WebhookConfiguration)(nil), (*webhook.WebhookConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WebhookConfiguration_To_webhook_WebhookConfiguration(a.(*webhookv1alpha1.WebhookConfiguration), b.(*webhook.WebhookConfiguration), scope)
 	}); err != nil {
@@ -104,6 +114,32 @@ func Convert_webhook_PEMSizeLimitsConfig_To_v1alpha1_PEMSizeLimitsConfig(in *web
 	return autoConvert_webhook_PEMSizeLimitsConfig_To_v1alpha1_PEMSizeLimitsConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ValidationRule_To_webhook_ValidationRule(in *webhookv1alpha1.ValidationRule, out *webhook.ValidationRule, s conversion.Scope) error {
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_ValidationRule_To_webhook_ValidationRule is an autogenerated conversion function.
+func Convert_v1alpha1_ValidationRule_To_webhook_ValidationRule(in *webhookv1alpha1.ValidationRule, out *webhook.ValidationRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValidationRule_To_webhook_ValidationRule(in, out, s)
+}
+
+func autoConvert_webhook_ValidationRule_To_v1alpha1_ValidationRule(in *webhook.ValidationRule, out *webhookv1alpha1.ValidationRule, s conversion.Scope) error {
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_webhook_ValidationRule_To_v1alpha1_ValidationRule is an autogenerated conversion function.
+func Convert_webhook_ValidationRule_To_v1alpha1_ValidationRule(in *webhook.ValidationRule, out *webhookv1alpha1.ValidationRule, s conversion.Scope) error {
+	return autoConvert_webhook_ValidationRule_To_v1alpha1_ValidationRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_WebhookConfiguration_To_webhook_WebhookConfiguration(in *webhookv1alpha1.WebhookConfiguration, out *webhook.WebhookConfiguration, s conversion.Scope) error {
 	if err := v1.Convert_Pointer_int32_To_int32(&in.SecurePort, &out.SecurePort, s); err != nil {
 		return err
@@ -130,6 +166,8 @@ func autoConvert_v1alpha1_WebhookConfiguration_To_webhook_WebhookConfiguration(i
 	if err := Convert_v1alpha1_PEMSizeLimitsConfig_To_webhook_PEMSizeLimitsConfig(&in.PEMSizeLimitsConfig, &out.PEMSizeLimitsConfig, s); err != nil {
 		return err
 	}
+	out.ValidationRules = *(*[]webhook.ValidationRule)(unsafe.Pointer(&in.ValidationRules))
+	out.AdmissionPlugins = *(*map[string]bool)(unsafe.Pointer(&in.AdmissionPlugins))
 	return nil
 }
 
@@ -164,6 +202,8 @@ func autoConvert_webhook_WebhookConfiguration_To_v1alpha1_WebhookConfiguration(i
 	if err := Convert_webhook_PEMSizeLimitsConfig_To_v1alpha1_PEMSizeLimitsConfig(&in.PEMSizeLimitsConfig, &out.PEMSizeLimitsConfig, s); err != nil {
 		return err
 	}
+	out.ValidationRules = *(*[]webhookv1alpha1.ValidationRule)(unsafe.Pointer(&in.ValidationRules))
+	out.AdmissionPlugins = *(*map[string]bool)(unsafe.Pointer(&in.AdmissionPlugins))
 	return nil
 }
 
@@ -17,6 +17,9 @@ limitations under the License.
 package validation
 
 import (
+	"slices"
+
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -29,6 +32,10 @@ func ValidateWebhookConfiguration(cfg *config.WebhookConfiguration, fldPath *fie
 
 	allErrors = append(allErrors, logsapi.Validate(&cfg.Logging, nil, fldPath.Child("logging"))...)
 	allErrors = append(allErrors, sharedvalidation.ValidateTLSConfig(&cfg.TLSConfig, fldPath.Child("tlsConfig"))...)
+	if cfg.TLSConfig.Dynamic.VerifyClientCertificates {
+		allErrors = append(allErrors, field.Forbidden(fldPath.Child("tlsConfig", "dynamic", "verifyClientCertificates"), "use enableClientVerification and clientCAPath to verify the client certificates of the Kubernetes API server"))
+	}
+	allErrors = append(allErrors, sharedvalidation.ValidateTLSClientVerification(&cfg.MetricsTLSConfig, fldPath.Child("metricsTLSConfig"))...)
 
 	if cfg.HealthzPort < 0 || cfg.HealthzPort > 65535 {
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("healthzPort"), cfg.HealthzPort, "must be a valid port number"))
@@ -39,6 +46,39 @@ func ValidateWebhookConfiguration(cfg *config.WebhookConfiguration, fldPath *fie
 
 	allErrors = append(allErrors, validatePEMSizeLimitsConfig(&cfg.PEMSizeLimitsConfig, fldPath.Child("pemSizeLimitsConfig"))...)
 
+	for i := range cfg.ValidationRules {
+		allErrors = append(allErrors, validateValidationRule(&cfg.ValidationRules[i], fldPath.Child("validationRules").Index(i))...)
+	}
+
+	return allErrors
+}
+
+// validationRuleResources are the resources validation rules can be evaluated
+// against.
+var validationRuleResources = []string{"certificates", "certificaterequests", "issuers", "clusterissuers"}
+
+func validateValidationRule(rule *config.ValidationRule, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	if len(rule.Resources) == 0 {
+		allErrors = append(allErrors, field.Required(fldPath.Child("resources"), "at least one resource must be specified"))
+	}
+	for i, resource := range rule.Resources {
+		if !slices.Contains(validationRuleResources, resource) {
+			allErrors = append(allErrors, field.NotSupported(fldPath.Child("resources").Index(i), resource, validationRuleResources))
+		}
+	}
+
+	if rule.NamespaceSelector != nil {
+		allErrors = append(allErrors, metavalidation.ValidateLabelSelector(rule.NamespaceSelector,
+			metavalidation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	// The expression itself is compiled when the webhook starts.
+	if rule.Expression == "" {
+		allErrors = append(allErrors, field.Required(fldPath.Child("expression"), "must be specified"))
+	}
+
 	return allErrors
 }
 
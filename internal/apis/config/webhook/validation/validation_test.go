@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
@@ -92,6 +93,46 @@ func TestValidateWebhookConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with client verification of the webhook server",
+			&config.WebhookConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				TLSConfig: shared.TLSConfig{
+					Dynamic: shared.DynamicServingConfig{
+						SecretNamespace:          "cert-manager",
+						SecretName:               "test",
+						DNSNames:                 []string{"example.com"},
+						VerifyClientCertificates: true,
+					},
+				},
+				PEMSizeLimitsConfig: validPEMSizeLimitsConfig(),
+			},
+			func(wc *config.WebhookConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Forbidden(field.NewPath("tlsConfig", "dynamic", "verifyClientCertificates"), "use enableClientVerification and clientCAPath to verify the client certificates of the Kubernetes API server"),
+				}
+			},
+		},
+		{
+			"with client verification of the metrics server",
+			&config.WebhookConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				MetricsTLSConfig: shared.TLSConfig{
+					Dynamic: shared.DynamicServingConfig{
+						SecretNamespace:          "cert-manager",
+						SecretName:               "test",
+						DNSNames:                 []string{"example.com"},
+						VerifyClientCertificates: true,
+					},
+				},
+				PEMSizeLimitsConfig: validPEMSizeLimitsConfig(),
+			},
+			nil,
+		},
 		{
 			"with valid healthz port",
 			&config.WebhookConfiguration{
@@ -163,6 +204,55 @@ func TestValidateWebhookConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with valid validation rules",
+			&config.WebhookConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				PEMSizeLimitsConfig: validPEMSizeLimitsConfig(),
+				ValidationRules: []config.ValidationRule{
+					{
+						Resources: []string{"certificates", "certificaterequests"},
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"tier": "internal"},
+						},
+						Expression: "object.spec.dnsNames.all(name, name.endsWith('.corp.example.com'))",
+						Message:    "dnsNames must end in .corp.example.com",
+					},
+				},
+			},
+			nil,
+		},
+		{
+			"with invalid validation rules",
+			&config.WebhookConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				PEMSizeLimitsConfig: validPEMSizeLimitsConfig(),
+				ValidationRules: []config.ValidationRule{
+					{
+						Resources:  []string{"certificates"},
+						Expression: "has(object.spec.commonName)",
+					},
+					{
+						Resources: []string{"secrets"},
+					},
+					{
+						Expression: "true",
+					},
+				},
+			},
+			func(wc *config.WebhookConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.NotSupported(field.NewPath("validationRules").Index(1).Child("resources").Index(0), "secrets",
+						[]string{"certificates", "certificaterequests", "issuers", "clusterissuers"}),
+					field.Required(field.NewPath("validationRules").Index(1).Child("expression"), "must be specified"),
+					field.Required(field.NewPath("validationRules").Index(2).Child("resources"), "at least one resource must be specified"),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
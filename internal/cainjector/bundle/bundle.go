@@ -18,64 +18,194 @@ package bundle
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
+	"slices"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"k8s.io/utils/set"
 
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
+// RetentionPolicy configures which certificates are retained in a merged
+// bundle after they have been removed from the CA data source.
+type RetentionPolicy struct {
+	// MaxCertificates is the maximum number of certificates in the merged
+	// bundle. Certificates from the CA data source are always included, so
+	// the bundle exceeds this limit if the CA data source alone does.
+	// If 0, the number of certificates is not limited.
+	MaxCertificates int
+
+	// GracePeriod is how long a certificate is retained after it has been
+	// removed from the CA data source. If 0, certificates are retained until
+	// they expire.
+	GracePeriod time.Duration
+
+	// Removed is a set of SHA-256 fingerprints (see Fingerprint) of
+	// certificates which are not retained once they have been removed from
+	// the CA data source.
+	Removed set.Set[string]
+}
+
+// CertificateStatus records a certificate which is present in a merged bundle.
+type CertificateStatus struct {
+	// Fingerprint is the SHA-256 fingerprint of the certificate.
+	Fingerprint string `json:"fingerprint"`
+
+	// Subject is the subject of the certificate.
+	Subject string `json:"subject,omitempty"`
+
+	// NotAfter is the time at which the certificate expires.
+	NotAfter metav1.Time `json:"notAfter"`
+
+	// RemovedFromSource is the time at which the certificate was first seen
+	// to be no longer present in the CA data source. It is not set for
+	// certificates which are present in the CA data source.
+	RemovedFromSource *metav1.Time `json:"removedFromSource,omitempty"`
+}
+
+// Fingerprint returns the hex encoded SHA-256 fingerprint of the DER encoded
+// certificate.
+func Fingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
 // AppendCertificatesToBundle will append the provided certificates to the
 // provided bundle, if the certificate already exists in the bundle then it is
 // not re-added.
 //
 // Additionally expired certificates are removed from the bundle.
 func AppendCertificatesToBundle(bundle []byte, additional []byte) ([]byte, error) {
+	merged, _, err := MergeCertificatesIntoBundle(bundle, additional, nil, RetentionPolicy{}, time.Now())
+	return merged, err
+}
+
+// MergeCertificatesIntoBundle merges the certificates from the CA data
+// source into the provided bundle. Certificates in the bundle which are no
+// longer present in the source are retained according to the given policy,
+// and expired certificates are removed from the bundle.
+//
+// status is the status returned by a previous merge into the same bundle and
+// is used to determine when retained certificates were removed from the
+// source. The returned status records every certificate in the merged bundle.
+func MergeCertificatesIntoBundle(bundle []byte, source []byte, status []CertificateStatus, policy RetentionPolicy, now time.Time) ([]byte, []CertificateStatus, error) {
 	certificatesFromBundle, err := pki.DecodeX509CertificateSetBytes(bundle)
 	if err != nil && len(bundle) != 0 {
-		return nil, fmt.Errorf("failed to parse bundle: %w", err)
+		return nil, nil, fmt.Errorf("failed to parse bundle: %w", err)
 	}
 
-	certificatesToMerge, err := pki.DecodeX509CertificateSetBytes(additional)
-	if err != nil && len(additional) != 0 {
-		return nil, fmt.Errorf("failed to parse additional certificates: %w", err)
+	certificatesFromSource, err := pki.DecodeX509CertificateSetBytes(source)
+	if err != nil && len(source) != 0 {
+		return nil, nil, fmt.Errorf("failed to parse additional certificates: %w", err)
 	}
 
-	certificatesSeen := set.New[string]()
-	certificatesMerged := make([]*x509.Certificate, 0, len(certificatesFromBundle)+len(certificatesToMerge))
+	removedFromSource := make(map[string]time.Time, len(status))
+	for _, s := range status {
+		if s.RemovedFromSource != nil {
+			removedFromSource[s.Fingerprint] = s.RemovedFromSource.Time
+		}
+	}
 
-	// We delete expired certificates from the bundle, for this we will
-	// repeatedly need the current time
-	now := time.Now()
+	inSource := set.New[string]()
+	for _, certificate := range certificatesFromSource {
+		if !now.After(certificate.NotAfter) {
+			inSource.Insert(string(certificate.Raw))
+		}
+	}
 
-	// Merge in all certificates that already exist in the bundle
+	// Work out which of the certificates that are no longer in the source
+	// should be retained
+	type retainedCertificate struct {
+		raw       string
+		removedAt time.Time
+	}
+	var retained []retainedCertificate
+	retainedSeen := set.New[string]()
 	for _, certificate := range certificatesFromBundle {
 		raw := string(certificate.Raw)
-		if !certificatesSeen.Has(raw) && !now.After(certificate.NotAfter) {
-			certificatesMerged = append(certificatesMerged, certificate)
-			certificatesSeen.Insert(raw)
+		if inSource.Has(raw) || retainedSeen.Has(raw) || now.After(certificate.NotAfter) {
+			continue
 		}
+		retainedSeen.Insert(raw)
+
+		fingerprint := Fingerprint(certificate)
+		if policy.Removed.Has(fingerprint) {
+			continue
+		}
+
+		removedAt, ok := removedFromSource[fingerprint]
+		if !ok {
+			removedAt = now
+		}
+		if policy.GracePeriod > 0 && now.Sub(removedAt) >= policy.GracePeriod {
+			continue
+		}
+
+		retained = append(retained, retainedCertificate{raw: raw, removedAt: removedAt})
+	}
+
+	// If there are too many certificates, drop the certificates which were
+	// removed from the source the longest time ago first
+	if excess := inSource.Len() + len(retained) - policy.MaxCertificates; policy.MaxCertificates > 0 && excess > 0 {
+		slices.SortStableFunc(retained, func(a, b retainedCertificate) int {
+			return a.removedAt.Compare(b.removedAt)
+		})
+		retained = retained[min(excess, len(retained)):]
+	}
+
+	retainedRemovedAt := make(map[string]time.Time, len(retained))
+	for _, r := range retained {
+		retainedRemovedAt[r.raw] = r.removedAt
 	}
 
-	// Merge in all additional certificates
-	for _, certificate := range certificatesToMerge {
+	certificatesSeen := set.New[string]()
+	certificatesMerged := make([]*x509.Certificate, 0, inSource.Len()+len(retained))
+	mergedStatus := make([]CertificateStatus, 0, inSource.Len()+len(retained))
+	merge := func(certificate *x509.Certificate) {
 		raw := string(certificate.Raw)
-		if !certificatesSeen.Has(raw) && !now.After(certificate.NotAfter) {
-			certificatesMerged = append(certificatesMerged, certificate)
-			certificatesSeen.Insert(raw)
+		if certificatesSeen.Has(raw) {
+			return
+		}
+
+		certificateStatus := CertificateStatus{
+			Fingerprint: Fingerprint(certificate),
+			Subject:     certificate.Subject.String(),
+			NotAfter:    metav1.NewTime(certificate.NotAfter),
 		}
+		if removedAt, ok := retainedRemovedAt[raw]; ok {
+			certificateStatus.RemovedFromSource = ptr.To(metav1.NewTime(removedAt))
+		} else if !inSource.Has(raw) {
+			return
+		}
+
+		certificatesMerged = append(certificatesMerged, certificate)
+		mergedStatus = append(mergedStatus, certificateStatus)
+		certificatesSeen.Insert(raw)
+	}
+
+	// Merge in all certificates that already exist in the bundle, followed by
+	// all additional certificates from the source
+	for _, certificate := range certificatesFromBundle {
+		merge(certificate)
+	}
+	for _, certificate := range certificatesFromSource {
+		merge(certificate)
 	}
 
 	// Build the chain
 	buff := bytes.NewBuffer([]byte{})
 	for _, certificate := range certificatesMerged {
 		if err := pem.Encode(buff, &pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}); err != nil {
-			return nil, fmt.Errorf("failed encode certificate in PEM format: %w", err)
+			return nil, nil, fmt.Errorf("failed encode certificate in PEM format: %w", err)
 		}
 	}
 
-	return buff.Bytes(), nil
+	return buff.Bytes(), mergedStatus, nil
 }
@@ -21,9 +21,15 @@ import (
 	"crypto"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"k8s.io/utils/set"
+
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -93,6 +99,126 @@ func TestAppendCertificatesToBundle(t *testing.T) {
 	}
 }
 
+func TestMergeCertificatesIntoBundle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hourAgo := metav1.NewTime(now.Add(-time.Hour))
+	dayAgo := metav1.NewTime(now.Add(-24 * time.Hour))
+
+	// Create certificates for use in tests
+	source := mustCreateCertificate(t, "source", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+	old1 := mustCreateCertificate(t, "old-1", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+	old2 := mustCreateCertificate(t, "old-2", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		Name     string
+		Bundle   []byte
+		Source   []byte
+		Status   []CertificateStatus
+		Policy   RetentionPolicy
+		Expected []byte
+		// ExpectedRemovedFromSource maps the fingerprints of the certificates
+		// in the expected bundle to the time they were removed from the source
+		ExpectedRemovedFromSource map[string]*metav1.Time
+	}{
+		{
+			Name:     "records_when_a_certificate_is_removed_from_the_source",
+			Bundle:   joinPEM(old1, source),
+			Source:   source,
+			Expected: joinPEM(old1, source),
+			ExpectedRemovedFromSource: map[string]*metav1.Time{
+				mustFingerprint(t, old1):   ptr.To(metav1.NewTime(now)),
+				mustFingerprint(t, source): nil,
+			},
+		},
+		{
+			Name:   "keeps_the_time_a_certificate_was_removed_from_the_source",
+			Bundle: joinPEM(old1, source),
+			Source: source,
+			Status: []CertificateStatus{
+				{Fingerprint: mustFingerprint(t, old1), RemovedFromSource: &hourAgo},
+			},
+			Expected: joinPEM(old1, source),
+			ExpectedRemovedFromSource: map[string]*metav1.Time{
+				mustFingerprint(t, old1):   &hourAgo,
+				mustFingerprint(t, source): nil,
+			},
+		},
+		{
+			Name:   "removes_certificates_after_the_grace_period",
+			Bundle: joinPEM(old1, old2, source),
+			Source: source,
+			Status: []CertificateStatus{
+				{Fingerprint: mustFingerprint(t, old1), RemovedFromSource: &dayAgo},
+				{Fingerprint: mustFingerprint(t, old2), RemovedFromSource: &hourAgo},
+			},
+			Policy:   RetentionPolicy{GracePeriod: 12 * time.Hour},
+			Expected: joinPEM(old2, source),
+			ExpectedRemovedFromSource: map[string]*metav1.Time{
+				mustFingerprint(t, old2):   &hourAgo,
+				mustFingerprint(t, source): nil,
+			},
+		},
+		{
+			Name:   "removes_the_oldest_certificates_over_the_maximum",
+			Bundle: joinPEM(old1, old2, source),
+			Source: source,
+			Status: []CertificateStatus{
+				{Fingerprint: mustFingerprint(t, old1), RemovedFromSource: &dayAgo},
+				{Fingerprint: mustFingerprint(t, old2), RemovedFromSource: &hourAgo},
+			},
+			Policy:   RetentionPolicy{MaxCertificates: 2},
+			Expected: joinPEM(old2, source),
+			ExpectedRemovedFromSource: map[string]*metav1.Time{
+				mustFingerprint(t, old2):   &hourAgo,
+				mustFingerprint(t, source): nil,
+			},
+		},
+		{
+			Name:     "always_keeps_certificates_in_the_source",
+			Bundle:   joinPEM(old1, source),
+			Source:   joinPEM(old2, source),
+			Policy:   RetentionPolicy{MaxCertificates: 1},
+			Expected: joinPEM(source, old2),
+			ExpectedRemovedFromSource: map[string]*metav1.Time{
+				mustFingerprint(t, source): nil,
+				mustFingerprint(t, old2):   nil,
+			},
+		},
+		{
+			Name:     "removes_explicitly_removed_certificates",
+			Bundle:   joinPEM(old1, old2, source),
+			Source:   source,
+			Policy:   RetentionPolicy{Removed: set.New(mustFingerprint(t, old1), mustFingerprint(t, source))},
+			Expected: joinPEM(old2, source),
+			ExpectedRemovedFromSource: map[string]*metav1.Time{
+				mustFingerprint(t, old2):   ptr.To(metav1.NewTime(now)),
+				mustFingerprint(t, source): nil,
+			},
+		},
+	}
+
+	for _, test := range cases {
+		t.Run(test.Name, func(t *testing.T) {
+			result, status, err := MergeCertificatesIntoBundle(test.Bundle, test.Source, test.Status, test.Policy, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !bytes.Equal(result, test.Expected) {
+				t.Fatalf("unexpected result, expected %q, got %q", test.Expected, result)
+			}
+
+			removedFromSource := make(map[string]*metav1.Time, len(status))
+			for _, s := range status {
+				removedFromSource[s.Fingerprint] = s.RemovedFromSource
+			}
+			if !reflect.DeepEqual(removedFromSource, test.ExpectedRemovedFromSource) {
+				t.Fatalf("unexpected status, expected %v, got %v", test.ExpectedRemovedFromSource, removedFromSource)
+			}
+		})
+	}
+}
+
 func mustCreateCertificate(t *testing.T, name string, notBefore, notAfter time.Time) []byte {
 	pk, err := pki.GenerateECPrivateKey(256)
 	if err != nil {
@@ -128,7 +254,18 @@ func mustCreateCertificate(t *testing.T, name string, notBefore, notAfter time.T
 	return certPEM
 }
 
+func mustFingerprint(t *testing.T, certPEM []byte) string {
+	certificate, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return Fingerprint(certificate)
+}
+
 func joinPEM(first []byte, rest ...[]byte) []byte {
+	// Copy first so that the certificates being joined are never modified
+	first = slices.Clone(first)
 	for _, b := range rest {
 		first = append(first, b...)
 	}
@@ -17,9 +17,15 @@ limitations under the License.
 package collectors
 
 import (
+	"crypto"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
@@ -32,25 +38,59 @@ var (
 	certNotBeforeTimeSecondMetric  = prometheus.NewDesc("certmanager_certificate_not_before_timestamp_seconds", "The timestamp before which the certificate is invalid, expressed as a Unix Epoch Time.", []string{"name", "namespace", "issuer_name", "issuer_kind", "issuer_group"}, nil)
 	certExpirationTimestampSeconds = prometheus.NewDesc("certmanager_certificate_expiration_timestamp_seconds", "The timestamp after which the certificate expires, expressed in Unix Epoch Time.", []string{"name", "namespace", "issuer_name", "issuer_kind", "issuer_group"}, nil)
 	certRenewalTimestampSeconds    = prometheus.NewDesc("certmanager_certificate_renewal_timestamp_seconds", "The timestamp after which the certificate should be renewed, expressed in Unix Epoch Time.", []string{"name", "namespace", "issuer_name", "issuer_kind", "issuer_group"}, nil)
+	tlsSecretExpirationTimestamp   = prometheus.NewDesc("certmanager_tls_secret_expiration_timestamp_seconds", "The timestamp after which the certificate in a kubernetes.io/tls Secret which is not managed by a Certificate expires, expressed in Unix Epoch Time.", []string{"name", "namespace"}, nil)
+	certInfoMetric                 = prometheus.NewDesc("certmanager_certificate_info", "Information about the certificate stored in the Secret of the certificate. The value is always 1. The serial number is exposed by certmanager_certificate_serial_info, as it changes on every renewal.", []string{"name", "namespace", "issuer_name", "issuer_kind", "issuer_group", "key_algorithm", "key_size", "signature_algorithm", "chain_signature_algorithms", "issuer_common_name", "san_count", "chain_length", "key_rotated"}, nil)
+	certSerialInfoMetric           = prometheus.NewDesc("certmanager_certificate_serial_info", "The hex encoded serial number of the certificate stored in the Secret of the certificate. The value is always 1. Only exposed if enabled, as every renewal creates a new time series.", []string{"name", "namespace", "issuer_name", "issuer_kind", "issuer_group", "serial_number"}, nil)
 )
 
 type CertificateCollector struct {
 	certificatesLister                    cmlisters.CertificateLister
+	secretsLister                         internalinformers.SecretLister
+	certificateRequestsIndexer            cache.Indexer
+	certificateInfoCache                  *certificateInfoCache
+	publicKeyCache                        *publicKeyCache
+	tlsSecretExpiries                     *TLSSecretExpiries
 	certificateReadyStatusMetric          *prometheus.Desc
 	certificateNotAfterTimeSecondMetric   *prometheus.Desc
 	certificateNotBeforeTimeSecondMetric  *prometheus.Desc
 	certificateExpirationTimestampSeconds *prometheus.Desc
 	certificateRenewalTimestampSeconds    *prometheus.Desc
+	certificateInfoMetric                 *prometheus.Desc
+	certificateSerialInfoMetric           *prometheus.Desc
+	tlsSecretExpirationTimestampSeconds   *prometheus.Desc
 }
 
-func NewCertificateCollector(certificatesLister cmlisters.CertificateLister) prometheus.Collector {
+// NewCertificateCollector returns a collector for Certificate metrics. If
+// secretsLister is nil, the certificate info metric, which is derived from the
+// certificate stored in each Certificate's Secret, is not collected.
+// The certificateRequestsIndexer is optional and must have the
+// CertificateRequestOwnerRevisionIndex, it is used to detect whether the
+// private key was rotated on the last renewal.
+// The tlsSecretExpiries are optional and are exposed as the expiry of the
+// certificates in TLS Secrets which are not managed by a Certificate.
+// The certificate serial info metric is only collected if
+// enableSerialInfo is true, as it creates a new time series on every renewal.
+func NewCertificateCollector(certificatesLister cmlisters.CertificateLister, secretsLister internalinformers.SecretLister, certificateRequestsIndexer cache.Indexer, tlsSecretExpiries *TLSSecretExpiries, enableSerialInfo bool) prometheus.Collector {
+	var serialInfoMetric *prometheus.Desc
+	if enableSerialInfo {
+		serialInfoMetric = certSerialInfoMetric
+	}
+
 	return &CertificateCollector{
 		certificatesLister:                    certificatesLister,
+		secretsLister:                         secretsLister,
+		certificateRequestsIndexer:            certificateRequestsIndexer,
+		certificateInfoCache:                  &certificateInfoCache{entries: map[types.UID]certificateInfoCacheEntry{}},
+		publicKeyCache:                        &publicKeyCache{entries: map[types.UID]crypto.PublicKey{}},
+		tlsSecretExpiries:                     tlsSecretExpiries,
 		certificateReadyStatusMetric:          certReadyStatusMetric,
 		certificateNotAfterTimeSecondMetric:   certNotAfterTimeSecondMetric,
 		certificateNotBeforeTimeSecondMetric:  certNotBeforeTimeSecondMetric,
 		certificateExpirationTimestampSeconds: certExpirationTimestampSeconds,
 		certificateRenewalTimestampSeconds:    certRenewalTimestampSeconds,
+		certificateInfoMetric:                 certInfoMetric,
+		certificateSerialInfoMetric:           serialInfoMetric,
+		tlsSecretExpirationTimestampSeconds:   tlsSecretExpirationTimestamp,
 	}
 }
 
@@ -60,6 +100,11 @@ func (cc *CertificateCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- cc.certificateNotBeforeTimeSecondMetric
 	ch <- cc.certificateExpirationTimestampSeconds
 	ch <- cc.certificateRenewalTimestampSeconds
+	ch <- cc.certificateInfoMetric
+	if cc.certificateSerialInfoMetric != nil {
+		ch <- cc.certificateSerialInfoMetric
+	}
+	ch <- cc.tlsSecretExpirationTimestampSeconds
 }
 
 func (cc *CertificateCollector) Collect(ch chan<- prometheus.Metric) {
@@ -68,13 +113,20 @@ func (cc *CertificateCollector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
+	seenSecrets := make(map[types.UID]struct{})
+	seenRequests := make(map[types.UID]struct{})
 	for _, cert := range certsList {
 		cc.updateCertificateReadyStatus(cert, ch)
 		cc.updateCertificateNotAfter(cert, ch)
 		cc.updateCertificateNotBefore(cert, ch)
 		cc.updateCertificateExpiry(cert, ch)
 		cc.updateCertificateRenewalTime(cert, ch)
+		cc.updateCertificateInfo(cert, ch, seenSecrets, seenRequests)
 	}
+	cc.certificateInfoCache.prune(seenSecrets)
+	cc.publicKeyCache.prune(seenRequests)
+
+	cc.updateTLSSecretExpiry(ch)
 }
 
 func (cc *CertificateCollector) updateCertificateReadyStatus(cert *cmapi.Certificate, ch chan<- prometheus.Metric) {
@@ -194,3 +246,71 @@ func (cc *CertificateCollector) updateCertificateRenewalTime(cert *cmapi.Certifi
 
 	ch <- metric
 }
+
+func (cc *CertificateCollector) updateCertificateInfo(cert *cmapi.Certificate, ch chan<- prometheus.Metric, seenSecrets, seenRequests map[types.UID]struct{}) {
+	if cc.secretsLister == nil {
+		return
+	}
+
+	secret, err := cc.secretsLister.Secrets(cert.Namespace).Get(cert.Spec.SecretName)
+	if err != nil {
+		return
+	}
+	seenSecrets[secret.UID] = struct{}{}
+
+	info := cc.certificateInfoCache.get(secret)
+	if info == nil {
+		return
+	}
+
+	metric := prometheus.MustNewConstMetric(
+		cc.certificateInfoMetric,
+		prometheus.GaugeValue,
+		1,
+		cert.Name,
+		cert.Namespace,
+		cert.Spec.IssuerRef.Name,
+		cert.Spec.IssuerRef.Kind,
+		cert.Spec.IssuerRef.Group,
+		info.keyAlgorithm,
+		info.keySize,
+		info.signatureAlgorithm,
+		info.chainSignatureAlgorithms,
+		info.issuerCommonName,
+		info.sanCount,
+		info.chainLength,
+		keyRotatedOnLastRenewal(cc.certificateRequestsIndexer, cc.publicKeyCache, cert, seenRequests),
+	)
+
+	ch <- metric
+
+	if cc.certificateSerialInfoMetric != nil {
+		ch <- prometheus.MustNewConstMetric(
+			cc.certificateSerialInfoMetric,
+			prometheus.GaugeValue,
+			1,
+			cert.Name,
+			cert.Namespace,
+			cert.Spec.IssuerRef.Name,
+			cert.Spec.IssuerRef.Kind,
+			cert.Spec.IssuerRef.Group,
+			info.serialNumber,
+		)
+	}
+}
+
+func (cc *CertificateCollector) updateTLSSecretExpiry(ch chan<- prometheus.Metric) {
+	if cc.tlsSecretExpiries == nil {
+		return
+	}
+
+	cc.tlsSecretExpiries.forEach(func(key types.NamespacedName, notAfter time.Time) {
+		ch <- prometheus.MustNewConstMetric(
+			cc.tlsSecretExpirationTimestampSeconds,
+			prometheus.GaugeValue,
+			float64(notAfter.Unix()),
+			key.Name,
+			key.Namespace,
+		)
+	})
+}
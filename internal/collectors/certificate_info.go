@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	keyRotatedTrue    = "true"
+	keyRotatedFalse   = "false"
+	keyRotatedUnknown = "unknown"
+)
+
+// certificateInfo holds the details of the certificate stored in a
+// Certificate's Secret which are exposed by the certificate info and serial
+// info metrics.
+type certificateInfo struct {
+	keyAlgorithm             string
+	keySize                  string
+	signatureAlgorithm       string
+	chainSignatureAlgorithms string
+	issuerCommonName         string
+	serialNumber             string
+	sanCount                 string
+	chainLength              string
+}
+
+// certificateInfoCache caches the parsed contents of Secrets by UID and
+// resourceVersion so that the stored certificates are only decoded again once
+// the Secret has changed.
+type certificateInfoCache struct {
+	lock    sync.Mutex
+	entries map[types.UID]certificateInfoCacheEntry
+}
+
+type certificateInfoCacheEntry struct {
+	resourceVersion string
+	info            *certificateInfo
+}
+
+// get returns the certificateInfo for the given Secret, parsing it if the
+// Secret has not been seen at its current resourceVersion. A nil
+// certificateInfo is returned if the Secret does not contain a valid
+// certificate.
+func (c *certificateInfoCache) get(secret *corev1.Secret) *certificateInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if entry, ok := c.entries[secret.UID]; ok && entry.resourceVersion == secret.ResourceVersion {
+		return entry.info
+	}
+
+	info := certificateInfoForSecret(secret)
+	c.entries[secret.UID] = certificateInfoCacheEntry{
+		resourceVersion: secret.ResourceVersion,
+		info:            info,
+	}
+	return info
+}
+
+// prune removes all cache entries for Secrets which are not in seen.
+func (c *certificateInfoCache) prune(seen map[types.UID]struct{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for uid := range c.entries {
+		if _, ok := seen[uid]; !ok {
+			delete(c.entries, uid)
+		}
+	}
+}
+
+// certificateInfoForSecret decodes the certificate chain stored in the
+// Secret's tls.crt. A nil certificateInfo is returned if the chain cannot be
+// decoded.
+func certificateInfoForSecret(secret *corev1.Secret) *certificateInfo {
+	chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil || len(chain) == 0 {
+		return nil
+	}
+	leaf := chain[0]
+
+	chainSignatureAlgorithms := make([]string, 0, len(chain))
+	for _, cert := range chain {
+		chainSignatureAlgorithms = append(chainSignatureAlgorithms, cert.SignatureAlgorithm.String())
+	}
+	slices.Sort(chainSignatureAlgorithms)
+	chainSignatureAlgorithms = slices.Compact(chainSignatureAlgorithms)
+
+	sanCount := len(leaf.DNSNames) + len(leaf.IPAddresses) + len(leaf.URIs) + len(leaf.EmailAddresses)
+
+	return &certificateInfo{
+		keyAlgorithm:             leaf.PublicKeyAlgorithm.String(),
+		keySize:                  strconv.Itoa(publicKeySize(leaf.PublicKey)),
+		signatureAlgorithm:       leaf.SignatureAlgorithm.String(),
+		chainSignatureAlgorithms: strings.Join(chainSignatureAlgorithms, ","),
+		issuerCommonName:         leaf.Issuer.CommonName,
+		serialNumber:             leaf.SerialNumber.Text(16),
+		sanCount:                 strconv.Itoa(sanCount),
+		chainLength:              strconv.Itoa(len(chain)),
+	}
+}
+
+// publicKeySize returns the size in bits of the given public key, or 0 if the
+// key type is not known.
+func publicKeySize(pub crypto.PublicKey) int {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return pub.N.BitLen()
+	case *ecdsa.PublicKey:
+		return pub.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return ed25519.PublicKeySize * 8
+	default:
+		return 0
+	}
+}
+
+// CertificateRequestOwnerRevisionIndex is the name of the CertificateRequest
+// informer index used to look up the CertificateRequests of a Certificate's
+// revision.
+const CertificateRequestOwnerRevisionIndex = "cert-manager.io/owner-revision"
+
+// CertificateRequestOwnerRevisionIndexFunc indexes CertificateRequests by the
+// UID of their controlling owner and their revision.
+func CertificateRequestOwnerRevisionIndexFunc(obj any) ([]string, error) {
+	req, ok := obj.(*cmapi.CertificateRequest)
+	if !ok {
+		return nil, nil
+	}
+	owner := metav1.GetControllerOf(req)
+	if owner == nil {
+		return nil, nil
+	}
+	revision, ok := req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	return []string{ownerRevisionKey(owner.UID, revision)}, nil
+}
+
+func ownerRevisionKey(uid types.UID, revision string) string {
+	return string(uid) + "/" + revision
+}
+
+// publicKeyCache caches the public keys of the CSRs of CertificateRequests
+// by UID. The request of a CertificateRequest is immutable, so the entries
+// never need to be invalidated, only pruned once the CertificateRequest no
+// longer exists.
+type publicKeyCache struct {
+	lock    sync.Mutex
+	entries map[types.UID]crypto.PublicKey
+}
+
+// get returns the public key of the CertificateRequest's CSR, or nil if the
+// CSR cannot be decoded.
+func (c *publicKeyCache) get(req *cmapi.CertificateRequest) crypto.PublicKey {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if pub, ok := c.entries[req.UID]; ok {
+		return pub
+	}
+
+	var pub crypto.PublicKey
+	if csr, err := pki.DecodeX509CertificateRequestBytes(req.Spec.Request); err == nil {
+		pub = csr.PublicKey
+	}
+	c.entries[req.UID] = pub
+	return pub
+}
+
+// prune removes all cache entries for CertificateRequests which are not in seen.
+func (c *publicKeyCache) prune(seen map[types.UID]struct{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for uid := range c.entries {
+		if _, ok := seen[uid]; !ok {
+			delete(c.entries, uid)
+		}
+	}
+}
+
+// keyRotatedOnLastRenewal compares the public key of the CertificateRequest
+// for the Certificate's current revision with the one for the previous
+// revision. If either CertificateRequest no longer exists, for example because
+// it was removed due to the Certificate's revisionHistoryLimit, "unknown" is
+// returned.
+// The UIDs of the compared CertificateRequests are added to seen.
+func keyRotatedOnLastRenewal(indexer cache.Indexer, keys *publicKeyCache, crt *cmapi.Certificate, seen map[types.UID]struct{}) string {
+	if indexer == nil || crt.Status.Revision == nil || *crt.Status.Revision < 2 {
+		return keyRotatedUnknown
+	}
+
+	current := certificateRequestPublicKey(indexer, keys, crt, *crt.Status.Revision, seen)
+	previous := certificateRequestPublicKey(indexer, keys, crt, *crt.Status.Revision-1, seen)
+	if current == nil || previous == nil {
+		return keyRotatedUnknown
+	}
+
+	equal, err := pki.PublicKeysEqual(current, previous)
+	if err != nil {
+		return keyRotatedUnknown
+	}
+	if equal {
+		return keyRotatedFalse
+	}
+	return keyRotatedTrue
+}
+
+// certificateRequestPublicKey returns the public key of the CSR in the
+// CertificateRequest owned by the Certificate for the given revision, or nil
+// if there is not exactly one such CertificateRequest.
+func certificateRequestPublicKey(indexer cache.Indexer, keys *publicKeyCache, crt *cmapi.Certificate, revision int, seen map[types.UID]struct{}) crypto.PublicKey {
+	objs, err := indexer.ByIndex(CertificateRequestOwnerRevisionIndex, ownerRevisionKey(crt.UID, strconv.Itoa(revision)))
+	if err != nil || len(objs) != 1 {
+		return nil
+	}
+
+	req, ok := objs[0].(*cmapi.CertificateRequest)
+	if !ok || req.Namespace != crt.Namespace {
+		return nil
+	}
+
+	seen[req.UID] = struct{}{}
+	return keys.get(req)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TLSSecretExpiries holds the expiry of the certificates stored in
+// kubernetes.io/tls Secrets which are not managed by a Certificate. It is
+// populated by the tls-secret-expiry controller and exposed by the
+// CertificateCollector.
+type TLSSecretExpiries struct {
+	lock    sync.RWMutex
+	entries map[types.NamespacedName]time.Time
+}
+
+func NewTLSSecretExpiries() *TLSSecretExpiries {
+	return &TLSSecretExpiries{entries: map[types.NamespacedName]time.Time{}}
+}
+
+// Set records the expiry of the certificate stored in the given Secret.
+func (e *TLSSecretExpiries) Set(key types.NamespacedName, notAfter time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.entries[key] = notAfter
+}
+
+// Delete removes the expiry recorded for the given Secret.
+func (e *TLSSecretExpiries) Delete(key types.NamespacedName) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.entries, key)
+}
+
+func (e *TLSSecretExpiries) forEach(fn func(key types.NamespacedName, notAfter time.Time)) {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	for key, notAfter := range e.entries {
+		fn(key, notAfter)
+	}
+}
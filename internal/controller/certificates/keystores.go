@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// KeystorePassword returns the password of a keystore of the given type
+// (e.g. "JKS"), read from the referenced Secret in the given namespace if the
+// reference has a name, or the literal password otherwise. Errors returned by
+// the lister are wrapped, so that NotFound errors can be detected by callers.
+func KeystorePassword(secretLister internalinformers.SecretLister, namespace, keystoreType string, ref cmmeta.SecretKeySelector, password *string) ([]byte, error) {
+	switch {
+	case ref.Name != "":
+		pwSecret, err := secretLister.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("fetching %s keystore password from Secret: %w", keystoreType, err)
+		}
+
+		if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
+			return nil, fmt.Errorf("%s keystore password Secret contains no data for key %q", keystoreType, ref.Key)
+		}
+
+		return pwSecret.Data[ref.Key], nil
+
+	case password != nil:
+		if len(*password) == 0 {
+			return nil, fmt.Errorf("%s literal password cannot be empty", keystoreType)
+		}
+
+		return []byte(*password), nil
+
+	default:
+		return nil, fmt.Errorf("either passwordSecretRef or password must be set for %s keystore", keystoreType)
+	}
+}
+
+// JKSKeystoreAlias returns the alias of the key in the Certificate's JKS
+// keystore, defaulting to `certificate`.
+func JKSKeystoreAlias(crt *cmapi.Certificate) string {
+	if crt.Spec.Keystores != nil && crt.Spec.Keystores.JKS != nil && crt.Spec.Keystores.JKS.Alias != nil {
+		return *crt.Spec.Keystores.JKS.Alias
+	}
+	return "certificate"
+}
+
+// KeystorePasswords are the passwords of a Certificate's keystores. A
+// password is nil if the Certificate has no such keystore, or the password
+// could not be resolved. PKCS12Key is only set if the PKCS12 keystore has a
+// separate private key entry password.
+type KeystorePasswords struct {
+	JKS       []byte
+	PKCS12    []byte
+	PKCS12Key []byte
+}
+
+// JKSKeystoreFingerprint returns the fingerprint of the Certificate's JKS
+// keystore, encoded using the given password.
+func JKSKeystoreFingerprint(crt *cmapi.Certificate, password, keystore []byte) string {
+	return KeystoreFingerprint(password, keystore, JKSKeystoreAlias(crt))
+}
+
+// PKCS12KeystoreFingerprint returns the fingerprint of the Certificate's
+// PKCS12 keystore, encoded using the given password and private key entry
+// password. The alias and key password are only included if either is
+// configured, so that the fingerprints of existing keystores are unchanged.
+func PKCS12KeystoreFingerprint(crt *cmapi.Certificate, password, keyPassword, keystore []byte) string {
+	pkcs12 := crt.Spec.Keystores.PKCS12
+	options := []string{string(pkcs12.Profile)}
+	if pkcs12.Alias != nil || pkcs12.KeyPasswordSecretRef != nil {
+		options = append(options, ptr.Deref(pkcs12.Alias, ""), string(keyPassword))
+	}
+	return KeystoreFingerprint(password, keystore, options...)
+}
+
+// KeystoreFingerprint returns a hex encoded HMAC-SHA256 of the given keystore
+// and the options it was encoded with (e.g. the JKS alias), keyed by the
+// keystore password. Storing the fingerprint alongside the keystore allows a
+// change of password or options to be detected without storing the password
+// itself.
+func KeystoreFingerprint(password, keystore []byte, options ...string) string {
+	mac := hmac.New(sha256.New, password)
+	for _, option := range options {
+		mac.Write([]byte(option))
+		mac.Write([]byte{0})
+	}
+	mac.Write(keystore)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PEMTruststore returns the PEM encoded CA chain of the given certificate
+// chain: the intermediate certificates following the leaf certificate,
+// followed by the certificates in the given CA. Duplicate certificates are
+// only included once. The leaf certificate is never included.
+func PEMTruststore(certificate, ca []byte) ([]byte, error) {
+	var chain []byte
+	if len(certificate) > 0 {
+		certs, err := utilpki.DecodeX509CertificateChainBytes(certificate)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs[1:] {
+			certPEM, err := utilpki.EncodeX509(cert)
+			if err != nil {
+				return nil, err
+			}
+			chain = append(chain, certPEM...)
+		}
+	}
+
+	if len(ca) > 0 {
+		certs, err := utilpki.DecodeX509CertificateSetBytes(ca)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			certPEM, err := utilpki.EncodeX509(cert)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Contains(chain, certPEM) {
+				continue
+			}
+			chain = append(chain, certPEM...)
+		}
+	}
+
+	return chain, nil
+}
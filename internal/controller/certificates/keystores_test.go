@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_PEMTruststore(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	leaf := testcrypto.MustCreateCert(t, pk, gen.Certificate("leaf", gen.SetCertificateCommonName("leaf")))
+	intermediate := testcrypto.MustCreateCert(t, pk, gen.Certificate("intermediate", gen.SetCertificateCommonName("intermediate")))
+	root := testcrypto.MustCreateCert(t, pk, gen.Certificate("root", gen.SetCertificateCommonName("root")))
+
+	tests := map[string]struct {
+		certificate []byte
+		ca          []byte
+		expected    []byte
+	}{
+		"with only a leaf certificate, should return an empty truststore": {
+			certificate: leaf,
+			expected:    nil,
+		},
+		"with a leaf certificate and a CA, should return only the CA": {
+			certificate: leaf,
+			ca:          root,
+			expected:    root,
+		},
+		"with a chain and a CA, should return the intermediates followed by the CA": {
+			certificate: bytes.Join([][]byte{leaf, intermediate}, nil),
+			ca:          root,
+			expected:    bytes.Join([][]byte{intermediate, root}, nil),
+		},
+		"with a chain including the CA, should not duplicate the CA": {
+			certificate: bytes.Join([][]byte{leaf, intermediate, root}, nil),
+			ca:          root,
+			expected:    bytes.Join([][]byte{intermediate, root}, nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := PEMTruststore(test.certificate, test.ca)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_KeystoreFingerprint(t *testing.T) {
+	keystore := []byte("keystore")
+	fingerprint := KeystoreFingerprint([]byte("password"), keystore, "alias")
+
+	assert.Equal(t, fingerprint, KeystoreFingerprint([]byte("password"), keystore, "alias"))
+	assert.NotEqual(t, fingerprint, KeystoreFingerprint([]byte("new-password"), keystore, "alias"))
+	assert.NotEqual(t, fingerprint, KeystoreFingerprint([]byte("password"), keystore, "new-alias"))
+	assert.NotEqual(t, fingerprint, KeystoreFingerprint([]byte("password"), []byte("new-keystore"), "alias"))
+}
@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// SecretAdoptionRequested returns true if the Certificate has requested that
+// its existing Secret is adopted, and has not yet been issued.
+// Adoption is only ever attempted before the first revision so that an
+// adopted Secret is never confused with one issued by cert-manager.
+func SecretAdoptionRequested(crt *cmapi.Certificate) bool {
+	return crt.Status.Revision == nil && crt.Annotations[cmapi.AdoptSecretAnnotationKey] == "true"
+}
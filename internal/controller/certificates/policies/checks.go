@@ -19,6 +19,7 @@ package policies
 import (
 	"bytes"
 	"cmp"
+	"crypto"
 	"crypto/x509"
 	"fmt"
 	"slices"
@@ -54,7 +55,7 @@ func SecretIsMissingData(input Input) (string, string, bool) {
 	}
 	pkData := input.Secret.Data[corev1.TLSPrivateKeyKey]
 	certData := input.Secret.Data[corev1.TLSCertKey]
-	if len(pkData) == 0 {
+	if len(pkData) == 0 && !usesExternalCSR(input) {
 		return MissingData, "Issuing certificate as Secret does not contain a private key", true
 	}
 	if len(certData) == 0 {
@@ -64,6 +65,10 @@ func SecretIsMissingData(input Input) (string, string, bool) {
 }
 
 func SecretPublicKeysDiffer(input Input) (string, string, bool) {
+	if usesExternalCSR(input) {
+		// The private key is not stored in the Secret.
+		return "", "", false
+	}
 	pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
 	if err != nil {
 		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
@@ -85,6 +90,10 @@ func SecretPublicKeysDiffer(input Input) (string, string, bool) {
 }
 
 func SecretPrivateKeyMismatchesSpec(input Input) (string, string, bool) {
+	if usesExternalCSR(input) {
+		// The private key is not stored in the Secret.
+		return "", "", false
+	}
 	pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
 	if err != nil {
 		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
@@ -97,6 +106,25 @@ func SecretPrivateKeyMismatchesSpec(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// SecretCertificateMismatchesSpec checks that the certificate in the Secret
+// matches the Certificate spec. Unlike currentSecretValidForSpec, names are
+// not allowed to move between the commonName and dnsNames fields.
+func SecretCertificateMismatchesSpec(input Input) (string, string, bool) {
+	x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Secret contains an invalid certificate: %v", err), true
+	}
+
+	violations, err := pki.CertificateMatchesSpec(x509Cert, input.Certificate.Spec)
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Failed to compare the certificate in the Secret with the spec: %v", err), true
+	}
+	if len(violations) > 0 {
+		return SecretMismatch, fmt.Sprintf("Existing certificate is not up to date for spec: %v", violations), true
+	}
+	return "", "", false
+}
+
 // SecretKeystoreFormatMismatch - When the keystore is not defined, the keystore
 // related fields are removed from the secret.
 // When one or more key stores are defined,  the
@@ -110,7 +138,8 @@ func SecretKeystoreFormatMismatch(input Input) (string, string, bool) {
 		if len(input.Secret.Data[cmapi.PKCS12SecretKey]) != 0 ||
 			len(input.Secret.Data[cmapi.PKCS12TruststoreKey]) != 0 ||
 			len(input.Secret.Data[cmapi.JKSSecretKey]) != 0 ||
-			len(input.Secret.Data[cmapi.JKSTruststoreKey]) != 0 {
+			len(input.Secret.Data[cmapi.JKSTruststoreKey]) != 0 ||
+			len(input.Secret.Data[cmapi.PEMTruststoreKey]) != 0 {
 			return SecretMismatch, "Keystore is not defined", true
 		}
 		return "", "", false
@@ -154,6 +183,50 @@ func SecretKeystoreFormatMismatch(input Input) (string, string, bool) {
 		}
 	}
 
+	if input.Certificate.Spec.Keystores.PEMTruststore != nil && input.Certificate.Spec.Keystores.PEMTruststore.Create {
+		truststore, err := internalcertificates.PEMTruststore(input.Secret.Data[corev1.TLSCertKey], input.Secret.Data[cmmeta.TLSCAKey])
+		if err != nil {
+			return InvalidCertificate, fmt.Sprintf("Failed to build the PEM truststore: %v", err), true
+		}
+		if !bytes.Equal(input.Secret.Data[cmapi.PEMTruststoreKey], truststore) {
+			return SecretMismatch, "PEM Truststore key does not contain the CA chain", true
+		}
+	} else {
+		if len(input.Secret.Data[cmapi.PEMTruststoreKey]) != 0 {
+			return SecretMismatch, "PEM Truststore not defined", true
+		}
+	}
+
+	return "", "", false
+}
+
+// SecretKeystoreFingerprintMismatch - When a JKS or PKCS12 keystore is created,
+// the fingerprint annotation of the keystore must match its current passwords
+// and alias or profile, so that the keystore is re-encoded when any of them
+// change. Keystores whose password could not be resolved are not checked.
+func SecretKeystoreFingerprintMismatch(input Input) (string, string, bool) {
+	keystores := input.Certificate.Spec.Keystores
+	if keystores == nil {
+		return "", "", false
+	}
+
+	passwords := input.KeystorePasswords
+
+	if keystores.JKS != nil && keystores.JKS.Create && len(passwords.JKS) > 0 {
+		fingerprint := internalcertificates.JKSKeystoreFingerprint(input.Certificate, passwords.JKS, input.Secret.Data[cmapi.JKSSecretKey])
+		if input.Secret.Annotations[cmapi.JKSKeystoreFingerprintAnnotationKey] != fingerprint {
+			return KeystoreChanged, "JKS Keystore password or alias has changed", true
+		}
+	}
+
+	if keystores.PKCS12 != nil && keystores.PKCS12.Create && len(passwords.PKCS12) > 0 &&
+		(keystores.PKCS12.KeyPasswordSecretRef == nil || len(passwords.PKCS12Key) > 0) {
+		fingerprint := internalcertificates.PKCS12KeystoreFingerprint(input.Certificate, passwords.PKCS12, passwords.PKCS12Key, input.Secret.Data[cmapi.PKCS12SecretKey])
+		if input.Secret.Annotations[cmapi.PKCS12KeystoreFingerprintAnnotationKey] != fingerprint {
+			return KeystoreChanged, "PKCS12 Keystore password, alias or profile has changed", true
+		}
+	}
+
 	return "", "", false
 }
 
@@ -190,9 +263,22 @@ func SecretPublicKeyDiffersFromCurrentCertificateRequest(input Input) (string, s
 	if input.CurrentRevisionRequest == nil {
 		return "", "", false
 	}
-	pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
-	if err != nil {
-		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
+
+	// If the private key is held outside of cert-manager, compare the public
+	// key of the certificate in the Secret instead.
+	var publicKey crypto.PublicKey
+	if usesExternalCSR(input) {
+		x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
+		}
+		publicKey = x509Cert.PublicKey
+	} else {
+		pk, err := pki.DecodePrivateKeyBytes(input.Secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains invalid private key data: %v", err), true
+		}
+		publicKey = pk.Public()
 	}
 
 	csr, err := pki.DecodeX509CertificateRequestBytes(input.CurrentRevisionRequest.Spec.Request)
@@ -200,17 +286,52 @@ func SecretPublicKeyDiffersFromCurrentCertificateRequest(input Input) (string, s
 		return InvalidCertificateRequest, fmt.Sprintf("Failed to decode current CertificateRequest: %v", err), true
 	}
 
-	equal, err := pki.PublicKeysEqual(csr.PublicKey, pk.Public())
+	equal, err := pki.PublicKeysEqual(csr.PublicKey, publicKey)
 	if err != nil {
 		return InvalidCertificateRequest, fmt.Sprintf("CertificateRequest's public key is invalid: %v", err), true
 	}
 	if !equal {
+		if usesExternalCSR(input) {
+			return SecretMismatch, "Secret contains a certificate that does not match the current CertificateRequest", true
+		}
 		return SecretMismatch, "Secret contains a private key that does not match the current CertificateRequest", true
 	}
 
 	return "", "", false
 }
 
+// SecretPublicKeyDiffersFromExternalCSR checks that the certificate in the
+// Secret was issued for the public key of the Certificate's external
+// certificate signing request. A failure is caused by the CSR being replaced,
+// for example after the private key held outside of cert-manager was rotated.
+func SecretPublicKeyDiffersFromExternalCSR(input Input) (string, string, bool) {
+	if !usesExternalCSR(input) || input.ExternalCSR == nil {
+		return "", "", false
+	}
+
+	x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Issuing certificate as Secret contains an invalid certificate: %v", err), true
+	}
+
+	equal, err := pki.PublicKeysEqual(x509Cert.PublicKey, input.ExternalCSR.PublicKey)
+	if err != nil {
+		return InvalidCertificateRequest, fmt.Sprintf("External certificate signing request's public key is invalid: %v", err), true
+	}
+	if !equal {
+		return ExternalCSRChanged, "Issuing certificate as the external certificate signing request has a different public key to the certificate in the Secret", true
+	}
+
+	return "", "", false
+}
+
+// usesExternalCSR returns true if the Certificate is issued from an external
+// certificate signing request, in which case the Secret does not contain the
+// private key.
+func usesExternalCSR(input Input) bool {
+	return input.Certificate != nil && input.Certificate.Spec.ExternalCSR != nil
+}
+
 func CurrentCertificateRequestMismatchesSpec(input Input) (string, string, bool) {
 	if input.CurrentRevisionRequest == nil {
 		// Fallback to comparing the Certificate spec with the issued certificate.
@@ -255,7 +376,10 @@ func currentSecretValidForSpec(input Input) (string, string, bool) {
 // CurrentCertificateNearingExpiry returns a policy function that can be used to
 // check whether an X.509 cert currently issued for a Certificate should be
 // renewed.
-func CurrentCertificateNearingExpiry(c clock.Clock) Func {
+// If maxRenewalJitter is non-zero, the renewal time is brought forward by a
+// deterministic per-Certificate amount of up to maxRenewalJitter, so that
+// certificates issued at the same time do not all renew at the same time.
+func CurrentCertificateNearingExpiry(c clock.Clock, maxRenewalJitter time.Duration) Func {
 	return func(input Input) (string, string, bool) {
 		x509Cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
 		if err != nil {
@@ -307,7 +431,8 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 			return reason, message, true
 		}
 
-		renewalTime, err := pki.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage, crt.Spec.Renewal)
+		renewalTime, err := pki.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage, crt.Spec.Renewal,
+			pki.WithRenewalJitter(crt.Namespace+"/"+crt.Name, maxRenewalJitter))
 		if err != nil {
 			reason = WindowError
 			message = err.Error()
@@ -459,6 +584,9 @@ func SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager string)
 			cmapi.IssuerNameAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
 			cmapi.IssuerKindAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
 			cmapi.IssuerGroupAnnotationKey, // SecretIssuerAnnotationsMismatch checks the value
+
+			cmapi.JKSKeystoreFingerprintAnnotationKey,    // SecretKeystoreFingerprintMismatch checks the value
+			cmapi.PKCS12KeystoreFingerprintAnnotationKey, // SecretKeystoreFingerprintMismatch checks the value
 		)
 
 		// Remove the non cert-manager labels from the managed labels so we can compare
@@ -717,36 +845,7 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 // non re-triable error.
 func SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled bool, fieldManager string) Func {
 	return func(input Input) (string, string, bool) {
-		var hasOwnerRefManagedField bool
-		// Determine whether the Secret has the Certificate as an owner reference
-		// which is owned by the field manager.
-		for _, managedField := range input.Secret.ManagedFields {
-			if managedField.Manager != fieldManager || managedField.FieldsV1 == nil {
-				continue
-			}
-
-			var fieldset fieldpath.Set
-			if err := fieldset.FromJSON(managedField.FieldsV1.GetRawReader()); err != nil {
-				return ManagedFieldsParseError, fmt.Sprintf("failed to decode managed fields on Secret: %s", err), true
-			}
-			if fieldset.Has(fieldpath.Path{
-				{FieldName: new("metadata")},
-				{FieldName: new("ownerReferences")},
-				{Key: &value.FieldList{{Name: "uid", Value: value.NewValueInterface(string(input.Certificate.UID))}}},
-			}) {
-				hasOwnerRefManagedField = true
-				break
-			}
-		}
-
-		// The presence of the Certificate owner reference should match owner
-		// reference being enabled.
-		if ownerRefEnabled != hasOwnerRefManagedField {
-			return SecretOwnerRefMismatch,
-				fmt.Sprintf("unexpected managed Secret Owner Reference field on Secret --enable-certificate-owner-ref=%t", ownerRefEnabled), true
-		}
-
-		return "", "", false
+		return ownerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager, input.Certificate, input.Secret, "Secret", SecretOwnerRefMismatch)
 	}
 }
 
@@ -755,38 +854,81 @@ func SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled bool, fieldManager
 // * owner reference is enabled, but the reference has an incorrect value
 func SecretOwnerReferenceMismatch(ownerRefEnabled bool) Func {
 	return func(input Input) (string, string, bool) {
-		// If the Owner Reference is not enabled, we don't need to check the value
-		// and can exit early.
-		if !ownerRefEnabled {
-			return "", "", false
-		}
+		return ownerReferenceMismatch(ownerRefEnabled, input.Certificate, input.Secret, "Secret", SecretOwnerRefMismatch)
+	}
+}
 
-		var (
-			expRef                         = *metav1.NewControllerRef(input.Certificate, cmapi.SchemeGroupVersion.WithKind("Certificate"))
-			hasOwnerRefMatchingCertificate bool
-		)
-		for _, ownerRef := range input.Secret.OwnerReferences {
-			// Owner Reference slice is keyed by UID, so only one Owner Reference
-			// with a particular UID can exist meaning we can break early.
-			// https://github.com/kubernetes/apimachinery/blob/04356ed4cbb061c810a5e3d655802fd1e24284da/pkg/apis/meta/v1/types.go#L251
-			if ownerRef.UID == input.Certificate.UID {
-				if apiequality.Semantic.DeepEqual(ownerRef, expRef) {
-					// Break early, there can only be one owner ref with this UID.
-					hasOwnerRefMatchingCertificate = true
-					break
-				}
-			}
+// ownerReferenceManagedFieldMismatch validates that the given object, which is
+// of the given kind, has an owner reference to the Certificate which is owned
+// by the field manager if and only if owner references are enabled.
+func ownerReferenceManagedFieldMismatch(ownerRefEnabled bool, fieldManager string, crt *cmapi.Certificate, obj metav1.Object, kind, reason string) (string, string, bool) {
+	var hasOwnerRefManagedField bool
+	// Determine whether the object has the Certificate as an owner reference
+	// which is owned by the field manager.
+	for _, managedField := range obj.GetManagedFields() {
+		if managedField.Manager != fieldManager || managedField.FieldsV1 == nil {
+			continue
 		}
 
-		// Owner reference is enabled at this point. If the Owner Reference value
-		// doesn't match the expected value, return violation.
-		if !hasOwnerRefMatchingCertificate {
-			return SecretOwnerRefMismatch,
-				fmt.Sprintf("unexpected Secret Owner Reference value on Secret --enable-certificate-owner-ref=%t", ownerRefEnabled), true
+		var fieldset fieldpath.Set
+		if err := fieldset.FromJSON(managedField.FieldsV1.GetRawReader()); err != nil {
+			return ManagedFieldsParseError, fmt.Sprintf("failed to decode managed fields on %s: %s", kind, err), true
+		}
+		if fieldset.Has(fieldpath.Path{
+			{FieldName: new("metadata")},
+			{FieldName: new("ownerReferences")},
+			{Key: &value.FieldList{{Name: "uid", Value: value.NewValueInterface(string(crt.UID))}}},
+		}) {
+			hasOwnerRefManagedField = true
+			break
 		}
+	}
 
+	// The presence of the Certificate owner reference should match owner
+	// reference being enabled.
+	if ownerRefEnabled != hasOwnerRefManagedField {
+		return reason,
+			fmt.Sprintf("unexpected managed %s Owner Reference field on %s --enable-certificate-owner-ref=%t", kind, kind, ownerRefEnabled), true
+	}
+
+	return "", "", false
+}
+
+// ownerReferenceMismatch validates that the given object, which is of the
+// given kind, has the expected owner reference to the Certificate if owner
+// references are enabled.
+func ownerReferenceMismatch(ownerRefEnabled bool, crt *cmapi.Certificate, obj metav1.Object, kind, reason string) (string, string, bool) {
+	// If the Owner Reference is not enabled, we don't need to check the value
+	// and can exit early.
+	if !ownerRefEnabled {
 		return "", "", false
 	}
+
+	var (
+		expRef                         = *metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind("Certificate"))
+		hasOwnerRefMatchingCertificate bool
+	)
+	for _, ownerRef := range obj.GetOwnerReferences() {
+		// Owner Reference slice is keyed by UID, so only one Owner Reference
+		// with a particular UID can exist meaning we can break early.
+		// https://github.com/kubernetes/apimachinery/blob/04356ed4cbb061c810a5e3d655802fd1e24284da/pkg/apis/meta/v1/types.go#L251
+		if ownerRef.UID == crt.UID {
+			if apiequality.Semantic.DeepEqual(ownerRef, expRef) {
+				// Break early, there can only be one owner ref with this UID.
+				hasOwnerRefMatchingCertificate = true
+				break
+			}
+		}
+	}
+
+	// Owner reference is enabled at this point. If the Owner Reference value
+	// doesn't match the expected value, return violation.
+	if !hasOwnerRefMatchingCertificate {
+		return reason,
+			fmt.Sprintf("unexpected %s Owner Reference value on %s --enable-certificate-owner-ref=%t", kind, kind, ownerRefEnabled), true
+	}
+
+	return "", "", false
 }
 
 // mapsHaveMatchingValues returns true if the two maps have the same values for
@@ -17,6 +17,7 @@ limitations under the License.
 package policies
 
 import (
+	"crypto/x509"
 	"testing"
 	"time"
 
@@ -25,7 +26,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -42,11 +45,50 @@ import (
 func Test_NewTriggerPolicyChain(t *testing.T) {
 	clock := &fakeclock.FakeClock{}
 	staticFixedPrivateKey := testcrypto.MustCreatePEMPrivateKey(t)
+	mustDecodeCSR := func(csrPEM []byte) *x509.CertificateRequest {
+		csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csr
+	}
+	externalCSRCertificate := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			CommonName: "example.com",
+			IssuerRef: cmmeta.IssuerReference{
+				Name:  "testissuer",
+				Kind:  "IssuerKind",
+				Group: "group.example.com",
+			},
+			ExternalCSR: &cmapi.CertificateExternalCSR{Name: "csr"},
+		},
+		Status: cmapi.CertificateStatus{
+			RenewalTime: &metav1.Time{Time: clock.Now().Add(time.Hour)},
+		},
+	}
+	externalCSRSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "something",
+			Annotations: map[string]string{
+				cmapi.IssuerNameAnnotationKey:  "testissuer",
+				cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+				cmapi.IssuerGroupAnnotationKey: "group.example.com",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: {},
+			corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+				&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+				clock.Now().Add(time.Minute*-30),
+				clock.Now().Add(time.Hour*2),
+			),
+		},
+	}
 	tests := map[string]struct {
 		// policy inputs
 		certificate *cmapi.Certificate
 		request     *cmapi.CertificateRequest
 		secret      *corev1.Secret
+		externalCSR *x509.CertificateRequest
 
 		// expected outputs
 		reason, message string
@@ -135,6 +177,30 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			message: "Issuing certificate as Secret contains a private key that does not match the certificate",
 			reissue: true,
 		},
+		"does not trigger issuance if the Certificate uses an external CSR and the Secret has no private key": {
+			certificate: externalCSRCertificate,
+			secret:      externalCSRSecret,
+			externalCSR: mustDecodeCSR(testcrypto.MustGenerateCSRImpl(t, staticFixedPrivateKey, externalCSRCertificate)),
+		},
+		"trigger issuance if the external CSR has a different public key to the certificate in the Secret": {
+			certificate: externalCSRCertificate,
+			secret:      externalCSRSecret,
+			externalCSR: mustDecodeCSR(testcrypto.MustGenerateCSRImpl(t, testcrypto.MustCreatePEMPrivateKey(t), externalCSRCertificate)),
+			reason:      ExternalCSRChanged,
+			message:     "Issuing certificate as the external certificate signing request has a different public key to the certificate in the Secret",
+			reissue:     true,
+		},
+		"trigger issuance if the Certificate uses an external CSR and the Secret contains a certificate that does not match the current CertificateRequest": {
+			certificate: externalCSRCertificate,
+			secret:      externalCSRSecret,
+			request: &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{
+				IssuerRef: externalCSRCertificate.Spec.IssuerRef,
+				Request:   testcrypto.MustGenerateCSRImpl(t, testcrypto.MustCreatePEMPrivateKey(t), externalCSRCertificate),
+			}},
+			reason:  SecretMismatch,
+			message: "Secret contains a certificate that does not match the current CertificateRequest",
+			reissue: true,
+		},
 		"trigger issuance as Secret has old or incorrect 'issuer name' annotation": {
 			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
 				SecretName: "something",
@@ -580,13 +646,14 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			},
 		},
 	}
-	policyChain := NewTriggerPolicyChain(clock)
+	policyChain := NewTriggerPolicyChain(clock, 0)
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			reason, message, reissue := policyChain.Evaluate(Input{
 				Certificate:            test.certificate,
 				CurrentRevisionRequest: test.request,
 				Secret:                 test.secret,
+				ExternalCSR:            test.externalCSR,
 			})
 
 			if test.reason != reason {
@@ -2276,3 +2343,174 @@ func Test_SecretCertificateNameAnnotationsMismatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_SecretKeystoreFingerprintMismatch(t *testing.T) {
+	crt := gen.Certificate("test-certificate",
+		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{
+			JKS:    &cmapi.JKSKeystore{Create: true, Alias: ptr.To("my-alias")},
+			PKCS12: &cmapi.PKCS12Keystore{Create: true, Profile: cmapi.Modern2023PKCS12Profile},
+		}),
+	)
+	crtWithPKCS12KeyPassword := gen.Certificate("test-certificate",
+		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{
+			PKCS12: &cmapi.PKCS12Keystore{
+				Create:               true,
+				Profile:              cmapi.Modern2023PKCS12Profile,
+				KeyPasswordSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "key-password"}},
+			},
+		}),
+	)
+	jks, p12 := []byte("jks-keystore"), []byte("p12-keystore")
+
+	secretWithFingerprints := func(jksFingerprint, p12Fingerprint string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					cmapi.JKSKeystoreFingerprintAnnotationKey:    jksFingerprint,
+					cmapi.PKCS12KeystoreFingerprintAnnotationKey: p12Fingerprint,
+				},
+			},
+			Data: map[string][]byte{
+				cmapi.JKSSecretKey:    jks,
+				cmapi.PKCS12SecretKey: p12,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		input Input
+
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"with matching fingerprints, should return false": {
+			input: Input{
+				Certificate: crt,
+				Secret: secretWithFingerprints(
+					internalcertificates.KeystoreFingerprint([]byte("jks-password"), jks, "my-alias"),
+					internalcertificates.KeystoreFingerprint([]byte("p12-password"), p12, string(cmapi.Modern2023PKCS12Profile)),
+				),
+				KeystorePasswords: internalcertificates.KeystorePasswords{
+					JKS:    []byte("jks-password"),
+					PKCS12: []byte("p12-password"),
+				},
+			},
+			expViolation: false,
+		},
+		"with unknown passwords, should return false": {
+			input: Input{
+				Certificate: crt,
+				Secret:      secretWithFingerprints("", ""),
+			},
+			expViolation: false,
+		},
+		"with a changed JKS password, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret: secretWithFingerprints(
+					internalcertificates.KeystoreFingerprint([]byte("old-password"), jks, "my-alias"),
+					internalcertificates.KeystoreFingerprint([]byte("p12-password"), p12, string(cmapi.Modern2023PKCS12Profile)),
+				),
+				KeystorePasswords: internalcertificates.KeystorePasswords{
+					JKS:    []byte("jks-password"),
+					PKCS12: []byte("p12-password"),
+				},
+			},
+			expReason:    KeystoreChanged,
+			expMessage:   "JKS Keystore password or alias has changed",
+			expViolation: true,
+		},
+		"with a changed JKS alias, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret: secretWithFingerprints(
+					internalcertificates.KeystoreFingerprint([]byte("jks-password"), jks, "certificate"),
+					internalcertificates.KeystoreFingerprint([]byte("p12-password"), p12, string(cmapi.Modern2023PKCS12Profile)),
+				),
+				KeystorePasswords: internalcertificates.KeystorePasswords{
+					JKS:    []byte("jks-password"),
+					PKCS12: []byte("p12-password"),
+				},
+			},
+			expReason:    KeystoreChanged,
+			expMessage:   "JKS Keystore password or alias has changed",
+			expViolation: true,
+		},
+		"with a changed PKCS12 profile, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret: secretWithFingerprints(
+					internalcertificates.KeystoreFingerprint([]byte("jks-password"), jks, "my-alias"),
+					internalcertificates.KeystoreFingerprint([]byte("p12-password"), p12, string(cmapi.LegacyRC2PKCS12Profile)),
+				),
+				KeystorePasswords: internalcertificates.KeystorePasswords{
+					JKS:    []byte("jks-password"),
+					PKCS12: []byte("p12-password"),
+				},
+			},
+			expReason:    KeystoreChanged,
+			expMessage:   "PKCS12 Keystore password, alias or profile has changed",
+			expViolation: true,
+		},
+		"with a changed PKCS12 key password, should return true": {
+			input: Input{
+				Certificate: crtWithPKCS12KeyPassword,
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							cmapi.PKCS12KeystoreFingerprintAnnotationKey: internalcertificates.PKCS12KeystoreFingerprint(crtWithPKCS12KeyPassword, []byte("p12-password"), []byte("old-key-password"), p12),
+						},
+					},
+					Data: map[string][]byte{cmapi.PKCS12SecretKey: p12},
+				},
+				KeystorePasswords: internalcertificates.KeystorePasswords{
+					PKCS12:    []byte("p12-password"),
+					PKCS12Key: []byte("key-password"),
+				},
+			},
+			expReason:    KeystoreChanged,
+			expMessage:   "PKCS12 Keystore password, alias or profile has changed",
+			expViolation: true,
+		},
+		"with a matching PKCS12 key password, should return false": {
+			input: Input{
+				Certificate: crtWithPKCS12KeyPassword,
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							cmapi.PKCS12KeystoreFingerprintAnnotationKey: internalcertificates.PKCS12KeystoreFingerprint(crtWithPKCS12KeyPassword, []byte("p12-password"), []byte("key-password"), p12),
+						},
+					},
+					Data: map[string][]byte{cmapi.PKCS12SecretKey: p12},
+				},
+				KeystorePasswords: internalcertificates.KeystorePasswords{
+					PKCS12:    []byte("p12-password"),
+					PKCS12Key: []byte("key-password"),
+				},
+			},
+			expViolation: false,
+		},
+		"without a fingerprint annotation, should return true": {
+			input: Input{
+				Certificate: crt,
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{cmapi.JKSSecretKey: jks},
+				},
+				KeystorePasswords: internalcertificates.KeystorePasswords{JKS: []byte("jks-password")},
+			},
+			expReason:    KeystoreChanged,
+			expMessage:   "JKS Keystore password or alias has changed",
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretKeystoreFingerprintMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
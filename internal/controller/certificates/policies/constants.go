@@ -44,6 +44,10 @@ const (
 	// already has a `cert-manager.io/certificate-name` annotation
 	// with the name of another Certificate.
 	IncorrectCertificate string = "IncorrectCertificate"
+	// ExternalCSRChanged is a policy violation reason for a scenario where
+	// the public key of the Certificate's spec.externalCSR does not match
+	// the certificate in the Secret.
+	ExternalCSRChanged string = "ExternalCSRChanged"
 	// RequestChanged is a policy violation reason for a scenario where
 	// CertificateRequest not valid for Certificate's spec.
 	RequestChanged string = "RequestChanged"
@@ -66,9 +70,16 @@ const (
 	// SecretManagedMetadataMismatch is a policy violation whereby the Secret is
 	// missing labels that should have been added by cert-manager
 	SecretManagedMetadataMismatch string = "SecretManagedMetadataMismatch"
+	// KeystoreChanged is a policy violation whereby the password, alias or
+	// profile of a keystore in the Secret no longer matches the Certificate's
+	// keystore configuration.
+	KeystoreChanged string = "KeystoreChanged"
 	// RenewalDisabled is a policy violation whereby the Certificate's
 	// Renewal Policy is set to Disabled.
 	RenewalDisabled string = "Disabled"
+	// RenewalRequested is a policy violation whereby the Certificate's
+	// spec.renewalRequest has not yet been handled.
+	RenewalRequested string = "RenewalRequested"
 
 	// AdditionalOutputFormatsMismatch is a policy violation whereby the
 	// Certificate's AdditionalOutputFormats is not reflected on the target
@@ -81,4 +92,21 @@ const (
 	// a missing owner reference to the Certificate, or has an owner reference it
 	// shouldn't have.
 	SecretOwnerRefMismatch string = "SecretOwnerRefMismatch"
+
+	// SecretReplicaMissing is a policy violation whereby a replica of the
+	// Certificate's Secret does not exist in a namespace it should be
+	// replicated to.
+	SecretReplicaMissing string = "SecretReplicaMissing"
+	// SecretReplicaMismatch is a policy violation whereby a replica of the
+	// Certificate's Secret has different data, labels or annotations to the
+	// Certificate's Secret.
+	SecretReplicaMismatch string = "SecretReplicaMismatch"
+
+	// TrustConfigMapMismatch is a policy violation whereby the Certificate's
+	// TrustConfigMap does not exist, or has missing, extra or wrong data.
+	TrustConfigMapMismatch string = "TrustConfigMapMismatch"
+	// TrustConfigMapOwnerRefMismatch is a policy violation whereby the
+	// Certificate's TrustConfigMap either has a missing owner reference to the
+	// Certificate, or has an owner reference it shouldn't have.
+	TrustConfigMapOwnerRefMismatch string = "TrustConfigMapOwnerRefMismatch"
 )
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// secretDriftReasons are the policy violation reasons which are returned when
+// the contents of a Certificate's Secret no longer match what was stored by
+// cert-manager.
+var secretDriftReasons = sets.New(
+	MissingData,
+	InvalidKeyPair,
+	InvalidCertificate,
+	IncorrectIssuer,
+	IncorrectCertificate,
+	SecretMismatch,
+)
+
+// secretDriftDataKeys are the keys in a Certificate's Secret data which are
+// inspected by the trigger policy checks.
+var secretDriftDataKeys = []string{
+	corev1.TLSCertKey,
+	corev1.TLSPrivateKeyKey,
+	cmmeta.TLSCAKey,
+}
+
+// SecretDrift describes a change made to a Certificate's Secret by a field
+// manager other than cert-manager, which caused a policy check to fail.
+type SecretDrift struct {
+	// Check is the reason returned by the policy check which failed.
+	Check string
+
+	// Keys are the Secret data keys, and cert-manager annotations and labels,
+	// which are owned by Managers. Data keys are formatted as `data[key]`,
+	// annotations as `annotations[key]` and labels as `labels[key]`.
+	Keys []string
+
+	// Managers are the names of the field managers, other than cert-manager,
+	// which own Keys.
+	Managers []string
+}
+
+// IsSecretDriftReason returns true if the given policy violation reason is
+// one which is returned when the contents of a Certificate's Secret have
+// changed.
+func IsSecretDriftReason(reason string) bool {
+	return secretDriftReasons.Has(reason)
+}
+
+// SecretDriftForViolation returns the SecretDrift which caused the policy
+// violation with the given reason, or nil if the violation was not caused by
+// the Secret being changed outside of cert-manager.
+// A violation is considered to be drift if the Certificate has previously been
+// issued, the reason is one which is returned when the Secret's contents have
+// changed, and any of the Secret's certificate data or cert-manager
+// annotations and labels are owned by a field manager other than the given
+// cert-manager field manager.
+func SecretDriftForViolation(input Input, fieldManager, reason string) (*SecretDrift, error) {
+	if input.Certificate == nil || input.Certificate.Status.Revision == nil || input.Secret == nil {
+		return nil, nil
+	}
+	if !IsSecretDriftReason(reason) {
+		return nil, nil
+	}
+
+	keys, managers := sets.New[string](), sets.New[string]()
+	for _, managedField := range input.Secret.ManagedFields {
+		if managedField.Manager == fieldManager || managedField.FieldsV1 == nil {
+			continue
+		}
+
+		var fieldset fieldpath.Set
+		if err := fieldset.FromJSON(managedField.FieldsV1.GetRawReader()); err != nil {
+			return nil, fmt.Errorf("failed to decode managed fields on Secret: %w", err)
+		}
+
+		owned := secretDriftOwnedKeys(&fieldset)
+		if len(owned) == 0 {
+			continue
+		}
+		keys.Insert(owned...)
+		managers.Insert(managedField.Manager)
+	}
+
+	if managers.Len() == 0 {
+		return nil, nil
+	}
+
+	return &SecretDrift{
+		Check:    reason,
+		Keys:     sets.List(keys),
+		Managers: sets.List(managers),
+	}, nil
+}
+
+// secretDriftOwnedKeys returns the certificate data keys, and cert-manager
+// annotations and labels, which are present in the given managed fields.
+func secretDriftOwnedKeys(fieldset *fieldpath.Set) []string {
+	var keys []string
+
+	for _, key := range secretDriftDataKeys {
+		if fieldset.Has(fieldpath.Path{
+			{FieldName: new("data")},
+			{FieldName: new(key)},
+		}) {
+			keys = append(keys, fmt.Sprintf("data[%s]", key))
+		}
+	}
+
+	metadata := fieldset.Children.Descend(fieldpath.PathElement{
+		FieldName: new("metadata"),
+	})
+	for _, field := range []string{"annotations", "labels"} {
+		metadata.Children.Descend(fieldpath.PathElement{
+			FieldName: new(field),
+		}).Iterate(func(path fieldpath.Path) {
+			key := strings.TrimPrefix(path.String(), ".")
+			if strings.Contains(key, "cert-manager.io/") {
+				keys = append(keys, fmt.Sprintf("%s[%s]", field, key))
+			}
+		})
+	}
+
+	return keys
+}
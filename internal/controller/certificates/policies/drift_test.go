@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_SecretDriftForViolation(t *testing.T) {
+	const fieldManager = "cert-manager-unit-test"
+
+	issuedCertificate := gen.Certificate("test-certificate", gen.SetCertificateRevision(1))
+
+	tests := map[string]struct {
+		certificate         *cmapi.Certificate
+		secretManagedFields []metav1.ManagedFieldsEntry
+		reason              string
+
+		expDrift *SecretDrift
+		expErr   bool
+	}{
+		"if the Certificate has not been issued, should return nil": {
+			certificate: gen.Certificate("test-certificate"),
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl-edit", FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.crt": {}}}`)},
+			},
+			reason: InvalidKeyPair,
+		},
+		"if the reason is not caused by the Secret's contents, should return nil": {
+			certificate: issuedCertificate,
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl-edit", FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.crt": {}}}`)},
+			},
+			reason: Renewing,
+		},
+		"if the certificate data is only owned by cert-manager, should return nil": {
+			certificate: issuedCertificate,
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.crt": {}, "f:tls.key": {}}}`)},
+				{Manager: "helm", FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:other": {}}, "f:metadata": {"f:labels": {"f:app": {}}}}`)},
+			},
+			reason: InvalidKeyPair,
+		},
+		"if the managed fields cannot be decoded, should return an error": {
+			certificate: issuedCertificate,
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl-edit", FieldsV1: metav1.NewFieldsV1(`{"f:data": `)},
+			},
+			reason: InvalidKeyPair,
+			expErr: true,
+		},
+		"if the certificate data and annotations are owned by other managers, should return the keys and managers": {
+			certificate: issuedCertificate,
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:ca.crt": {}}}`)},
+				{Manager: "kubectl-edit", FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.crt": {}, "f:tls.key": {}}}`)},
+				{Manager: "kubectl-annotate", FieldsV1: metav1.NewFieldsV1(`{"f:metadata": {
+					"f:annotations": {"f:cert-manager.io/issuer-name": {}, "f:example.com/other": {}},
+					"f:labels": {"f:controller.cert-manager.io/fao": {}}
+				}}`)},
+			},
+			reason: IncorrectIssuer,
+			expDrift: &SecretDrift{
+				Check: IncorrectIssuer,
+				Keys: []string{
+					"annotations[cert-manager.io/issuer-name]",
+					"data[tls.crt]",
+					"data[tls.key]",
+					"labels[controller.cert-manager.io/fao]",
+				},
+				Managers: []string{"kubectl-annotate", "kubectl-edit"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			drift, err := SecretDriftForViolation(Input{
+				Certificate: test.certificate,
+				Secret:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{ManagedFields: test.secretManagedFields}},
+			}, fieldManager, test.reason)
+			assert.Equal(t, test.expErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, test.expDrift, drift)
+		})
+	}
+}
@@ -212,6 +212,7 @@ import (
 	"fmt"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
@@ -229,6 +230,7 @@ import (
 type Gatherer struct {
 	CertificateRequestLister cmlisters.CertificateRequestLister
 	SecretLister             internalinformers.SecretLister
+	ConfigMapLister          corelisters.ConfigMapLister
 }
 
 // DataForCertificate returns the secret as well as the "current" and "next"
@@ -321,6 +323,17 @@ func (g *Gatherer) DataForCertificate(ctx context.Context, crt *cmapi.Certificat
 		NextRevisionRequest:    nextCR,
 	}
 
+	// Attempt to fetch the external certificate signing request, but tolerate
+	// it being missing or invalid. The requestmanager controller reports why
+	// it cannot be used.
+	if crt.Spec.ExternalCSR != nil {
+		if _, csr, err := certificates.ExternalCSR(crt, g.SecretLister, g.ConfigMapLister); err != nil {
+			log.V(logf.DebugLevel).Info("Failed to fetch external certificate signing request", "error", err)
+		} else {
+			i.ExternalCSR = csr
+		}
+	}
+
 	// NB: We don't care if issuer has enabled/disabled the ARI feature because there is a null check here.
 	// We don't want to bring in issuer/clusterissuer lister here since it is not required.
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ACMEUseARI) {
@@ -18,9 +18,13 @@ limitations under the License.
 package policies
 
 import (
+	"crypto/x509"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
@@ -43,6 +47,26 @@ type Input struct {
 	NextRevisionRequest *cmapi.CertificateRequest
 
 	ARIRenewalInfo *acmeapi.RenewalInfoResponse
+
+	// ConfigMap is the Certificate's TrustConfigMap, which is checked by the
+	// post issuance policy chain. It is nil if the Certificate has no
+	// TrustConfigMap or the ConfigMap does not exist.
+	ConfigMap *corev1.ConfigMap
+
+	// SecretReplica is a replica of the Certificate's Secret in another
+	// namespace, which is checked by the Secret replica policy chain against
+	// the Certificate's Secret. It is nil if the replica does not exist.
+	SecretReplica *corev1.Secret
+
+	// ExternalCSR is the decoded certificate signing request referenced by
+	// the Certificate's spec.externalCSR. It is nil if the Certificate has
+	// no external CSR, or the CSR could not be fetched or decoded.
+	ExternalCSR *x509.CertificateRequest
+
+	// KeystorePasswords are the current passwords of the Certificate's
+	// keystores, which are checked by the post issuance policy chain against
+	// the Secret's keystore fingerprints.
+	KeystorePasswords internalcertificates.KeystorePasswords
 }
 
 // A Func evaluates the given input data and decides whether a check has passed
@@ -68,7 +92,7 @@ func (c Chain) Evaluate(input Input) (string, string, bool) {
 
 // NewTriggerPolicyChain includes trigger policy checks, which if returns true,
 // should cause a Certificate to be marked for issuance.
-func NewTriggerPolicyChain(c clock.Clock) Chain {
+func NewTriggerPolicyChain(c clock.Clock, maxRenewalJitter time.Duration) Chain {
 	return Chain{
 		SecretDoesNotExist,     // Make sure the Secret exists
 		SecretIsMissingData,    // Make sure the Secret has the required keys set
@@ -77,10 +101,11 @@ func NewTriggerPolicyChain(c clock.Clock) Chain {
 		SecretIssuerAnnotationsMismatch,          // Make sure the Secret's IssuerRef annotations match the Certificate spec
 		SecretCertificateNameAnnotationsMismatch, // Make sure the Secret's CertificateName annotation matches the Certificate's name
 
-		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
-		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
-		CurrentCertificateRequestMismatchesSpec,             // Make sure the current CertificateRequest matches the Certificate spec
-		CurrentCertificateNearingExpiry(c),                  // Make sure the Certificate in the Secret is not nearing expiry
+		SecretPrivateKeyMismatchesSpec,                       // Make sure the PrivateKey Type and Size match the Certificate spec
+		SecretPublicKeyDiffersFromCurrentCertificateRequest,  // Make sure the Secret's PublicKey matches the current CertificateRequest
+		SecretPublicKeyDiffersFromExternalCSR,                // Make sure the Secret's PublicKey matches the external CSR
+		CurrentCertificateRequestMismatchesSpec,              // Make sure the current CertificateRequest matches the Certificate spec
+		CurrentCertificateNearingExpiry(c, maxRenewalJitter), // Make sure the Certificate in the Secret is not nearing expiry
 	}
 }
 
@@ -118,6 +143,40 @@ func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string)
 		SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager),
 
 		SecretKeystoreFormatMismatch,
+		SecretKeystoreFingerprintMismatch,
+
+		TrustConfigMapDataMismatch,                                                      // Make sure the TrustConfigMap exists and has the published data
+		TrustConfigMapDataManagedFieldsMismatch(fieldManager),                           // Make sure only the expected data keys exist
+		TrustConfigMapOwnerReferenceMismatch(ownerRefEnabled),                           // Make sure the owner reference has the correct value
+		TrustConfigMapOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager), // Make sure the owner reference is only present if enabled
+	}
+}
+
+// NewSecretAdoptionPolicyChain includes policy checks which must all pass for
+// the existing Secret of a Certificate which has not yet been issued to be
+// adopted as the Certificate's first revision.
+func NewSecretAdoptionPolicyChain(c clock.Clock) Chain {
+	return Chain{
+		SecretDoesNotExist,     // Make sure the Secret exists
+		SecretIsMissingData,    // Make sure the Secret has the required keys set
+		SecretPublicKeysDiffer, // Make sure the PrivateKey and PublicKey match in the Secret
+
+		SecretCertificateNameAnnotationsMismatch, // Make sure the Secret has not been issued for another Certificate
+
+		SecretPrivateKeyMismatchesSpec,        // Make sure the PrivateKey Type and Size match the Certificate spec
+		SecretCertificateMismatchesSpec,       // Make sure the certificate in the Secret matches the Certificate spec
+		CurrentCertificateNearingExpiry(c, 0), // Make sure the certificate in the Secret is not due for renewal
+	}
+}
+
+// NewSecretReplicaPolicyChain includes policy checks which are performed on
+// each replica of a Certificate's Secret, to detect replicas which have
+// drifted from the Certificate's Secret.
+func NewSecretReplicaPolicyChain() Chain {
+	return Chain{
+		SecretReplicaDoesNotExist,     // Make sure the replica exists
+		SecretReplicaDataMismatch,     // Make sure the replica has the same data as the Secret
+		SecretReplicaMetadataMismatch, // Make sure the replica has the managed labels and annotations of the Secret
 	}
 }
 
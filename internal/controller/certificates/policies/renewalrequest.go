@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"fmt"
+)
+
+// CertificateRenewalRequested is a policy which checks whether the
+// Certificate has a manual renewal request in spec.renewalRequest which has
+// not yet been handled, i.e. whose ID differs from the ID recorded in
+// status.lastRenewalRequest.
+func CertificateRenewalRequested(input Input) (string, string, bool) {
+	request := input.Certificate.Spec.RenewalRequest
+	if request == nil {
+		return "", "", false
+	}
+	if last := input.Certificate.Status.LastRenewalRequest; last != nil && last.ID == request.ID {
+		return "", "", false
+	}
+
+	requestedBy := request.RequestedBy
+	if requestedBy == "" {
+		requestedBy = "unknown user"
+	}
+	message := fmt.Sprintf("Renewal %q requested by %s: %s", request.ID, requestedBy, request.Reason)
+	if request.RotatePrivateKey {
+		message += " (private key rotation requested)"
+	}
+	return RenewalRequested, message, true
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func Test_CertificateRenewalRequested(t *testing.T) {
+	tests := map[string]struct {
+		request         *cmapi.CertificateRenewalRequest
+		lastRequest     *cmapi.CertificateRenewalRequestStatus
+		expectedMessage string
+		expectViolation bool
+	}{
+		"if no renewal has been requested, should return no violation": {},
+		"if a renewal has been requested for the first time, should return a violation": {
+			request:         &cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "alice"},
+			expectedMessage: `Renewal "1" requested by alice: test`,
+			expectViolation: true,
+		},
+		"if a new renewal has been requested, should return a violation": {
+			request:         &cmapi.CertificateRenewalRequest{ID: "2", Reason: "test", RequestedBy: "alice", RotatePrivateKey: true},
+			lastRequest:     &cmapi.CertificateRenewalRequestStatus{ID: "1"},
+			expectedMessage: `Renewal "2" requested by alice: test (private key rotation requested)`,
+			expectViolation: true,
+		},
+		"if the renewal request has already been handled, should return no violation": {
+			request:     &cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "alice"},
+			lastRequest: &cmapi.CertificateRenewalRequestStatus{ID: "1"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message, violation := CertificateRenewalRequested(Input{Certificate: &cmapi.Certificate{
+				Spec:   cmapi.CertificateSpec{RenewalRequest: test.request},
+				Status: cmapi.CertificateStatus{LastRenewalRequest: test.lastRequest},
+			}})
+			assert.Equal(t, test.expectViolation, violation)
+			assert.Equal(t, test.expectedMessage, message)
+			if test.expectViolation {
+				assert.Equal(t, RenewalRequested, reason)
+			}
+		})
+	}
+}
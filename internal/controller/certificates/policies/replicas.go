@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+)
+
+// SecretReplicaDoesNotExist is a policy which checks that the replica of the
+// Certificate's Secret exists.
+func SecretReplicaDoesNotExist(input Input) (string, string, bool) {
+	if input.SecretReplica == nil {
+		return SecretReplicaMissing, "Secret replica does not exist", true
+	}
+	return "", "", false
+}
+
+// SecretReplicaDataMismatch is a policy which checks that the replica of the
+// Certificate's Secret contains exactly the same data as the Certificate's
+// Secret.
+func SecretReplicaDataMismatch(input Input) (string, string, bool) {
+	var mismatched []string
+	for k, v := range input.Secret.Data {
+		if replicaValue, ok := input.SecretReplica.Data[k]; !ok || !bytes.Equal(v, replicaValue) {
+			mismatched = append(mismatched, k)
+		}
+	}
+	for k := range input.SecretReplica.Data {
+		if _, ok := input.Secret.Data[k]; !ok {
+			mismatched = append(mismatched, k)
+		}
+	}
+
+	if len(mismatched) > 0 {
+		slices.Sort(mismatched)
+		return SecretReplicaMismatch, fmt.Sprintf("Secret replica data does not match the Secret for keys: %v", mismatched), true
+	}
+	return "", "", false
+}
+
+// SecretReplicaMetadataMismatch is a policy which checks that the replica of
+// the Certificate's Secret has all of the labels and annotations which are
+// replicated from the Certificate's Secret.
+func SecretReplicaMetadataMismatch(input Input) (string, string, bool) {
+	desired := internalcertificates.SecretReplicaFor(input.Certificate, input.Secret, input.SecretReplica.Namespace)
+
+	for k, v := range desired.Labels {
+		if replicaValue, ok := input.SecretReplica.Labels[k]; !ok || replicaValue != v {
+			return SecretReplicaMismatch, fmt.Sprintf("Secret replica label %q does not match the Secret", k), true
+		}
+	}
+	for k, v := range desired.Annotations {
+		if replicaValue, ok := input.SecretReplica.Annotations[k]; !ok || replicaValue != v {
+			return SecretReplicaMismatch, fmt.Sprintf("Secret replica annotation %q does not match the Secret", k), true
+		}
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func Test_NewSecretReplicaPolicyChain(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+		Spec: cmapi.CertificateSpec{
+			SecretName:     "test-secret",
+			SecretTemplate: &cmapi.CertificateSecretTemplate{Labels: map[string]string{"foo": "bar"}},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test-namespace",
+			Name:        "test-secret",
+			Labels:      map[string]string{"foo": "bar"},
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test-name"},
+		},
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+	}
+	replica := internalcertificates.SecretReplicaFor(crt, secret, "other-namespace")
+
+	tests := map[string]struct {
+		replica         *corev1.Secret
+		expectedReason  string
+		expectedMessage string
+		expectViolation bool
+	}{
+		"if the replica does not exist, should return a violation": {
+			replica:         nil,
+			expectedReason:  SecretReplicaMissing,
+			expectedMessage: "Secret replica does not exist",
+			expectViolation: true,
+		},
+		"if the replica is up to date, should not return a violation": {
+			replica: replica,
+		},
+		"if the replica has extra labels and annotations, should not return a violation": {
+			replica: func() *corev1.Secret {
+				replica := replica.DeepCopy()
+				replica.Labels["extra"] = "label"
+				replica.Annotations["extra"] = "annotation"
+				return replica
+			}(),
+		},
+		"if the replica has different data, should return a violation": {
+			replica: func() *corev1.Secret {
+				replica := replica.DeepCopy()
+				replica.Data[corev1.TLSCertKey] = []byte("other")
+				return replica
+			}(),
+			expectedReason:  SecretReplicaMismatch,
+			expectedMessage: "Secret replica data does not match the Secret for keys: [tls.crt]",
+			expectViolation: true,
+		},
+		"if the replica has extra data, should return a violation": {
+			replica: func() *corev1.Secret {
+				replica := replica.DeepCopy()
+				replica.Data["extra"] = []byte("data")
+				return replica
+			}(),
+			expectedReason:  SecretReplicaMismatch,
+			expectedMessage: "Secret replica data does not match the Secret for keys: [extra]",
+			expectViolation: true,
+		},
+		"if the replica is missing a SecretTemplate label, should return a violation": {
+			replica: func() *corev1.Secret {
+				replica := replica.DeepCopy()
+				delete(replica.Labels, "foo")
+				return replica
+			}(),
+			expectedReason:  SecretReplicaMismatch,
+			expectedMessage: `Secret replica label "foo" does not match the Secret`,
+			expectViolation: true,
+		},
+		"if the replica has a different cert-manager annotation, should return a violation": {
+			replica: func() *corev1.Secret {
+				replica := replica.DeepCopy()
+				replica.Annotations[cmapi.CertificateNameKey] = "other"
+				return replica
+			}(),
+			expectedReason:  SecretReplicaMismatch,
+			expectedMessage: `Secret replica annotation "cert-manager.io/certificate-name" does not match the Secret`,
+			expectViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message, violation := NewSecretReplicaPolicyChain().Evaluate(Input{
+				Certificate:   crt,
+				Secret:        secret,
+				SecretReplica: test.replica,
+			})
+			assert.Equal(t, test.expectedReason, reason)
+			assert.Equal(t, test.expectedMessage, message)
+			assert.Equal(t, test.expectViolation, violation)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// trustConfigMapData returns the data which is expected in the Certificate's
+// TrustConfigMap, based on the contents of the Certificate's Secret.
+func trustConfigMapData(input Input) map[string]string {
+	return internalcertificates.TrustConfigMapData(input.Certificate,
+		input.Secret.Data[cmmeta.TLSCAKey], input.Secret.Data[corev1.TLSCertKey])
+}
+
+// TrustConfigMapDataMismatch validates that the Certificate's TrustConfigMap,
+// if one is configured, exists and contains the expected data from the
+// Certificate's Secret.
+// NOTE: Extra data is detected by the TrustConfigMapDataManagedFieldsMismatch
+// function instead.
+func TrustConfigMapDataMismatch(input Input) (string, string, bool) {
+	if input.Certificate.Spec.TrustConfigMap == nil {
+		return "", "", false
+	}
+
+	if input.ConfigMap == nil {
+		return TrustConfigMapMismatch, fmt.Sprintf("ConfigMap %q does not exist", input.Certificate.Spec.TrustConfigMap.Name), true
+	}
+
+	if input.ConfigMap.Labels[cmapi.PartOfCertManagerControllerLabelKey] != "true" {
+		return TrustConfigMapMismatch, fmt.Sprintf("ConfigMap is missing the %s label", cmapi.PartOfCertManagerControllerLabelKey), true
+	}
+
+	for k, v := range trustConfigMapData(input) {
+		if configMapValue, ok := input.ConfigMap.Data[k]; !ok || configMapValue != v {
+			return TrustConfigMapMismatch, fmt.Sprintf("ConfigMap data %q does not match the Secret", k), true
+		}
+	}
+
+	return "", "", false
+}
+
+// TrustConfigMapDataManagedFieldsMismatch validates that the field manager
+// owns exactly the expected data keys of the Certificate's TrustConfigMap, so
+// that keys which are no longer published are removed.
+// A violation with the reason `ManagedFieldsParseError` should be considered a
+// non re-triable error.
+func TrustConfigMapDataManagedFieldsMismatch(fieldManager string) Func {
+	return func(input Input) (string, string, bool) {
+		if input.Certificate.Spec.TrustConfigMap == nil || input.ConfigMap == nil {
+			return "", "", false
+		}
+
+		managedKeys := sets.New[string]()
+		for _, managedField := range input.ConfigMap.ManagedFields {
+			if managedField.Manager != fieldManager || managedField.FieldsV1 == nil {
+				continue
+			}
+
+			var fieldset fieldpath.Set
+			if err := fieldset.FromJSON(managedField.FieldsV1.GetRawReader()); err != nil {
+				return ManagedFieldsParseError, fmt.Sprintf("failed to decode managed fields on ConfigMap: %s", err), true
+			}
+
+			data := fieldset.Children.Descend(fieldpath.PathElement{FieldName: new("data")})
+			data.Iterate(func(path fieldpath.Path) {
+				managedKeys.Insert(*path[0].FieldName)
+			})
+		}
+
+		expKeys := sets.KeySet(trustConfigMapData(input))
+		if extraKeys := managedKeys.Difference(expKeys); len(extraKeys) > 0 {
+			return TrustConfigMapMismatch, fmt.Sprintf("ConfigMap has these extra data keys: %v", sets.List(extraKeys)), true
+		}
+
+		return "", "", false
+	}
+}
+
+// TrustConfigMapOwnerReferenceMismatch validates that the Certificate's
+// TrustConfigMap has the expected owner reference if it is enabled.
+func TrustConfigMapOwnerReferenceMismatch(ownerRefEnabled bool) Func {
+	return func(input Input) (string, string, bool) {
+		if input.Certificate.Spec.TrustConfigMap == nil || input.ConfigMap == nil {
+			return "", "", false
+		}
+		return ownerReferenceMismatch(ownerRefEnabled, input.Certificate, input.ConfigMap, "ConfigMap", TrustConfigMapOwnerRefMismatch)
+	}
+}
+
+// TrustConfigMapOwnerReferenceManagedFieldMismatch validates that the
+// Certificate's TrustConfigMap has an owner reference to the Certificate
+// owned by the field manager if and only if owner references are enabled.
+// A violation with the reason `ManagedFieldsParseError` should be considered a
+// non re-triable error.
+func TrustConfigMapOwnerReferenceManagedFieldMismatch(ownerRefEnabled bool, fieldManager string) Func {
+	return func(input Input) (string, string, bool) {
+		if input.Certificate.Spec.TrustConfigMap == nil || input.ConfigMap == nil {
+			return "", "", false
+		}
+		return ownerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager, input.Certificate, input.ConfigMap, "ConfigMap", TrustConfigMapOwnerRefMismatch)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func Test_TrustConfigMapPolicies(t *testing.T) {
+	const fieldManager = "cert-manager-unit-test"
+
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name", UID: "test-uid"},
+		Spec: cmapi.CertificateSpec{
+			SecretName:     "test-secret",
+			TrustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "test-configmap"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+		Data:       map[string][]byte{"ca.crt": []byte("ca"), "tls.crt": []byte("cert")},
+	}
+	ownerRef := *metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind("Certificate"))
+	configMap := func(managedFields string, data map[string]string, ownerRefs ...metav1.OwnerReference) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "test-namespace",
+				Name:            "test-configmap",
+				Labels:          map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+				OwnerReferences: ownerRefs,
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: fieldManager, FieldsV1: metav1.NewFieldsV1(managedFields)},
+				},
+			},
+			Data: data,
+		}
+	}
+
+	tests := map[string]struct {
+		certificate     *cmapi.Certificate
+		configMap       *corev1.ConfigMap
+		ownerRefEnabled bool
+
+		expectedReason  string
+		expectedMessage string
+		expectViolation bool
+	}{
+		"if the Certificate has no TrustConfigMap, should not return a violation": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "test-secret"}},
+		},
+		"if the ConfigMap does not exist, should return a violation": {
+			certificate:     crt,
+			expectedReason:  TrustConfigMapMismatch,
+			expectedMessage: `ConfigMap "test-configmap" does not exist`,
+			expectViolation: true,
+		},
+		"if the ConfigMap is up to date, should not return a violation": {
+			certificate: crt,
+			configMap:   configMap(`{"f:data": {"f:ca.crt": {}}}`, map[string]string{"ca.crt": "ca"}),
+		},
+		"if the ConfigMap has other data not owned by the field manager, should not return a violation": {
+			certificate: crt,
+			configMap:   configMap(`{"f:data": {"f:ca.crt": {}}}`, map[string]string{"ca.crt": "ca", "other": "data"}),
+		},
+		"if the ConfigMap has the wrong CA, should return a violation": {
+			certificate:     crt,
+			configMap:       configMap(`{"f:data": {"f:ca.crt": {}}}`, map[string]string{"ca.crt": "other"}),
+			expectedReason:  TrustConfigMapMismatch,
+			expectedMessage: `ConfigMap data "ca.crt" does not match the Secret`,
+			expectViolation: true,
+		},
+		"if the ConfigMap is missing the base label, should return a violation": {
+			certificate: crt,
+			configMap: func() *corev1.ConfigMap {
+				cm := configMap(`{"f:data": {"f:ca.crt": {}}}`, map[string]string{"ca.crt": "ca"})
+				cm.Labels = nil
+				return cm
+			}(),
+			expectedReason:  TrustConfigMapMismatch,
+			expectedMessage: "ConfigMap is missing the controller.cert-manager.io/fao label",
+			expectViolation: true,
+		},
+		"if the ConfigMap has data which is no longer published owned by the field manager, should return a violation": {
+			certificate:     crt,
+			configMap:       configMap(`{"f:data": {"f:ca.crt": {}, "f:tls.crt": {}}}`, map[string]string{"ca.crt": "ca", "tls.crt": "cert"}),
+			expectedReason:  TrustConfigMapMismatch,
+			expectedMessage: "ConfigMap has these extra data keys: [tls.crt]",
+			expectViolation: true,
+		},
+		"if owner references are enabled and the ConfigMap has no owner reference, should return a violation": {
+			certificate:     crt,
+			configMap:       configMap(`{"f:data": {"f:ca.crt": {}}}`, map[string]string{"ca.crt": "ca"}),
+			ownerRefEnabled: true,
+			expectedReason:  TrustConfigMapOwnerRefMismatch,
+			expectedMessage: "unexpected ConfigMap Owner Reference value on ConfigMap --enable-certificate-owner-ref=true",
+			expectViolation: true,
+		},
+		"if owner references are enabled and the ConfigMap has a managed owner reference, should not return a violation": {
+			certificate: crt,
+			configMap: configMap(`{"f:data": {"f:ca.crt": {}}, "f:metadata": {"f:ownerReferences": {"k:{\"uid\":\"test-uid\"}": {}}}}`,
+				map[string]string{"ca.crt": "ca"}, ownerRef),
+			ownerRefEnabled: true,
+		},
+		"if owner references are disabled and the ConfigMap has a managed owner reference, should return a violation": {
+			certificate: crt,
+			configMap: configMap(`{"f:data": {"f:ca.crt": {}}, "f:metadata": {"f:ownerReferences": {"k:{\"uid\":\"test-uid\"}": {}}}}`,
+				map[string]string{"ca.crt": "ca"}, ownerRef),
+			expectedReason:  TrustConfigMapOwnerRefMismatch,
+			expectedMessage: "unexpected managed ConfigMap Owner Reference field on ConfigMap --enable-certificate-owner-ref=false",
+			expectViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			chain := Chain{
+				TrustConfigMapDataMismatch,
+				TrustConfigMapDataManagedFieldsMismatch(fieldManager),
+				TrustConfigMapOwnerReferenceMismatch(test.ownerRefEnabled),
+				TrustConfigMapOwnerReferenceManagedFieldMismatch(test.ownerRefEnabled, fieldManager),
+			}
+			reason, message, violation := chain.Evaluate(Input{
+				Certificate: test.certificate,
+				Secret:      secret,
+				ConfigMap:   test.configMap,
+			})
+			assert.Equal(t, test.expectedReason, reason)
+			assert.Equal(t, test.expectedMessage, message)
+			assert.Equal(t, test.expectViolation, violation)
+		})
+	}
+}
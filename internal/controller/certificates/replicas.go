@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// SecretReplicaOf returns the value of the SecretReplicaOfAnnotationKey
+// annotation which is set on replicas of the given Certificate's Secret.
+func SecretReplicaOf(crt *cmapi.Certificate) string {
+	return crt.Namespace + "/" + crt.Name
+}
+
+// SecretReplicasAllowedFrom returns true if the given Namespace has opted in
+// to receiving Secret replicas from Certificates in the namespace `from`,
+// using the AllowSecretReplicasFromAnnotationKey annotation.
+func SecretReplicasAllowedFrom(namespace *corev1.Namespace, from string) bool {
+	value, ok := namespace.Annotations[cmapi.AllowSecretReplicasFromAnnotationKey]
+	if !ok {
+		return false
+	}
+	for allowed := range strings.SplitSeq(value, ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == from {
+			return true
+		}
+	}
+	return false
+}
+
+// SecretReplicaFor returns the desired replica of the Certificate's Secret in
+// the given namespace. The replica contains the same data as the
+// Certificate's Secret, along with the cert-manager managed annotations and
+// the labels and annotations of the Certificate's SecretTemplate. Replicas
+// are always labelled as part of cert-manager so that they are cached.
+func SecretReplicaFor(crt *cmapi.Certificate, secret *corev1.Secret, namespace string) *corev1.Secret {
+	replica := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        secret.Name,
+			Namespace:   namespace,
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+		Data: maps.Clone(secret.Data),
+		Type: secret.Type,
+	}
+
+	var templateLabels, templateAnnotations map[string]string
+	if crt.Spec.SecretTemplate != nil {
+		templateLabels = crt.Spec.SecretTemplate.Labels
+		templateAnnotations = crt.Spec.SecretTemplate.Annotations
+	}
+
+	for k, v := range secret.Labels {
+		if _, ok := templateLabels[k]; ok {
+			replica.Labels[k] = v
+		}
+	}
+	replica.Labels[cmapi.PartOfCertManagerControllerLabelKey] = "true"
+	for k, v := range secret.Annotations {
+		if _, ok := templateAnnotations[k]; ok || strings.HasPrefix(k, "cert-manager.io/") {
+			replica.Annotations[k] = v
+		}
+	}
+	replica.Annotations[cmapi.SecretReplicaOfAnnotationKey] = SecretReplicaOf(crt)
+
+	return replica
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestSecretReplicasAllowedFrom(t *testing.T) {
+	tests := map[string]struct {
+		annotations map[string]string
+		from        string
+		expected    bool
+	}{
+		"no annotation": {
+			from:     "ns",
+			expected: false,
+		},
+		"wildcard": {
+			annotations: map[string]string{cmapi.AllowSecretReplicasFromAnnotationKey: "*"},
+			from:        "ns",
+			expected:    true,
+		},
+		"listed namespace": {
+			annotations: map[string]string{cmapi.AllowSecretReplicasFromAnnotationKey: "other, ns"},
+			from:        "ns",
+			expected:    true,
+		},
+		"unlisted namespace": {
+			annotations: map[string]string{cmapi.AllowSecretReplicasFromAnnotationKey: "other,ns-2"},
+			from:        "ns",
+			expected:    false,
+		},
+		"empty annotation": {
+			annotations: map[string]string{cmapi.AllowSecretReplicasFromAnnotationKey: ""},
+			from:        "ns",
+			expected:    false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "target", Annotations: test.annotations}}
+			assert.Equal(t, test.expected, SecretReplicasAllowedFrom(namespace, test.from))
+		})
+	}
+}
+
+func TestSecretReplicaFor(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "crt"},
+		Spec: cmapi.CertificateSpec{
+			SecretTemplate: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"template-label": "a"},
+				Annotations: map[string]string{"template-annotation": "b"},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "secret",
+			Labels:    map[string]string{"template-label": "a", "other-label": "c"},
+			Annotations: map[string]string{
+				"template-annotation":    "b",
+				"other-annotation":       "d",
+				cmapi.CertificateNameKey: "crt",
+			},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("cert")},
+	}
+
+	assert.Equal(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "target",
+			Name:      "secret",
+			Labels: map[string]string{
+				"template-label": "a",
+				cmapi.PartOfCertManagerControllerLabelKey: "true",
+			},
+			Annotations: map[string]string{
+				"template-annotation":              "b",
+				cmapi.CertificateNameKey:           "crt",
+				cmapi.SecretReplicaOfAnnotationKey: "ns/crt",
+			},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("cert")},
+	}, SecretReplicaFor(crt, secret, "target"))
+}
@@ -20,8 +20,11 @@ import (
 	"bytes"
 	"crypto/x509"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmutil "github.com/cert-manager/cert-manager/pkg/util"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -102,3 +105,17 @@ func OutputFormatDER(privateKey []byte) []byte {
 func OutputFormatCombinedPEM(privateKey, certificate []byte) []byte {
 	return bytes.Join([][]byte{privateKey, certificate}, []byte("\n"))
 }
+
+// TrustConfigMapData returns the data of the ConfigMap which the CA, and
+// optionally the certificate, of the Certificate's Secret are published to
+// using the Certificate's TrustConfigMap. The CA is omitted if it is empty.
+func TrustConfigMapData(crt *cmapi.Certificate, ca, certificate []byte) map[string]string {
+	data := make(map[string]string)
+	if len(ca) > 0 {
+		data[cmmeta.TLSCAKey] = string(ca)
+	}
+	if crt.Spec.TrustConfigMap != nil && crt.Spec.TrustConfigMap.IncludeCertificate && len(certificate) > 0 {
+		data[corev1.TLSCertKey] = string(certificate)
+	}
+	return data
+}
@@ -41,13 +41,6 @@ const (
 	// FeatureName featuregate.Feature = "FeatureName"
 	// =========================== END TEMPLATE ===========================
 
-	// Owner: N/A
-	// Alpha: v1.4
-	//
-	// ExperimentalCertificateSigningRequestControllers enables all CertificateSigningRequest
-	// controllers that sign Kubernetes CertificateSigningRequest resources
-	ExperimentalCertificateSigningRequestControllers featuregate.Feature = "ExperimentalCertificateSigningRequestControllers"
-
 	// Owner: N/A
 	// Alpha: v1.5
 	// Beta: v1.15
@@ -105,6 +98,8 @@ const (
 	// Secrets are labelled with controller.cert-manager.io/fao label. Users
 	// can also label other Secrets, such as issuer credentials Secrets that
 	// they know cert-manager will need to access, to speed up issuance.
+	// ConfigMaps, such as those containing the external CSR of a
+	// Certificate, are filtered the same way.
 	// See https://github.com/cert-manager/cert-manager/blob/master/design/20221205-memory-management.md
 	SecretsFilteredCaching featuregate.Feature = "SecretsFilteredCaching"
 
@@ -158,6 +153,19 @@ const (
 	// and only prints a log line if added.
 	ValidateCAA featuregate.Feature = "ValidateCAA"
 
+	// Owner: N/A
+	// Alpha: v1.4
+	// Flag removed: v1.22
+	//
+	// ExperimentalCertificateSigningRequestControllers is a now-removed feature gate which
+	// enabled the controllers that sign Kubernetes CertificateSigningRequest resources.
+	// These controllers are now enabled by default, and can be disabled like any other
+	// controller using the `controllers` option.
+	// The feature gate is still defined here so that users who specify the feature gate aren't
+	// hit with "unknown feature gate" errors which crash the controller, but this is a no-op
+	// and only prints a log line if added.
+	ExperimentalCertificateSigningRequestControllers featuregate.Feature = "ExperimentalCertificateSigningRequestControllers"
+
 	// Owner: @wallrj
 	// Alpha: v1.18.0
 	// Beta: v1.18.0
@@ -221,19 +229,18 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	StableCertificateRequestName:       {Default: true, PreRelease: featuregate.Beta},
 	SecretsFilteredCaching:             {Default: true, PreRelease: featuregate.Beta},
 
-	ExperimentalCertificateSigningRequestControllers: {Default: false, PreRelease: featuregate.Alpha},
-	ExperimentalGatewayAPISupport:                    {Default: true, PreRelease: featuregate.Beta},
-	ListenerSets:                                     {Default: false, PreRelease: featuregate.Alpha},
-	AdditionalCertificateOutputFormats:               {Default: true, PreRelease: featuregate.GA},
-	ServerSideApply:                                  {Default: false, PreRelease: featuregate.Alpha},
-	LiteralCertificateSubject:                        {Default: true, PreRelease: featuregate.Beta},
-	UseCertificateRequestBasicConstraints:            {Default: false, PreRelease: featuregate.Alpha},
-	NameConstraints:                                  {Default: true, PreRelease: featuregate.Beta},
-	OtherNames:                                       {Default: true, PreRelease: featuregate.Beta},
-	UseDomainQualifiedFinalizer:                      {Default: true, PreRelease: featuregate.GA},
-	DefaultPrivateKeyRotationPolicyAlways:            {Default: true, PreRelease: featuregate.GA},
-	ACMEHTTP01IngressPathTypeExact:                   {Default: true, PreRelease: featuregate.Beta},
-	ACMEUseARI:                                       {Default: false, PreRelease: featuregate.Alpha},
+	ExperimentalGatewayAPISupport:         {Default: true, PreRelease: featuregate.Beta},
+	ListenerSets:                          {Default: false, PreRelease: featuregate.Alpha},
+	AdditionalCertificateOutputFormats:    {Default: true, PreRelease: featuregate.GA},
+	ServerSideApply:                       {Default: false, PreRelease: featuregate.Alpha},
+	LiteralCertificateSubject:             {Default: true, PreRelease: featuregate.Beta},
+	UseCertificateRequestBasicConstraints: {Default: false, PreRelease: featuregate.Alpha},
+	NameConstraints:                       {Default: true, PreRelease: featuregate.Beta},
+	OtherNames:                            {Default: true, PreRelease: featuregate.Beta},
+	UseDomainQualifiedFinalizer:           {Default: true, PreRelease: featuregate.GA},
+	DefaultPrivateKeyRotationPolicyAlways: {Default: true, PreRelease: featuregate.GA},
+	ACMEHTTP01IngressPathTypeExact:        {Default: true, PreRelease: featuregate.Beta},
+	ACMEUseARI:                            {Default: false, PreRelease: featuregate.Alpha},
 
 	// NB: Deprecated + removed feature gates are kept here.
 	// `featuregate.Deprecated` exists, but will cause the featuregate library
@@ -247,4 +254,5 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	// > E...] "error executing command" err="failed to set feature gates from initial flags-based config: unrecognized feature gate: ValidateCAA" logger="cert-manager"
 	// So we leave it here, set to alpha.
 	ValidateCAA: {Default: false, PreRelease: featuregate.Alpha},
+	ExperimentalCertificateSigningRequestControllers: {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -19,6 +19,7 @@ package informers
 import (
 	corev1 "k8s.io/api/core/v1"
 	certificatesv1 "k8s.io/client-go/informers/certificates/v1"
+	corev1informers "k8s.io/client-go/informers/core/v1"
 	networkingv1informers "k8s.io/client-go/informers/networking/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -30,7 +31,10 @@ import (
 // - create smaller interfaces that don't have methods that our control loops don't need (thus avoid defining unnecessary methods in implementations)
 // - swap embedded upstream interfaces for our own ones
 
-var secretsGVR = corev1.SchemeGroupVersion.WithResource("secrets")
+var (
+	secretsGVR    = corev1.SchemeGroupVersion.WithResource("secrets")
+	configMapsGVR = corev1.SchemeGroupVersion.WithResource("configmaps")
+)
 
 const pleaseOpenIssue = "Please report this by opening an issue with this error and cert-manager controller logs and stack trace https://github.com/cert-manager/cert-manager/issues/new/choose"
 
@@ -48,6 +52,19 @@ type KubeInformerFactory interface {
 	Ingresses() networkingv1informers.IngressInformer
 	Secrets() SecretInformer
 	CertificateSigningRequests() certificatesv1.CertificateSigningRequestInformer
+	Namespaces() corev1informers.NamespaceInformer
+	ConfigMaps() ConfigMapInformer
+}
+
+// ConfigMapInformer is like client-go ConfigMapInformer
+// https://github.com/kubernetes/client-go/blob/release-1.26/informers/core/v1/configmap.go#L35-L40
+// but embeds our own Informer interface, so that ConfigMaps can be cached the
+// same way as Secrets.
+type ConfigMapInformer interface {
+	// Informer ensures that an Informer has been initialized and returns the initialized informer.
+	Informer() Informer
+	// Lister returns a lister for the initialized informer. It will also ensure that the informer exists.
+	Lister() corev1listers.ConfigMapLister
 }
 
 // SecretInformer is like client-go SecretInformer
@@ -74,4 +91,10 @@ type Informer interface {
 	// HasSynced returns true if the informer's cache has synced (at least
 	// one LIST has been performed)
 	HasSynced() bool
+	// AddIndexers adds indexers to the informer's cache of typed objects.
+	// When Secrets are filtered, only the Secrets which are labelled as
+	// being part of cert-manager are indexed.
+	AddIndexers(indexers cache.Indexers) error
+	// GetIndexer returns the informer's cache of typed objects.
+	GetIndexer() cache.Indexer
 }
@@ -83,6 +83,14 @@ func (bf *baseFactory) CertificateSigningRequests() certificatesv1.CertificateSi
 	return bf.f.Certificates().V1().CertificateSigningRequests()
 }
 
+func (bf *baseFactory) Namespaces() corev1informers.NamespaceInformer {
+	return bf.f.Core().V1().Namespaces()
+}
+
+func (bf *baseFactory) ConfigMaps() ConfigMapInformer {
+	return &baseConfigMapInformer{f: bf.f.Core().V1().ConfigMaps()}
+}
+
 var _ SecretInformer = &baseSecretInformer{}
 
 // baseSecretInformer is an implementation of SecretInformer that only uses
@@ -105,3 +113,19 @@ func (bsi *baseSecretInformer) Lister() SecretLister {
 func (bsi *baseSecretInformer) new(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
 	return corev1informers.NewSecretInformer(client, bsi.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 }
+
+var _ ConfigMapInformer = &baseConfigMapInformer{}
+
+// baseConfigMapInformer is an implementation of ConfigMapInformer that only
+// uses upstream client-go functionality
+type baseConfigMapInformer struct {
+	f corev1informers.ConfigMapInformer
+}
+
+func (bci *baseConfigMapInformer) Informer() Informer {
+	return bci.f.Informer()
+}
+
+func (bci *baseConfigMapInformer) Lister() corev1listers.ConfigMapLister {
+	return bci.f.Lister()
+}
@@ -128,6 +128,154 @@ func (bf *filteredSecretsFactory) CertificateSigningRequests() certificatesv1.Ce
 	return bf.typedInformerFactory.Certificates().V1().CertificateSigningRequests()
 }
 
+func (bf *filteredSecretsFactory) Namespaces() corev1informers.NamespaceInformer {
+	return bf.typedInformerFactory.Core().V1().Namespaces()
+}
+
+// ConfigMaps returns an informer which caches ConfigMaps the same way as
+// Secrets: ConfigMaps labelled as being part of cert-manager are cached in
+// full, and only the metadata of other ConfigMaps is cached. Unlabelled
+// ConfigMaps, such as those holding an external CSR, are retrieved from kube
+// apiserver when they are read.
+func (bf *filteredSecretsFactory) ConfigMaps() ConfigMapInformer {
+	f := func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return corev1informers.NewFilteredConfigMapInformer(client, bf.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(listOptions *metav1.ListOptions) {
+			listOptions.LabelSelector = isCertManageSecretLabelSelector.String()
+		})
+	}
+	return &filteredConfigMapInformer{
+		typedInformerFactory:    bf.typedInformerFactory,
+		metadataInformerFactory: bf.metadataInformerFactory,
+		typedClient:             bf.client.CoreV1(),
+		newTyped:                f,
+		ctx:                     bf.ctx,
+	}
+}
+
+var _ ConfigMapInformer = &filteredConfigMapInformer{}
+
+// filteredConfigMapInformer is an implementation of ConfigMapInformer that
+// uses two caches (typed and metadata) to list and watch ConfigMaps
+type filteredConfigMapInformer struct {
+	typedInformerFactory    kubeinformers.SharedInformerFactory
+	metadataInformerFactory metadatainformer.SharedInformerFactory
+	typedClient             typedcorev1.ConfigMapsGetter
+	newTyped                internalinterfaces.NewInformerFunc
+
+	// Go recommends to not store context in
+	// structs, but here we have no other way as we need to use root context inside
+	// Get whose signature is defined upstream and does not accept context
+	ctx context.Context
+}
+
+func (f *filteredConfigMapInformer) Informer() Informer {
+	typedInformer := f.typedInformerFactory.InformerFor(&corev1.ConfigMap{}, f.newTyped)
+
+	metadataInformer := f.metadataInformerFactory.ForResource(configMapsGVR).Informer()
+	if err := metadataInformer.SetTransform(partialMetadataRemoveAll); err != nil {
+		panic(fmt.Sprintf("internal error: error setting transformer on the metadata informer: %v", err))
+	}
+	return &informer{
+		typedInformer:    typedInformer,
+		metadataInformer: metadataInformer,
+	}
+}
+
+func (f *filteredConfigMapInformer) Lister() corev1listers.ConfigMapLister {
+	return &configMapLister{
+		typedLister:           corev1listers.NewConfigMapLister(f.typedInformerFactory.InformerFor(&corev1.ConfigMap{}, f.newTyped).GetIndexer()),
+		partialMetadataLister: metadatalister.New(f.metadataInformerFactory.ForResource(configMapsGVR).Informer().GetIndexer(), configMapsGVR),
+		typedClient:           f.typedClient,
+		ctx:                   f.ctx,
+	}
+}
+
+// configMapLister is an implementation of ConfigMapLister which gets and
+// lists ConfigMaps using a combination of typed and metadata caches and kube
+// apiserver, in the same way as secretLister.
+type configMapLister struct {
+	partialMetadataLister metadatalister.Lister
+	typedLister           corev1listers.ConfigMapLister
+	typedClient           typedcorev1.ConfigMapsGetter
+	// Go recommends to not store context in
+	// structs, but here we have no other way as we need to use root context inside
+	// Get whose signature is defined upstream and does not accept context
+	ctx context.Context
+}
+
+func (cl *configMapLister) List(selector labels.Selector) ([]*corev1.ConfigMap, error) {
+	return cl.list(cl.typedLister.List, cl.partialMetadataLister.List, selector)
+}
+
+func (cl *configMapLister) ConfigMaps(namespace string) corev1listers.ConfigMapNamespaceLister {
+	return &configMapNamespaceLister{configMapLister: cl, namespace: namespace}
+}
+
+// list returns the ConfigMaps matching the selector in both caches. The
+// ConfigMaps found in the metadata cache are retrieved from kube apiserver.
+func (cl *configMapLister) list(
+	typedList func(labels.Selector) ([]*corev1.ConfigMap, error),
+	metadataList func(labels.Selector) ([]*metav1.PartialObjectMetadata, error),
+	selector labels.Selector,
+) ([]*corev1.ConfigMap, error) {
+	configMaps, err := typedList(selector)
+	if err != nil {
+		return nil, fmt.Errorf("error listing ConfigMaps from typed cache: %w", err)
+	}
+	metadataConfigMaps, err := metadataList(selector)
+	if err != nil {
+		return nil, fmt.Errorf("error listing ConfigMaps from metadata only cache: %w", err)
+	}
+	for _, configMapMeta := range metadataConfigMaps {
+		configMap, err := cl.typedClient.ConfigMaps(configMapMeta.Namespace).Get(cl.ctx, configMapMeta.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving ConfigMap from kube apiserver: %w", err)
+		}
+		configMaps = append(configMaps, configMap)
+	}
+	return configMaps, nil
+}
+
+var _ corev1listers.ConfigMapNamespaceLister = &configMapNamespaceLister{}
+
+// configMapNamespaceLister is an implementation of
+// corelisters.ConfigMapNamespaceLister. It looks for ConfigMaps in both
+// caches, if the ConfigMap is found in the metadata cache, it will retrieve it
+// from kube apiserver.
+type configMapNamespaceLister struct {
+	*configMapLister
+	namespace string
+}
+
+func (cnl *configMapNamespaceLister) Get(name string) (*corev1.ConfigMap, error) {
+	configMap, err := cnl.typedLister.ConfigMaps(cnl.namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("error retrieving ConfigMap from the typed cache: %w", err)
+	}
+	// A ConfigMap which has just been labelled or unlabelled may briefly be
+	// in both caches, in which case it is retrieved from kube apiserver.
+	_, metadataErr := cnl.partialMetadataLister.Namespace(cnl.namespace).Get(name)
+	if metadataErr != nil && !apierrors.IsNotFound(metadataErr) {
+		return nil, fmt.Errorf("error retrieving object from partial object metadata cache: %w", metadataErr)
+	}
+	if metadataErr == nil {
+		return cnl.typedClient.ConfigMaps(cnl.namespace).Get(cnl.ctx, name, metav1.GetOptions{})
+	}
+	if err == nil {
+		return configMap, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Group: corev1.GroupName, Resource: "configmaps"}, name)
+}
+
+func (cnl *configMapNamespaceLister) List(selector labels.Selector) ([]*corev1.ConfigMap, error) {
+	return cnl.list(cnl.typedLister.ConfigMaps(cnl.namespace).List, func(selector labels.Selector) ([]*metav1.PartialObjectMetadata, error) {
+		return cnl.partialMetadataLister.Namespace(cnl.namespace).List(selector)
+	}, selector)
+}
+
 func (bf *filteredSecretsFactory) Secrets() SecretInformer {
 	f := func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
 		return corev1informers.NewFilteredSecretInformer(client, bf.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, func(listOptions *metav1.ListOptions) {
@@ -194,6 +342,16 @@ func (i *informer) HasSynced() bool {
 	return i.typedInformer.HasSynced() && i.metadataInformer.HasSynced()
 }
 
+// AddIndexers adds indexers to the typed informer only, as the metadata
+// informer does not hold the labels and annotations of Secrets.
+func (i *informer) AddIndexers(indexers cache.Indexers) error {
+	return i.typedInformer.AddIndexers(indexers)
+}
+
+func (i *informer) GetIndexer() cache.Indexer {
+	return i.typedInformer.GetIndexer()
+}
+
 func (i *informer) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
 	_, err := i.metadataInformer.AddEventHandler(handler)
 	if err != nil {
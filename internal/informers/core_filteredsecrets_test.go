@@ -19,7 +19,9 @@ package informers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,9 +30,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	metadatafake "k8s.io/client-go/metadata/fake"
 	"k8s.io/client-go/metadata/metadatalister"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 func Test_secretNamespaceLister_Get(t *testing.T) {
@@ -449,3 +456,77 @@ func Test_secretNamespaceLister_List(t *testing.T) {
 		})
 	}
 }
+
+func Test_filteredSecretsFactory_ConfigMaps(t *testing.T) {
+	labelledConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "labelled", Namespace: "foo",
+			Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+		},
+		Data: map[string]string{"ca.crt": "ca"},
+	}
+	unlabelledConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: "foo"},
+		Data:       map[string]string{"csr.pem": "csr"},
+	}
+	partialMetadata := func(configMap *corev1.ConfigMap) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: configMap.ObjectMeta,
+		}
+	}
+
+	typedClient := kubefake.NewClientset(labelledConfigMap, unlabelledConfigMap)
+	scheme := metadatafake.NewTestScheme()
+	assert.NoError(t, metav1.AddMetaToScheme(scheme))
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, partialMetadata(labelledConfigMap), partialMetadata(unlabelledConfigMap))
+
+	factory := NewFilteredSecretsKubeInformerFactory(t.Context(), typedClient, metadataClient, 0, "")
+	configMapInformer := factory.ConfigMaps()
+
+	var (
+		lock        sync.Mutex
+		observedAdd []string
+	)
+	_, err := configMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			lock.Lock()
+			defer lock.Unlock()
+			observedAdd = append(observedAdd, fmt.Sprintf("%T %s", obj, obj.(metav1.Object).GetName()))
+		},
+	})
+	assert.NoError(t, err)
+	lister := configMapInformer.Lister()
+
+	stopCh := make(chan struct{})
+	factory.Start(stopCh)
+	defer factory.Shutdown()
+	defer close(stopCh)
+	for informer, synced := range factory.WaitForCacheSync(stopCh) {
+		assert.True(t, synced, "informer %s not synced", informer)
+	}
+
+	// Unlabelled ConfigMaps are observed through the metadata informer only.
+	lock.Lock()
+	assert.ElementsMatch(t, []string{
+		"*v1.ConfigMap labelled",
+		"*v1.PartialObjectMetadata unlabelled",
+	}, observedAdd)
+	lock.Unlock()
+
+	got, err := lister.ConfigMaps("foo").Get("labelled")
+	assert.NoError(t, err)
+	assert.Equal(t, labelledConfigMap, got)
+
+	// Unlabelled ConfigMaps are retrieved from kube apiserver.
+	got, err = lister.ConfigMaps("foo").Get("unlabelled")
+	assert.NoError(t, err)
+	assert.Equal(t, unlabelledConfigMap, got)
+
+	_, err = lister.ConfigMaps("foo").Get("missing")
+	assert.True(t, apierrors.IsNotFound(err), "expected a not found error, got: %v", err)
+
+	list, err := lister.List(labels.Everything())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*corev1.ConfigMap{labelledConfigMap, unlabelledConfigMap}, list)
+}
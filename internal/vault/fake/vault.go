@@ -18,6 +18,7 @@ limitations under the License.
 package fake
 
 import (
+	"net/url"
 	"time"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
@@ -27,16 +28,20 @@ import (
 // Vault is a mock implementation of the Vault interface
 type Vault struct {
 	NewFn                           func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*Vault, error)
-	SignFn                          func([]byte, time.Duration) ([]byte, []byte, error)
+	SignFn                          func([]byte, time.Duration, []*url.URL) ([]byte, []byte, error)
+	SignSSHFn                       func(string, time.Duration, *cmapi.SSHCertificateRequestSpec) ([]byte, error)
 	IsVaultInitializedAndUnsealedFn func() error
 }
 
 // New returns a new fake Vault
 func New() *Vault {
 	v := &Vault{
-		SignFn: func([]byte, time.Duration) ([]byte, []byte, error) {
+		SignFn: func([]byte, time.Duration, []*url.URL) ([]byte, []byte, error) {
 			return nil, nil, nil
 		},
+		SignSSHFn: func(string, time.Duration, *cmapi.SSHCertificateRequestSpec) ([]byte, error) {
+			return nil, nil
+		},
 		IsVaultInitializedAndUnsealedFn: func() error {
 			return nil
 		},
@@ -50,18 +55,31 @@ func New() *Vault {
 }
 
 // Sign implements `vault.Interface`.
-func (v *Vault) Sign(csrPEM []byte, duration time.Duration) ([]byte, []byte, error) {
-	return v.SignFn(csrPEM, duration)
+func (v *Vault) Sign(csrPEM []byte, duration time.Duration, uriSANs []*url.URL) ([]byte, []byte, error) {
+	return v.SignFn(csrPEM, duration, uriSANs)
 }
 
 // WithSign sets the fake Vault's Sign function.
 func (v *Vault) WithSign(certPEM, caPEM []byte, err error) *Vault {
-	v.SignFn = func([]byte, time.Duration) ([]byte, []byte, error) {
+	v.SignFn = func([]byte, time.Duration, []*url.URL) ([]byte, []byte, error) {
 		return certPEM, caPEM, err
 	}
 	return v
 }
 
+// SignSSH implements `vault.Interface`.
+func (v *Vault) SignSSH(keyID string, duration time.Duration, spec *cmapi.SSHCertificateRequestSpec) ([]byte, error) {
+	return v.SignSSHFn(keyID, duration, spec)
+}
+
+// WithSignSSH sets the fake Vault's SignSSH function.
+func (v *Vault) WithSignSSH(cert []byte, err error) *Vault {
+	v.SignSSHFn = func(string, time.Duration, *cmapi.SSHCertificateRequestSpec) ([]byte, error) {
+		return cert, err
+	}
+	return v
+}
+
 // WithNew sets the fake Vault's New function.
 func (v *Vault) WithNew(f func(string, internalinformers.SecretLister, cmapi.GenericIssuer) (*Vault, error)) *Vault {
 	v.NewFn = f
@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
 	"strings"
 	"time"
@@ -59,7 +60,8 @@ type ClientBuilder func(ctx context.Context, namespace string, _ func(ns string)
 // with a Vault server, verifying its status and signing certificate request for
 // Vault's certificate.
 type Interface interface {
-	Sign(csrPEM []byte, duration time.Duration) (certPEM []byte, caPEM []byte, err error)
+	Sign(csrPEM []byte, duration time.Duration, uriSANs []*url.URL) (certPEM []byte, caPEM []byte, err error)
+	SignSSH(keyID string, duration time.Duration, spec *v1.SSHCertificateRequestSpec) (cert []byte, err error)
 	IsVaultInitializedAndUnsealed() error
 }
 
@@ -159,17 +161,22 @@ func New(ctx context.Context, namespace string, createTokenFn func(ns string) Cr
 }
 
 // Sign will connect to a Vault instance to sign a certificate signing request.
-func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []byte, err error) {
+// If uriSANs is not nil, it is requested instead of the URI SANs of the CSR.
+func (v *Vault) Sign(csrPEM []byte, duration time.Duration, uriSANs []*url.URL) (cert []byte, ca []byte, err error) {
 	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to decode CSR for signing: %s", err)
 	}
 
+	if uriSANs == nil {
+		uriSANs = csr.URIs
+	}
+
 	parameters := map[string]string{
 		"common_name": csr.Subject.CommonName,
 		"alt_names":   strings.Join(csr.DNSNames, ","),
 		"ip_sans":     strings.Join(pki.IPAddressesToString(csr.IPAddresses), ","),
-		"uri_sans":    strings.Join(pki.URLsToString(csr.URIs), ","),
+		"uri_sans":    strings.Join(pki.URLsToString(uriSANs), ","),
 		"ttl":         duration.String(),
 		"csr":         string(csrPEM),
 
@@ -202,6 +209,65 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	return extractCertificatesFromVaultCertificateSecret(&vaultResult)
 }
 
+// SignSSH will connect to a Vault instance to sign the SSH public key of an
+// SSHCertificateRequest, using the SSH secrets engine mounted at the issuer's
+// `sshPath`. The signed certificate is returned in authorized_keys format.
+func (v *Vault) SignSSH(keyID string, duration time.Duration, spec *v1.SSHCertificateRequestSpec) ([]byte, error) {
+	vaultIssuer := v.issuer.GetSpec().Vault
+	if vaultIssuer.SSHPath == "" {
+		return nil, errors.New("vault issuer does not have an sshPath configured")
+	}
+
+	certType := "user"
+	if spec.Type == v1.SSHCertificateTypeHost {
+		certType = "host"
+	}
+
+	parameters := map[string]any{
+		"public_key":       string(spec.PublicKey),
+		"cert_type":        certType,
+		"key_id":           keyID,
+		"valid_principals": strings.Join(spec.Principals, ","),
+		"ttl":              duration.String(),
+	}
+	// When unset, the extensions and critical options default to the ones
+	// configured on the Vault role.
+	if spec.Extensions != nil {
+		parameters["extensions"] = spec.Extensions
+	}
+	if spec.CriticalOptions != nil {
+		parameters["critical_options"] = spec.CriticalOptions
+	}
+
+	url := path.Join("/v1", vaultIssuer.SSHPath)
+
+	request := v.client.NewRequest("POST", url)
+
+	if err := request.SetJSONBody(parameters); err != nil {
+		return nil, fmt.Errorf("failed to build vault request: %s", err)
+	}
+
+	resp, err := v.client.RawRequest(request)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign SSH public key by vault: %s", err)
+	}
+
+	vaultResult := vault.Secret{}
+	if err := resp.DecodeJSON(&vaultResult); err != nil {
+		return nil, fmt.Errorf("failed to decode response returned by vault: %s", err)
+	}
+
+	signedKey, ok := vaultResult.Data["signed_key"].(string)
+	if !ok || signedKey == "" {
+		return nil, errors.New("vault response does not contain a signed SSH key")
+	}
+
+	return []byte(signedKey), nil
+}
+
 func (v *Vault) setToken(ctx context.Context, client Client) error {
 	// IMPORTANT: Because of backwards compatibility with older versions that
 	// incorrectly allowed multiple authentication methods to be specified at
@@ -344,7 +344,7 @@ func TestSign(t *testing.T) {
 			client:        test.fakeClient,
 		}
 
-		cert, ca, err := v.Sign(test.csrPEM, time.Minute)
+		cert, ca, err := v.Sign(test.csrPEM, time.Minute, nil)
 		if ((test.expectedErr == nil) != (err == nil)) &&
 			test.expectedErr != nil &&
 			test.expectedErr.Error() != err.Error() {
@@ -377,6 +377,95 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignSSH(t *testing.T) {
+	spec := &cmapiv1.SSHCertificateRequestSpec{
+		Type:       cmapiv1.SSHCertificateTypeHost,
+		PublicKey:  []byte("ssh-ed25519 AAAA..."),
+		Principals: []string{"host.example.com", "10.0.0.1"},
+		CriticalOptions: map[string]string{
+			"source-address": "10.0.0.0/8",
+		},
+	}
+	signedKeyResponse, err := jsonutil.EncodeJSON(map[string]any{
+		"data": map[string]any{
+			"serial_number": "c73f26d2340276aa",
+			"signed_key":    "ssh-ed25519-cert-v01@openssh.com AAAA...\n",
+		},
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		issuer       *cmapiv1.Issuer
+		fakeClient   *vaultfake.FakeClient
+		expectedErr  string
+		expectedCert string
+	}{
+		"an issuer without an sshPath should error": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapiv1.VaultIssuer{Path: "pki/sign/role"}),
+			),
+			fakeClient:  vaultfake.NewFakeClient(),
+			expectedErr: "vault issuer does not have an sshPath configured",
+		},
+		"a failed request should error": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapiv1.VaultIssuer{SSHPath: "ssh/sign/role"}),
+			),
+			fakeClient:  vaultfake.NewFakeClient().WithRawRequest(nil, errors.New("request failed")),
+			expectedErr: "failed to sign SSH public key by vault: request failed",
+		},
+		"a response without a signed key should error": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapiv1.VaultIssuer{SSHPath: "ssh/sign/role"}),
+			),
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					Body: io.NopCloser(strings.NewReader(`{"data":{}}`))},
+			}, nil),
+			expectedErr: "vault response does not contain a signed SSH key",
+		},
+		"a good response should return the signed key": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapiv1.VaultIssuer{SSHPath: "ssh/sign/role"}),
+			),
+			fakeClient: vaultfake.NewFakeClient().WithRawRequestFn(func(t *testing.T, req *vault.Request) (*vault.Response, error) {
+				assert.Equal(t, map[string]any{
+					"public_key":       "ssh-ed25519 AAAA...",
+					"cert_type":        "host",
+					"key_id":           "default/test",
+					"valid_principals": "host.example.com,10.0.0.1",
+					"ttl":              "1h0m0s",
+					"critical_options": map[string]string{"source-address": "10.0.0.0/8"},
+				}, req.Obj)
+				return &vault.Response{
+					Response: &http.Response{
+						Body: io.NopCloser(bytes.NewReader(signedKeyResponse))},
+				}, nil
+			}),
+			expectedCert: "ssh-ed25519-cert-v01@openssh.com AAAA...\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.fakeClient.T = t
+			v := &Vault{
+				namespace: "test-namespace",
+				issuer:    test.issuer,
+				client:    test.fakeClient,
+			}
+
+			cert, err := v.SignSSH("default/test", time.Hour, spec)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCert, string(cert))
+		})
+	}
+}
+
 type testExtractCertificatesFromVaultCertT struct {
 	secret       *certutil.Secret
 	expectedCert string
@@ -1834,7 +1923,7 @@ func TestSignIntegration(t *testing.T) {
 		}, false)
 	require.NoError(t, err)
 
-	certPEM, caPEM, err := v.Sign(csrPEM, time.Hour)
+	certPEM, caPEM, err := v.Sign(csrPEM, time.Hour, nil)
 	require.NoError(t, err)
 	require.NotEmpty(t, certPEM)
 	require.NotEmpty(t, caPEM)
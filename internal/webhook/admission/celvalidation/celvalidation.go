@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package celvalidation contains an admission plugin which evaluates the CEL
+// validation rules of the webhook configuration against cert-manager
+// resources.
+package celvalidation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/namespaces"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+)
+
+// perCallCostLimit is the maximum cost of evaluating a single rule, which
+// bounds the time spent evaluating expressions over large objects. It matches
+// the per call limit of the Kubernetes API server.
+const perCallCostLimit = 1000000
+
+// PluginName is the name of this admission plugin.
+const PluginName = "CELValidation"
+
+type celValidation struct {
+	*admission.Handler
+
+	rules           []compiledRule
+	client          kubernetes.Interface
+	namespaceLister corelisters.NamespaceLister
+}
+
+type compiledRule struct {
+	resources []string
+	// selector is nil if the rule applies in all namespaces.
+	selector labels.Selector
+	program  cel.Program
+	message  string
+}
+
+var _ admission.ValidationInterface = &celValidation{}
+
+// NewPlugin compiles the given validation rules, returning an error if any
+// of them is not a valid CEL expression evaluating to a bool. The labels of
+// namespaces are read from the cache of the given lister, whose informer must
+// be started by the caller.
+func NewPlugin(rules []config.ValidationRule, client kubernetes.Interface, namespaceLister corelisters.NamespaceLister) (admission.Interface, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+
+	compiled := make([]compiledRule, 0, len(rules))
+	for i, rule := range rules {
+		ast, issues := env.Compile(rule.Expression)
+		if issues.Err() != nil {
+			return nil, fmt.Errorf("validationRules[%d]: invalid expression: %w", i, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("validationRules[%d]: expression must evaluate to a bool, got %s", i, ast.OutputType())
+		}
+		program, err := env.Program(ast, cel.CostLimit(perCallCostLimit))
+		if err != nil {
+			return nil, fmt.Errorf("validationRules[%d]: %w", i, err)
+		}
+
+		var selector labels.Selector
+		if rule.NamespaceSelector != nil {
+			if selector, err = metav1.LabelSelectorAsSelector(rule.NamespaceSelector); err != nil {
+				return nil, fmt.Errorf("validationRules[%d]: invalid namespaceSelector: %w", i, err)
+			}
+		}
+
+		message := rule.Message
+		if message == "" {
+			message = fmt.Sprintf("failed rule: %s", rule.Expression)
+		}
+
+		compiled = append(compiled, compiledRule{
+			resources: rule.Resources,
+			selector:  selector,
+			program:   program,
+			message:   message,
+		})
+	}
+
+	return &celValidation{
+		Handler:         admission.NewHandler(admissionv1.Create, admissionv1.Update),
+		rules:           compiled,
+		client:          client,
+		namespaceLister: namespaceLister,
+	}, nil
+}
+
+func (p *celValidation) Validate(ctx context.Context, request admissionv1.AdmissionRequest, _, _ runtime.Object) ([]string, error) {
+	if admission.IsResourceUnset(request.Resource) {
+		return nil, admission.ErrResourceUnset
+	}
+
+	// Only run this admission plugin for cert-manager.io resources
+	if request.Resource.Group != "cert-manager.io" || request.SubResource != "" {
+		return nil, nil
+	}
+
+	var rules []compiledRule
+	for _, rule := range p.rules {
+		if slices.Contains(rule.resources, request.Resource.Resource) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// Rules are evaluated against the resource as it was submitted, rather
+	// than the internal type used by the built-in validation.
+	vars := map[string]any{"oldObject": nil}
+	var obj map[string]any
+	if err := json.Unmarshal(request.Object.Raw, &obj); err != nil {
+		return nil, err
+	}
+	vars["object"] = obj
+	if request.Operation == admissionv1.Update && len(request.OldObject.Raw) > 0 {
+		var oldObj map[string]any
+		if err := json.Unmarshal(request.OldObject.Raw, &oldObj); err != nil {
+			return nil, err
+		}
+		vars["oldObject"] = oldObj
+	}
+
+	var namespaceLabels labels.Set
+	var errs []error
+	for _, rule := range rules {
+		if rule.selector != nil {
+			// Cluster scoped resources are not in any namespace, so are
+			// never selected by a namespaceSelector.
+			if request.Namespace == "" {
+				continue
+			}
+			if namespaceLabels == nil {
+				var err error
+				namespaceLabels, err = namespaces.Labels(ctx, p.namespaceLister, p.client, request.Namespace)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !rule.selector.Matches(namespaceLabels) {
+				continue
+			}
+		}
+
+		out, _, err := rule.program.ContextEval(ctx, vars)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: error evaluating rule: %w", rule.message, err))
+			continue
+		}
+		if out != types.True {
+			errs = append(errs, errors.New(rule.message))
+		}
+	}
+
+	return nil, utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celvalidation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func certificatesResource() metav1.GroupVersionResource {
+	return metav1.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+}
+
+func rawCertificate(t *testing.T, dnsNames ...string) runtime.RawExtension {
+	raw, err := json.Marshal(&cmapi.Certificate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "cert-manager.io/v1", Kind: "Certificate"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "testns"},
+		Spec:       cmapi.CertificateSpec{SecretName: "test", DNSNames: dnsNames},
+	})
+	require.NoError(t, err)
+	return runtime.RawExtension{Raw: raw}
+}
+
+func TestNewPlugin(t *testing.T) {
+	tests := map[string]struct {
+		rule    config.ValidationRule
+		wantErr string
+	}{
+		"valid expression": {
+			rule: config.ValidationRule{Resources: []string{"certificates"}, Expression: "has(object.spec.secretName)"},
+		},
+		"invalid expression": {
+			rule:    config.ValidationRule{Resources: []string{"certificates"}, Expression: "object.spec.("},
+			wantErr: "validationRules[0]: invalid expression",
+		},
+		"expression which does not evaluate to a bool": {
+			rule:    config.ValidationRule{Resources: []string{"certificates"}, Expression: "'abc'"},
+			wantErr: "validationRules[0]: expression must evaluate to a bool, got string",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := kubefake.NewClientset()
+			namespaces := kubeinformers.NewSharedInformerFactory(client, 0).Core().V1().Namespaces().Lister()
+			_, err := NewPlugin([]config.ValidationRule{test.rule}, client, namespaces)
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	internalNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "testns", Labels: map[string]string{"tier": "internal"}}}
+	otherNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "testns"}}
+
+	corpDNSNames := config.ValidationRule{
+		Resources: []string{"certificates"},
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"tier": "internal"},
+		},
+		Expression: "!has(object.spec.dnsNames) || object.spec.dnsNames.all(name, name.endsWith('.corp.example.com'))",
+		Message:    "dnsNames must end in .corp.example.com",
+	}
+	immutableDNSNames := config.ValidationRule{
+		Resources:  []string{"certificates"},
+		Expression: "oldObject == null || object.spec.dnsNames == oldObject.spec.dnsNames",
+	}
+
+	tests := map[string]struct {
+		rules       []config.ValidationRule
+		namespace   *corev1.Namespace
+		resource    metav1.GroupVersionResource
+		subResource string
+		operation   admissionv1.Operation
+		object      runtime.RawExtension
+		oldObject   runtime.RawExtension
+		wantErr     string
+	}{
+		"should allow a Certificate which satisfies the rule": {
+			rules:     []config.ValidationRule{corpDNSNames},
+			namespace: internalNamespace,
+			resource:  certificatesResource(),
+			operation: admissionv1.Create,
+			object:    rawCertificate(t, "a.corp.example.com"),
+		},
+		"should reject a Certificate which does not satisfy the rule with its message": {
+			rules:     []config.ValidationRule{corpDNSNames},
+			namespace: internalNamespace,
+			resource:  certificatesResource(),
+			operation: admissionv1.Create,
+			object:    rawCertificate(t, "a.corp.example.com", "example.com"),
+			wantErr:   "dnsNames must end in .corp.example.com",
+		},
+		"should allow a Certificate without dnsNames": {
+			rules:     []config.ValidationRule{corpDNSNames},
+			namespace: internalNamespace,
+			resource:  certificatesResource(),
+			operation: admissionv1.Create,
+			object:    rawCertificate(t),
+		},
+		"should not evaluate the rule in namespaces not matching its namespaceSelector": {
+			rules:     []config.ValidationRule{corpDNSNames},
+			namespace: otherNamespace,
+			resource:  certificatesResource(),
+			operation: admissionv1.Create,
+			object:    rawCertificate(t, "example.com"),
+		},
+		"should not evaluate the rule against other resources": {
+			rules:     []config.ValidationRule{corpDNSNames},
+			namespace: internalNamespace,
+			resource:  metav1.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "issuers"},
+			operation: admissionv1.Create,
+			object:    rawCertificate(t, "example.com"),
+		},
+		"should not evaluate the rule against subresources": {
+			rules:       []config.ValidationRule{corpDNSNames},
+			namespace:   internalNamespace,
+			resource:    certificatesResource(),
+			subResource: "status",
+			operation:   admissionv1.Update,
+			object:      rawCertificate(t, "example.com"),
+			oldObject:   rawCertificate(t, "example.com"),
+		},
+		"should set oldObject to null on create": {
+			rules:     []config.ValidationRule{immutableDNSNames},
+			namespace: otherNamespace,
+			resource:  certificatesResource(),
+			operation: admissionv1.Create,
+			object:    rawCertificate(t, "example.com"),
+		},
+		"should reject an update which does not satisfy a transition rule with the default message": {
+			rules:     []config.ValidationRule{immutableDNSNames},
+			namespace: otherNamespace,
+			resource:  certificatesResource(),
+			operation: admissionv1.Update,
+			object:    rawCertificate(t, "example.com"),
+			oldObject: rawCertificate(t, "example.org"),
+			wantErr:   "failed rule: oldObject == null || object.spec.dnsNames == oldObject.spec.dnsNames",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := kubefake.NewClientset(test.namespace)
+			factory := kubeinformers.NewSharedInformerFactory(client, 0)
+			p, err := NewPlugin(test.rules, client, factory.Core().V1().Namespaces().Lister())
+			require.NoError(t, err)
+			factory.Start(t.Context().Done())
+			factory.WaitForCacheSync(t.Context().Done())
+			t.Cleanup(factory.Shutdown)
+
+			request := admissionv1.AdmissionRequest{
+				Operation:   test.operation,
+				Resource:    test.resource,
+				SubResource: test.subResource,
+				Namespace:   "testns",
+				Object:      test.object,
+				OldObject:   test.oldObject,
+			}
+			_, err = p.(*celValidation).Validate(t.Context(), request, nil, nil)
+			if test.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.wantErr)
+			}
+		})
+	}
+}
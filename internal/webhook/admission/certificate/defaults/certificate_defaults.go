@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package defaults contains an admission plugin which applies the defaults of
+// the CertificateDefaultPolicies selecting a Certificate's namespace when the
+// Certificate is created.
+package defaults
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/namespaces"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+)
+
+// PluginName is the name of this admission plugin.
+const PluginName = "CertificateDefaults"
+
+type certificateDefaults struct {
+	*admission.Handler
+
+	cmClient        cmclient.Interface
+	kubeClient      kubernetes.Interface
+	policyLister    cmlisters.CertificateDefaultPolicyLister
+	policiesSynced  cache.InformerSynced
+	namespaceLister corelisters.NamespaceLister
+}
+
+var _ admission.MutationInterface = &certificateDefaults{}
+
+// NewPlugin returns the CertificateDefaults admission plugin. Policies and
+// namespaces are read from the caches of the given informers, which must be
+// started by the caller.
+func NewPlugin(cmClient cmclient.Interface, kubeClient kubernetes.Interface, policyInformer cminformers.CertificateDefaultPolicyInformer, namespaceLister corelisters.NamespaceLister) admission.Interface {
+	return &certificateDefaults{
+		Handler:         admission.NewHandler(admissionv1.Create),
+		cmClient:        cmClient,
+		kubeClient:      kubeClient,
+		policyLister:    policyInformer.Lister(),
+		policiesSynced:  policyInformer.Informer().HasSynced,
+		namespaceLister: namespaceLister,
+	}
+}
+
+func (p *certificateDefaults) Mutate(ctx context.Context, request admissionv1.AdmissionRequest, obj *unstructured.Unstructured) error {
+	if admission.IsResourceUnset(request.Resource) {
+		return admission.ErrResourceUnset
+	}
+
+	// Only run this admission plugin for Certificate CREATE operations
+	if request.Resource.Group != "cert-manager.io" ||
+		request.Resource.Resource != "certificates" ||
+		request.SubResource != "" ||
+		request.Operation != admissionv1.Create {
+		return nil
+	}
+
+	policies, err := p.selectingPolicies(ctx, request.Namespace)
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+
+	unstructuredSpec, _, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return err
+	}
+	var spec cmapi.CertificateSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredSpec, &spec); err != nil {
+		return err
+	}
+
+	defaulted := spec.DeepCopy()
+	for _, policy := range policies {
+		applyDefaults(defaulted, &policy.Spec)
+	}
+
+	// Only write the fields which have been defaulted, so that the patch
+	// returned to the API server is minimal.
+	for _, f := range []struct {
+		name     string
+		old, new any
+	}{
+		{"privateKey", spec.PrivateKey, defaulted.PrivateKey},
+		{"duration", spec.Duration, defaulted.Duration},
+		{"renewBeforePercentage", spec.RenewBeforePercentage, defaulted.RenewBeforePercentage},
+		{"secretTemplate", spec.SecretTemplate, defaulted.SecretTemplate},
+		{"revisionHistoryLimit", spec.RevisionHistoryLimit, defaulted.RevisionHistoryLimit},
+	} {
+		if reflect.DeepEqual(f.old, f.new) {
+			continue
+		}
+		value, err := toUnstructuredValue(f.new)
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(obj.Object, value, "spec", f.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectingPolicies returns the CertificateDefaultPolicies which select the
+// given namespace, sorted by name.
+func (p *certificateDefaults) selectingPolicies(ctx context.Context, namespace string) ([]*cmapi.CertificateDefaultPolicy, error) {
+	all, err := p.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaceLabels labels.Set
+	var policies []*cmapi.CertificateDefaultPolicy
+	for _, policy := range all {
+		if policy.Spec.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("CertificateDefaultPolicy %q has an invalid namespaceSelector: %w", policy.Name, err)
+			}
+			// Only look up the namespace if a policy needs its labels.
+			if namespaceLabels == nil {
+				namespaceLabels, err = namespaces.Labels(ctx, p.namespaceLister, p.kubeClient, namespace)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !selector.Matches(namespaceLabels) {
+				continue
+			}
+		}
+		policies = append(policies, policy)
+	}
+
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+	return policies, nil
+}
+
+// listPolicies lists the CertificateDefaultPolicies from the informer's
+// cache. Until the cache has synced, e.g. just after the webhook started or
+// while the CertificateDefaultPolicy CRD is not installed, they are listed
+// from the API server instead, and a missing CRD means there are no
+// policies.
+func (p *certificateDefaults) listPolicies(ctx context.Context) ([]*cmapi.CertificateDefaultPolicy, error) {
+	if p.policiesSynced() {
+		return p.policyLister.List(labels.Everything())
+	}
+
+	list, err := p.cmClient.CertmanagerV1().CertificateDefaultPolicies().List(ctx, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CertificateDefaultPolicies: %w", err)
+	}
+	policies := make([]*cmapi.CertificateDefaultPolicy, 0, len(list.Items))
+	for i := range list.Items {
+		policies = append(policies, &list.Items[i])
+	}
+	return policies, nil
+}
+
+// applyDefaults sets the defaults of the policy on the fields of the
+// Certificate spec which are not set.
+func applyDefaults(spec *cmapi.CertificateSpec, defaults *cmapi.CertificateDefaultPolicySpec) {
+	// The private key of Certificates with an external CSR is not managed
+	// by cert-manager, and privateKey must not be set.
+	if defaults.PrivateKey != nil && spec.ExternalCSR == nil {
+		var privateKey cmapi.CertificatePrivateKey
+		if spec.PrivateKey != nil {
+			privateKey = *spec.PrivateKey
+		}
+		applyPrivateKeyDefaults(&privateKey, defaults.PrivateKey)
+		if spec.PrivateKey != nil || privateKey != (cmapi.CertificatePrivateKey{}) {
+			spec.PrivateKey = &privateKey
+		}
+	}
+
+	// A defaulted duration must be longer than an explicitly set
+	// renewBefore.
+	if spec.Duration == nil && defaults.Duration != nil &&
+		(spec.RenewBefore == nil || spec.RenewBefore.Duration < defaults.Duration.Duration) {
+		spec.Duration = defaults.Duration.DeepCopy()
+	}
+
+	// renewBefore and renewBeforePercentage are mutually exclusive.
+	if spec.RenewBefore == nil && spec.RenewBeforePercentage == nil && defaults.RenewBeforePercentage != nil {
+		spec.RenewBeforePercentage = ptr.To(*defaults.RenewBeforePercentage)
+	}
+
+	if defaults.SecretTemplate != nil {
+		var secretTemplate cmapi.CertificateSecretTemplate
+		if spec.SecretTemplate != nil {
+			secretTemplate = *spec.SecretTemplate
+		}
+		secretTemplate.Labels = mergeDefaults(secretTemplate.Labels, defaults.SecretTemplate.Labels)
+		secretTemplate.Annotations = mergeDefaults(secretTemplate.Annotations, defaults.SecretTemplate.Annotations)
+		if spec.SecretTemplate != nil || len(secretTemplate.Labels) > 0 || len(secretTemplate.Annotations) > 0 {
+			spec.SecretTemplate = &secretTemplate
+		}
+	}
+
+	if spec.RevisionHistoryLimit == nil && defaults.RevisionHistoryLimit != nil {
+		spec.RevisionHistoryLimit = ptr.To(*defaults.RevisionHistoryLimit)
+	}
+}
+
+func applyPrivateKeyDefaults(privateKey, defaults *cmapi.CertificatePrivateKey) {
+	if privateKey.RotationPolicy == "" {
+		privateKey.RotationPolicy = defaults.RotationPolicy
+	}
+	if privateKey.Encoding == "" {
+		privateKey.Encoding = defaults.Encoding
+	}
+	if privateKey.Algorithm == "" {
+		privateKey.Algorithm = defaults.Algorithm
+	}
+	// A default size is only valid for the default algorithm, where an
+	// unset algorithm means RSA.
+	if privateKey.Size == 0 && keyAlgorithm(privateKey.Algorithm) == keyAlgorithm(defaults.Algorithm) {
+		privateKey.Size = defaults.Size
+	}
+	if privateKey.RotationOverlap == nil && defaults.RotationOverlap != nil {
+		privateKey.RotationOverlap = defaults.RotationOverlap.DeepCopy()
+	}
+}
+
+func keyAlgorithm(algorithm cmapi.PrivateKeyAlgorithm) cmapi.PrivateKeyAlgorithm {
+	if algorithm == "" {
+		return cmapi.RSAKeyAlgorithm
+	}
+	return algorithm
+}
+
+// mergeDefaults returns values with the keys of defaults which it does not
+// contain added.
+func mergeDefaults(values, defaults map[string]string) map[string]string {
+	for k, v := range defaults {
+		if _, ok := values[k]; ok {
+			continue
+		}
+		if values == nil {
+			values = make(map[string]string, len(defaults))
+		}
+		values[k] = v
+	}
+	return values
+}
+
+// toUnstructuredValue converts a defaulted Certificate spec field to its
+// unstructured representation.
+func toUnstructuredValue(value any) (any, error) {
+	switch v := value.(type) {
+	case *metav1.Duration:
+		return v.Duration.String(), nil
+	case *int32:
+		return int64(*v), nil
+	default:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(v)
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+)
+
+var correctResource = metav1.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	scheme := runtime.NewScheme()
+	if err := cmapi.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unstr := unstructured.Unstructured{}
+	if err := scheme.Convert(obj, &unstr, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return &unstr
+}
+
+// newPlugin returns the plugin for the given clients. If start is true, its
+// informers are started and synced before it is returned.
+func newPlugin(t *testing.T, cmClient *cmfake.Clientset, kubeClient *kubefake.Clientset, start bool) *certificateDefaults {
+	kubeFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	cmFactory := cminformers.NewSharedInformerFactory(cmClient, 0)
+	plugin := NewPlugin(cmClient, kubeClient,
+		cmFactory.Certmanager().V1().CertificateDefaultPolicies(),
+		kubeFactory.Core().V1().Namespaces().Lister(),
+	).(*certificateDefaults)
+
+	if start {
+		kubeFactory.Start(t.Context().Done())
+		cmFactory.Start(t.Context().Done())
+		kubeFactory.WaitForCacheSync(t.Context().Done())
+		cmFactory.WaitForCacheSync(t.Context().Done())
+		t.Cleanup(kubeFactory.Shutdown)
+		t.Cleanup(cmFactory.Shutdown)
+	}
+	return plugin
+}
+
+func TestMutate(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "testns", Labels: map[string]string{"team": "a"}}}
+
+	policy := func(name string, spec cmapi.CertificateDefaultPolicySpec) *cmapi.CertificateDefaultPolicy {
+		return &cmapi.CertificateDefaultPolicy{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+	}
+	orgDefaults := policy("org", cmapi.CertificateDefaultPolicySpec{
+		PrivateKey: &cmapi.CertificatePrivateKey{
+			Algorithm:      cmapi.ECDSAKeyAlgorithm,
+			Size:           384,
+			RotationPolicy: cmapi.RotationPolicyAlways,
+		},
+		Duration:              &metav1.Duration{Duration: 30 * 24 * time.Hour},
+		RenewBeforePercentage: new(int32(25)),
+		SecretTemplate: &cmapi.CertificateSecretTemplate{
+			Labels: map[string]string{"org": "example", "team": "platform"},
+		},
+		RevisionHistoryLimit: new(int32(3)),
+	})
+
+	tests := map[string]struct {
+		operation   admissionv1.Operation
+		subResource string
+		policies    []runtime.Object
+		spec        cmapi.CertificateSpec
+		expected    cmapi.CertificateSpec
+	}{
+		"should not default if there are no policies": {
+			operation: admissionv1.Create,
+			spec:      cmapi.CertificateSpec{SecretName: "abc"},
+			expected:  cmapi.CertificateSpec{SecretName: "abc"},
+		},
+		"should not default on update": {
+			operation: admissionv1.Update,
+			policies:  []runtime.Object{orgDefaults},
+			spec:      cmapi.CertificateSpec{SecretName: "abc"},
+			expected:  cmapi.CertificateSpec{SecretName: "abc"},
+		},
+		"should default unset fields on create": {
+			operation: admissionv1.Create,
+			policies:  []runtime.Object{orgDefaults},
+			spec:      cmapi.CertificateSpec{SecretName: "abc"},
+			expected: cmapi.CertificateSpec{
+				SecretName: "abc",
+				PrivateKey: &cmapi.CertificatePrivateKey{
+					Algorithm:      cmapi.ECDSAKeyAlgorithm,
+					Size:           384,
+					RotationPolicy: cmapi.RotationPolicyAlways,
+				},
+				Duration:              &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				RenewBeforePercentage: new(int32(25)),
+				SecretTemplate: &cmapi.CertificateSecretTemplate{
+					Labels: map[string]string{"org": "example", "team": "platform"},
+				},
+				RevisionHistoryLimit: new(int32(3)),
+			},
+		},
+		"should not override fields set on the Certificate": {
+			operation: admissionv1.Create,
+			policies:  []runtime.Object{orgDefaults},
+			spec: cmapi.CertificateSpec{
+				SecretName:           "abc",
+				PrivateKey:           &cmapi.CertificatePrivateKey{Algorithm: cmapi.RSAKeyAlgorithm},
+				Duration:             &metav1.Duration{Duration: 48 * time.Hour},
+				RenewBefore:          &metav1.Duration{Duration: 24 * time.Hour},
+				SecretTemplate:       &cmapi.CertificateSecretTemplate{Labels: map[string]string{"team": "app"}},
+				RevisionHistoryLimit: new(int32(1)),
+			},
+			expected: cmapi.CertificateSpec{
+				SecretName: "abc",
+				// The size is not defaulted as it is only valid for ECDSA.
+				PrivateKey:           &cmapi.CertificatePrivateKey{Algorithm: cmapi.RSAKeyAlgorithm, RotationPolicy: cmapi.RotationPolicyAlways},
+				Duration:             &metav1.Duration{Duration: 48 * time.Hour},
+				RenewBefore:          &metav1.Duration{Duration: 24 * time.Hour},
+				SecretTemplate:       &cmapi.CertificateSecretTemplate{Labels: map[string]string{"org": "example", "team": "app"}},
+				RevisionHistoryLimit: new(int32(1)),
+			},
+		},
+		"should not default the duration if it is not longer than renewBefore": {
+			operation: admissionv1.Create,
+			policies: []runtime.Object{policy("org", cmapi.CertificateDefaultPolicySpec{
+				Duration: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			})},
+			spec: cmapi.CertificateSpec{
+				SecretName:  "abc",
+				RenewBefore: &metav1.Duration{Duration: 60 * 24 * time.Hour},
+			},
+			expected: cmapi.CertificateSpec{
+				SecretName:  "abc",
+				RenewBefore: &metav1.Duration{Duration: 60 * 24 * time.Hour},
+			},
+		},
+		"should not default the private key of Certificates with an external CSR": {
+			operation: admissionv1.Create,
+			policies:  []runtime.Object{policy("org", cmapi.CertificateDefaultPolicySpec{PrivateKey: orgDefaults.Spec.PrivateKey})},
+			spec: cmapi.CertificateSpec{
+				SecretName:  "abc",
+				ExternalCSR: &cmapi.CertificateExternalCSR{Name: "csr"},
+			},
+			expected: cmapi.CertificateSpec{
+				SecretName:  "abc",
+				ExternalCSR: &cmapi.CertificateExternalCSR{Name: "csr"},
+			},
+		},
+		"should apply policies in order of name and skip policies not selecting the namespace": {
+			operation: admissionv1.Create,
+			policies: []runtime.Object{
+				policy("b", cmapi.CertificateDefaultPolicySpec{
+					RevisionHistoryLimit: new(int32(5)),
+					Duration:             &metav1.Duration{Duration: 10 * 24 * time.Hour},
+				}),
+				policy("a", cmapi.CertificateDefaultPolicySpec{
+					NamespaceSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+					RevisionHistoryLimit: new(int32(2)),
+				}),
+				policy("0-other-team", cmapi.CertificateDefaultPolicySpec{
+					NamespaceSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+					RevisionHistoryLimit: new(int32(9)),
+				}),
+			},
+			spec: cmapi.CertificateSpec{SecretName: "abc"},
+			expected: cmapi.CertificateSpec{
+				SecretName:           "abc",
+				Duration:             &metav1.Duration{Duration: 10 * 24 * time.Hour},
+				RevisionHistoryLimit: new(int32(2)),
+			},
+		},
+		"should ignore subresources": {
+			operation:   admissionv1.Create,
+			subResource: "status",
+			policies:    []runtime.Object{orgDefaults},
+			spec:        cmapi.CertificateSpec{SecretName: "abc"},
+			expected:    cmapi.CertificateSpec{SecretName: "abc"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plugin := newPlugin(t, cmfake.NewClientset(test.policies...), kubefake.NewClientset(namespace), true)
+
+			request := admissionv1.AdmissionRequest{
+				Operation:   test.operation,
+				Resource:    correctResource,
+				SubResource: test.subResource,
+				Namespace:   namespace.Name,
+			}
+			obj := toUnstructured(t, &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace.Name},
+				Spec:       test.spec,
+			})
+			assert.NoError(t, plugin.Mutate(t.Context(), request, obj))
+
+			var crt cmapi.Certificate
+			assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &crt))
+			assert.Equal(t, test.expected, crt.Spec)
+		})
+	}
+}
+
+func TestMutateBeforeCacheSync(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "testns", Labels: map[string]string{"team": "a"}}}
+	policy := &cmapi.CertificateDefaultPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a"},
+		Spec: cmapi.CertificateDefaultPolicySpec{
+			NamespaceSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			RevisionHistoryLimit: new(int32(2)),
+		},
+	}
+
+	tests := map[string]struct {
+		policies   []runtime.Object
+		missingCRD bool
+		expected   cmapi.CertificateSpec
+	}{
+		"should read policies and namespaces from the API server": {
+			policies: []runtime.Object{policy},
+			expected: cmapi.CertificateSpec{SecretName: "abc", RevisionHistoryLimit: new(int32(2))},
+		},
+		"should not default if the CertificateDefaultPolicy CRD is not installed": {
+			missingCRD: true,
+			expected:   cmapi.CertificateSpec{SecretName: "abc"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmClient := cmfake.NewClientset(test.policies...)
+			if test.missingCRD {
+				cmClient.PrependReactor("list", "certificatedefaultpolicies", func(coretesting.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: "cert-manager.io", Resource: "certificatedefaultpolicies"}, "")
+				})
+			}
+			plugin := newPlugin(t, cmClient, kubefake.NewClientset(namespace), false)
+
+			request := admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Resource:  correctResource,
+				Namespace: namespace.Name,
+			}
+			obj := toUnstructured(t, &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace.Name},
+				Spec:       cmapi.CertificateSpec{SecretName: "abc"},
+			})
+			assert.NoError(t, plugin.Mutate(t.Context(), request, obj))
+
+			var crt cmapi.Certificate
+			assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &crt))
+			assert.Equal(t, test.expected, crt.Spec)
+		})
+	}
+}
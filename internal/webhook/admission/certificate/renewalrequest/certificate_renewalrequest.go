@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package renewalrequest contains an admission plugin which records the
+// identity of the user who requested a manual renewal of a Certificate using
+// `spec.renewalRequest`.
+package renewalrequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+)
+
+// PluginName is the name of this admission plugin.
+const PluginName = "CertificateRenewalRequest"
+
+type certificateRenewalRequest struct {
+	*admission.Handler
+}
+
+var _ admission.ValidationInterface = &certificateRenewalRequest{}
+var _ admission.MutationInterface = &certificateRenewalRequest{}
+
+func NewPlugin() admission.Interface {
+	return &certificateRenewalRequest{
+		Handler: admission.NewHandler(admissionv1.Create, admissionv1.Update),
+	}
+}
+
+func (p *certificateRenewalRequest) Mutate(ctx context.Context, request admissionv1.AdmissionRequest, obj *unstructured.Unstructured) error {
+	if admission.IsResourceUnset(request.Resource) {
+		return admission.ErrResourceUnset
+	}
+
+	// Only run this admission plugin for Certificate resources
+	if request.Resource.Group != "cert-manager.io" ||
+		request.Resource.Resource != "certificates" ||
+		request.SubResource != "" {
+		return nil
+	}
+
+	renewalRequest, found, err := unstructured.NestedMap(obj.Object, "spec", "renewalRequest")
+	if err != nil || !found {
+		return err
+	}
+
+	var oldRenewalRequest map[string]any
+	if request.Operation == admissionv1.Update && len(request.OldObject.Raw) > 0 {
+		var oldObj map[string]any
+		if err := json.Unmarshal(request.OldObject.Raw, &oldObj); err != nil {
+			return err
+		}
+		if oldRenewalRequest, _, err = unstructured.NestedMap(oldObj, "spec", "renewalRequest"); err != nil {
+			return err
+		}
+	}
+
+	// Record the requester if this is a new renewal request, otherwise
+	// preserve the requester of the existing renewal request.
+	requestedBy := request.UserInfo.Username
+	if oldRenewalRequest != nil && !renewalRequestChanged(oldRenewalRequest, renewalRequest) {
+		requestedBy, _, _ = unstructured.NestedString(oldRenewalRequest, "requestedBy")
+	}
+
+	return unstructured.SetNestedField(obj.Object, requestedBy, "spec", "renewalRequest", "requestedBy")
+}
+
+func (p *certificateRenewalRequest) Validate(ctx context.Context, request admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+	if admission.IsResourceUnset(request.Resource) {
+		return nil, admission.ErrResourceUnset
+	}
+
+	// Only run this admission plugin for Certificate resources
+	if request.Resource.Group != "cert-manager.io" ||
+		request.Resource.Resource != "certificates" ||
+		request.SubResource != "" {
+		return nil, nil
+	}
+
+	crt, ok := obj.(*certmanager.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.Certificate")
+	}
+	if crt.Spec.RenewalRequest == nil {
+		return nil, nil
+	}
+
+	var oldRenewalRequest *certmanager.CertificateRenewalRequest
+	switch request.Operation {
+	case admissionv1.Create:
+	case admissionv1.Update:
+		oldCrt, ok := oldObj.(*certmanager.Certificate)
+		if !ok {
+			return nil, fmt.Errorf("internal error: oldObject in admission request is not of type *certmanager.Certificate")
+		}
+		oldRenewalRequest = oldCrt.Spec.RenewalRequest
+	default:
+		return nil, fmt.Errorf("internal error: request operation has changed - this should never be possible")
+	}
+
+	fldPath := field.NewPath("spec", "renewalRequest", "requestedBy")
+
+	var el field.ErrorList
+	if oldRenewalRequest == nil || oldRenewalRequest.ID != crt.Spec.RenewalRequest.ID ||
+		oldRenewalRequest.Reason != crt.Spec.RenewalRequest.Reason ||
+		oldRenewalRequest.RotatePrivateKey != crt.Spec.RenewalRequest.RotatePrivateKey {
+		if crt.Spec.RenewalRequest.RequestedBy != request.UserInfo.Username {
+			el = append(el, field.Forbidden(fldPath, "requestedBy must be that of the requester"))
+		}
+	} else if oldRenewalRequest.RequestedBy != crt.Spec.RenewalRequest.RequestedBy {
+		el = append(el, field.Forbidden(fldPath, "requestedBy cannot be changed once set"))
+	}
+	return nil, el.ToAggregate()
+}
+
+// renewalRequestChanged returns true if any field other than requestedBy
+// differs between the two unstructured renewal requests.
+func renewalRequestChanged(oldRenewalRequest, renewalRequest map[string]any) bool {
+	for _, key := range []string{"id", "reason", "rotatePrivateKey"} {
+		if !reflect.DeepEqual(oldRenewalRequest[key], renewalRequest[key]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package renewalrequest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var correctResource = metav1.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	scheme := runtime.NewScheme()
+	if err := cmapi.AddToScheme(scheme); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unstr := unstructured.Unstructured{}
+	if err := scheme.Convert(obj, &unstr, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return &unstr
+}
+
+func TestMutate(t *testing.T) {
+	certificate := func(renewalRequest *cmapi.CertificateRenewalRequest) *cmapi.Certificate {
+		return &cmapi.Certificate{Spec: cmapi.CertificateSpec{RenewalRequest: renewalRequest}}
+	}
+
+	tests := map[string]struct {
+		operation           admissionv1.Operation
+		subResource         string
+		oldObj              *cmapi.Certificate
+		obj                 *cmapi.Certificate
+		expectedRequestedBy *string
+	}{
+		"should not set requestedBy if there is no renewal request": {
+			operation: admissionv1.Create,
+			obj:       certificate(nil),
+		},
+		"should set requestedBy on create": {
+			operation:           admissionv1.Create,
+			obj:                 certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "someone-else"}),
+			expectedRequestedBy: new("testuser"),
+		},
+		"should set requestedBy when a renewal request is added": {
+			operation:           admissionv1.Update,
+			oldObj:              certificate(nil),
+			obj:                 certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test"}),
+			expectedRequestedBy: new("testuser"),
+		},
+		"should set requestedBy when the renewal request changes": {
+			operation:           admissionv1.Update,
+			oldObj:              certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			obj:                 certificate(&cmapi.CertificateRenewalRequest{ID: "2", Reason: "test", RequestedBy: "olduser"}),
+			expectedRequestedBy: new("testuser"),
+		},
+		"should preserve requestedBy when the renewal request does not change": {
+			operation:           admissionv1.Update,
+			oldObj:              certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			obj:                 certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "testuser"}),
+			expectedRequestedBy: new("olduser"),
+		},
+		"should ignore status updates": {
+			operation:           admissionv1.Update,
+			subResource:         "status",
+			oldObj:              certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			obj:                 certificate(&cmapi.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			expectedRequestedBy: new("olduser"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := admissionv1.AdmissionRequest{
+				Operation:   test.operation,
+				Resource:    correctResource,
+				SubResource: test.subResource,
+				UserInfo:    authenticationv1.UserInfo{Username: "testuser"},
+			}
+			if test.oldObj != nil {
+				raw, err := json.Marshal(test.oldObj)
+				assert.NoError(t, err)
+				request.OldObject = runtime.RawExtension{Raw: raw}
+			}
+
+			obj := toUnstructured(t, test.obj)
+			assert.NoError(t, NewPlugin().(*certificateRenewalRequest).Mutate(t.Context(), request, obj))
+
+			requestedBy, found, err := unstructured.NestedString(obj.Object, "spec", "renewalRequest", "requestedBy")
+			assert.NoError(t, err)
+			if test.expectedRequestedBy == nil {
+				assert.False(t, found)
+			} else {
+				assert.Equal(t, *test.expectedRequestedBy, requestedBy)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	fldPath := field.NewPath("spec", "renewalRequest", "requestedBy")
+	certificate := func(renewalRequest *certmanager.CertificateRenewalRequest) *certmanager.Certificate {
+		return &certmanager.Certificate{Spec: certmanager.CertificateSpec{RenewalRequest: renewalRequest}}
+	}
+
+	tests := map[string]struct {
+		operation   admissionv1.Operation
+		oldObj, obj *certmanager.Certificate
+		expectedErr error
+	}{
+		"should allow no renewal request": {
+			operation: admissionv1.Create,
+			obj:       certificate(nil),
+		},
+		"should allow a renewal request made by the requester": {
+			operation: admissionv1.Create,
+			obj:       certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "testuser"}),
+		},
+		"should not allow a renewal request made by another user": {
+			operation:   admissionv1.Create,
+			obj:         certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "someone-else"}),
+			expectedErr: field.ErrorList{field.Forbidden(fldPath, "requestedBy must be that of the requester")}.ToAggregate(),
+		},
+		"should not allow a changed renewal request made by another user": {
+			operation:   admissionv1.Update,
+			oldObj:      certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			obj:         certificate(&certmanager.CertificateRenewalRequest{ID: "2", Reason: "test", RequestedBy: "olduser"}),
+			expectedErr: field.ErrorList{field.Forbidden(fldPath, "requestedBy must be that of the requester")}.ToAggregate(),
+		},
+		"should allow other updates to a Certificate with an unchanged renewal request": {
+			operation: admissionv1.Update,
+			oldObj:    certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			obj:       certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+		},
+		"should not allow requestedBy to be changed": {
+			operation:   admissionv1.Update,
+			oldObj:      certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "olduser"}),
+			obj:         certificate(&certmanager.CertificateRenewalRequest{ID: "1", Reason: "test", RequestedBy: "testuser"}),
+			expectedErr: field.ErrorList{field.Forbidden(fldPath, "requestedBy cannot be changed once set")}.ToAggregate(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var oldObj runtime.Object
+			if test.oldObj != nil {
+				oldObj = test.oldObj
+			}
+			warnings, err := NewPlugin().(*certificateRenewalRequest).Validate(t.Context(), admissionv1.AdmissionRequest{
+				Operation: test.operation,
+				Resource:  correctResource,
+				UserInfo:  authenticationv1.UserInfo{Username: "testuser"},
+			}, oldObj, test.obj)
+			assert.Empty(t, warnings)
+			assert.Equal(t, test.expectedErr, err)
+		})
+	}
+}
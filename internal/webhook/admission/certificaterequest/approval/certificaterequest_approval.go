@@ -18,7 +18,8 @@ package approval
 
 // CertificateRequestApproval is a plugin that ensures entities that are attempting to
 // modify `status.conditions[type="Approved"]` or `status.conditions[type="Denied"]`
-// have permission to do so (granted via RBAC).
+// of CertificateRequests and SSHCertificateRequests have permission to do so
+// (granted via RBAC).
 // Entities will need to be able to `approve` (verb) `signers` (resource type) in
 // `cert-manager.io` (group) with the name `<issuer-type>.<issuer-group>/[<certificaterequest-namespace>.]<issuer-name>`.
 // For example: `issuers.cert-manager.io/my-namespace.my-issuer-name`.
@@ -40,11 +41,15 @@ import (
 
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
 	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
 )
 
 const negativeCacheTTL = 30 * time.Second
 
+// PluginName is the name of this admission plugin.
+const PluginName = "CertificateRequestApproval"
+
 type certificateRequestApproval struct {
 	*admission.Handler
 
@@ -84,26 +89,47 @@ func (c *certificateRequestApproval) Validate(ctx context.Context, request admis
 		return nil, admission.ErrResourceUnset
 	}
 
-	if request.Resource.Group != "cert-manager.io" ||
-		request.Resource.Resource != "certificaterequests" ||
-		request.SubResource != "status" {
+	if request.Resource.Group != "cert-manager.io" || request.SubResource != "status" {
 		return nil, nil
 	}
 
-	cr, ok := obj.(*certmanager.CertificateRequest)
-	if !ok {
-		return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.CertificateRequest")
-	}
-	oldCR, ok := oldObj.(*certmanager.CertificateRequest)
-	if !ok {
-		return nil, fmt.Errorf("internal error: oldObject in admission request is not of type *certmanager.CertificateRequest")
+	var (
+		issuerRef          cmmeta.IssuerReference
+		namespace          string
+		oldConds, newConds []certmanager.CertificateRequestCondition
+	)
+	switch request.Resource.Resource {
+	case "certificaterequests":
+		cr, ok := obj.(*certmanager.CertificateRequest)
+		if !ok {
+			return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.CertificateRequest")
+		}
+		oldCR, ok := oldObj.(*certmanager.CertificateRequest)
+		if !ok {
+			return nil, fmt.Errorf("internal error: oldObject in admission request is not of type *certmanager.CertificateRequest")
+		}
+		issuerRef, namespace = cr.Spec.IssuerRef, cr.Namespace
+		oldConds, newConds = oldCR.Status.Conditions, cr.Status.Conditions
+	case "sshcertificaterequests":
+		cr, ok := obj.(*certmanager.SSHCertificateRequest)
+		if !ok {
+			return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.SSHCertificateRequest")
+		}
+		oldCR, ok := oldObj.(*certmanager.SSHCertificateRequest)
+		if !ok {
+			return nil, fmt.Errorf("internal error: oldObject in admission request is not of type *certmanager.SSHCertificateRequest")
+		}
+		issuerRef, namespace = cr.Spec.IssuerRef, cr.Namespace
+		oldConds, newConds = oldCR.Status.Conditions, cr.Status.Conditions
+	default:
+		return nil, nil
 	}
-	if !approvalConditionsHaveChanged(oldCR, cr) {
+	if !approvalConditionsHaveChanged(oldConds, newConds) {
 		return nil, nil
 	}
 
-	group := cr.Spec.IssuerRef.Group
-	kind := cr.Spec.IssuerRef.Kind
+	group := issuerRef.Group
+	kind := issuerRef.Kind
 	// TODO: move this defaulting into the Scheme (registered as default functions) so
 	//       these will be set when the CertificateRequest is decoded.
 	if group == "" {
@@ -118,27 +144,27 @@ func (c *certificateRequestApproval) Validate(ctx context.Context, request admis
 	switch {
 	case err == errNoResourceExists:
 		return nil, field.Forbidden(field.NewPath("spec.issuerRef"),
-			fmt.Sprintf("referenced signer resource does not exist: %v", cr.Spec.IssuerRef))
+			fmt.Sprintf("referenced signer resource does not exist: %v", issuerRef))
 	case err != nil:
 		return nil, err
 	}
 
-	signerNames := signerNamesForAPIResource(cr.Spec.IssuerRef.Name, cr.Namespace, *apiResource)
+	signerNames := signerNamesForAPIResource(issuerRef.Name, namespace, *apiResource)
 	if !isAuthorizedForSignerNames(ctx, c.authorizer, userInfoForRequest(request), signerNames) {
 		return nil, field.Forbidden(field.NewPath("status.conditions"),
-			fmt.Sprintf("user %q does not have permissions to set approved/denied conditions for issuer %v", request.UserInfo.Username, cr.Spec.IssuerRef))
+			fmt.Sprintf("user %q does not have permissions to set approved/denied conditions for issuer %v", request.UserInfo.Username, issuerRef))
 	}
 
 	return nil, nil
 }
 
 // approvalConditionsHaveChanged returns true if either the Approved or Denied conditions
-// have been added to the CertificateRequest.
-func approvalConditionsHaveChanged(oldCR, cr *certmanager.CertificateRequest) bool {
-	oldCRApproving := util.GetCertificateRequestCondition(oldCR.Status.Conditions, certmanager.CertificateRequestConditionApproved)
-	newCRApproving := util.GetCertificateRequestCondition(cr.Status.Conditions, certmanager.CertificateRequestConditionApproved)
-	oldCRDenying := util.GetCertificateRequestCondition(oldCR.Status.Conditions, certmanager.CertificateRequestConditionDenied)
-	newCRDenying := util.GetCertificateRequestCondition(cr.Status.Conditions, certmanager.CertificateRequestConditionDenied)
+// have been added to the conditions of a CertificateRequest or SSHCertificateRequest.
+func approvalConditionsHaveChanged(oldConds, newConds []certmanager.CertificateRequestCondition) bool {
+	oldCRApproving := util.GetCertificateRequestCondition(oldConds, certmanager.CertificateRequestConditionApproved)
+	newCRApproving := util.GetCertificateRequestCondition(newConds, certmanager.CertificateRequestConditionApproved)
+	oldCRDenying := util.GetCertificateRequestCondition(oldConds, certmanager.CertificateRequestConditionDenied)
+	newCRDenying := util.GetCertificateRequestCondition(newConds, certmanager.CertificateRequestConditionDenied)
 	return (oldCRApproving == nil && newCRApproving != nil) || (oldCRDenying == nil && newCRDenying != nil)
 }
 
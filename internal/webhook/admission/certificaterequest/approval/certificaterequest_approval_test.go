@@ -312,6 +312,90 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+// TestValidate_SSHCertificateRequest verifies that approving an
+// SSHCertificateRequest requires the same permissions as approving a
+// CertificateRequest referencing the same issuer.
+func TestValidate_SSHCertificateRequest(t *testing.T) {
+	baseCR := &certmanager.SSHCertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns"},
+		Spec: certmanager.SSHCertificateRequestSpec{
+			IssuerRef: meta.IssuerReference{
+				Name:  "my-issuer",
+				Kind:  "Issuer",
+				Group: "example.io",
+			},
+		},
+	}
+	approvedCR := baseCR.DeepCopy()
+	approvedCR.Status.Conditions = []certmanager.CertificateRequestCondition{
+		{Type: certmanager.CertificateRequestConditionApproved, Status: meta.ConditionTrue, Reason: "cert-manager.io"},
+	}
+
+	req := &admissionv1.AdmissionRequest{
+		UserInfo:  authnv1.UserInfo{Username: "user-1"},
+		Operation: admissionv1.Update,
+		Resource: metav1.GroupVersionResource{
+			Group:    "cert-manager.io",
+			Resource: "sshcertificaterequests",
+		},
+		SubResource: "status",
+	}
+	discoverclient := discoveryfake.NewDiscovery().
+		WithServerGroups(func() (*metav1.APIGroupList, error) {
+			return &metav1.APIGroupList{
+				Groups: []metav1.APIGroup{
+					{
+						Name: "example.io",
+						Versions: []metav1.GroupVersionForDiscovery{
+							{GroupVersion: "example.io/a-version", Version: "a-version"},
+						},
+					},
+				},
+			}, nil
+		}).
+		WithServerResourcesForGroupVersion(func(groupVersion string) (*metav1.APIResourceList, error) {
+			return &metav1.APIResourceList{
+				APIResources: []metav1.APIResource{
+					{Name: "issuers", Namespaced: true, Kind: "Issuer"},
+				},
+			}, nil
+		})
+
+	tests := map[string]struct {
+		authorizer *fakeAuthorizer
+		expErr     error
+	}{
+		"if the approver has permissions for the signer, return nil": {
+			authorizer: &fakeAuthorizer{
+				verb:        "approve",
+				allowedName: "issuers.example.io/testns.my-issuer",
+				decision:    authorizer.DecisionAllow,
+			},
+		},
+		"if the approver does not have permissions for the signer, return forbidden": {
+			authorizer: &fakeAuthorizer{
+				verb:        "approve",
+				allowedName: "issuers.example.io/testns.my-issuer",
+				decision:    authorizer.DecisionNoOpinion,
+			},
+			expErr: field.Forbidden(field.NewPath("status.conditions"),
+				`user "user-1" does not have permissions to set approved/denied conditions for issuer {my-issuer Issuer example.io}`),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.authorizer.t = t
+			a := NewPlugin(test.authorizer, discoverclient).(*certificateRequestApproval)
+
+			warnings, err := a.Validate(t.Context(), *req, baseCR, approvedCR)
+			if len(warnings) > 0 {
+				t.Errorf("expected no warnings but got: %v", warnings)
+			}
+			compareErrors(t, test.expErr, err)
+		})
+	}
+}
+
 // TestValidate_ResourceUnset verifies that a zero-valued Resource is rejected
 // with an error rather than silently skipped. See admission.ErrResourceUnset
 // for why: skipping here would mean the approval RBAC check is never
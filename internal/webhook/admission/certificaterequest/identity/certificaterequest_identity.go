@@ -32,6 +32,9 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
 )
 
+// PluginName is the name of this admission plugin.
+const PluginName = "CertificateRequestIdentity"
+
 type certificateRequestIdentity struct {
 	*admission.Handler
 }
@@ -50,9 +53,9 @@ func (p *certificateRequestIdentity) Mutate(ctx context.Context, request admissi
 		return admission.ErrResourceUnset
 	}
 
-	// Only run this admission plugin for CertificateRequest CREATE operations
-	if request.Resource.Group != "cert-manager.io" ||
-		request.Resource.Resource != "certificaterequests" ||
+	// Only run this admission plugin for CertificateRequest and
+	// SSHCertificateRequest CREATE operations
+	if !isRequestResource(request) ||
 		request.Operation != admissionv1.Create {
 		return nil
 	}
@@ -89,65 +92,92 @@ func (p *certificateRequestIdentity) Validate(ctx context.Context, request admis
 		return nil, admission.ErrResourceUnset
 	}
 
-	// Only run this admission plugin for CertificateRequest resources
-	if request.Resource.Group != "cert-manager.io" ||
-		request.Resource.Resource != "certificaterequests" {
+	// Only run this admission plugin for CertificateRequest and
+	// SSHCertificateRequest resources
+	if !isRequestResource(request) {
 		return nil, nil
 	}
 
-	// Cast the obj to a CertificateRequest
-	cr, ok := obj.(*certmanager.CertificateRequest)
-	if !ok {
-		return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.CertificateRequest")
+	id, err := identityOf(obj, "object")
+	if err != nil {
+		return nil, err
 	}
 
 	switch request.Operation {
 	case admissionv1.Create:
-		return nil, validateCreate(request, cr)
+		return nil, validateCreate(request, id)
 	case admissionv1.Update:
-		oldCR, ok := oldObj.(*certmanager.CertificateRequest)
-		if !ok {
-			return nil, fmt.Errorf("internal error: oldObject in admission request is not of type *certmanager.CertificateRequest")
+		oldID, err := identityOf(oldObj, "oldObject")
+		if err != nil {
+			return nil, err
 		}
-		return nil, validateUpdate(oldCR, cr)
+		return nil, validateUpdate(oldID, id)
 	default:
 		return nil, fmt.Errorf("internal error: request operation has changed - this should never be possible")
 	}
 }
 
-func validateUpdate(oldCR *certmanager.CertificateRequest, cr *certmanager.CertificateRequest) error {
+// isRequestResource returns true if the admission request is for a
+// CertificateRequest or an SSHCertificateRequest, both of which record the
+// identity of their requester.
+func isRequestResource(request admissionv1.AdmissionRequest) bool {
+	return request.Resource.Group == "cert-manager.io" &&
+		(request.Resource.Resource == "certificaterequests" || request.Resource.Resource == "sshcertificaterequests")
+}
+
+// requesterIdentity is the identity of the requester recorded in the spec of
+// a CertificateRequest or SSHCertificateRequest.
+type requesterIdentity struct {
+	uid      string
+	username string
+	groups   []string
+	extra    map[string][]string
+}
+
+func identityOf(obj runtime.Object, label string) (requesterIdentity, error) {
+	switch cr := obj.(type) {
+	case *certmanager.CertificateRequest:
+		return requesterIdentity{uid: cr.Spec.UID, username: cr.Spec.Username, groups: cr.Spec.Groups, extra: cr.Spec.Extra}, nil
+	case *certmanager.SSHCertificateRequest:
+		return requesterIdentity{uid: cr.Spec.UID, username: cr.Spec.Username, groups: cr.Spec.Groups, extra: cr.Spec.Extra}, nil
+	default:
+		return requesterIdentity{}, fmt.Errorf("internal error: %s in admission request is not of type *certmanager.CertificateRequest or *certmanager.SSHCertificateRequest", label)
+	}
+}
+
+func validateUpdate(oldID, id requesterIdentity) error {
 	fldPath := field.NewPath("spec")
 
 	var el field.ErrorList
-	if oldCR.Spec.UID != cr.Spec.UID {
+	if oldID.uid != id.uid {
 		el = append(el, field.Forbidden(fldPath.Child("uid"), "uid identity cannot be changed once set"))
 	}
-	if oldCR.Spec.Username != cr.Spec.Username {
+	if oldID.username != id.username {
 		el = append(el, field.Forbidden(fldPath.Child("username"), "username identity cannot be changed once set"))
 	}
-	if !util.EqualUnsorted(oldCR.Spec.Groups, cr.Spec.Groups) {
+	if !util.EqualUnsorted(oldID.groups, id.groups) {
 		el = append(el, field.Forbidden(fldPath.Child("groups"), "groups identity cannot be changed once set"))
 	}
-	if !reflect.DeepEqual(oldCR.Spec.Extra, cr.Spec.Extra) {
+	if !reflect.DeepEqual(oldID.extra, id.extra) {
 		el = append(el, field.Forbidden(fldPath.Child("extra"), "extra identity cannot be changed once set"))
 	}
 	return el.ToAggregate()
 }
 
-func validateCreate(request admissionv1.AdmissionRequest, cr *certmanager.CertificateRequest) error {
+func validateCreate(request admissionv1.AdmissionRequest, id requesterIdentity) error {
 	fldPath := field.NewPath("spec")
 
 	var el field.ErrorList
-	if cr.Spec.UID != request.UserInfo.UID {
+	if id.uid != request.UserInfo.UID {
 		el = append(el, field.Forbidden(fldPath.Child("uid"), "uid identity must be that of the requester"))
 	}
-	if cr.Spec.Username != request.UserInfo.Username {
+	if id.username != request.UserInfo.Username {
 		el = append(el, field.Forbidden(fldPath.Child("username"), "username identity must be that of the requester"))
 	}
-	if !util.EqualUnsorted(cr.Spec.Groups, request.UserInfo.Groups) {
+	if !util.EqualUnsorted(id.groups, request.UserInfo.Groups) {
 		el = append(el, field.Forbidden(fldPath.Child("groups"), "groups identity must be that of the requester"))
 	}
-	if !extrasMatch(cr.Spec.Extra, request.UserInfo.Extra) {
+	if !extrasMatch(id.extra, request.UserInfo.Extra) {
 		el = append(el, field.Forbidden(fldPath.Child("extra"), "extra identity must be that of the requester"))
 	}
 	return el.ToAggregate()
@@ -98,6 +98,32 @@ func TestMutate(t *testing.T) {
 	}
 }
 
+func TestMutate_SSHCertificateRequest(t *testing.T) {
+	plugin := NewPlugin().(*certificateRequestIdentity)
+	cr := &cmapi.SSHCertificateRequest{}
+	crUnstr := toUnstructured(t, cr)
+	err := plugin.Mutate(t.Context(), admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Resource: metav1.GroupVersionResource{
+			Group:    "cert-manager.io",
+			Version:  "v1",
+			Resource: "sshcertificaterequests",
+		},
+		UserInfo: authenticationv1.UserInfo{
+			Username: "testuser",
+			UID:      "testuid",
+			Groups:   []string{"testgroup"},
+		}}, crUnstr)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	fromUnstructured(t, crUnstr, cr)
+
+	if cr.Spec.Username != "testuser" || cr.Spec.UID != "testuid" || len(cr.Spec.Groups) != 1 || cr.Spec.Groups[0] != "testgroup" {
+		t.Errorf("unexpected identity. got: %q %q %q, expected %q %q %q", cr.Spec.Username, cr.Spec.UID, cr.Spec.Groups, "testuser", "testuid", "[testgroup]")
+	}
+}
+
 func TestMutate_Ignores(t *testing.T) {
 	plugin := NewPlugin().(*certificateRequestIdentity)
 	tests := map[string]struct {
@@ -307,6 +333,35 @@ func TestValidateCreate(t *testing.T) {
 	}
 }
 
+func TestValidateCreate_SSHCertificateRequest(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	req := admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Resource: metav1.GroupVersionResource{
+			Group:    "cert-manager.io",
+			Version:  "v1",
+			Resource: "sshcertificaterequests",
+		},
+		UserInfo: authenticationv1.UserInfo{
+			UID:      "abc",
+			Username: "user-1",
+		},
+	}
+
+	p := NewPlugin().(*certificateRequestIdentity)
+	_, err := p.Validate(t.Context(), req, nil, &certmanager.SSHCertificateRequest{
+		Spec: certmanager.SSHCertificateRequestSpec{UID: "abc", Username: "user-1"},
+	})
+	compareErrors(t, nil, err)
+
+	_, err = p.Validate(t.Context(), req, nil, &certmanager.SSHCertificateRequest{
+		Spec: certmanager.SSHCertificateRequestSpec{UID: "abc", Username: "user-2"},
+	})
+	compareErrors(t, field.ErrorList{
+		field.Forbidden(fldPath.Child("username"), "username identity must be that of the requester"),
+	}.ToAggregate(), err)
+}
+
 func compareErrors(t *testing.T, exp, act error) {
 	if exp == nil && act == nil {
 		return
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package namespaces provides admission plugins with the labels of the
+// Namespaces of the resources they admit.
+package namespaces
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// Labels returns the labels of the named Namespace. The Namespace is read
+// from the lister's cache, and only from the API server if it is not cached
+// yet, e.g. because it has just been created.
+func Labels(ctx context.Context, lister corelisters.NamespaceLister, client kubernetes.Interface, name string) (labels.Set, error) {
+	ns, err := lister.Get(name)
+	if apierrors.IsNotFound(err) {
+		ns, err = client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %q: %w", name, err)
+	}
+	if ns.Labels == nil {
+		return labels.Set{}, nil
+	}
+	return labels.Set(ns.Labels), nil
+}
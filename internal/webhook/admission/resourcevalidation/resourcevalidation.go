@@ -22,6 +22,7 @@ import (
 	"reflect"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -35,6 +36,9 @@ import (
 	admission "github.com/cert-manager/cert-manager/pkg/webhook/admission"
 )
 
+// PluginName is the name of this admission plugin.
+const PluginName = "ResourceValidation"
+
 type resourceValidation struct {
 	*admission.Handler
 }
@@ -45,8 +49,13 @@ var certificateGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificates
 var certificateRequestGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificaterequests")
 var issuerGVR = certmanagerv1.SchemeGroupVersion.WithResource("issuers")
 var clusterIssuerGVR = certmanagerv1.SchemeGroupVersion.WithResource("clusterissuers")
+var sshCertificateGVR = certmanagerv1.SchemeGroupVersion.WithResource("sshcertificates")
+var sshCertificateRequestGVR = certmanagerv1.SchemeGroupVersion.WithResource("sshcertificaterequests")
+var certificateRequestPolicyGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificaterequestpolicies")
+var certificateDefaultPolicyGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificatedefaultpolicies")
 var orderGVR = acmev1.SchemeGroupVersion.WithResource("orders")
 var challengeGVR = acmev1.SchemeGroupVersion.WithResource("challenges")
+var certificateSigningRequestGVR = certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests")
 
 type validateCreateFunc func(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string)
 type validateUpdateFunc func(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string)
@@ -82,12 +91,19 @@ func checkType(obj runtime.Object, want reflect.Type, label string) error {
 }
 
 var validationMapping = map[schema.GroupVersionResource]validationPair{
-	certificateGVR:        newValidationPair(&certmanager.Certificate{}, cmvalidation.ValidateCertificate, cmvalidation.ValidateUpdateCertificate),
-	certificateRequestGVR: newValidationPair(&certmanager.CertificateRequest{}, cmvalidation.ValidateCertificateRequest, cmvalidation.ValidateUpdateCertificateRequest),
-	issuerGVR:             newValidationPair(&certmanager.Issuer{}, cmvalidation.ValidateIssuer, cmvalidation.ValidateUpdateIssuer),
-	clusterIssuerGVR:      newValidationPair(&certmanager.ClusterIssuer{}, cmvalidation.ValidateClusterIssuer, cmvalidation.ValidateUpdateClusterIssuer),
-	orderGVR:              newValidationPair(&acme.Order{}, acmevalidation.ValidateOrder, acmevalidation.ValidateOrderUpdate),
-	challengeGVR:          newValidationPair(&acme.Challenge{}, acmevalidation.ValidateChallenge, acmevalidation.ValidateChallengeUpdate),
+	certificateGVR:              newValidationPair(&certmanager.Certificate{}, cmvalidation.ValidateCertificate, cmvalidation.ValidateUpdateCertificate),
+	certificateRequestGVR:       newValidationPair(&certmanager.CertificateRequest{}, cmvalidation.ValidateCertificateRequest, cmvalidation.ValidateUpdateCertificateRequest),
+	issuerGVR:                   newValidationPair(&certmanager.Issuer{}, cmvalidation.ValidateIssuer, cmvalidation.ValidateUpdateIssuer),
+	clusterIssuerGVR:            newValidationPair(&certmanager.ClusterIssuer{}, cmvalidation.ValidateClusterIssuer, cmvalidation.ValidateUpdateClusterIssuer),
+	sshCertificateGVR:           newValidationPair(&certmanager.SSHCertificate{}, cmvalidation.ValidateSSHCertificate, cmvalidation.ValidateUpdateSSHCertificate),
+	sshCertificateRequestGVR:    newValidationPair(&certmanager.SSHCertificateRequest{}, cmvalidation.ValidateSSHCertificateRequest, cmvalidation.ValidateUpdateSSHCertificateRequest),
+	certificateRequestPolicyGVR: newValidationPair(&certmanager.CertificateRequestPolicy{}, cmvalidation.ValidateCertificateRequestPolicy, cmvalidation.ValidateUpdateCertificateRequestPolicy),
+	certificateDefaultPolicyGVR: newValidationPair(&certmanager.CertificateDefaultPolicy{}, cmvalidation.ValidateCertificateDefaultPolicy, cmvalidation.ValidateUpdateCertificateDefaultPolicy),
+	orderGVR:                    newValidationPair(&acme.Order{}, acmevalidation.ValidateOrder, acmevalidation.ValidateOrderUpdate),
+	challengeGVR:                newValidationPair(&acme.Challenge{}, acmevalidation.ValidateChallenge, acmevalidation.ValidateChallengeUpdate),
+	// CertificateSigningRequests have no internal version, so are validated
+	// as their v1 type.
+	certificateSigningRequestGVR: newValidationPair(&certificatesv1.CertificateSigningRequest{}, cmvalidation.ValidateCertificateSigningRequest, cmvalidation.ValidateUpdateCertificateSigningRequest),
 }
 
 func NewPlugin() admission.Interface {
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+	"github.com/cert-manager/cert-manager/pkg/webhook/server"
+)
+
+// ConfigReloader applies changes to the webhook configuration to a running
+// webhook server. The admission plugins, the validation rules and the TLS
+// settings of the webhook listener are reloaded. Changes to other fields are
+// only applied when the webhook is restarted.
+type ConfigReloader struct {
+	log         logr.Logger
+	server      *server.Server
+	pluginChain *admission.DynamicPluginChain
+	// buildAdmissionChain builds the admission plugin chain for a
+	// configuration.
+	buildAdmissionChain func(config.WebhookConfiguration) (admission.PluginChain, error)
+
+	mu      sync.Mutex
+	current config.WebhookConfiguration
+}
+
+// Reload applies the given configuration to the webhook server. If the
+// configuration cannot be applied, an error is returned and the server
+// continues to use its current configuration.
+func (r *ConfigReloader) Reload(opts config.WebhookConfiguration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pluginChain admission.PluginChain
+	admissionChanged := !equality.Semantic.DeepEqual(r.current.AdmissionPlugins, opts.AdmissionPlugins) ||
+		!equality.Semantic.DeepEqual(r.current.ValidationRules, opts.ValidationRules)
+	if admissionChanged {
+		var err error
+		pluginChain, err = r.buildAdmissionChain(opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The TLS settings are always updated, so that changes to the client CA
+	// file are also picked up.
+	if err := r.server.UpdateTLSSettings(server.TLSSettings{
+		CipherSuites:              opts.TLSConfig.CipherSuites,
+		MinTLSVersion:             opts.TLSConfig.MinTLSVersion,
+		EnableClientVerification:  opts.EnableClientVerification,
+		ClientCAPath:              opts.ClientCAPath,
+		ClientCertificateSubjects: opts.ClientCertificateSubjects,
+	}); err != nil {
+		return err
+	}
+
+	if admissionChanged {
+		r.pluginChain.Set(pluginChain)
+		r.log.V(logf.InfoLevel).Info("reloaded admission plugins")
+	}
+
+	if requiresRestart(r.current, opts) {
+		r.log.V(logf.WarnLevel).Info("the webhook configuration contains changes which are only applied when the webhook is restarted")
+	}
+
+	r.current = *opts.DeepCopy()
+	return nil
+}
+
+// requiresRestart returns true if the configurations differ in fields which
+// cannot be reloaded.
+func requiresRestart(current, updated config.WebhookConfiguration) bool {
+	current, updated = *current.DeepCopy(), *updated.DeepCopy()
+	for _, opts := range []*config.WebhookConfiguration{&current, &updated} {
+		opts.TLSConfig.CipherSuites = nil
+		opts.TLSConfig.MinTLSVersion = ""
+		opts.EnableClientVerification = false
+		opts.ClientCAPath = ""
+		opts.ClientCertificateSubjects = nil
+		opts.ValidationRules = nil
+		opts.AdmissionPlugins = nil
+		// The PEM size limits are applied by the caller of Reload.
+		opts.PEMSizeLimitsConfig = config.PEMSizeLimitsConfig{}
+	}
+	return !equality.Semantic.DeepEqual(current, updated)
+}
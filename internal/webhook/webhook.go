@@ -17,15 +17,24 @@ limitations under the License.
 package webhook
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	certificatesv1 "k8s.io/api/certificates/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	acmeinstall "github.com/cert-manager/cert-manager/internal/apis/acme/install"
 	cminstall "github.com/cert-manager/cert-manager/internal/apis/certmanager/install"
@@ -33,9 +42,15 @@ import (
 	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
 	metainstall "github.com/cert-manager/cert-manager/internal/apis/meta/install"
 	"github.com/cert-manager/cert-manager/internal/kube"
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/celvalidation"
+	crtdefaults "github.com/cert-manager/cert-manager/internal/webhook/admission/certificate/defaults"
+	crtrenewalrequest "github.com/cert-manager/cert-manager/internal/webhook/admission/certificate/renewalrequest"
 	crapproval "github.com/cert-manager/cert-manager/internal/webhook/admission/certificaterequest/approval"
 	cridentity "github.com/cert-manager/cert-manager/internal/webhook/admission/certificaterequest/identity"
 	"github.com/cert-manager/cert-manager/internal/webhook/admission/resourcevalidation"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmv1informers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/server/tls"
 	"github.com/cert-manager/cert-manager/pkg/server/tls/authority"
@@ -46,27 +61,55 @@ import (
 // NewCertManagerWebhookServer creates a new webhook server configured with all cert-manager
 // resource types, validation, defaulting and conversion functions.
 func NewCertManagerWebhookServer(log logr.Logger, opts config.WebhookConfiguration, optionFunctions ...func(*server.Server)) (*server.Server, error) {
+	s, _, err := NewReloadableCertManagerWebhookServer(log, opts, optionFunctions...)
+	return s, err
+}
+
+// NewReloadableCertManagerWebhookServer creates a new webhook server like
+// NewCertManagerWebhookServer, and a ConfigReloader which applies changes to
+// the webhook configuration to the server while it is running.
+func NewReloadableCertManagerWebhookServer(log logr.Logger, opts config.WebhookConfiguration, optionFunctions ...func(*server.Server)) (*server.Server, *ConfigReloader, error) {
 	//nolint:staticcheck // For backwards compatibility.
 	restcfg, err := kube.BuildClientConfig(opts.APIServerHost, opts.KubeConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	cl, err := kubernetes.NewForConfig(restcfg)
 	if err != nil {
-		return nil, fmt.Errorf("error creating kubernetes client: %s", err)
+		return nil, nil, fmt.Errorf("error creating kubernetes client: %s", err)
+	}
+
+	cmcl, err := cmclient.NewForConfig(restcfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating cert-manager client: %s", err)
 	}
 
+	informers := newAdmissionInformers(cl, cmcl)
+
 	// Set up the admission chain
-	admissionHandler, err := buildAdmissionChain(cl)
+	pluginChain, err := buildAdmissionChain(log, cl, cmcl, informers, opts)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	admissionHandler := admission.NewDynamicPluginChain(pluginChain)
 
 	scheme := runtime.NewScheme()
 	cminstall.Install(scheme)
 	acmeinstall.Install(scheme)
 	metainstall.Install(scheme)
+	installCertificates(scheme)
+
+	metricsCertificateSource := buildCertificateSource(log, opts.MetricsTLSConfig, restcfg)
+	// Clients of the metrics server must present a certificate signed by the
+	// dynamic serving CA if client verification is enabled
+	var metricsClientCAs tls.CertPoolSource
+	if opts.MetricsTLSConfig.Dynamic.VerifyClientCertificates {
+		var ok bool
+		if metricsClientCAs, ok = metricsCertificateSource.(tls.CertPoolSource); !ok {
+			return nil, nil, fmt.Errorf("client certificate verification requires the metrics server to use a dynamic TLS config")
+		}
+	}
 
 	s := &server.Server{
 		ResourceScheme:            scheme,
@@ -80,20 +123,78 @@ func NewCertManagerWebhookServer(log logr.Logger, opts config.WebhookConfigurati
 		ValidationWebhook:         admissionHandler,
 		MutationWebhook:           admissionHandler,
 		MetricsListenAddress:      opts.MetricsListenAddress,
-		MetricsCertificateSource:  buildCertificateSource(log, opts.MetricsTLSConfig, restcfg),
+		MetricsCertificateSource:  metricsCertificateSource,
+		MetricsClientCAs:          metricsClientCAs,
 		MetricsCipherSuites:       opts.MetricsTLSConfig.CipherSuites,
 		MetricsMinTLSVersion:      opts.MetricsTLSConfig.MinTLSVersion,
 		EnableClientVerification:  opts.EnableClientVerification,
 		ClientCAPath:              opts.ClientCAPath,
 		ClientCertificateSubjects: opts.ClientCertificateSubjects,
+		Runnables:                 []manager.Runnable{informers},
 	}
 	for _, fn := range optionFunctions {
 		fn(s)
 	}
-	return s, nil
+
+	reloader := &ConfigReloader{
+		log:         log,
+		server:      s,
+		pluginChain: admissionHandler,
+		buildAdmissionChain: func(opts config.WebhookConfiguration) (admission.PluginChain, error) {
+			return buildAdmissionChain(log, cl, cmcl, informers, opts)
+		},
+		current: *opts.DeepCopy(),
+	}
+	return s, reloader, nil
+}
+
+// admissionInformers are the shared informers whose caches are read by
+// admission plugins. They are created once so that the admission chain can be
+// rebuilt when the configuration is reloaded without restarting them.
+type admissionInformers struct {
+	kubeFactory kubeinformers.SharedInformerFactory
+	cmFactory   cminformers.SharedInformerFactory
+
+	namespaces corelisters.NamespaceLister
+	policies   cmv1informers.CertificateDefaultPolicyInformer
+}
+
+func newAdmissionInformers(client kubernetes.Interface, cmClient cmclient.Interface) *admissionInformers {
+	kubeFactory := kubeinformers.NewSharedInformerFactory(client, 0)
+	cmFactory := cminformers.NewSharedInformerFactory(cmClient, 0)
+	i := &admissionInformers{
+		kubeFactory: kubeFactory,
+		cmFactory:   cmFactory,
+		namespaces:  kubeFactory.Core().V1().Namespaces().Lister(),
+		policies:    cmFactory.Certmanager().V1().CertificateDefaultPolicies(),
+	}
+	// Register the informers before the factories are started.
+	i.policies.Informer()
+	return i
 }
 
-func buildAdmissionChain(client kubernetes.Interface) (admission.PluginChain, error) {
+// Start starts the informers and stops them when the context is cancelled.
+// Admission plugins read from the API server until the caches have synced,
+// so Start does not wait for them.
+func (i *admissionInformers) Start(ctx context.Context) error {
+	i.kubeFactory.Start(ctx.Done())
+	i.cmFactory.Start(ctx.Done())
+	<-ctx.Done()
+	i.kubeFactory.Shutdown()
+	i.cmFactory.Shutdown()
+	return nil
+}
+
+// requiredAdmissionPlugins are the admission plugins which cannot be
+// disabled, because cert-manager relies on them to validate resources and to
+// authenticate and authorize the users who request and approve certificates.
+var requiredAdmissionPlugins = sets.New(
+	cridentity.PluginName,
+	crapproval.PluginName,
+	resourcevalidation.PluginName,
+)
+
+func buildAdmissionChain(log logr.Logger, client kubernetes.Interface, cmClient cmclient.Interface, informers *admissionInformers, opts config.WebhookConfiguration) (admission.PluginChain, error) {
 	authorizer, err := authorizerfactory.DelegatingAuthorizerConfig{
 		SubjectAccessReviewClient: client.AuthorizationV1(),
 		// cache responses for 1 second
@@ -111,15 +212,69 @@ func buildAdmissionChain(client kubernetes.Interface) (admission.PluginChain, er
 		return nil, fmt.Errorf("error creating authorization handler: %v", err)
 	}
 
-	pluginChain := admission.PluginChain([]admission.Interface{
-		cridentity.NewPlugin(),
-		crapproval.NewPlugin(authorizer, client.Discovery()),
-		resourcevalidation.NewPlugin(),
-	})
+	// plugins are all admission plugins, in the order in which they are run.
+	plugins := []struct {
+		name string
+		new  func() (admission.Interface, error)
+	}{
+		{cridentity.PluginName, func() (admission.Interface, error) { return cridentity.NewPlugin(), nil }},
+		{crapproval.PluginName, func() (admission.Interface, error) { return crapproval.NewPlugin(authorizer, client.Discovery()), nil }},
+		{crtrenewalrequest.PluginName, func() (admission.Interface, error) { return crtrenewalrequest.NewPlugin(), nil }},
+		{crtdefaults.PluginName, func() (admission.Interface, error) {
+			return crtdefaults.NewPlugin(cmClient, client, informers.policies, informers.namespaces), nil
+		}},
+		{resourcevalidation.PluginName, func() (admission.Interface, error) { return resourcevalidation.NewPlugin(), nil }},
+		{celvalidation.PluginName, func() (admission.Interface, error) {
+			celValidation, err := celvalidation.NewPlugin(opts.ValidationRules, client, informers.namespaces)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling validation rules: %v", err)
+			}
+			return celValidation, nil
+		}},
+	}
+
+	known := sets.New[string]()
+	for _, plugin := range plugins {
+		known.Insert(plugin.name)
+	}
+	for name, enabled := range opts.AdmissionPlugins {
+		if !known.Has(name) {
+			return nil, fmt.Errorf("unknown admission plugin %q, known admission plugins are %s", name, strings.Join(sets.List(known), ", "))
+		}
+		if !enabled && requiredAdmissionPlugins.Has(name) {
+			return nil, fmt.Errorf("admission plugin %q cannot be disabled", name)
+		}
+	}
+
+	var pluginChain admission.PluginChain
+	for _, plugin := range plugins {
+		if enabled, ok := opts.AdmissionPlugins[plugin.name]; ok && !enabled {
+			log.V(logf.InfoLevel).Info("admission plugin is disabled", "plugin", plugin.name)
+			continue
+		}
+		p, err := plugin.new()
+		if err != nil {
+			return nil, err
+		}
+		pluginChain = append(pluginChain, p)
+	}
 
 	return pluginChain, nil
 }
 
+// installCertificates registers the certificates.k8s.io/v1 types so that
+// CertificateSigningRequests referencing cert-manager signers can be
+// validated. The group has no internal version, so the v1 types are also
+// registered as the internal types, meaning no conversion is required when
+// decoding them.
+func installCertificates(scheme *runtime.Scheme) {
+	utilruntime.Must(certificatesv1.AddToScheme(scheme))
+	scheme.AddKnownTypes(schema.GroupVersion{Group: certificatesv1.GroupName, Version: runtime.APIVersionInternal},
+		&certificatesv1.CertificateSigningRequest{},
+		&certificatesv1.CertificateSigningRequestList{},
+	)
+}
+
 func buildCertificateSource(log logr.Logger, tlsConfig shared.TLSConfig, restCfg *rest.Config) tls.CertificateSource {
 	switch {
 	case tlsConfig.FilesystemConfigProvided():
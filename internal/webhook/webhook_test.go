@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/celvalidation"
+	crtdefaults "github.com/cert-manager/cert-manager/internal/webhook/admission/certificate/defaults"
+	"github.com/cert-manager/cert-manager/internal/webhook/admission/resourcevalidation"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+	"github.com/cert-manager/cert-manager/pkg/webhook/server"
+)
+
+func TestBuildAdmissionChain(t *testing.T) {
+	tests := map[string]struct {
+		admissionPlugins map[string]bool
+		validationRules  []config.ValidationRule
+		expectedPlugins  int
+		expectedErr      string
+	}{
+		"all admission plugins are enabled by default": {
+			expectedPlugins: 6,
+		},
+		"admission plugins can be disabled": {
+			admissionPlugins: map[string]bool{
+				crtdefaults.PluginName:   false,
+				celvalidation.PluginName: false,
+			},
+			expectedPlugins: 4,
+		},
+		"admission plugins can be explicitly enabled": {
+			admissionPlugins: map[string]bool{
+				resourcevalidation.PluginName: true,
+			},
+			expectedPlugins: 6,
+		},
+		"required admission plugins cannot be disabled": {
+			admissionPlugins: map[string]bool{
+				resourcevalidation.PluginName: false,
+			},
+			expectedErr: `admission plugin "ResourceValidation" cannot be disabled`,
+		},
+		"unknown admission plugins are rejected": {
+			admissionPlugins: map[string]bool{
+				"Unknown": true,
+			},
+			expectedErr: `unknown admission plugin "Unknown", known admission plugins are CELValidation, CertificateDefaults, CertificateRenewalRequest, CertificateRequestApproval, CertificateRequestIdentity, ResourceValidation`,
+		},
+		"invalid validation rules are not compiled if CEL validation is disabled": {
+			admissionPlugins: map[string]bool{
+				celvalidation.PluginName: false,
+			},
+			validationRules: []config.ValidationRule{{Resources: []string{"certificates"}, Expression: "invalid("}},
+			expectedPlugins: 5,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, cmClient := fake.NewClientset(), cmfake.NewClientset()
+			chain, err := buildAdmissionChain(logr.Discard(), client, cmClient, newAdmissionInformers(client, cmClient), config.WebhookConfiguration{
+				AdmissionPlugins: test.admissionPlugins,
+				ValidationRules:  test.validationRules,
+			})
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, chain, test.expectedPlugins)
+		})
+	}
+}
+
+func TestConfigReloaderReload(t *testing.T) {
+	var built []config.WebhookConfiguration
+	reloader := &ConfigReloader{
+		log:         logr.Discard(),
+		server:      &server.Server{},
+		pluginChain: admission.NewDynamicPluginChain(nil),
+		buildAdmissionChain: func(opts config.WebhookConfiguration) (admission.PluginChain, error) {
+			built = append(built, opts)
+			return admission.PluginChain{admission.NewHandler()}, nil
+		},
+	}
+
+	// The admission chain is only rebuilt if the admission configuration
+	// changes.
+	require.NoError(t, reloader.Reload(config.WebhookConfiguration{SecurePort: 6443}))
+	assert.Empty(t, built)
+
+	opts := config.WebhookConfiguration{AdmissionPlugins: map[string]bool{celvalidation.PluginName: false}}
+	require.NoError(t, reloader.Reload(opts))
+	assert.Equal(t, []config.WebhookConfiguration{opts}, built)
+
+	// An invalid configuration is not applied.
+	err := reloader.Reload(config.WebhookConfiguration{EnableClientVerification: true})
+	assert.EqualError(t, err, "a client CA path must be provided when client verification is enabled")
+	assert.Equal(t, opts.AdmissionPlugins, reloader.current.AdmissionPlugins)
+}
+
+func TestRequiresRestart(t *testing.T) {
+	current := config.WebhookConfiguration{SecurePort: 6443}
+
+	updated := current
+	updated.ClientCAPath = "ca.crt"
+	updated.AdmissionPlugins = map[string]bool{celvalidation.PluginName: false}
+	updated.PEMSizeLimitsConfig.MaxBundleSize = 1
+	assert.False(t, requiresRestart(current, updated))
+
+	updated.SecurePort = 8443
+	assert.True(t, requiresRestart(current, updated))
+}
@@ -18,10 +18,13 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	genericoptions "k8s.io/apiserver/pkg/server/options"
 	"k8s.io/component-base/logs"
@@ -30,6 +33,8 @@ import (
 	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook/apiserver"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	servertls "github.com/cert-manager/cert-manager/pkg/server/tls"
+	"github.com/cert-manager/cert-manager/pkg/server/tls/authority"
 )
 
 type WebhookServerOptions struct {
@@ -39,6 +44,57 @@ type WebhookServerOptions struct {
 
 	SolverGroup string
 	Solvers     []webhook.Solver
+
+	// DynamicServing configures the server to serve certificates signed by a
+	// self-managed CA, instead of the certificates configured by the secure
+	// serving options.
+	DynamicServing DynamicServingOptions
+}
+
+// DynamicServingOptions configures a CA which is stored in a Secret resource
+// and used to sign the serving certificates of the webhook server.
+// The CA and the serving certificates are rotated automatically.
+type DynamicServingOptions struct {
+	// SecretNamespace is the namespace of the Secret resource which stores the CA.
+	SecretNamespace string
+
+	// SecretName is the name of the Secret resource which stores the CA.
+	SecretName string
+
+	// DNSNames are the DNS names included in the serving certificates.
+	DNSNames []string
+
+	// LeafDuration is the validity duration of the serving certificates.
+	LeafDuration time.Duration
+}
+
+func (o *DynamicServingOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.SecretNamespace, "dynamic-serving-ca-secret-namespace", o.SecretNamespace, ""+
+		"namespace of the Secret resource which stores the CA that signs serving certificates")
+	fs.StringVar(&o.SecretName, "dynamic-serving-ca-secret-name", o.SecretName, ""+
+		"name of the Secret resource which stores the CA that signs serving certificates")
+	fs.StringSliceVar(&o.DNSNames, "dynamic-serving-dns-names", o.DNSNames, ""+
+		"DNS names that should be present on certificates generated by the dynamic serving CA")
+	fs.DurationVar(&o.LeafDuration, "dynamic-serving-leaf-duration", o.LeafDuration, ""+
+		"leaf duration of serving certificates")
+}
+
+// Enabled returns true if any of the dynamic serving options is set.
+func (o DynamicServingOptions) Enabled() bool {
+	return o.SecretNamespace != "" || o.SecretName != "" || len(o.DNSNames) > 0
+}
+
+func (o DynamicServingOptions) Validate() error {
+	if !o.Enabled() {
+		return nil
+	}
+	if o.SecretNamespace == "" || o.SecretName == "" || len(o.DNSNames) == 0 {
+		return errors.New("--dynamic-serving-ca-secret-namespace, --dynamic-serving-ca-secret-name and --dynamic-serving-dns-names must be set together")
+	}
+	if o.LeafDuration < 0 {
+		return errors.New("--dynamic-serving-leaf-duration must not be negative")
+	}
+	return nil
 }
 
 func NewWebhookServerOptions(groupName string, solvers ...webhook.Solver) *WebhookServerOptions {
@@ -86,6 +142,7 @@ func NewCommandStartWebhookServer(_ context.Context, groupName string, solvers .
 	flags := cmd.Flags()
 	logf.AddFlags(o.Logging, flags)
 	o.RecommendedOptions.AddFlags(flags)
+	o.DynamicServing.AddFlags(flags)
 
 	return cmd
 }
@@ -99,6 +156,10 @@ func (o WebhookServerOptions) Validate(args []string) error {
 		return fmt.Errorf("error validating recommended options: %v", errs)
 	}
 
+	if err := o.DynamicServing.Validate(); err != nil {
+		return fmt.Errorf("error validating dynamic serving options: %v", err)
+	}
+
 	return nil
 }
 
@@ -108,11 +169,15 @@ func (o *WebhookServerOptions) Complete() error {
 
 // Config creates a new webhook server config that includes generic upstream
 // apiserver options, rest client config and the Solvers configured for this
-// webhook server
+// webhook server.
+// If dynamic serving is enabled, the serving certificates are provided by a
+// DynamicSource which is started by a post-start hook of the server.
 func (o WebhookServerOptions) Config() (*apiserver.Config, error) {
 	// TODO have a "real" external address
-	if err := o.RecommendedOptions.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", nil, []net.IP{net.ParseIP("127.0.0.1")}); err != nil {
-		return nil, fmt.Errorf("error creating self-signed certificates: %v", err)
+	if !o.DynamicServing.Enabled() {
+		if err := o.RecommendedOptions.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", nil, []net.IP{net.ParseIP("127.0.0.1")}); err != nil {
+			return nil, fmt.Errorf("error creating self-signed certificates: %v", err)
+		}
 	}
 
 	serverConfig := genericapiserver.NewRecommendedConfig(apiserver.Codecs)
@@ -120,6 +185,12 @@ func (o WebhookServerOptions) Config() (*apiserver.Config, error) {
 		return nil, err
 	}
 
+	if o.DynamicServing.Enabled() {
+		if err := o.applyDynamicServing(serverConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	config := &apiserver.Config{
 		GenericConfig: serverConfig,
 		ExtraConfig: apiserver.ExtraConfig{
@@ -130,6 +201,35 @@ func (o WebhookServerOptions) Config() (*apiserver.Config, error) {
 	return config, nil
 }
 
+// applyDynamicServing configures the server to serve certificates from a
+// DynamicSource, which is started once the server is running.
+func (o WebhookServerOptions) applyDynamicServing(serverConfig *genericapiserver.RecommendedConfig) error {
+	if serverConfig.SecureServing == nil {
+		return errors.New("dynamic serving requires secure serving to be enabled")
+	}
+
+	source := &servertls.DynamicSource{
+		DNSNames: o.DynamicServing.DNSNames,
+		Authority: &authority.DynamicAuthority{
+			SecretNamespace: o.DynamicServing.SecretNamespace,
+			SecretName:      o.DynamicServing.SecretName,
+			SecretLabels:    map[string]string{"app.kubernetes.io/managed-by": "cert-manager"},
+			LeafDuration:    o.DynamicServing.LeafDuration,
+			RESTConfig:      serverConfig.ClientConfig,
+		},
+	}
+	serverConfig.SecureServing.Cert = servertls.NewCertKeyContentProvider("dynamic-serving-certificate", source)
+
+	return serverConfig.AddPostStartHook("start-dynamic-serving-certificate", func(hookCtx genericapiserver.PostStartHookContext) error {
+		go func() {
+			if err := source.Start(hookCtx); err != nil {
+				logf.Log.Error(err, "failed to run the dynamic serving certificate source")
+			}
+		}()
+		return nil
+	})
+}
+
 // RunWebhookServer creates a new apiserver, registers an API Group for each of
 // the configured solvers and runs the new apiserver.
 func (o WebhookServerOptions) RunWebhookServer(ctx context.Context) error {
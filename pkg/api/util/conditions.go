@@ -235,6 +235,50 @@ func RemoveCertificateCondition(crt *cmapi.Certificate, conditionType cmapi.Cert
 	crt.Status.Conditions = updatedConditions
 }
 
+// GetSSHCertificateCondition returns the condition of the given type on the
+// given SSHCertificate, or nil if it is not set.
+func GetSSHCertificateCondition(crt *cmapi.SSHCertificate, conditionType cmapi.CertificateConditionType) *cmapi.CertificateCondition {
+	for i, cond := range crt.Status.Conditions {
+		if cond.Type == conditionType {
+			return &crt.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetSSHCertificateCondition will set a 'condition' on the given
+// SSHCertificate, following the same rules as SetCertificateCondition.
+func SetSSHCertificateCondition(crt *cmapi.SSHCertificate, observedGeneration int64, conditionType cmapi.CertificateConditionType,
+	status cmmeta.ConditionStatus, reason, message string) {
+	newCondition := cmapi.CertificateCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: observedGeneration,
+	}
+
+	nowTime := metav1.NewTime(Clock.Now())
+	newCondition.LastTransitionTime = &nowTime
+
+	for idx, cond := range crt.Status.Conditions {
+		if cond.Type != conditionType {
+			continue
+		}
+
+		// If this update doesn't contain a state transition, we don't update the
+		// conditions LastTransitionTime to Now()
+		if cond.Status == status {
+			newCondition.LastTransitionTime = cond.LastTransitionTime
+		}
+
+		crt.Status.Conditions[idx] = newCondition
+		return
+	}
+
+	crt.Status.Conditions = append(crt.Status.Conditions, newCondition)
+}
+
 // SetCertificateRequestCondition will set a 'condition' on the given CertificateRequest.
 //   - If no condition of the same type already exists, the condition will be
 //     inserted with the LastTransitionTime set to the current time.
@@ -397,3 +441,70 @@ func CertificateRequestIsDenied(cr *cmapi.CertificateRequest) bool {
 
 	return false
 }
+
+// GetSSHCertificateRequestCondition returns the condition of the given type
+// on the given SSHCertificateRequest, or nil if it is not set.
+func GetSSHCertificateRequestCondition(req *cmapi.SSHCertificateRequest, conditionType cmapi.CertificateRequestConditionType) *cmapi.CertificateRequestCondition {
+	for i, cond := range req.Status.Conditions {
+		if cond.Type == conditionType {
+			return &req.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetSSHCertificateRequestCondition will set a 'condition' on the given
+// SSHCertificateRequest, following the same rules as
+// SetCertificateRequestCondition.
+func SetSSHCertificateRequestCondition(cr *cmapi.SSHCertificateRequest, conditionType cmapi.CertificateRequestConditionType, status cmmeta.ConditionStatus, reason, message string) {
+	newCondition := cmapi.CertificateRequestCondition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+
+	nowTime := metav1.NewTime(Clock.Now())
+	newCondition.LastTransitionTime = &nowTime
+
+	for idx, cond := range cr.Status.Conditions {
+		if cond.Type != conditionType {
+			continue
+		}
+
+		// If this update doesn't contain a state transition, we don't update
+		// the conditions LastTransitionTime to Now()
+		if cond.Status == status {
+			newCondition.LastTransitionTime = cond.LastTransitionTime
+		}
+
+		cr.Status.Conditions[idx] = newCondition
+		return
+	}
+
+	cr.Status.Conditions = append(cr.Status.Conditions, newCondition)
+}
+
+// SSHCertificateRequestReadyReason returns the reason of the Ready condition
+// of an SSHCertificateRequest, or "" if it is not set.
+func SSHCertificateRequestReadyReason(cr *cmapi.SSHCertificateRequest) string {
+	if cond := GetSSHCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady); cond != nil {
+		return cond.Reason
+	}
+	return ""
+}
+
+// SSHCertificateRequestIsApproved returns true if the SSHCertificateRequest
+// is approved via an Approved condition of status `True`, returns false
+// otherwise.
+func SSHCertificateRequestIsApproved(cr *cmapi.SSHCertificateRequest) bool {
+	cond := GetSSHCertificateRequestCondition(cr, cmapi.CertificateRequestConditionApproved)
+	return cond != nil && cond.Status == cmmeta.ConditionTrue
+}
+
+// SSHCertificateRequestIsDenied returns true if the SSHCertificateRequest is
+// denied via a Denied condition of status `True`, returns false otherwise.
+func SSHCertificateRequestIsDenied(cr *cmapi.SSHCertificateRequest) bool {
+	cond := GetSSHCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDenied)
+	return cond != nil && cond.Status == cmmeta.ConditionTrue
+}
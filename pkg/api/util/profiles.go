@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// CertificateProfileSettings are the defaults and constraints of a
+// certificate profile.
+type CertificateProfileSettings struct {
+	// Usages are requested when the Certificate does not specify any usages.
+	Usages []cmapi.KeyUsage
+
+	// RequiredExtKeyUsage must be present in the Certificate's usages.
+	RequiredExtKeyUsage x509.ExtKeyUsage
+
+	// AllowedExtKeyUsages are the only extended key usages the Certificate's
+	// usages may contain.
+	AllowedExtKeyUsages []x509.ExtKeyUsage
+
+	// MinimumRSAKeySize is the minimum size of RSA private keys, and the size
+	// used when the Certificate does not specify one.
+	MinimumRSAKeySize int
+
+	// OCSPNoCheck is true if the id-pkix-ocsp-nocheck extension should be
+	// requested.
+	OCSPNoCheck bool
+}
+
+var certificateProfiles = map[cmapi.CertificateProfile]CertificateProfileSettings{
+	cmapi.ServerTLSCertificateProfile: {
+		Usages:              []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth},
+		RequiredExtKeyUsage: x509.ExtKeyUsageServerAuth,
+		AllowedExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		MinimumRSAKeySize:   2048,
+	},
+	cmapi.ClientTLSCertificateProfile: {
+		Usages:              []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageClientAuth},
+		RequiredExtKeyUsage: x509.ExtKeyUsageClientAuth,
+		AllowedExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		MinimumRSAKeySize:   2048,
+	},
+	cmapi.CodeSigningCertificateProfile: {
+		Usages:              []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageCodeSigning},
+		RequiredExtKeyUsage: x509.ExtKeyUsageCodeSigning,
+		AllowedExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning, x509.ExtKeyUsageTimeStamping},
+		// The CA/Browser Forum code signing requirements mandate RSA keys of
+		// at least 3072 bits.
+		MinimumRSAKeySize: 3072,
+	},
+	cmapi.SMIMECertificateProfile: {
+		Usages:              []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageEmailProtection},
+		RequiredExtKeyUsage: x509.ExtKeyUsageEmailProtection,
+		AllowedExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection},
+		MinimumRSAKeySize:   2048,
+	},
+	cmapi.OCSPSigningCertificateProfile: {
+		Usages:              []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageOCSPSigning},
+		RequiredExtKeyUsage: x509.ExtKeyUsageOCSPSigning,
+		AllowedExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+		MinimumRSAKeySize:   2048,
+		OCSPNoCheck:         true,
+	},
+}
+
+// CertificateProfileSettingsFor returns the settings of the given certificate
+// profile, or false if the profile is unknown.
+func CertificateProfileSettingsFor(profile cmapi.CertificateProfile) (CertificateProfileSettings, bool) {
+	settings, ok := certificateProfiles[profile]
+	return settings, ok
+}
+
+// CertificateProfiles returns the names of all known certificate profiles.
+func CertificateProfiles() []cmapi.CertificateProfile {
+	return []cmapi.CertificateProfile{
+		cmapi.ServerTLSCertificateProfile,
+		cmapi.ClientTLSCertificateProfile,
+		cmapi.CodeSigningCertificateProfile,
+		cmapi.SMIMECertificateProfile,
+		cmapi.OCSPSigningCertificateProfile,
+	}
+}
+
+// CertificateUsages returns the usages requested by the given Certificate
+// spec: its usages if set, otherwise the default usages of its profile.
+// It returns nil if neither is set, in which case the default key usages
+// apply.
+func CertificateUsages(spec *cmapi.CertificateSpec) []cmapi.KeyUsage {
+	if len(spec.Usages) > 0 {
+		return spec.Usages
+	}
+	if settings, ok := certificateProfiles[spec.Profile]; ok {
+		return settings.Usages
+	}
+	return nil
+}
+
+// DefaultRSAKeySize returns the size of RSA private keys generated for the
+// given Certificate spec when it does not specify one.
+func DefaultRSAKeySize(spec *cmapi.CertificateSpec) int {
+	if settings, ok := certificateProfiles[spec.Profile]; ok {
+		return settings.MinimumRSAKeySize
+	}
+	return 2048
+}
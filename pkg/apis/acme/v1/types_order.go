@@ -98,6 +98,13 @@ type OrderSpec struct {
 	// +optional
 	Profile string `json:"profile,omitempty"`
 
+	// PreferredChain is the chain to use if the ACME server outputs multiple,
+	// overriding the preferredChain of the ACME issuer. It is matched in the
+	// same way as the issuer's preferredChain.
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	PreferredChain string `json:"preferredChain,omitempty"`
+
 	// Replaces is the ARI CertID (RFC 9773 §4.1) of the certificate that this
 	// Order is intended to replace. When set, cert-manager will include the
 	// "replaces" field on the newOrder request to the ACME server if and only
@@ -30,6 +30,9 @@ const (
 
 	// Deprecated: the default is now 2/3 of Certificate's duration
 	DefaultRenewBefore = time.Hour * 24 * 30
+
+	// default SSH certificate duration if SSHCertificate.spec.duration is not set
+	DefaultSSHCertificateDuration = time.Hour * 24
 )
 
 const (
@@ -56,6 +56,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterIssuerList{},
 		&CertificateRequest{},
 		&CertificateRequestList{},
+		&SSHCertificate{},
+		&SSHCertificateList{},
+		&SSHCertificateRequest{},
+		&SSHCertificateRequestList{},
+		&CertificateRequestPolicy{},
+		&CertificateRequestPolicyList{},
+		&CertificateDefaultPolicy{},
+		&CertificateDefaultPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
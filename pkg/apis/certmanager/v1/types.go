@@ -95,6 +95,10 @@ const (
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
+	// Annotation key for the name of the SSHCertificate that a Secret is
+	// related to.
+	SSHCertificateNameKey = "cert-manager.io/ssh-certificate-name"
+
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
@@ -186,14 +190,61 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// SecretDriftPolicyAnnotationKey is an annotation that can be added to
+	// Certificate resources to configure how cert-manager responds when the
+	// Certificate's Secret is modified outside of cert-manager.
+	// A Warning Event and metric are always recorded when drift is detected.
+	// If set to `AlertOnly`, the Certificate will not be re-issued to correct
+	// the drift. If unset or set to `Reissue`, the Certificate is re-issued.
+	SecretDriftPolicyAnnotationKey = "cert-manager.io/secret-drift-policy"
+
+	// AdoptSecretAnnotationKey is an annotation that can be added to
+	// Certificate resources which have not yet been issued. If set to `true`
+	// and the Certificate's Secret already contains a valid certificate and
+	// private key which match the Certificate's spec, the Secret is adopted
+	// as revision 1 of the Certificate rather than a new certificate being
+	// issued. The adopted certificate is renewed at its normal renewal time.
+	AdoptSecretAnnotationKey = "cert-manager.io/adopt-secret"
+
+	// AllowSecretReplicasFromAnnotationKey is an annotation that must be set
+	// on a Namespace for Certificates in other namespaces to replicate their
+	// Secret into it using `spec.secretReplicas`. The value is a comma
+	// separated list of the namespaces that replicas are accepted from, or
+	// `*` to accept replicas from all namespaces.
+	AllowSecretReplicasFromAnnotationKey = "cert-manager.io/allow-secret-replicas-from"
+
+	// SecretReplicaOfAnnotationKey is set on Secrets which are replicas of a
+	// Certificate's Secret. The value is the namespace and name of the
+	// Certificate, in the form `<namespace>/<name>`.
+	SecretReplicaOfAnnotationKey = "cert-manager.io/secret-replica-of"
+
+	// JKSKeystoreFingerprintAnnotationKey and
+	// PKCS12KeystoreFingerprintAnnotationKey are set on Certificate Secrets
+	// which contain a JKS or PKCS12 keystore. The value is a keyed hash of
+	// the keystore and the options it was encoded with, using the keystore
+	// password as the key. It is used to detect when the keystore password,
+	// alias or profile has changed, so that the keystore can be re-encoded.
+	JKSKeystoreFingerprintAnnotationKey    = "cert-manager.io/jks-keystore-fingerprint"
+	PKCS12KeystoreFingerprintAnnotationKey = "cert-manager.io/pkcs12-keystore-fingerprint"
+)
+
+// Values of the SecretDriftPolicyAnnotationKey annotation.
+const (
+	SecretDriftPolicyReissue   = "Reissue"
+	SecretDriftPolicyAlertOnly = "AlertOnly"
 )
 
 // Common/known resource kinds.
 const (
-	ClusterIssuerKind      = "ClusterIssuer"
-	IssuerKind             = "Issuer"
-	CertificateKind        = "Certificate"
-	CertificateRequestKind = "CertificateRequest"
+	ClusterIssuerKind            = "ClusterIssuer"
+	IssuerKind                   = "Issuer"
+	CertificateKind              = "Certificate"
+	CertificateRequestKind       = "CertificateRequest"
+	SSHCertificateKind           = "SSHCertificate"
+	SSHCertificateRequestKind    = "SSHCertificateRequest"
+	CertificateRequestPolicyKind = "CertificateRequestPolicy"
+	CertificateDefaultPolicyKind = "CertificateDefaultPolicy"
 )
 
 const (
@@ -213,12 +264,40 @@ const (
 	// as namespace/name.
 	WantInjectFromSecretAnnotation = "cert-manager.io/inject-ca-from-secret"
 
+	// WantInjectFromIssuerAnnotation is the annotation that specifies that a particular
+	// object wants injection of the CA of an issuer.  It takes the form of a reference
+	// to an Issuer as namespace/name, or to a ClusterIssuer as name. The CAs of CA,
+	// Vault, SelfSigned and Venafi issuers can be injected, and only if cainjector is
+	// run with --enable-issuers-data-source. The CA of a SelfSigned issuer is read from
+	// the Secrets of the Certificates it issued, and the CA of a Venafi issuer is read
+	// from Venafi with the most recent certificate it issued. ACME issuers are not
+	// supported.
+	WantInjectFromIssuerAnnotation = "cert-manager.io/inject-ca-from-issuer"
+
 	// AllowsInjectionFromSecretAnnotation is an annotation that must be added
 	// to Secret resource that want to denote that they can be directly
 	// injected into injectables that have a `inject-ca-from-secret` annotation.
 	// If an injectable references a Secret that does NOT have this annotation,
 	// the cainjector will refuse to inject the secret.
 	AllowsInjectionFromSecretAnnotation = "cert-manager.io/allow-direct-injection"
+
+	// InjectCARemovedAnnotation is the annotation that lists CA certificates
+	// which should no longer be retained in the CA bundles of an injectable
+	// once they have been removed from the CA data source. It takes the form
+	// of a comma separated list of hex encoded SHA-256 fingerprints.
+	InjectCARemovedAnnotation = "cert-manager.io/inject-ca-removed"
+
+	// InjectCAStatusAnnotation is the annotation set by the cainjector on
+	// injectables to record the CA certificates present in the injected CA
+	// bundles, and when any retained CA certificates were removed from the CA
+	// data source. The value is a JSON encoded list and should not be edited.
+	InjectCAStatusAnnotation = "cert-manager.io/inject-ca-status"
+
+	// InjectCAFingerprintAnnotation is the annotation set by the cainjector on
+	// injectables to record the CA data last injected from the CA data source.
+	// It takes the form of a comma separated list of hex encoded SHA-256
+	// fingerprints of the CA certificates.
+	InjectCAFingerprintAnnotation = "cert-manager.io/inject-ca-fingerprint"
 )
 
 // Issuer specific Annotations
@@ -293,6 +372,45 @@ const (
 	UsageNetscapeSGC       KeyUsage = "netscape sgc"
 )
 
+// Staged private key rotation specific secret keys
+const (
+	// NextCertificateSecretKey is the name of the data entry in the Secret
+	// resource used to store the next certificate during a staged private
+	// key rotation.
+	NextCertificateSecretKey = "next.crt"
+	// NextPrivateKeySecretKey is the name of the data entry in the Secret
+	// resource used to store the next private key during a staged private
+	// key rotation.
+	NextPrivateKeySecretKey = "next.key"
+)
+
+// External certificate signing request specific keys
+const (
+	// CertificateSigningRequestKey is the default name of the data entry in
+	// the Secret or ConfigMap referenced by a Certificate's
+	// `spec.externalCSR` which contains the PEM encoded CSR.
+	CertificateSigningRequestKey = "tls.csr"
+)
+
+// SSHCertificate specific secret keys
+const (
+	// SSHPrivateKeySecretKey is the name of the data entry in the Secret
+	// resource used to store the OpenSSH encoded private key. It matches the
+	// key used by Secrets of type `kubernetes.io/ssh-auth`.
+	SSHPrivateKeySecretKey = "ssh-privatekey"
+	// SSHPublicKeySecretKey is the name of the data entry in the Secret
+	// resource used to store the public key in authorized_keys format.
+	SSHPublicKeySecretKey = "ssh-publickey"
+	// SSHCertificateSecretKey is the name of the data entry in the Secret
+	// resource used to store the signed SSH certificate in authorized_keys
+	// format.
+	SSHCertificateSecretKey = "ssh-certificate"
+	// SSHCAPublicKeySecretKey is the name of the data entry in the Secret
+	// resource used to store the public key of the signing SSH CA in
+	// authorized_keys format.
+	SSHCAPublicKeySecretKey = "ssh-ca-publickey"
+)
+
 // Keystore specific secret keys
 const (
 	// PKCS12SecretKey is the name of the data entry in the Secret resource
@@ -307,6 +425,10 @@ const (
 	// Data Entry Name in the Secret resource for JKS containing Certificate Authority
 	JKSTruststoreKey = "truststore.jks"
 
+	// PEMTruststoreKey is the name of the data entry in the Secret resource
+	// used to store the PEM encoded CA chain of the certificate.
+	PEMTruststoreKey = "truststore.pem"
+
 	// The password used to encrypt the keystore and truststore
 	KeystorePassword = "keystorePassword"
 )
@@ -108,6 +108,37 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
+// +kubebuilder:validation:Enum=serverTLS;clientTLS;codeSigning;smime;ocspSigning
+type CertificateProfile string
+
+const (
+	// ServerTLSCertificateProfile is for TLS server certificates. It requests
+	// the `server auth` extended key usage and requires `dnsNames` or
+	// `ipAddresses` to be set.
+	ServerTLSCertificateProfile CertificateProfile = "serverTLS"
+
+	// ClientTLSCertificateProfile is for TLS client certificates. It requests
+	// the `client auth` extended key usage. Like any Certificate, it requires
+	// a common name or a subject alternative name to be set.
+	ClientTLSCertificateProfile CertificateProfile = "clientTLS"
+
+	// CodeSigningCertificateProfile is for code signing certificates. It
+	// requests the `code signing` extended key usage, requires `commonName`
+	// or `literalSubject` to be set and defaults RSA keys to 3072 bits.
+	CodeSigningCertificateProfile CertificateProfile = "codeSigning"
+
+	// SMIMECertificateProfile is for S/MIME certificates. It requests the
+	// `email protection` extended key usage and requires `emailAddresses` to
+	// be set.
+	SMIMECertificateProfile CertificateProfile = "smime"
+
+	// OCSPSigningCertificateProfile is for delegated OCSP responder
+	// certificates. It requests the `ocsp signing` extended key usage,
+	// requires `commonName` or `literalSubject` to be set and adds the
+	// id-pkix-ocsp-nocheck extension (RFC 6960, 4.2.2.2.1) to the request.
+	OCSPSigningCertificateProfile CertificateProfile = "ocspSigning"
+)
+
 // +kubebuilder:validation:Enum=SHA256WithRSA;SHA384WithRSA;SHA512WithRSA;ECDSAWithSHA256;ECDSAWithSHA384;ECDSAWithSHA512;PureEd25519
 type SignatureAlgorithm string
 
@@ -254,6 +285,46 @@ type CertificateSpec struct {
 	// +optional
 	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
 
+	// Defines other namespaces that the Certificate's Secret will be
+	// replicated to. Replicas have the same name, data, labels and annotations
+	// as the Certificate's Secret, and are only written to namespaces which
+	// have opted in to receiving replicas from the Certificate's namespace
+	// using the `cert-manager.io/allow-secret-replicas-from` annotation.
+	// +optional
+	SecretReplicas *CertificateSecretReplicas `json:"secretReplicas,omitempty"`
+
+	// Defines a ConfigMap that the CA certificate stored in the `ca.crt` key
+	// of the Certificate's Secret, and optionally the certificate stored in
+	// `tls.crt`, is published to. This allows clients which only need to
+	// trust the certificate to do so without read access to the Secret,
+	// which also contains the private key. The ConfigMap lives in the same
+	// namespace as the Certificate resource.
+	// +optional
+	TrustConfigMap *CertificateTrustConfigMap `json:"trustConfigMap,omitempty"`
+
+	// Requests a manual renewal of the certificate. Setting this field, or
+	// changing its `id`, causes the certificate to be re-issued once. The
+	// request, including the user who made it, is recorded in
+	// `status.lastRenewalRequest` and surfaced as an Event once it has been
+	// handled, so that manual renewals are auditable.
+	// +optional
+	RenewalRequest *CertificateRenewalRequest `json:"renewalRequest,omitempty"`
+
+	// Configures the certificate to be requested using a PEM encoded
+	// certificate signing request (CSR) that was created outside of
+	// cert-manager, for example by a hardware security module (HSM) which
+	// holds the private key. When set, cert-manager does not generate a
+	// private key. CertificateRequests are created from the referenced CSR,
+	// and the Certificate's Secret only contains the issued certificate
+	// (`tls.crt`) and CA (`ca.crt`), with an empty `tls.key`.
+	//
+	// The CSR must match the rest of the Certificate's spec. Replacing the CSR,
+	// for example after rotating the private key, triggers a re-issuance.
+	// This field cannot be combined with `privateKey`, `keystores` or
+	// `additionalOutputFormats`.
+	// +optional
+	ExternalCSR *CertificateExternalCSR `json:"externalCSR,omitempty"`
+
 	// Additional keystore output formats to be stored in the Certificate's Secret.
 	// +optional
 	Keystores *CertificateKeystores `json:"keystores,omitempty"`
@@ -281,11 +352,26 @@ type CertificateSpec struct {
 	// resources. If `encodeUsagesInRequest` is unset or set to `true`, the usages
 	// will additionally be encoded in the `request` field which contains the CSR blob.
 	//
-	// If unset, defaults to `digital signature` and `key encipherment`.
+	// If unset, defaults to the usages of `profile` if set, or otherwise to
+	// `digital signature` and `key encipherment`.
 	// +optional
 	// +listType=atomic
 	Usages []KeyUsage `json:"usages,omitempty"`
 
+	// Profile is a named certificate profile which sets sane defaults for, and
+	// validates, the Certificate's usages, private key size and extensions.
+	// Allowed values are `serverTLS`, `clientTLS`, `codeSigning`, `smime` and
+	// `ocspSigning`.
+	//
+	// If `usages` is unset, the profile's default usages are requested.
+	// If `usages` is set, it must contain the profile's extended key usage and
+	// must not contain extended key usages which the profile does not allow.
+	// The profile may also require other fields to be set, for example the
+	// `smime` profile requires `emailAddresses`. Certificates using a profile
+	// cannot be CAs.
+	// +optional
+	Profile CertificateProfile `json:"profile,omitempty"`
+
 	// Private key options. These include the key algorithm and size, the used
 	// encoding and the rotation policy.
 	// +optional
@@ -392,6 +478,19 @@ type CertificatePrivateKey struct {
 	// No other values are allowed.
 	// +optional
 	Size int `json:"size,omitempty"`
+
+	// RotationOverlap enables staged private key rotation. When set, and a
+	// re-issuance results in a new private key, the new certificate and
+	// private key are first published to the `next.crt` and `next.key`
+	// entries of the Certificate's Secret, alongside the current
+	// certificate and private key. They are promoted to `tls.crt` and
+	// `tls.key` once the overlap period has passed, or when the current
+	// certificate expires if that happens earlier. This gives peers which
+	// pin or pre-load keys time to learn the next key before it is used.
+	// The Certificate remains `Issuing` until the new key is promoted.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// +optional
+	RotationOverlap *metav1.Duration `json:"rotationOverlap,omitempty"`
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -500,6 +599,22 @@ type CertificateKeystores struct {
 	// `spec.secretName` Secret resource.
 	// +optional
 	PKCS12 *PKCS12Keystore `json:"pkcs12,omitempty"`
+
+	// PEMTruststore configures options for storing a PEM encoded truststore
+	// in the `spec.secretName` Secret resource.
+	// +optional
+	PEMTruststore *PEMTruststore `json:"pemTruststore,omitempty"`
+}
+
+// PEMTruststore configures options for storing the CA chain of the
+// certificate as a PEM bundle in the target secret.
+type PEMTruststore struct {
+	// Create enables PEM truststore creation for the Certificate.
+	// If true, a file named `truststore.pem` will be created in the target
+	// Secret resource, containing the intermediate certificates of the chain
+	// and the issuing Certificate Authority, if provided by the issuer.
+	// The leaf certificate and private key are not included.
+	Create bool `json:"create"`
 }
 
 // JKS configures options for storing a JKS keystore in the target secret.
@@ -566,6 +681,21 @@ type PKCS12Keystore struct {
 	// +optional
 	Profile PKCS12Profile `json:"profile,omitempty"`
 
+	// Alias specifies the friendly name of the private key entry, and of its
+	// certificate, in the keystore. If not provided, no friendly name is set.
+	// Can only be set when `profile` is `Modern2023`.
+	// +optional
+	Alias *string `json:"alias,omitempty"`
+
+	// KeyPasswordSecretRef is a reference to a non-empty key in a Secret
+	// resource containing the password used to encrypt the private key entry
+	// of the PKCS#12 keystore, for consumers which require the private key
+	// entry to have its own password. If not provided, the private key entry
+	// is encrypted using the keystore password.
+	// Can only be set when `profile` is `Modern2023`.
+	// +optional
+	KeyPasswordSecretRef *cmmeta.SecretKeySelector `json:"keyPasswordSecretRef,omitempty"`
+
 	// PasswordSecretRef is a reference to a non-empty key in a Secret resource
 	// containing the password used to encrypt the PKCS#12 keystore.
 	// Mutually exclusive with password.
@@ -702,6 +832,13 @@ type CertificateStatus struct {
 	// +optional
 	NextPrivateKeySecretName *string `json:"nextPrivateKeySecretName,omitempty"`
 
+	// The time at which the next certificate and private key were published
+	// to the `next.crt` and `next.key` entries of the Secret as part of a
+	// staged private key rotation. It is unset once they are promoted to
+	// `tls.crt` and `tls.key`.
+	// +optional
+	NextPrivateKeyPublishedTime *metav1.Time `json:"nextPrivateKeyPublishedTime,omitempty"`
+
 	// The number of continuous failed issuance attempts up till now. This
 	// field gets removed (if set) on a successful issuance and gets set to
 	// 1 if unset and an issuance has failed. If an issuance has failed, the
@@ -710,6 +847,11 @@ type CertificateStatus struct {
 	// +optional
 	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
 
+	// The last renewal request from `spec.renewalRequest` which has been
+	// handled by triggering an issuance.
+	// +optional
+	LastRenewalRequest *CertificateRenewalRequestStatus `json:"lastRenewalRequest,omitempty"`
+
 	// ACME stores information that is fetched from the ACME CA server.
 	// +optional
 	ACME *CertificateACMEStatus `json:"acme,omitempty"`
@@ -776,6 +918,12 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// A condition added to Certificate resources by the 'issuing' controller
+	// when the ConfigMap named in `spec.trustConfigMap` already exists and is
+	// not managed by the Certificate, in which case the ConfigMap is not
+	// updated. It is removed once the ConfigMap can be managed.
+	CertificateConditionTrustConfigMapConflict CertificateConditionType = "TrustConfigMapConflict"
 )
 
 // CertificateSecretTemplate defines the default labels and annotations
@@ -790,6 +938,98 @@ type CertificateSecretTemplate struct {
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
+// CertificateSecretReplicas defines the namespaces that the Kubernetes Secret
+// resource named in `CertificateSpec.secretName` is replicated to.
+type CertificateSecretReplicas struct {
+	// Namespaces is a list of namespaces to replicate the Secret to.
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects namespaces to replicate the Secret to, in
+	// addition to those listed in `namespaces`.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// CertificateTrustConfigMap defines the ConfigMap that the CA certificate of
+// the Certificate's Secret is published to.
+type CertificateTrustConfigMap struct {
+	// Name of the ConfigMap resource that will be automatically created and
+	// managed by this Certificate resource.
+	Name string `json:"name"`
+
+	// IncludeCertificate, if true, additionally publishes the certificate
+	// stored in the `tls.crt` key of the Certificate's Secret to the
+	// ConfigMap.
+	// +optional
+	IncludeCertificate bool `json:"includeCertificate,omitempty"`
+}
+
+// CertificateExternalCSR references a certificate signing request stored in a
+// Secret or ConfigMap in the same namespace as the Certificate.
+type CertificateExternalCSR struct {
+	// Kind of the resource containing the CSR, either `Secret` or
+	// `ConfigMap`. Defaults to `Secret`.
+	// +optional
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the resource containing the CSR.
+	Name string `json:"name"`
+
+	// Key of the entry containing the PEM encoded CSR. Defaults to `tls.csr`.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// CertificateRenewalRequest is a request to manually renew a certificate.
+type CertificateRenewalRequest struct {
+	// ID uniquely identifies the renewal request. A new issuance is only
+	// triggered when the ID differs from the ID of the last handled request,
+	// so the ID must be changed to request another renewal.
+	ID string `json:"id"`
+
+	// Reason is a human readable explanation of why the renewal was
+	// requested, for example a reference to an incident.
+	Reason string `json:"reason"`
+
+	// RotatePrivateKey, if true, forces a new private key to be generated
+	// for the renewed certificate, regardless of the
+	// `spec.privateKey.rotationPolicy` of the Certificate.
+	// +optional
+	RotatePrivateKey bool `json:"rotatePrivateKey,omitempty"`
+
+	// RequestedBy is the name of the user who requested the renewal. It is
+	// set by the cert-manager webhook and cannot be set by the user.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
+
+// CertificateRenewalRequestStatus records a renewal request which has been
+// handled.
+type CertificateRenewalRequestStatus struct {
+	// ID of the handled renewal request.
+	ID string `json:"id"`
+
+	// Reason given for the handled renewal request.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// RequestedBy is the name of the user who requested the renewal.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// RotatePrivateKey is true if a new private key was requested.
+	// +optional
+	RotatePrivateKey bool `json:"rotatePrivateKey,omitempty"`
+
+	// HandledTime is the time at which the renewal request was handled by
+	// triggering an issuance.
+	// +optional
+	HandledTime *metav1.Time `json:"handledTime,omitempty"`
+}
+
 // NameConstraints is a type to represent x509 NameConstraints
 type NameConstraints struct {
 	// if true then the name constraints are marked critical.
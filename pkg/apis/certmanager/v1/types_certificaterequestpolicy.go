@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+// +kubebuilder:resource:scope=Cluster,shortName={crp,crps},categories=cert-manager
+
+// A CertificateRequestPolicy restricts which CertificateRequests referencing
+// the selected issuers are approved by cert-manager's built-in approver.
+//
+// A CertificateRequest referencing an issuer which is selected by at least
+// one CertificateRequestPolicy is approved if it is permitted by one of them,
+// and denied otherwise. CertificateRequests referencing issuers which are not
+// selected by any CertificateRequestPolicy are approved unconditionally.
+//
+// SSHCertificateRequests are approved and denied in the same way, and are
+// only permitted by policies which set `allowed.ssh`.
+type CertificateRequestPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired state of the CertificateRequestPolicy.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Spec CertificateRequestPolicySpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateRequestPolicyList is a list of CertificateRequestPolicies.
+type CertificateRequestPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of CertificateRequestPolicies
+	Items []CertificateRequestPolicy `json:"items"`
+}
+
+// CertificateRequestPolicySpec defines the desired state of
+// CertificateRequestPolicy.
+type CertificateRequestPolicySpec struct {
+	// IssuerRefs selects the issuers whose CertificateRequests are governed by
+	// this policy. At least one must be specified.
+	// +listType=atomic
+	IssuerRefs []CertificateRequestPolicyIssuerRef `json:"issuerRefs"`
+
+	// NamespaceSelector restricts the namespaces CertificateRequests permitted
+	// by this policy can be created in. If unset, CertificateRequests in any
+	// namespace are permitted.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Requesters restricts the users and groups that can create
+	// CertificateRequests permitted by this policy. If unset, CertificateRequests
+	// created by anyone are permitted.
+	// +optional
+	Requesters *CertificateRequestPolicyRequesters `json:"requesters,omitempty"`
+
+	// Allowed are the attributes that CertificateRequests permitted by this
+	// policy may request.
+	// +optional
+	Allowed CertificateRequestPolicyAllowed `json:"allowed"`
+}
+
+// CertificateRequestPolicyIssuerRef selects issuers by name, kind and group.
+// Each field may contain `*` wildcards, and an empty field matches any value.
+type CertificateRequestPolicyIssuerRef struct {
+	// Name of the selected issuers.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Kind of the selected issuers, matched against the defaulted kind
+	// (`Issuer`) of the CertificateRequest's issuerRef.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the selected issuers, matched against the defaulted group
+	// (`cert-manager.io`) of the CertificateRequest's issuerRef.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateRequestPolicyRequesters restricts the users and groups that can
+// create CertificateRequests. A CertificateRequest matches if it was created
+// by one of the users or by a member of one of the groups.
+type CertificateRequestPolicyRequesters struct {
+	// Usernames which may create CertificateRequests. Entries may contain `*`
+	// wildcards, e.g: "system:serviceaccount:my-namespace:*".
+	// +optional
+	// +listType=atomic
+	Usernames []string `json:"usernames,omitempty"`
+
+	// Groups whose members may create CertificateRequests.
+	// +optional
+	// +listType=atomic
+	Groups []string `json:"groups,omitempty"`
+}
+
+// CertificateRequestPolicyAllowed are the attributes a CertificateRequest may
+// request. Entries of the `commonNames`, `dnsNames`, `uris` and
+// `emailAddresses` lists may contain `*` wildcards, which match any sequence
+// of characters. Unless stated otherwise, a CertificateRequest may not
+// request an attribute whose list is empty. Subject alternative names other
+// than DNS names, IP addresses, URIs and email addresses, such as otherName
+// SANs, may never be requested.
+type CertificateRequestPolicyAllowed struct {
+	// CommonNames which may be requested.
+	// +optional
+	// +listType=atomic
+	CommonNames []string `json:"commonNames,omitempty"`
+
+	// Subject are the subject attributes, other than the common name, which
+	// may be requested.
+	// +optional
+	Subject *CertificateRequestPolicyAllowedSubject `json:"subject,omitempty"`
+
+	// DNSNames which may be requested, e.g: "*.example.com".
+	// +optional
+	// +listType=atomic
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPRanges are the CIDR ranges of the IP addresses which may be
+	// requested, e.g: "10.0.0.0/8".
+	// +optional
+	// +listType=atomic
+	IPRanges []string `json:"ipRanges,omitempty"`
+
+	// URIs which may be requested, e.g: "spiffe://example.com/ns/*".
+	// +optional
+	// +listType=atomic
+	URIs []string `json:"uris,omitempty"`
+
+	// EmailAddresses which may be requested, e.g: "*@example.com".
+	// +optional
+	// +listType=atomic
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// Usages which may be requested. CertificateRequests which do not request
+	// any usages request `digital signature` and `key encipherment`.
+	// +optional
+	// +listType=atomic
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// KeyAlgorithms are the private key algorithms which may be requested.
+	// If unset, any private key algorithm may be requested.
+	// +optional
+	// +listType=atomic
+	KeyAlgorithms []PrivateKeyAlgorithm `json:"keyAlgorithms,omitempty"`
+
+	// MaxDuration is the longest duration which may be requested.
+	// CertificateRequests which do not request a duration request 90 days.
+	// If unset, any duration may be requested.
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+
+	// IsCA is true if CA certificates may be requested.
+	// +optional
+	IsCA bool `json:"isCA,omitempty"`
+
+	// SSH are the attributes that SSHCertificateRequests permitted by this
+	// policy may request. The `namespaceSelector` and `requesters` of the
+	// policy, and the `keyAlgorithms` and `maxDuration` above, also apply to
+	// SSHCertificateRequests. If unset, no SSHCertificateRequest is permitted
+	// by this policy.
+	// +optional
+	SSH *CertificateRequestPolicyAllowedSSH `json:"ssh,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedSSH are the attributes an
+// SSHCertificateRequest may request. Entries may contain `*` wildcards, which
+// match any sequence of characters. SSHCertificateRequests which do not
+// request a duration request 24 hours.
+type CertificateRequestPolicyAllowedSSH struct {
+	// UserPrincipals which `User` SSH certificates may request.
+	// +optional
+	// +listType=atomic
+	UserPrincipals []string `json:"userPrincipals,omitempty"`
+
+	// HostPrincipals which `Host` SSH certificates may request.
+	// +optional
+	// +listType=atomic
+	HostPrincipals []string `json:"hostPrincipals,omitempty"`
+
+	// Extensions are the names of the extensions which may be requested.
+	// `User` SSHCertificateRequests which do not request any extensions
+	// request the extensions that OpenSSH enables by default:
+	// `permit-X11-forwarding`, `permit-agent-forwarding`,
+	// `permit-port-forwarding`, `permit-pty` and `permit-user-rc`.
+	// +optional
+	// +listType=atomic
+	Extensions []string `json:"extensions,omitempty"`
+
+	// CriticalOptions are the names of the critical options which may be
+	// requested.
+	// +optional
+	// +listType=atomic
+	CriticalOptions []string `json:"criticalOptions,omitempty"`
+}
+
+// CertificateRequestPolicyAllowedSubject are the subject attributes, other
+// than the common name, which a CertificateRequest may request. Entries may
+// contain `*` wildcards, which match any sequence of characters. Subject
+// attributes of other types may never be requested.
+type CertificateRequestPolicyAllowedSubject struct {
+	// Organizations (O) which may be requested.
+	// +optional
+	// +listType=atomic
+	Organizations []string `json:"organizations,omitempty"`
+
+	// OrganizationalUnits (OU) which may be requested.
+	// +optional
+	// +listType=atomic
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+
+	// Countries (C) which may be requested.
+	// +optional
+	// +listType=atomic
+	Countries []string `json:"countries,omitempty"`
+
+	// Provinces (ST) which may be requested.
+	// +optional
+	// +listType=atomic
+	Provinces []string `json:"provinces,omitempty"`
+
+	// Localities (L) which may be requested.
+	// +optional
+	// +listType=atomic
+	Localities []string `json:"localities,omitempty"`
+
+	// StreetAddresses which may be requested.
+	// +optional
+	// +listType=atomic
+	StreetAddresses []string `json:"streetAddresses,omitempty"`
+
+	// PostalCodes which may be requested.
+	// +optional
+	// +listType=atomic
+	PostalCodes []string `json:"postalCodes,omitempty"`
+
+	// SerialNumbers which may be requested.
+	// +optional
+	// +listType=atomic
+	SerialNumbers []string `json:"serialNumbers,omitempty"`
+}
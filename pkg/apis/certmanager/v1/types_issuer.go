@@ -249,6 +249,12 @@ type VaultIssuer struct {
 	// "my_pki_mount/sign/my-role-name".
 	Path string `json:"path"`
 
+	// SSHPath is the mount path of the Vault SSH secrets engine's `sign`
+	// endpoint, e.g: "my_ssh_mount/sign/my-role-name". It must be set for
+	// the issuer to be able to sign SSHCertificates.
+	// +optional
+	SSHPath string `json:"sshPath,omitempty"`
+
 	// Name of the vault namespace. Namespaces is a set of features within Vault Enterprise that allows Vault environments to support Secure Multi-tenancy. e.g: "ns1"
 	// More about namespaces can be found here https://www.vaultproject.io/docs/enterprise/namespaces
 	// +optional
@@ -281,6 +287,14 @@ type VaultIssuer struct {
 	// Vault server requires mTLS.
 	// +optional
 	ClientKeySecretRef *cmmeta.SecretKeySelector `json:"clientKeySecretRef,omitempty"`
+
+	// SPIFFE configures this issuer to bind the URI SAN of the certificates it
+	// issues to the SPIFFE ID of the ServiceAccount which created the
+	// CertificateRequest.
+	// In the `Derive` mode, the Vault role must be configured with
+	// `use_csr_sans=false` and must allow the SPIFFE IDs as URI SANs.
+	// +optional
+	SPIFFE *SPIFFEIdentity `json:"spiffe,omitempty"`
 }
 
 // VaultAuth is configuration used to authenticate with a Vault server. The
@@ -463,8 +477,79 @@ type CAIssuer struct {
 	// +optional
 	// +listType=atomic
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// SPIFFE configures this issuer to bind the URI SAN of the certificates it
+	// issues to the SPIFFE ID of the ServiceAccount which created the
+	// CertificateRequest.
+	// +optional
+	SPIFFE *SPIFFEIdentity `json:"spiffe,omitempty"`
+
+	// SSH allows this issuer to sign SSHCertificates. The private key stored
+	// in the issuer's Secret is then also used as an SSH certificate
+	// authority, so SSH servers and clients which trust its public key accept
+	// the SSH certificates signed by this issuer. Like CertificateRequests,
+	// SSHCertificateRequests are only signed once they have been approved,
+	// and may only request the principals allowed here.
+	// If not set, SSHCertificateRequests referencing this issuer are not
+	// signed.
+	// +optional
+	SSH *CAIssuerSSH `json:"ssh,omitempty"`
+}
+
+// CAIssuerSSH configures the signing of SSHCertificates by a CA issuer.
+// Entries of the allowed principal lists may contain `*` wildcards, which
+// match any sequence of characters, e.g. `*.example.com`. At least one of
+// the lists must be set.
+type CAIssuerSSH struct {
+	// AllowedUserPrincipals is the list of principals which `User` SSH
+	// certificates signed by this issuer may request. If empty, no `User`
+	// SSH certificates are signed.
+	// +optional
+	// +listType=atomic
+	AllowedUserPrincipals []string `json:"allowedUserPrincipals,omitempty"`
+
+	// AllowedHostPrincipals is the list of principals which `Host` SSH
+	// certificates signed by this issuer may request. If empty, no `Host`
+	// SSH certificates are signed.
+	// +optional
+	// +listType=atomic
+	AllowedHostPrincipals []string `json:"allowedHostPrincipals,omitempty"`
+}
+
+// SPIFFEIdentity configures an issuer to issue certificates whose only URI SAN
+// is the SPIFFE ID of the ServiceAccount which created the CertificateRequest,
+// in the form `spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>`.
+// CertificateRequests which were not created by a ServiceAccount, which request
+// a CA certificate or which request any other URI SAN are rejected.
+type SPIFFEIdentity struct {
+	// TrustDomain is the SPIFFE trust domain of the issued SPIFFE IDs, e.g.
+	// `cluster.local`.
+	TrustDomain string `json:"trustDomain"`
+
+	// Mode controls how the SPIFFE ID is added to issued certificates.
+	// In the `Constrain` mode, CertificateRequests must request the SPIFFE ID
+	// as their URI SAN. In the `Derive` mode, CertificateRequests may omit the
+	// URI SAN and the SPIFFE ID is added to the issued certificate.
+	// Defaults to `Constrain`.
+	// +optional
+	// +kubebuilder:validation:Enum=Constrain;Derive
+	Mode SPIFFEIdentityMode `json:"mode,omitempty"`
 }
 
+// SPIFFEIdentityMode controls how an issuer adds SPIFFE IDs to the
+// certificates it issues.
+type SPIFFEIdentityMode string
+
+const (
+	// SPIFFEIdentityModeConstrain requires CertificateRequests to request the
+	// SPIFFE ID of their requester as URI SAN.
+	SPIFFEIdentityModeConstrain SPIFFEIdentityMode = "Constrain"
+
+	// SPIFFEIdentityModeDerive adds the SPIFFE ID of the requester to issued
+	// certificates, if it was not requested by the CertificateRequest.
+	SPIFFEIdentityModeDerive SPIFFEIdentityMode = "Derive"
+)
+
 // IssuerStatus contains status information about an Issuer
 type IssuerStatus struct {
 	// List of status conditions to indicate the status of a CertificateRequest.
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Approved",type="string",JSONPath=`.status.conditions[?(@.type == "Approved")].status`
+// +kubebuilder:printcolumn:name="Denied",type="string",JSONPath=`.status.conditions[?(@.type == "Denied")].status`
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type == "Ready")].status`
+// +kubebuilder:printcolumn:name="Issuer",type="string",JSONPath=`.spec.issuerRef.name`
+// +kubebuilder:printcolumn:name="Requester",type="string",JSONPath=`.spec.username`
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=`.status.conditions[?(@.type == "Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`,description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+// +kubebuilder:resource:scope=Namespaced,shortName={sshcr,sshcrs},categories=cert-manager
+// +kubebuilder:subresource:status
+
+// An SSHCertificateRequest is used to request a signed OpenSSH certificate
+// for a public key from one of the configured issuers. SSHCertificates create
+// an SSHCertificateRequest for each issuance.
+//
+// Like CertificateRequests, SSHCertificateRequests are only signed once they
+// have been approved, and all fields within the `spec` are immutable after
+// creation. An SSHCertificateRequest will either succeed or fail, as denoted
+// by its `Ready` status condition and its `status.failureTime` field.
+type SSHCertificateRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired state of the SSHCertificateRequest resource.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Spec SSHCertificateRequestSpec `json:"spec"`
+
+	// Status of the SSHCertificateRequest.
+	// This is set and managed automatically.
+	// Read-only.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status SSHCertificateRequestStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SSHCertificateRequestList is a list of SSHCertificateRequests.
+type SSHCertificateRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of SSHCertificateRequests
+	Items []SSHCertificateRequest `json:"items"`
+}
+
+// SSHCertificateRequestSpec defines the desired state of
+// SSHCertificateRequest.
+type SSHCertificateRequestSpec struct {
+	// Type of the SSH certificate, either `User` or `Host`.
+	// Defaults to `User` if not specified.
+	// +optional
+	Type SSHCertificateType `json:"type,omitempty"`
+
+	// KeyID is the key identifier of the SSH certificate, which is logged by
+	// the SSH server when the certificate is used for authentication.
+	// Defaults to `<namespace>/<name>` of the SSHCertificateRequest if not
+	// specified.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Principals is the list of user names (for `User` certificates) or host
+	// names (for `Host` certificates) the SSH certificate is valid for.
+	// At least one principal must be specified.
+	// +listType=atomic
+	Principals []string `json:"principals"`
+
+	// Requested 'duration' (i.e. lifetime) of the SSH certificate. Note that
+	// the issuer may choose to ignore the requested duration.
+	//
+	// If unset, this defaults to 24 hours.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Extensions to be included in the SSH certificate. Extensions can only be
+	// set on `User` certificates.
+	// If unset, `User` certificates are issued with the extensions that
+	// OpenSSH enables by default.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty"`
+
+	// CriticalOptions to be included in the SSH certificate.
+	// +optional
+	CriticalOptions map[string]string `json:"criticalOptions,omitempty"`
+
+	// The public key to be signed, in the OpenSSH `authorized_keys` format.
+	PublicKey []byte `json:"publicKey"`
+
+	// Reference to the issuer responsible for issuing the SSH certificate.
+	// Only CA issuers with `ssh` set and Vault issuers with `sshPath` set are
+	// supported.
+	// If the issuer is namespace-scoped, it must be in the same namespace as
+	// the SSHCertificateRequest. If the issuer is cluster-scoped, it can be
+	// used from any namespace.
+	//
+	// The `name` field of the reference must always be specified.
+	IssuerRef cmmeta.IssuerReference `json:"issuerRef"`
+
+	// Username contains the name of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	// +optional
+	Username string `json:"username,omitempty"`
+	// UID contains the uid of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	// +optional
+	UID string `json:"uid,omitempty"`
+	// Groups contains group membership of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	// +optional
+	// +listType=atomic
+	Groups []string `json:"groups,omitempty"`
+	// Extra contains extra attributes of the user that created the SSHCertificateRequest.
+	// Populated by the cert-manager webhook on creation and immutable.
+	// +optional
+	Extra map[string][]string `json:"extra,omitempty"`
+}
+
+// SSHCertificateRequestStatus defines the observed state of
+// SSHCertificateRequest and the resulting signed SSH certificate.
+type SSHCertificateRequestStatus struct {
+	// List of status conditions to indicate the status of an
+	// SSHCertificateRequest.
+	// Known condition types are `Ready`, `Approved` and `Denied`.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []CertificateRequestCondition `json:"conditions,omitempty"`
+
+	// The signed SSH certificate, in the OpenSSH `authorized_keys` format.
+	// If not set, the SSHCertificateRequest has either not been completed or
+	// has failed. More information on failure can be found by checking the
+	// `conditions` field.
+	// +optional
+	Certificate []byte `json:"certificate,omitempty"`
+
+	// FailureTime stores the time that this SSHCertificateRequest failed.
+	// +optional
+	FailureTime *metav1.Time `json:"failureTime,omitempty"`
+}
@@ -41,6 +41,13 @@ type CAInjectorConfiguration struct {
 	// Should not be used with --namespace.
 	IgnoreNamespaces []string `json:"ignoreNamespaces,omitempty"`
 
+	// Namespace to read resources owned by cluster scoped resources such as
+	// ClusterIssuer from.
+	// This should match the clusterResourceNamespace of the cert-manager
+	// controller.
+	// Defaults to 'kube-system'.
+	ClusterResourceNamespace string `json:"clusterResourceNamespace,omitempty"`
+
 	// LeaderElectionConfig configures the behaviour of the leader election
 	LeaderElectionConfig sharedv1alpha1.LeaderElectionConfig `json:"leaderElectionConfig"`
 
@@ -52,6 +59,11 @@ type CAInjectorConfiguration struct {
 	// cert-manager resources as potential targets for CA data injection.
 	EnableInjectableConfig EnableInjectableConfig `json:"enableInjectableConfig"`
 
+	// caBundleRetention configures which CA certificates are retained in
+	// injected CA bundles after they have been removed from the CA data source.
+	// Only used if the CAInjectorMerging feature gate is enabled.
+	CABundleRetention CABundleRetentionConfig `json:"caBundleRetention"`
+
 	// Enable profiling for cainjector.
 	EnablePprof bool `json:"enablePprof"`
 
@@ -83,6 +95,12 @@ type EnableDataSourceConfig struct {
 	// cert-manager Certificate resources as potential sources of CA data.
 	// If not set, defaults to true.
 	Certificates *bool `json:"certificates"`
+
+	// Issuers determines whether cainjector's control loops will watch
+	// cert-manager Issuer and ClusterIssuer resources as potential sources of
+	// CA data.
+	// If not set, defaults to false.
+	Issuers *bool `json:"issuers"`
 }
 
 type EnableInjectableConfig struct {
@@ -110,3 +128,17 @@ type EnableInjectableConfig struct {
 	// If not set, defaults to true.
 	APIServices *bool `json:"apiServices"`
 }
+
+type CABundleRetentionConfig struct {
+	// maxCertificates is the maximum number of CA certificates in an injected
+	// CA bundle. CA certificates from the CA data source are always injected,
+	// and the CA certificates which were removed from the CA data source the
+	// longest time ago are dropped first.
+	// If not set or 0, the number of CA certificates is not limited.
+	MaxCertificates *int32 `json:"maxCertificates,omitempty"`
+
+	// gracePeriod is how long a CA certificate is retained in an injected CA
+	// bundle after it has been removed from the CA data source.
+	// If not set or 0, CA certificates are retained until they expire.
+	GracePeriod *sharedv1alpha1.Duration `json:"gracePeriod,omitempty"`
+}
@@ -22,9 +22,36 @@ limitations under the License.
 package v1alpha1
 
 import (
+	sharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleRetentionConfig) DeepCopyInto(out *CABundleRetentionConfig) {
+	*out = *in
+	if in.MaxCertificates != nil {
+		in, out := &in.MaxCertificates, &out.MaxCertificates
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleRetentionConfig.
+func (in *CABundleRetentionConfig) DeepCopy() *CABundleRetentionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleRetentionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CAInjectorConfiguration) DeepCopyInto(out *CAInjectorConfiguration) {
 	*out = *in
@@ -37,6 +64,7 @@ func (in *CAInjectorConfiguration) DeepCopyInto(out *CAInjectorConfiguration) {
 	in.LeaderElectionConfig.DeepCopyInto(&out.LeaderElectionConfig)
 	in.EnableDataSourceConfig.DeepCopyInto(&out.EnableDataSourceConfig)
 	in.EnableInjectableConfig.DeepCopyInto(&out.EnableInjectableConfig)
+	in.CABundleRetention.DeepCopyInto(&out.CABundleRetention)
 	in.Logging.DeepCopyInto(&out.Logging)
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
@@ -75,6 +103,11 @@ func (in *EnableDataSourceConfig) DeepCopyInto(out *EnableDataSourceConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Issuers != nil {
+		in, out := &in.Issuers, &out.Issuers
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
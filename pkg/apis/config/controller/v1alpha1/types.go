@@ -116,6 +116,13 @@ type ControllerConfiguration struct {
 	// TLS config for the metrics endpoint
 	MetricsTLSConfig sharedv1alpha1.TLSConfig `json:"metricsTLSConfig"`
 
+	// Expose the serial number of the certificate stored in the Secret of
+	// each Certificate as the certmanager_certificate_serial_info metric. The
+	// serial number changes on every renewal, so each renewal creates a new
+	// time series.
+	// Defaults to false.
+	EnableCertificateSerialMetric *bool `json:"enableCertificateSerialMetric,omitempty"`
+
 	// The host and port address, separated by a ':', that the healthz server
 	// should listen on.
 	HealthzListenAddress string `json:"healthzListenAddress,omitempty"`
@@ -152,6 +159,14 @@ type ControllerConfiguration struct {
 	// gatewayAPI configures the behaviour of the Gateway API integration
 	GatewayAPIConfig GatewayAPIConfig `json:"gatewayAPI,omitzero"`
 
+	// tlsSecretExpiryConfig configures the behaviour of the tls-secret-expiry
+	// controller
+	TLSSecretExpiryConfig TLSSecretExpiryConfig `json:"tlsSecretExpiryConfig,omitzero"`
+
+	// issuanceRateLimitConfig configures the rate at which CertificateRequests
+	// are created for Certificates, and the smoothing of renewal times
+	IssuanceRateLimitConfig IssuanceRateLimitConfig `json:"issuanceRateLimitConfig,omitzero"`
+
 	// certificateRequestMinimumBackoffDuration configures the minimum backoff duration
 	// when a certificate request fails (default 1h). The backoff delay starts at
 	// this value and is exponentially increased with each consecutive failure,
@@ -300,3 +315,40 @@ type PEMSizeLimitsConfig struct {
 	// Defaults to 330000 bytes.
 	MaxBundleSize *int32 `json:"maxBundleSize,omitempty"`
 }
+
+type IssuanceRateLimitConfig struct {
+	// interval is the period over which the global and per-issuer limits
+	// apply. Defaults to 1m.
+	Interval *sharedv1alpha1.Duration `json:"interval,omitempty"`
+
+	// globalLimit is the maximum number of CertificateRequests which are
+	// created for Certificates across all issuers in each interval.
+	// Defaults to 0, which disables the global limit.
+	GlobalLimit *int32 `json:"globalLimit,omitempty"`
+
+	// perIssuerLimit is the maximum number of CertificateRequests which are
+	// created for Certificates referencing the same issuer in each interval.
+	// Defaults to 0, which disables the per-issuer limit.
+	PerIssuerLimit *int32 `json:"perIssuerLimit,omitempty"`
+
+	// maxRenewalJitter is the maximum duration by which the renewal time of a
+	// Certificate is brought forward, so that Certificates issued at the same
+	// time are not all renewed at the same time. The jitter of each
+	// Certificate is derived from its namespace and name, so is stable across
+	// restarts. Defaults to 0, which disables jitter.
+	MaxRenewalJitter *sharedv1alpha1.Duration `json:"maxRenewalJitter,omitempty"`
+}
+
+type TLSSecretExpiryConfig struct {
+	// thresholds is the list of durations before the expiry of a certificate
+	// stored in a kubernetes.io/tls Secret, which is not managed by a
+	// Certificate, at which a Warning Event is fired and the webhook is
+	// notified.
+	// Defaults to 720h, 168h and 24h.
+	// +k8s:conversion-gen=false
+	Thresholds []sharedv1alpha1.Duration `json:"thresholds,omitempty"`
+
+	// webhookURL is an optional http or https URL to which a JSON notification
+	// is POSTed each time a Secret crosses a threshold or expires.
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
@@ -151,6 +151,11 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		**out = **in
 	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
+	if in.EnableCertificateSerialMetric != nil {
+		in, out := &in.EnableCertificateSerialMetric, &out.EnableCertificateSerialMetric
+		*out = new(bool)
+		**out = **in
+	}
 	if in.EnablePprof != nil {
 		in, out := &in.EnablePprof, &out.EnablePprof
 		*out = new(bool)
@@ -169,6 +174,8 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	in.ACMEDNS01Config.DeepCopyInto(&out.ACMEDNS01Config)
 	in.PEMSizeLimitsConfig.DeepCopyInto(&out.PEMSizeLimitsConfig)
 	in.GatewayAPIConfig.DeepCopyInto(&out.GatewayAPIConfig)
+	in.TLSSecretExpiryConfig.DeepCopyInto(&out.TLSSecretExpiryConfig)
+	in.IssuanceRateLimitConfig.DeepCopyInto(&out.IssuanceRateLimitConfig)
 	if in.CertificateRequestMinimumBackoffDuration != nil {
 		in, out := &in.CertificateRequestMinimumBackoffDuration, &out.CertificateRequestMinimumBackoffDuration
 		*out = new(sharedv1alpha1.Duration)
@@ -257,6 +264,42 @@ func (in *IngressShimConfig) DeepCopy() *IngressShimConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuanceRateLimitConfig) DeepCopyInto(out *IssuanceRateLimitConfig) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	if in.GlobalLimit != nil {
+		in, out := &in.GlobalLimit, &out.GlobalLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerIssuerLimit != nil {
+		in, out := &in.PerIssuerLimit, &out.PerIssuerLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRenewalJitter != nil {
+		in, out := &in.MaxRenewalJitter, &out.MaxRenewalJitter
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuanceRateLimitConfig.
+func (in *IssuanceRateLimitConfig) DeepCopy() *IssuanceRateLimitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuanceRateLimitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfig) DeepCopyInto(out *LeaderElectionConfig) {
 	*out = *in
@@ -314,3 +357,24 @@ func (in *PEMSizeLimitsConfig) DeepCopy() *PEMSizeLimitsConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSecretExpiryConfig) DeepCopyInto(out *TLSSecretExpiryConfig) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]sharedv1alpha1.Duration, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSecretExpiryConfig.
+func (in *TLSSecretExpiryConfig) DeepCopy() *TLSSecretExpiryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSecretExpiryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
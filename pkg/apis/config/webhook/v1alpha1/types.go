@@ -90,6 +90,47 @@ type WebhookConfiguration struct {
 
 	// pemSizeLimitsConfig configures the maximum sizes for PEM-encoded data
 	PEMSizeLimitsConfig PEMSizeLimitsConfig `json:"pemSizeLimitsConfig,omitzero"`
+
+	// validationRules are CEL expressions which are evaluated against
+	// cert-manager resources when they are created or updated, in addition to
+	// the built-in validation.
+	// +optional
+	ValidationRules []ValidationRule `json:"validationRules,omitempty"`
+
+	// admissionPlugins is a map of admission plugin names to bools that enable
+	// or disable the admission plugins run by the webhook. All admission plugins
+	// are enabled by default. The plugins that can be disabled are
+	// `CertificateDefaults`, `CertificateRenewalRequest` and `CELValidation`.
+	// +optional
+	AdmissionPlugins map[string]bool `json:"admissionPlugins,omitempty"`
+}
+
+// ValidationRule is a CEL expression which must evaluate to true for a
+// resource to be admitted.
+type ValidationRule struct {
+	// resources are the cert-manager.io resources the rule is evaluated
+	// against. Supported values are `certificates`, `certificaterequests`,
+	// `issuers` and `clusterissuers`.
+	Resources []string `json:"resources"`
+
+	// namespaceSelector restricts the rule to resources in namespaces matching
+	// the selector. Cluster scoped resources are only evaluated by rules without
+	// a namespaceSelector.
+	// If unset, the rule is evaluated in all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// expression is the CEL expression to evaluate, which must return a bool.
+	// The resource is available as `object`, and the resource before an update
+	// as `oldObject`, which is null when the resource is created.
+	// For example: "!has(object.spec.dnsNames) || object.spec.dnsNames.all(name, name.endsWith('.corp.example.com'))"
+	Expression string `json:"expression"`
+
+	// message is returned to the client when the expression evaluates to
+	// false.
+	// Defaults to "failed rule: <expression>".
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type PEMSizeLimitsConfig struct {
@@ -37,6 +37,19 @@ const (
 	CertificateSigningRequestMinimumDuration = time.Second * 600
 )
 
+// ACME Issuer specific Annotations
+const (
+	// CertificateSigningRequestACMEProfileAnnotationKey is the annotation key
+	// used to request a particular certificate profile from the ACME server,
+	// overriding the profile configured on the ACME issuer.
+	CertificateSigningRequestACMEProfileAnnotationKey = "experimental.cert-manager.io/acme-profile"
+
+	// CertificateSigningRequestACMEPreferredChainAnnotationKey is the
+	// annotation key used to request a particular chain from the ACME server,
+	// overriding the preferredChain configured on the ACME issuer.
+	CertificateSigningRequestACMEPreferredChainAnnotationKey = "experimental.cert-manager.io/acme-preferred-chain"
+)
+
 // SelfSigned Issuer specific Annotations
 const (
 	// CertificateSigningRequestPrivateKeyAnnotationKey is the annotation key
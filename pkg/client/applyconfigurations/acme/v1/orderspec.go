@@ -54,6 +54,10 @@ type OrderSpecApplyConfiguration struct {
 	// Profile allows requesting a certificate profile from the ACME server.
 	// Supported profiles are listed by the server's ACME directory URL.
 	Profile *string `json:"profile,omitempty"`
+	// PreferredChain is the chain to use if the ACME server outputs multiple,
+	// overriding the preferredChain of the ACME issuer. It is matched in the
+	// same way as the issuer's preferredChain.
+	PreferredChain *string `json:"preferredChain,omitempty"`
 	// Replaces is the ARI CertID (RFC 9773 §4.1) of the certificate that this
 	// Order is intended to replace. When set, cert-manager will include the
 	// "replaces" field on the newOrder request to the ACME server if and only
@@ -131,6 +135,14 @@ func (b *OrderSpecApplyConfiguration) WithProfile(value string) *OrderSpecApplyC
 	return b
 }
 
+// WithPreferredChain sets the PreferredChain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreferredChain field is set to the value of the last call.
+func (b *OrderSpecApplyConfiguration) WithPreferredChain(value string) *OrderSpecApplyConfiguration {
+	b.PreferredChain = &value
+	return b
+}
+
 // WithReplaces sets the Replaces field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Replaces field is set to the value of the last call.
@@ -38,6 +38,19 @@ type CAIssuerApplyConfiguration struct {
 	// it creates. See https://www.rfc-editor.org/rfc/rfc5280#section-4.2.2.1 for more details.
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+	// SPIFFE configures this issuer to bind the URI SAN of the certificates it
+	// issues to the SPIFFE ID of the ServiceAccount which created the
+	// CertificateRequest.
+	SPIFFE *SPIFFEIdentityApplyConfiguration `json:"spiffe,omitempty"`
+	// SSH allows this issuer to sign SSHCertificates. The private key stored
+	// in the issuer's Secret is then also used as an SSH certificate
+	// authority, so SSH servers and clients which trust its public key accept
+	// the SSH certificates signed by this issuer. Like CertificateRequests,
+	// SSHCertificateRequests are only signed once they have been approved,
+	// and may only request the principals allowed here.
+	// If not set, SSHCertificateRequests referencing this issuer are not
+	// signed.
+	SSH *CAIssuerSSHApplyConfiguration `json:"ssh,omitempty"`
 }
 
 // CAIssuerApplyConfiguration constructs a declarative configuration of the CAIssuer type for use with
@@ -83,3 +96,19 @@ func (b *CAIssuerApplyConfiguration) WithIssuingCertificateURLs(values ...string
 	}
 	return b
 }
+
+// WithSPIFFE sets the SPIFFE field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SPIFFE field is set to the value of the last call.
+func (b *CAIssuerApplyConfiguration) WithSPIFFE(value *SPIFFEIdentityApplyConfiguration) *CAIssuerApplyConfiguration {
+	b.SPIFFE = value
+	return b
+}
+
+// WithSSH sets the SSH field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSH field is set to the value of the last call.
+func (b *CAIssuerApplyConfiguration) WithSSH(value *CAIssuerSSHApplyConfiguration) *CAIssuerApplyConfiguration {
+	b.SSH = value
+	return b
+}
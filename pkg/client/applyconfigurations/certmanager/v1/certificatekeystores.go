@@ -30,6 +30,9 @@ type CertificateKeystoresApplyConfiguration struct {
 	// PKCS12 configures options for storing a PKCS12 keystore in the
 	// `spec.secretName` Secret resource.
 	PKCS12 *PKCS12KeystoreApplyConfiguration `json:"pkcs12,omitempty"`
+	// PEMTruststore configures options for storing a PEM encoded truststore
+	// in the `spec.secretName` Secret resource.
+	PEMTruststore *PEMTruststoreApplyConfiguration `json:"pemTruststore,omitempty"`
 }
 
 // CertificateKeystoresApplyConfiguration constructs a declarative configuration of the CertificateKeystores type for use with
@@ -53,3 +56,11 @@ func (b *CertificateKeystoresApplyConfiguration) WithPKCS12(value *PKCS12Keystor
 	b.PKCS12 = value
 	return b
 }
+
+// WithPEMTruststore sets the PEMTruststore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PEMTruststore field is set to the value of the last call.
+func (b *CertificateKeystoresApplyConfiguration) WithPEMTruststore(value *PEMTruststoreApplyConfiguration) *CertificateKeystoresApplyConfiguration {
+	b.PEMTruststore = value
+	return b
+}
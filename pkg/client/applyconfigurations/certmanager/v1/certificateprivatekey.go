@@ -20,6 +20,7 @@ package v1
 
 import (
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // CertificatePrivateKeyApplyConfiguration represents a declarative configuration of the CertificatePrivateKey type for use
@@ -67,6 +68,17 @@ type CertificatePrivateKeyApplyConfiguration struct {
 	// If `algorithm` is set to `Ed25519`, Size is ignored.
 	// No other values are allowed.
 	Size *int `json:"size,omitempty"`
+	// RotationOverlap enables staged private key rotation. When set, and a
+	// re-issuance results in a new private key, the new certificate and
+	// private key are first published to the `next.crt` and `next.key`
+	// entries of the Certificate's Secret, alongside the current
+	// certificate and private key. They are promoted to `tls.crt` and
+	// `tls.key` once the overlap period has passed, or when the current
+	// certificate expires if that happens earlier. This gives peers which
+	// pin or pre-load keys time to learn the next key before it is used.
+	// The Certificate remains `Issuing` until the new key is promoted.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	RotationOverlap *metav1.Duration `json:"rotationOverlap,omitempty"`
 }
 
 // CertificatePrivateKeyApplyConfiguration constructs a declarative configuration of the CertificatePrivateKey type for use with
@@ -106,3 +118,11 @@ func (b *CertificatePrivateKeyApplyConfiguration) WithSize(value int) *Certifica
 	b.Size = &value
 	return b
 }
+
+// WithRotationOverlap sets the RotationOverlap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RotationOverlap field is set to the value of the last call.
+func (b *CertificatePrivateKeyApplyConfiguration) WithRotationOverlap(value metav1.Duration) *CertificatePrivateKeyApplyConfiguration {
+	b.RotationOverlap = &value
+	return b
+}
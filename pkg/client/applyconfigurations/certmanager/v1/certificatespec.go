@@ -128,6 +128,38 @@ type CertificateSpecApplyConfiguration struct {
 	// in conjunction with, and cannot overwrite, the base set of annotations
 	// cert-manager sets on the Certificate's Secret.
 	SecretTemplate *CertificateSecretTemplateApplyConfiguration `json:"secretTemplate,omitempty"`
+	// Defines other namespaces that the Certificate's Secret will be
+	// replicated to. Replicas have the same name, data, labels and annotations
+	// as the Certificate's Secret, and are only written to namespaces which
+	// have opted in to receiving replicas from the Certificate's namespace
+	// using the `cert-manager.io/allow-secret-replicas-from` annotation.
+	SecretReplicas *CertificateSecretReplicasApplyConfiguration `json:"secretReplicas,omitempty"`
+	// Defines a ConfigMap that the CA certificate stored in the `ca.crt` key
+	// of the Certificate's Secret, and optionally the certificate stored in
+	// `tls.crt`, is published to. This allows clients which only need to
+	// trust the certificate to do so without read access to the Secret,
+	// which also contains the private key. The ConfigMap lives in the same
+	// namespace as the Certificate resource.
+	TrustConfigMap *CertificateTrustConfigMapApplyConfiguration `json:"trustConfigMap,omitempty"`
+	// Requests a manual renewal of the certificate. Setting this field, or
+	// changing its `id`, causes the certificate to be re-issued once. The
+	// request, including the user who made it, is recorded in
+	// `status.lastRenewalRequest` and surfaced as an Event once it has been
+	// handled, so that manual renewals are auditable.
+	RenewalRequest *CertificateRenewalRequestApplyConfiguration `json:"renewalRequest,omitempty"`
+	// Configures the certificate to be requested using a PEM encoded
+	// certificate signing request (CSR) that was created outside of
+	// cert-manager, for example by a hardware security module (HSM) which
+	// holds the private key. When set, cert-manager does not generate a
+	// private key. CertificateRequests are created from the referenced CSR,
+	// and the Certificate's Secret only contains the issued certificate
+	// (`tls.crt`) and CA (`ca.crt`), with an empty `tls.key`.
+	//
+	// The CSR must match the rest of the Certificate's spec. Replacing the CSR,
+	// for example after rotating the private key, triggers a re-issuance.
+	// This field cannot be combined with `privateKey`, `keystores` or
+	// `additionalOutputFormats`.
+	ExternalCSR *CertificateExternalCSRApplyConfiguration `json:"externalCSR,omitempty"`
 	// Additional keystore output formats to be stored in the Certificate's Secret.
 	Keystores *CertificateKeystoresApplyConfiguration `json:"keystores,omitempty"`
 	// Reference to the issuer responsible for issuing the certificate.
@@ -150,8 +182,21 @@ type CertificateSpecApplyConfiguration struct {
 	// resources. If `encodeUsagesInRequest` is unset or set to `true`, the usages
 	// will additionally be encoded in the `request` field which contains the CSR blob.
 	//
-	// If unset, defaults to `digital signature` and `key encipherment`.
+	// If unset, defaults to the usages of `profile` if set, or otherwise to
+	// `digital signature` and `key encipherment`.
 	Usages []certmanagerv1.KeyUsage `json:"usages,omitempty"`
+	// Profile is a named certificate profile which sets sane defaults for, and
+	// validates, the Certificate's usages, private key size and extensions.
+	// Allowed values are `serverTLS`, `clientTLS`, `codeSigning`, `smime` and
+	// `ocspSigning`.
+	//
+	// If `usages` is unset, the profile's default usages are requested.
+	// If `usages` is set, it must contain the profile's extended key usage and
+	// must not contain extended key usages which the profile does not allow.
+	// The profile may also require other fields to be set, for example the
+	// `smime` profile requires `emailAddresses`. Certificates using a profile
+	// cannot be CAs.
+	Profile *certmanagerv1.CertificateProfile `json:"profile,omitempty"`
 	// Private key options. These include the key algorithm and size, the used
 	// encoding and the rotation policy.
 	PrivateKey *CertificatePrivateKeyApplyConfiguration `json:"privateKey,omitempty"`
@@ -317,6 +362,38 @@ func (b *CertificateSpecApplyConfiguration) WithSecretTemplate(value *Certificat
 	return b
 }
 
+// WithSecretReplicas sets the SecretReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretReplicas field is set to the value of the last call.
+func (b *CertificateSpecApplyConfiguration) WithSecretReplicas(value *CertificateSecretReplicasApplyConfiguration) *CertificateSpecApplyConfiguration {
+	b.SecretReplicas = value
+	return b
+}
+
+// WithTrustConfigMap sets the TrustConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrustConfigMap field is set to the value of the last call.
+func (b *CertificateSpecApplyConfiguration) WithTrustConfigMap(value *CertificateTrustConfigMapApplyConfiguration) *CertificateSpecApplyConfiguration {
+	b.TrustConfigMap = value
+	return b
+}
+
+// WithRenewalRequest sets the RenewalRequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RenewalRequest field is set to the value of the last call.
+func (b *CertificateSpecApplyConfiguration) WithRenewalRequest(value *CertificateRenewalRequestApplyConfiguration) *CertificateSpecApplyConfiguration {
+	b.RenewalRequest = value
+	return b
+}
+
+// WithExternalCSR sets the ExternalCSR field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalCSR field is set to the value of the last call.
+func (b *CertificateSpecApplyConfiguration) WithExternalCSR(value *CertificateExternalCSRApplyConfiguration) *CertificateSpecApplyConfiguration {
+	b.ExternalCSR = value
+	return b
+}
+
 // WithKeystores sets the Keystores field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Keystores field is set to the value of the last call.
@@ -351,6 +428,14 @@ func (b *CertificateSpecApplyConfiguration) WithUsages(values ...certmanagerv1.K
 	return b
 }
 
+// WithProfile sets the Profile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Profile field is set to the value of the last call.
+func (b *CertificateSpecApplyConfiguration) WithProfile(value certmanagerv1.CertificateProfile) *CertificateSpecApplyConfiguration {
+	b.Profile = &value
+	return b
+}
+
 // WithPrivateKey sets the PrivateKey field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PrivateKey field is set to the value of the last call.
@@ -68,12 +68,20 @@ type CertificateStatusApplyConfiguration struct {
 	// It will automatically unset this field when the Issuing condition is
 	// not set or False.
 	NextPrivateKeySecretName *string `json:"nextPrivateKeySecretName,omitempty"`
+	// The time at which the next certificate and private key were published
+	// to the `next.crt` and `next.key` entries of the Secret as part of a
+	// staged private key rotation. It is unset once they are promoted to
+	// `tls.crt` and `tls.key`.
+	NextPrivateKeyPublishedTime *metav1.Time `json:"nextPrivateKeyPublishedTime,omitempty"`
 	// The number of continuous failed issuance attempts up till now. This
 	// field gets removed (if set) on a successful issuance and gets set to
 	// 1 if unset and an issuance has failed. If an issuance has failed, the
 	// delay till the next issuance will be calculated using formula
 	// time.Hour * 2 ^ (failedIssuanceAttempts - 1).
 	FailedIssuanceAttempts *int `json:"failedIssuanceAttempts,omitempty"`
+	// The last renewal request from `spec.renewalRequest` which has been
+	// handled by triggering an issuance.
+	LastRenewalRequest *CertificateRenewalRequestStatusApplyConfiguration `json:"lastRenewalRequest,omitempty"`
 	// ACME stores information that is fetched from the ACME CA server.
 	ACME *CertificateACMEStatusApplyConfiguration `json:"acme,omitempty"`
 }
@@ -145,6 +153,14 @@ func (b *CertificateStatusApplyConfiguration) WithNextPrivateKeySecretName(value
 	return b
 }
 
+// WithNextPrivateKeyPublishedTime sets the NextPrivateKeyPublishedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextPrivateKeyPublishedTime field is set to the value of the last call.
+func (b *CertificateStatusApplyConfiguration) WithNextPrivateKeyPublishedTime(value metav1.Time) *CertificateStatusApplyConfiguration {
+	b.NextPrivateKeyPublishedTime = &value
+	return b
+}
+
 // WithFailedIssuanceAttempts sets the FailedIssuanceAttempts field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the FailedIssuanceAttempts field is set to the value of the last call.
@@ -153,6 +169,14 @@ func (b *CertificateStatusApplyConfiguration) WithFailedIssuanceAttempts(value i
 	return b
 }
 
+// WithLastRenewalRequest sets the LastRenewalRequest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRenewalRequest field is set to the value of the last call.
+func (b *CertificateStatusApplyConfiguration) WithLastRenewalRequest(value *CertificateRenewalRequestStatusApplyConfiguration) *CertificateStatusApplyConfiguration {
+	b.LastRenewalRequest = value
+	return b
+}
+
 // WithACME sets the ACME field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ACME field is set to the value of the last call.
@@ -54,6 +54,17 @@ type PKCS12KeystoreApplyConfiguration struct {
 	// Files produced with this profile can be read by OpenSSL 3.4.0 and higher, Java 26 and higher,
 	// or with Java using compatible versions of Bouncy Castle. Meets FIPS 140-3 requirements.
 	Profile *certmanagerv1.PKCS12Profile `json:"profile,omitempty"`
+	// Alias specifies the friendly name of the private key entry, and of its
+	// certificate, in the keystore. If not provided, no friendly name is set.
+	// Can only be set when `profile` is `Modern2023`.
+	Alias *string `json:"alias,omitempty"`
+	// KeyPasswordSecretRef is a reference to a non-empty key in a Secret
+	// resource containing the password used to encrypt the private key entry
+	// of the PKCS#12 keystore, for consumers which require the private key
+	// entry to have its own password. If not provided, the private key entry
+	// is encrypted using the keystore password.
+	// Can only be set when `profile` is `Modern2023`.
+	KeyPasswordSecretRef *metav1.SecretKeySelectorApplyConfiguration `json:"keyPasswordSecretRef,omitempty"`
 	// PasswordSecretRef is a reference to a non-empty key in a Secret resource
 	// containing the password used to encrypt the PKCS#12 keystore.
 	// Mutually exclusive with password.
@@ -87,6 +98,22 @@ func (b *PKCS12KeystoreApplyConfiguration) WithProfile(value certmanagerv1.PKCS1
 	return b
 }
 
+// WithAlias sets the Alias field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Alias field is set to the value of the last call.
+func (b *PKCS12KeystoreApplyConfiguration) WithAlias(value string) *PKCS12KeystoreApplyConfiguration {
+	b.Alias = &value
+	return b
+}
+
+// WithKeyPasswordSecretRef sets the KeyPasswordSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KeyPasswordSecretRef field is set to the value of the last call.
+func (b *PKCS12KeystoreApplyConfiguration) WithKeyPasswordSecretRef(value *metav1.SecretKeySelectorApplyConfiguration) *PKCS12KeystoreApplyConfiguration {
+	b.KeyPasswordSecretRef = value
+	return b
+}
+
 // WithPasswordSecretRef sets the PasswordSecretRef field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the PasswordSecretRef field is set to the value of the last call.
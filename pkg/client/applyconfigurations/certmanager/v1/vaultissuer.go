@@ -38,6 +38,10 @@ type VaultIssuerApplyConfiguration struct {
 	// Path is the mount path of the Vault PKI backend's `sign` endpoint, e.g:
 	// "my_pki_mount/sign/my-role-name".
 	Path *string `json:"path,omitempty"`
+	// SSHPath is the mount path of the Vault SSH secrets engine's `sign`
+	// endpoint, e.g: "my_ssh_mount/sign/my-role-name". It must be set for
+	// the issuer to be able to sign SSHCertificates.
+	SSHPath *string `json:"sshPath,omitempty"`
 	// Name of the vault namespace. Namespaces is a set of features within Vault Enterprise that allows Vault environments to support Secure Multi-tenancy. e.g: "ns1"
 	// More about namespaces can be found here https://www.vaultproject.io/docs/enterprise/namespaces
 	Namespace *string `json:"namespace,omitempty"`
@@ -61,6 +65,12 @@ type VaultIssuerApplyConfiguration struct {
 	// Reference to a Secret containing a PEM-encoded Client Private Key to use when the
 	// Vault server requires mTLS.
 	ClientKeySecretRef *metav1.SecretKeySelectorApplyConfiguration `json:"clientKeySecretRef,omitempty"`
+	// SPIFFE configures this issuer to bind the URI SAN of the certificates it
+	// issues to the SPIFFE ID of the ServiceAccount which created the
+	// CertificateRequest.
+	// In the `Derive` mode, the Vault role must be configured with
+	// `use_csr_sans=false` and must allow the SPIFFE IDs as URI SANs.
+	SPIFFE *SPIFFEIdentityApplyConfiguration `json:"spiffe,omitempty"`
 }
 
 // VaultIssuerApplyConfiguration constructs a declarative configuration of the VaultIssuer type for use with
@@ -101,6 +111,14 @@ func (b *VaultIssuerApplyConfiguration) WithPath(value string) *VaultIssuerApply
 	return b
 }
 
+// WithSSHPath sets the SSHPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SSHPath field is set to the value of the last call.
+func (b *VaultIssuerApplyConfiguration) WithSSHPath(value string) *VaultIssuerApplyConfiguration {
+	b.SSHPath = &value
+	return b
+}
+
 // WithNamespace sets the Namespace field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Namespace field is set to the value of the last call.
@@ -142,3 +160,11 @@ func (b *VaultIssuerApplyConfiguration) WithClientKeySecretRef(value *metav1.Sec
 	b.ClientKeySecretRef = value
 	return b
 }
+
+// WithSPIFFE sets the SPIFFE field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SPIFFE field is set to the value of the last call.
+func (b *VaultIssuerApplyConfiguration) WithSPIFFE(value *SPIFFEIdentityApplyConfiguration) *VaultIssuerApplyConfiguration {
+	b.SPIFFE = value
+	return b
+}
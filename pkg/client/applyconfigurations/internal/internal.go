@@ -1081,6 +1081,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.IssuerReference
       default: {}
+    - name: preferredChain
+      type:
+        scalar: string
     - name: profile
       type:
         scalar: string
@@ -1176,6 +1179,27 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: spiffe
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SPIFFEIdentity
+    - name: ssh
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CAIssuerSSH
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CAIssuerSSH
+  map:
+    fields:
+    - name: allowedHostPrincipals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: allowedUserPrincipals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.Certificate
   map:
     fields:
@@ -1251,12 +1275,66 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateDefaultPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: ObjectMeta.v1.meta.apis.pkg.apimachinery.k8s.io
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateDefaultPolicySpec
+      default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateDefaultPolicySpec
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: namespaceSelector
+      type:
+        namedType: LabelSelector.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: privateKey
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificatePrivateKey
+    - name: renewBeforePercentage
+      type:
+        scalar: numeric
+    - name: revisionHistoryLimit
+      type:
+        scalar: numeric
+    - name: secretTemplate
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretTemplate
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateExternalCSR
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateKeystores
   map:
     fields:
     - name: jks
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.JKSKeystore
+    - name: pemTruststore
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.PEMTruststore
     - name: pkcs12
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.PKCS12Keystore
@@ -1269,6 +1347,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: encoding
       type:
         scalar: string
+    - name: rotationOverlap
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
     - name: rotationPolicy
       type:
         scalar: string
@@ -1287,6 +1368,42 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewalWindows
           elementRelationship: atomic
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewalRequest
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+      default: ""
+    - name: reason
+      type:
+        scalar: string
+      default: ""
+    - name: requestedBy
+      type:
+        scalar: string
+    - name: rotatePrivateKey
+      type:
+        scalar: boolean
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewalRequestStatus
+  map:
+    fields:
+    - name: handledTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: id
+      type:
+        scalar: string
+      default: ""
+    - name: reason
+      type:
+        scalar: string
+    - name: requestedBy
+      type:
+        scalar: string
+    - name: rotatePrivateKey
+      type:
+        scalar: boolean
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewalWindows
   map:
     fields:
@@ -1340,6 +1457,204 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicy
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: ObjectMeta.v1.meta.apis.pkg.apimachinery.k8s.io
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicySpec
+      default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyAllowed
+  map:
+    fields:
+    - name: commonNames
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: dnsNames
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: emailAddresses
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: ipRanges
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: isCA
+      type:
+        scalar: boolean
+    - name: keyAlgorithms
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: maxDuration
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: ssh
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyAllowedSSH
+    - name: subject
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyAllowedSubject
+    - name: uris
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: usages
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyAllowedSSH
+  map:
+    fields:
+    - name: criticalOptions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: extensions
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: hostPrincipals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: userPrincipals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyAllowedSubject
+  map:
+    fields:
+    - name: countries
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: localities
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: organizationalUnits
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: organizations
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: postalCodes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: provinces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: serialNumbers
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: streetAddresses
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyIssuerRef
+  map:
+    fields:
+    - name: group
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyRequesters
+  map:
+    fields:
+    - name: groups
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: usernames
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicySpec
+  map:
+    fields:
+    - name: allowed
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyAllowed
+      default: {}
+    - name: issuerRefs
+      type:
+        list:
+          elementType:
+            namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyIssuerRef
+          elementRelationship: atomic
+    - name: namespaceSelector
+      type:
+        namedType: LabelSelector.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: requesters
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestPolicyRequesters
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestSpec
   map:
     fields:
@@ -1402,6 +1717,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: failureTime
       type:
         namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretReplicas
+  map:
+    fields:
+    - name: namespaceSelector
+      type:
+        namedType: LabelSelector.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: namespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretTemplate
   map:
     fields:
@@ -1445,6 +1772,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: encodeUsagesInRequest
       type:
         scalar: boolean
+    - name: externalCSR
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateExternalCSR
     - name: ipAddresses
       type:
         list:
@@ -1476,6 +1806,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: privateKey
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificatePrivateKey
+    - name: profile
+      type:
+        scalar: string
     - name: renewBefore
       type:
         namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
@@ -1485,6 +1818,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: renewal
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewal
+    - name: renewalRequest
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewalRequest
     - name: revisionHistoryLimit
       type:
         scalar: numeric
@@ -1492,6 +1828,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: secretReplicas
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretReplicas
     - name: secretTemplate
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretTemplate
@@ -1501,6 +1840,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: subject
       type:
         namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.X509Subject
+    - name: trustConfigMap
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateTrustConfigMap
     - name: uris
       type:
         list:
@@ -1533,6 +1875,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: lastFailureTime
       type:
         namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: lastRenewalRequest
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRenewalRequestStatus
+    - name: nextPrivateKeyPublishedTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
     - name: nextPrivateKeySecretName
       type:
         scalar: string
@@ -1548,6 +1896,16 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: revision
       type:
         scalar: numeric
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateTrustConfigMap
+  map:
+    fields:
+    - name: includeCertificate
+      type:
+        scalar: boolean
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.ClusterIssuer
   map:
     fields:
@@ -1710,13 +2068,26 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: utf8Value
       type:
         scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.PEMTruststore
+  map:
+    fields:
+    - name: create
+      type:
+        scalar: boolean
+      default: false
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.PKCS12Keystore
   map:
     fields:
+    - name: alias
+      type:
+        scalar: string
     - name: create
       type:
         scalar: boolean
       default: false
+    - name: keyPasswordSecretRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.SecretKeySelector
     - name: password
       type:
         scalar: string
@@ -1727,6 +2098,222 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: profile
       type:
         scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SPIFFEIdentity
+  map:
+    fields:
+    - name: mode
+      type:
+        scalar: string
+    - name: trustDomain
+      type:
+        scalar: string
+      default: ""
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificate
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: ObjectMeta.v1.meta.apis.pkg.apimachinery.k8s.io
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateStatus
+      default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificatePrivateKey
+  map:
+    fields:
+    - name: algorithm
+      type:
+        scalar: string
+    - name: rotationPolicy
+      type:
+        scalar: string
+    - name: size
+      type:
+        scalar: numeric
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateRequest
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: ObjectMeta.v1.meta.apis.pkg.apimachinery.k8s.io
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateRequestSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateRequestStatus
+      default: {}
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateRequestSpec
+  map:
+    fields:
+    - name: criticalOptions
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: duration
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: extensions
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extra
+      type:
+        map:
+          elementType:
+            list:
+              elementType:
+                scalar: string
+              elementRelationship: atomic
+    - name: groups
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: issuerRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.IssuerReference
+      default: {}
+    - name: keyID
+      type:
+        scalar: string
+    - name: principals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: publicKey
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+    - name: uid
+      type:
+        scalar: string
+    - name: username
+      type:
+        scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateRequestStatus
+  map:
+    fields:
+    - name: certificate
+      type:
+        scalar: string
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateRequestCondition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: failureTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateSpec
+  map:
+    fields:
+    - name: criticalOptions
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: duration
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: extensions
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: issuerRef
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.meta.v1.IssuerReference
+      default: {}
+    - name: keyID
+      type:
+        scalar: string
+    - name: principals
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: privateKey
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificatePrivateKey
+    - name: renewBefore
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: renewBeforePercentage
+      type:
+        scalar: numeric
+    - name: secretName
+      type:
+        scalar: string
+      default: ""
+    - name: secretTemplate
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateSecretTemplate
+    - name: type
+      type:
+        scalar: string
+- name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SSHCertificateStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.CertificateCondition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: failedIssuanceAttempts
+      type:
+        scalar: numeric
+    - name: lastFailureTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: nextPrivateKeySecretName
+      type:
+        scalar: string
+    - name: notAfter
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: notBefore
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: renewalTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: revision
+      type:
+        scalar: numeric
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SelfSignedIssuer
   map:
     fields:
@@ -1849,6 +2436,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: serverName
       type:
         scalar: string
+    - name: spiffe
+      type:
+        namedType: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.SPIFFEIdentity
+    - name: sshPath
+      type:
+        scalar: string
 - name: com.github.cert-manager.cert-manager.pkg.apis.certmanager.v1.VaultKubernetesAuth
   map:
     fields:
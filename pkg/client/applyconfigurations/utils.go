@@ -116,6 +116,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscertmanagerv1.ACMERenewalWindowApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CAIssuer"):
 		return &applyconfigurationscertmanagerv1.CAIssuerApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CAIssuerSSH"):
+		return &applyconfigurationscertmanagerv1.CAIssuerSSHApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("Certificate"):
 		return &applyconfigurationscertmanagerv1.CertificateApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateACMEARIStatus"):
@@ -126,28 +128,56 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscertmanagerv1.CertificateAdditionalOutputFormatApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateCondition"):
 		return &applyconfigurationscertmanagerv1.CertificateConditionApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateDefaultPolicy"):
+		return &applyconfigurationscertmanagerv1.CertificateDefaultPolicyApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateDefaultPolicySpec"):
+		return &applyconfigurationscertmanagerv1.CertificateDefaultPolicySpecApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateExternalCSR"):
+		return &applyconfigurationscertmanagerv1.CertificateExternalCSRApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateKeystores"):
 		return &applyconfigurationscertmanagerv1.CertificateKeystoresApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificatePrivateKey"):
 		return &applyconfigurationscertmanagerv1.CertificatePrivateKeyApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRenewal"):
 		return &applyconfigurationscertmanagerv1.CertificateRenewalApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRenewalRequest"):
+		return &applyconfigurationscertmanagerv1.CertificateRenewalRequestApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRenewalRequestStatus"):
+		return &applyconfigurationscertmanagerv1.CertificateRenewalRequestStatusApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRenewalWindows"):
 		return &applyconfigurationscertmanagerv1.CertificateRenewalWindowsApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequest"):
 		return &applyconfigurationscertmanagerv1.CertificateRequestApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestCondition"):
 		return &applyconfigurationscertmanagerv1.CertificateRequestConditionApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicy"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicyApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicyAllowed"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicyAllowedApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicyAllowedSSH"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicyAllowedSSHApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicyAllowedSubject"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicyAllowedSubjectApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicyIssuerRef"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicyIssuerRefApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicyRequesters"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicyRequestersApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestPolicySpec"):
+		return &applyconfigurationscertmanagerv1.CertificateRequestPolicySpecApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestSpec"):
 		return &applyconfigurationscertmanagerv1.CertificateRequestSpecApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateRequestStatus"):
 		return &applyconfigurationscertmanagerv1.CertificateRequestStatusApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateSecretReplicas"):
+		return &applyconfigurationscertmanagerv1.CertificateSecretReplicasApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateSecretTemplate"):
 		return &applyconfigurationscertmanagerv1.CertificateSecretTemplateApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateSpec"):
 		return &applyconfigurationscertmanagerv1.CertificateSpecApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateStatus"):
 		return &applyconfigurationscertmanagerv1.CertificateStatusApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("CertificateTrustConfigMap"):
+		return &applyconfigurationscertmanagerv1.CertificateTrustConfigMapApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("ClusterIssuer"):
 		return &applyconfigurationscertmanagerv1.ClusterIssuerApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("Issuer"):
@@ -168,12 +198,30 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationscertmanagerv1.NameConstraintsApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("OtherName"):
 		return &applyconfigurationscertmanagerv1.OtherNameApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("PEMTruststore"):
+		return &applyconfigurationscertmanagerv1.PEMTruststoreApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("PKCS12Keystore"):
 		return &applyconfigurationscertmanagerv1.PKCS12KeystoreApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("SelfSignedIssuer"):
 		return &applyconfigurationscertmanagerv1.SelfSignedIssuerApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("ServiceAccountRef"):
 		return &applyconfigurationscertmanagerv1.ServiceAccountRefApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SPIFFEIdentity"):
+		return &applyconfigurationscertmanagerv1.SPIFFEIdentityApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificate"):
+		return &applyconfigurationscertmanagerv1.SSHCertificateApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificatePrivateKey"):
+		return &applyconfigurationscertmanagerv1.SSHCertificatePrivateKeyApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificateRequest"):
+		return &applyconfigurationscertmanagerv1.SSHCertificateRequestApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificateRequestSpec"):
+		return &applyconfigurationscertmanagerv1.SSHCertificateRequestSpecApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificateRequestStatus"):
+		return &applyconfigurationscertmanagerv1.SSHCertificateRequestStatusApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificateSpec"):
+		return &applyconfigurationscertmanagerv1.SSHCertificateSpecApplyConfiguration{}
+	case certmanagerv1.SchemeGroupVersion.WithKind("SSHCertificateStatus"):
+		return &applyconfigurationscertmanagerv1.SSHCertificateStatusApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("VaultAppRole"):
 		return &applyconfigurationscertmanagerv1.VaultAppRoleApplyConfiguration{}
 	case certmanagerv1.SchemeGroupVersion.WithKind("VaultAuth"):
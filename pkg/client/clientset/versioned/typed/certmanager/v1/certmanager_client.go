@@ -29,9 +29,13 @@ import (
 type CertmanagerV1Interface interface {
 	RESTClient() rest.Interface
 	CertificatesGetter
+	CertificateDefaultPoliciesGetter
 	CertificateRequestsGetter
+	CertificateRequestPoliciesGetter
 	ClusterIssuersGetter
 	IssuersGetter
+	SSHCertificatesGetter
+	SSHCertificateRequestsGetter
 }
 
 // CertmanagerV1Client is used to interact with features provided by the cert-manager.io group.
@@ -43,10 +47,18 @@ func (c *CertmanagerV1Client) Certificates(namespace string) CertificateInterfac
 	return newCertificates(c, namespace)
 }
 
+func (c *CertmanagerV1Client) CertificateDefaultPolicies() CertificateDefaultPolicyInterface {
+	return newCertificateDefaultPolicies(c)
+}
+
 func (c *CertmanagerV1Client) CertificateRequests(namespace string) CertificateRequestInterface {
 	return newCertificateRequests(c, namespace)
 }
 
+func (c *CertmanagerV1Client) CertificateRequestPolicies() CertificateRequestPolicyInterface {
+	return newCertificateRequestPolicies(c)
+}
+
 func (c *CertmanagerV1Client) ClusterIssuers() ClusterIssuerInterface {
 	return newClusterIssuers(c)
 }
@@ -55,6 +67,14 @@ func (c *CertmanagerV1Client) Issuers(namespace string) IssuerInterface {
 	return newIssuers(c, namespace)
 }
 
+func (c *CertmanagerV1Client) SSHCertificates(namespace string) SSHCertificateInterface {
+	return newSSHCertificates(c, namespace)
+}
+
+func (c *CertmanagerV1Client) SSHCertificateRequests(namespace string) SSHCertificateRequestInterface {
+	return newSSHCertificateRequests(c, namespace)
+}
+
 // NewForConfig creates a new CertmanagerV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
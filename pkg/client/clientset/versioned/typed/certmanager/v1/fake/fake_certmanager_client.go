@@ -32,10 +32,18 @@ func (c *FakeCertmanagerV1) Certificates(namespace string) v1.CertificateInterfa
 	return newFakeCertificates(c, namespace)
 }
 
+func (c *FakeCertmanagerV1) CertificateDefaultPolicies() v1.CertificateDefaultPolicyInterface {
+	return newFakeCertificateDefaultPolicies(c)
+}
+
 func (c *FakeCertmanagerV1) CertificateRequests(namespace string) v1.CertificateRequestInterface {
 	return newFakeCertificateRequests(c, namespace)
 }
 
+func (c *FakeCertmanagerV1) CertificateRequestPolicies() v1.CertificateRequestPolicyInterface {
+	return newFakeCertificateRequestPolicies(c)
+}
+
 func (c *FakeCertmanagerV1) ClusterIssuers() v1.ClusterIssuerInterface {
 	return newFakeClusterIssuers(c)
 }
@@ -44,6 +52,14 @@ func (c *FakeCertmanagerV1) Issuers(namespace string) v1.IssuerInterface {
 	return newFakeIssuers(c, namespace)
 }
 
+func (c *FakeCertmanagerV1) SSHCertificates(namespace string) v1.SSHCertificateInterface {
+	return newFakeSSHCertificates(c, namespace)
+}
+
+func (c *FakeCertmanagerV1) SSHCertificateRequests(namespace string) v1.SSHCertificateRequestInterface {
+	return newFakeSSHCertificateRequests(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCertmanagerV1) RESTClient() rest.Interface {
@@ -20,8 +20,16 @@ package v1
 
 type CertificateExpansion interface{}
 
+type CertificateDefaultPolicyExpansion interface{}
+
 type CertificateRequestExpansion interface{}
 
+type CertificateRequestPolicyExpansion interface{}
+
 type ClusterIssuerExpansion interface{}
 
 type IssuerExpansion interface{}
+
+type SSHCertificateExpansion interface{}
+
+type SSHCertificateRequestExpansion interface{}
@@ -26,12 +26,20 @@ import (
 type Interface interface {
 	// Certificates returns a CertificateInformer.
 	Certificates() CertificateInformer
+	// CertificateDefaultPolicies returns a CertificateDefaultPolicyInformer.
+	CertificateDefaultPolicies() CertificateDefaultPolicyInformer
 	// CertificateRequests returns a CertificateRequestInformer.
 	CertificateRequests() CertificateRequestInformer
+	// CertificateRequestPolicies returns a CertificateRequestPolicyInformer.
+	CertificateRequestPolicies() CertificateRequestPolicyInformer
 	// ClusterIssuers returns a ClusterIssuerInformer.
 	ClusterIssuers() ClusterIssuerInformer
 	// Issuers returns a IssuerInformer.
 	Issuers() IssuerInformer
+	// SSHCertificates returns a SSHCertificateInformer.
+	SSHCertificates() SSHCertificateInformer
+	// SSHCertificateRequests returns a SSHCertificateRequestInformer.
+	SSHCertificateRequests() SSHCertificateRequestInformer
 }
 
 type version struct {
@@ -50,11 +58,21 @@ func (v *version) Certificates() CertificateInformer {
 	return &certificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// CertificateDefaultPolicies returns a CertificateDefaultPolicyInformer.
+func (v *version) CertificateDefaultPolicies() CertificateDefaultPolicyInformer {
+	return &certificateDefaultPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // CertificateRequests returns a CertificateRequestInformer.
 func (v *version) CertificateRequests() CertificateRequestInformer {
 	return &certificateRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// CertificateRequestPolicies returns a CertificateRequestPolicyInformer.
+func (v *version) CertificateRequestPolicies() CertificateRequestPolicyInformer {
+	return &certificateRequestPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterIssuers returns a ClusterIssuerInformer.
 func (v *version) ClusterIssuers() ClusterIssuerInformer {
 	return &clusterIssuerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -64,3 +82,13 @@ func (v *version) ClusterIssuers() ClusterIssuerInformer {
 func (v *version) Issuers() IssuerInformer {
 	return &issuerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// SSHCertificates returns a SSHCertificateInformer.
+func (v *version) SSHCertificates() SSHCertificateInformer {
+	return &sSHCertificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SSHCertificateRequests returns a SSHCertificateRequestInformer.
+func (v *version) SSHCertificateRequests() SSHCertificateRequestInformer {
+	return &sSHCertificateRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
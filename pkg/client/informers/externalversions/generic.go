@@ -62,12 +62,20 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=cert-manager.io, Version=v1
 	case certmanagerv1.SchemeGroupVersion.WithResource("certificates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Certificates().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("certificatedefaultpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().CertificateDefaultPolicies().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("certificaterequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().CertificateRequests().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("certificaterequestpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().CertificateRequestPolicies().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("clusterissuers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().ClusterIssuers().Informer()}, nil
 	case certmanagerv1.SchemeGroupVersion.WithResource("issuers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Issuers().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("sshcertificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().SSHCertificates().Informer()}, nil
+	case certmanagerv1.SchemeGroupVersion.WithResource("sshcertificaterequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().SSHCertificateRequests().Informer()}, nil
 
 	}
 
@@ -26,6 +26,10 @@ type CertificateListerExpansion interface{}
 // CertificateNamespaceLister.
 type CertificateNamespaceListerExpansion interface{}
 
+// CertificateDefaultPolicyListerExpansion allows custom methods to be added to
+// CertificateDefaultPolicyLister.
+type CertificateDefaultPolicyListerExpansion interface{}
+
 // CertificateRequestListerExpansion allows custom methods to be added to
 // CertificateRequestLister.
 type CertificateRequestListerExpansion interface{}
@@ -34,6 +38,10 @@ type CertificateRequestListerExpansion interface{}
 // CertificateRequestNamespaceLister.
 type CertificateRequestNamespaceListerExpansion interface{}
 
+// CertificateRequestPolicyListerExpansion allows custom methods to be added to
+// CertificateRequestPolicyLister.
+type CertificateRequestPolicyListerExpansion interface{}
+
 // ClusterIssuerListerExpansion allows custom methods to be added to
 // ClusterIssuerLister.
 type ClusterIssuerListerExpansion interface{}
@@ -45,3 +53,19 @@ type IssuerListerExpansion interface{}
 // IssuerNamespaceListerExpansion allows custom methods to be added to
 // IssuerNamespaceLister.
 type IssuerNamespaceListerExpansion interface{}
+
+// SSHCertificateListerExpansion allows custom methods to be added to
+// SSHCertificateLister.
+type SSHCertificateListerExpansion interface{}
+
+// SSHCertificateNamespaceListerExpansion allows custom methods to be added to
+// SSHCertificateNamespaceLister.
+type SSHCertificateNamespaceListerExpansion interface{}
+
+// SSHCertificateRequestListerExpansion allows custom methods to be added to
+// SSHCertificateRequestLister.
+type SSHCertificateRequestListerExpansion interface{}
+
+// SSHCertificateRequestNamespaceListerExpansion allows custom methods to be added to
+// SSHCertificateRequestNamespaceLister.
+type SSHCertificateRequestNamespaceListerExpansion interface{}
@@ -40,6 +40,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 type controller struct {
@@ -68,6 +69,9 @@ type controller struct {
 	// used to record Events about resources to the API
 	recorder record.EventRecorder
 
+	// used to record challenge failure metrics
+	metrics *metrics.Metrics
+
 	// maintain a reference to the workqueue for this controller
 	// so the handleOwnedResource method can enqueue resources
 	queue workqueue.TypedRateLimitingInterface[types.NamespacedName]
@@ -144,6 +148,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister)
 	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.SchedulerOptions.MaxConcurrentChallenges)
 	c.recorder = ctx.Recorder
+	c.metrics = ctx.Metrics
 	c.accountRegistry = ctx.ACMEAccountRegistry
 	c.clock = ctx.Clock
 
@@ -91,6 +91,10 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 				return
 			}
 			err = utilerrors.NewAggregate([]error{err, updateError})
+			return
+		}
+		if !acme.IsFailureState(chOriginal.Status.State) && acme.IsFailureState(ch.Status.State) {
+			c.metrics.IncrementACMEChallengeFailure(string(ch.Spec.Type), string(ch.Status.State), ch.Spec.IssuerRef)
 		}
 	}()
 
@@ -37,6 +37,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/scheduler"
 )
 
@@ -58,6 +59,8 @@ type controller struct {
 	clock clock.Clock
 	// used to record Events about resources to the API
 	recorder record.EventRecorder
+	// used to record issuance failure metrics
+	metrics *metrics.Metrics
 	// clientset used to update cert-manager API resources
 	cmClient cmclient.Interface
 
@@ -152,6 +155,7 @@ func NewController(
 		clusterIssuerLister: clusterIssuerLister,
 		helper:              issuer.NewHelper(issuerLister, clusterIssuerLister),
 		recorder:            ctx.Recorder,
+		metrics:             ctx.Metrics,
 		cmClient:            ctx.CMClient,
 		accountRegistry:     ctx.ACMEAccountRegistry,
 		fieldManager:        ctx.FieldManager,
@@ -46,6 +46,7 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	acmeapi "github.com/cert-manager/cert-manager/third_party/forked/acme"
 )
@@ -79,6 +80,15 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 			return
 		}
 		dbg.Info("updated Order resource status successfully")
+
+		if !acme.IsFailureState(oldOrder.Status.State) {
+			switch o.Status.State {
+			case cmacme.Invalid:
+				c.metrics.IncrementIssuanceFailure(metrics.IssuanceFailureReasonOrderInvalid, o.Spec.IssuerRef)
+			case cmacme.Errored:
+				c.metrics.IncrementIssuanceFailure(metrics.IssuanceFailureReasonOrderErrored, o.Spec.IssuerRef)
+			}
+		}
 	}()
 
 	genericIssuer, err := c.helper.GetGenericIssuer(o.Spec.IssuerRef, o.Namespace)
@@ -639,8 +649,7 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 		return fmt.Errorf("error finalizing order: %v", err)
 	}
 
-	if issuer.GetSpec().ACME != nil && issuer.GetSpec().ACME.PreferredChain != "" {
-		preferredChainName := issuer.GetSpec().ACME.PreferredChain
+	if preferredChainName := preferredChain(o, issuer); preferredChainName != "" {
 		found, preferredCertChain, err := getPreferredCertChain(ctx, cl, certURL, certSlice, preferredChainName)
 		if err != nil {
 			return fmt.Errorf("error retrieving preferred chain: %w", err)
@@ -656,6 +665,18 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 	return c.storeCertificateOnStatus(ctx, o, certSlice)
 }
 
+// preferredChain returns the name of the chain to prefer for the Order, which
+// is the preferredChain of the Order if set, or else that of its issuer.
+func preferredChain(o *cmacme.Order, issuer cmapi.GenericIssuer) string {
+	if o.Spec.PreferredChain != "" {
+		return o.Spec.PreferredChain
+	}
+	if issuer.GetSpec().ACME != nil {
+		return issuer.GetSpec().ACME.PreferredChain
+	}
+	return ""
+}
+
 func (c *controller) storeCertificateOnStatus(ctx context.Context, o *cmacme.Order, certs [][]byte) error {
 	log := logf.FromContext(ctx)
 	// encode the retrieved certificates (including the chain)
@@ -720,8 +741,8 @@ func (c *controller) syncCertificateDataWithOrder(ctx context.Context, cl acmecl
 		return err
 	}
 
-	if issuer.GetSpec().ACME != nil && issuer.GetSpec().ACME.PreferredChain != "" {
-		found, preferredCertChain, err := getPreferredCertChain(ctx, cl, acmeOrder.CertURL, certs, issuer.GetSpec().ACME.PreferredChain)
+	if preferredChainName := preferredChain(o, issuer); preferredChainName != "" {
+		found, preferredCertChain, err := getPreferredCertChain(ctx, cl, acmeOrder.CertURL, certs, preferredChainName)
 		if err != nil {
 			return err
 		}
@@ -872,6 +872,50 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 				},
 			},
 		},
+		"preferred chain of the order overrides the preferred chain of the issuer": {
+			order: gen.OrderFrom(testOrderReady, gen.SetOrderPreferredChain("DST Root CA X3")),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.IssuerFrom(testIssuerHTTP01TestComPreferredChain, gen.SetIssuerACMEPreferredChain("ISRG Root X1")),
+					gen.OrderFrom(testOrderReady, gen.SetOrderPreferredChain("DST Root CA X3")),
+					testAuthorizationChallengeValid,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderValid.Namespace, gen.OrderFrom(testOrderValidAltCert, gen.SetOrderPreferredChain("DST Root CA X3")))),
+				},
+				ExpectedEvents: []string{
+					"Normal Complete Order completed successfully",
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetOrder: func(_ context.Context, url string) (*acmeapi.Order, error) {
+					return testACMEOrderValid, nil
+				},
+				FakeCreateOrderCert: func(_ context.Context, url string, csr []byte, bundle bool) ([][]byte, string, error) {
+					return rawTestCert, testACMEOrderValid.CertURL, nil
+				},
+				FakeListCertAlternates: func(_ context.Context, url string) ([]string, error) {
+					if url != testACMEOrderValid.CertURL {
+						return nil, errors.New("Cert URL is incorrect")
+					}
+					return []string{"http://alturl"}, nil
+				},
+				FakeFetchCert: func(_ context.Context, url string, bundle bool) ([][]byte, error) {
+					if url != "http://alturl" {
+						return nil, errors.New("Cert URL is incorrect: expected http://alturl got " + url)
+					}
+					if !bundle {
+						return nil, errors.New("Expecting to be called with bundle=true")
+					}
+					return rawTestAltCert, nil
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					return "key", nil
+				},
+			},
+		},
 		"preferred chain is default cert chain": {
 			order: testOrderReady.DeepCopy(),
 			builder: &testpkg.Builder{
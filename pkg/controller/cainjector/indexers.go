@@ -29,7 +29,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 const (
@@ -39,6 +41,18 @@ const (
 	// injectFromSecretPath is the index key used to look up the value of
 	// inject-ca-from-secret on targeted objects
 	injectFromSecretPath = ".metadata.annotations.inject-ca-from-secret"
+
+	// injectFromIssuerPath is the index key used to look up the value of
+	// inject-ca-from-issuer on targeted objects
+	injectFromIssuerPath = ".metadata.annotations.inject-ca-from-issuer"
+
+	// issuerSecretsPath is the index key used to look up the Secrets that
+	// the CA of an Issuer or ClusterIssuer is read from
+	issuerSecretsPath = ".spec.caSecrets"
+
+	// certificateIssuerPath is the index key used to look up the Certificates
+	// issued by an Issuer or ClusterIssuer
+	certificateIssuerPath = ".spec.issuerRef"
 )
 
 // certFromSecretToInjectableMapFuncBuilder returns a handler.MapFunc that, for
@@ -170,6 +184,146 @@ func secretForInjectableMapFuncBuilder(cl client.Reader, log logr.Logger, config
 	}
 }
 
+// issuerToInjectableMapFuncBuilder returns a handler.MapFunc that, for an
+// Issuer or ClusterIssuer change, ensures that if this issuer is configured as
+// a CA source for an injectable via inject-ca-from-issuer annotation, a
+// reconcile loop will be triggered for this injectable
+func issuerToInjectableMapFuncBuilder(cl client.Reader, log logr.Logger, config setup) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		issuerName := issuerIndexKey(obj.GetNamespace(), obj.GetName())
+		log := log.WithValues("type", config.resourceName, "issuer", issuerName)
+		return injectablesForIssuers(ctx, cl, log, config, issuerName)
+	}
+}
+
+// issuerCertificateToInjectableMapFuncBuilder returns a handler.MapFunc that,
+// for a Certificate change, ensures that if the issuer of this Certificate is
+// configured as a CA source for an injectable via inject-ca-from-issuer
+// annotation, a reconcile loop will be triggered for this injectable. The CA of
+// a SelfSigned issuer is read from the Certificates it issues.
+func issuerCertificateToInjectableMapFuncBuilder(cl client.Reader, log logr.Logger, config setup) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		cert, ok := obj.(*cmapi.Certificate)
+		if !ok {
+			return nil
+		}
+		issuerName := issuerRefKey(cert.Namespace, cert.Spec.IssuerRef)
+		if issuerName == "" {
+			return nil
+		}
+		log := log.WithValues("type", config.resourceName, "issuer", issuerName)
+		return injectablesForIssuers(ctx, cl, log, config, issuerName)
+	}
+}
+
+// issuerSecretToInjectableMapFuncBuilder returns a handler.MapFunc that, for a
+// Secret change, ensures that if the CA of an Issuer or ClusterIssuer is read
+// from this Secret and the issuer is configured as a CA source for an
+// injectable via inject-ca-from-issuer annotation, a reconcile loop will be
+// triggered for this injectable
+func issuerSecretToInjectableMapFuncBuilder(cl client.Reader, log logr.Logger, config setup, watchClusterIssuers bool) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []ctrl.Request {
+		secretName := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+		log := log.WithValues("type", config.resourceName, "secret", secretName)
+
+		var issuerNames []string
+		var issuers cmapi.IssuerList
+		if err := cl.List(ctx, &issuers, client.InNamespace(secretName.Namespace), client.MatchingFields{issuerSecretsPath: secretName.String()}); err != nil {
+			log.Error(err, "unable to fetch issuers associated with secret")
+			return nil
+		}
+		for _, issuer := range issuers.Items {
+			issuerNames = append(issuerNames, issuerIndexKey(issuer.Namespace, issuer.Name))
+		}
+
+		if watchClusterIssuers {
+			var clusterIssuers cmapi.ClusterIssuerList
+			if err := cl.List(ctx, &clusterIssuers, client.MatchingFields{issuerSecretsPath: secretName.String()}); err != nil {
+				log.Error(err, "unable to fetch cluster issuers associated with secret")
+				return nil
+			}
+			for _, issuer := range clusterIssuers.Items {
+				issuerNames = append(issuerNames, issuerIndexKey(issuer.Namespace, issuer.Name))
+			}
+		}
+
+		// The CA of a SelfSigned issuer is read from the Secrets of the
+		// Certificates it issues.
+		if certName := owningCertForSecret(obj); certName != nil {
+			var cert cmapi.Certificate
+			if err := cl.Get(ctx, *certName, &cert); err != nil {
+				if dropNotFound(err) != nil {
+					log.Error(err, "unable to fetch certificate that owns the secret", "certificate", *certName)
+				}
+			} else if issuerName := issuerRefKey(cert.Namespace, cert.Spec.IssuerRef); issuerName != "" {
+				issuerNames = append(issuerNames, issuerName)
+			}
+		}
+
+		return injectablesForIssuers(ctx, cl, log, config, issuerNames...)
+	}
+}
+
+// injectablesForIssuers returns requests for all injectables that have the
+// inject-ca-from-issuer annotation with one of the given issuer names.
+func injectablesForIssuers(ctx context.Context, cl client.Reader, log logr.Logger, config setup, issuerNames ...string) []ctrl.Request {
+	var reqs []ctrl.Request
+	for _, issuerName := range issuerNames {
+		objs := config.listType.DeepCopyObject().(client.ObjectList)
+		if err := cl.List(ctx, objs, client.MatchingFields{injectFromIssuerPath: issuerName}); err != nil {
+			log.Error(err, "unable to fetch injectables associated with issuer", "issuer", issuerName)
+			return nil
+		}
+
+		if err := meta.EachListItem(objs, func(obj runtime.Object) error {
+			metaInfo, err := meta.Accessor(obj)
+			if err != nil {
+				log.Error(err, "unable to get metadata from list item")
+				// continue on error
+				return nil
+			}
+			reqs = append(reqs, ctrl.Request{NamespacedName: types.NamespacedName{
+				Name:      metaInfo.GetName(),
+				Namespace: metaInfo.GetNamespace(),
+			}})
+			return nil
+		}); err != nil {
+			log.Error(err, "unable get items from list")
+			return nil
+		}
+	}
+
+	return reqs
+}
+
+// issuerIndexKey returns the name of an issuer as used in the
+// inject-ca-from-issuer annotation, which is namespace/name for Issuers and
+// name for ClusterIssuers.
+func issuerIndexKey(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}.String()
+}
+
+// issuerRefKey returns the name of the issuer referenced by ref from a
+// resource in namespace, in the form used in the inject-ca-from-issuer
+// annotation. An empty string is returned if ref does not reference an Issuer
+// or ClusterIssuer.
+func issuerRefKey(namespace string, ref cmmeta.IssuerReference) string {
+	if ref.Group != "" && ref.Group != certmanager.GroupName {
+		return ""
+	}
+	switch ref.Kind {
+	case "", cmapi.IssuerKind:
+		return issuerIndexKey(namespace, ref.Name)
+	case cmapi.ClusterIssuerKind:
+		return ref.Name
+	default:
+		return ""
+	}
+}
+
 // injectableCAFromIndexer is an IndexerFunc indexing on certificates
 // referenced by injectables.
 func injectableCAFromIndexer(rawObj client.Object) []string {
@@ -212,8 +366,71 @@ func injectableCAFromSecretIndexer(rawObj client.Object) []string {
 	return []string{secretNameRaw}
 }
 
+// injectableCAFromIssuerIndexer is an IndexerFunc indexing on issuers
+// referenced by injectables.
+func injectableCAFromIssuerIndexer(rawObj client.Object) []string {
+	metaInfo, err := meta.Accessor(rawObj)
+	if err != nil {
+		return nil
+	}
+
+	// skip invalid issuer names
+	issuerNameRaw := metaInfo.GetAnnotations()[cmapi.WantInjectFromIssuerAnnotation]
+	if issuerName := splitNamespacedName(issuerNameRaw); issuerName.Name == "" {
+		return nil
+	}
+
+	return []string{issuerNameRaw}
+}
+
+// issuerCASecretsIndexerBuilder returns an IndexerFunc indexing on the
+// Secrets that the CA of an Issuer or ClusterIssuer is read from. Secrets of
+// ClusterIssuers are read from the cluster resource namespace.
+func issuerCASecretsIndexerBuilder(clusterResourceNamespace string) client.IndexerFunc {
+	return func(rawObj client.Object) []string {
+		issuer, ok := rawObj.(cmapi.GenericIssuer)
+		if !ok {
+			return nil
+		}
+
+		resourceNamespace := issuer.GetNamespace()
+		if resourceNamespace == "" {
+			resourceNamespace = clusterResourceNamespace
+		}
+
+		var secretNames []string
+		spec := issuer.GetSpec()
+		if spec.CA != nil {
+			secretNames = append(secretNames, spec.CA.SecretName)
+		}
+		if spec.Vault != nil && spec.Vault.CABundleSecretRef != nil {
+			secretNames = append(secretNames, spec.Vault.CABundleSecretRef.Name)
+		}
+
+		var keys []string
+		for _, secretName := range secretNames {
+			keys = append(keys, types.NamespacedName{Namespace: resourceNamespace, Name: secretName}.String())
+		}
+		return keys
+	}
+}
+
+// certificateIssuerIndexer is an IndexerFunc indexing on the issuers
+// referenced by Certificates.
+func certificateIssuerIndexer(rawObj client.Object) []string {
+	cert, ok := rawObj.(*cmapi.Certificate)
+	if !ok {
+		return nil
+	}
+	issuerName := issuerRefKey(cert.Namespace, cert.Spec.IssuerRef)
+	if issuerName == "" {
+		return nil
+	}
+	return []string{issuerName}
+}
+
 // hasInjectableAnnotation returns predicates that determine whether an object is a
-// cainjector injectable by looking at whether it has one of the three
+// cainjector injectable by looking at whether it has one of the
 // annotations used to mark injectables.
 func hasInjectableAnnotation(o client.Object) bool {
 	annots := o.GetAnnotations()
@@ -226,5 +443,8 @@ func hasInjectableAnnotation(o client.Object) bool {
 	if _, ok := annots[cmapi.WantInjectFromSecretAnnotation]; ok {
 		return true
 	}
+	if _, ok := annots[cmapi.WantInjectFromIssuerAnnotation]; ok {
+		return true
+	}
 	return false
 }
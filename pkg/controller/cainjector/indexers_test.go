@@ -21,9 +21,19 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionreg "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertFromSecretToInjectableMapFuncBuilder_IgnoresNamespaces(t *testing.T) {
@@ -43,3 +53,105 @@ func TestCertFromSecretToInjectableMapFuncBuilder_IgnoresNamespaces(t *testing.T
 		t.Errorf("Expected nil for ignored namespace, got: %v", reqs)
 	}
 }
+
+func TestIssuerSecretToInjectableMapFuncBuilder(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kscheme.AddToScheme(scheme))
+	require.NoError(t, cmapi.AddToScheme(scheme))
+
+	webhook := func(name, issuerName string) *admissionreg.ValidatingWebhookConfiguration {
+		return &admissionreg.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{cmapi.WantInjectFromIssuerAnnotation: issuerName},
+			},
+		}
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&cmapi.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "ca"},
+				Spec:       cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}}},
+			},
+			&cmapi.ClusterIssuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca"},
+				Spec:       cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}}},
+			},
+			&cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "root"},
+				Spec:       cmapi.CertificateSpec{SecretName: "root", IssuerRef: cmmeta.IssuerReference{Name: "selfsigned"}},
+			},
+			webhook("issuer", "ns/ca"),
+			webhook("cluster-issuer", "ca"),
+			webhook("self-signed-issuer", "ns/selfsigned"),
+			webhook("other", "ns/other"),
+		).
+		WithIndex(&cmapi.Issuer{}, issuerSecretsPath, issuerCASecretsIndexerBuilder("cert-manager")).
+		WithIndex(&cmapi.ClusterIssuer{}, issuerSecretsPath, issuerCASecretsIndexerBuilder("cert-manager")).
+		WithIndex(&admissionreg.ValidatingWebhookConfiguration{}, injectFromIssuerPath, injectableCAFromIssuerIndexer).
+		Build()
+
+	mapFunc := issuerSecretToInjectableMapFuncBuilder(cl, logr.Discard(), ValidatingWebhookSetup, true)
+	secret := func(namespace string) *metav1.PartialObjectMetadata {
+		secret := &metav1.PartialObjectMetadata{}
+		secret.SetNamespace(namespace)
+		secret.SetName("ca")
+		return secret
+	}
+	certificateSecret := &metav1.PartialObjectMetadata{}
+	certificateSecret.SetNamespace("ns")
+	certificateSecret.SetName("root")
+	certificateSecret.SetAnnotations(map[string]string{cmapi.CertificateNameKey: "root"})
+
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: types.NamespacedName{Name: "issuer"}}},
+		mapFunc(t.Context(), secret("ns")),
+	)
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: types.NamespacedName{Name: "cluster-issuer"}}},
+		mapFunc(t.Context(), secret("cert-manager")),
+	)
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: types.NamespacedName{Name: "self-signed-issuer"}}},
+		mapFunc(t.Context(), certificateSecret),
+	)
+}
+
+func TestIssuerCertificateToInjectableMapFuncBuilder(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kscheme.AddToScheme(scheme))
+	require.NoError(t, cmapi.AddToScheme(scheme))
+
+	webhook := func(name, issuerName string) *admissionreg.ValidatingWebhookConfiguration {
+		return &admissionreg.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{cmapi.WantInjectFromIssuerAnnotation: issuerName},
+			},
+		}
+	}
+	cl := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(webhook("issuer", "ns/selfsigned"), webhook("cluster-issuer", "selfsigned")).
+		WithIndex(&admissionreg.ValidatingWebhookConfiguration{}, injectFromIssuerPath, injectableCAFromIssuerIndexer).
+		Build()
+
+	mapFunc := issuerCertificateToInjectableMapFuncBuilder(cl, logr.Discard(), ValidatingWebhookSetup)
+	certificate := func(issuerRef cmmeta.IssuerReference) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "root"},
+			Spec:       cmapi.CertificateSpec{SecretName: "root", IssuerRef: issuerRef},
+		}
+	}
+
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: types.NamespacedName{Name: "issuer"}}},
+		mapFunc(t.Context(), certificate(cmmeta.IssuerReference{Name: "selfsigned"})),
+	)
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: types.NamespacedName{Name: "cluster-issuer"}}},
+		mapFunc(t.Context(), certificate(cmmeta.IssuerReference{Name: "selfsigned", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"})),
+	)
+	assert.Empty(t, mapFunc(t.Context(), certificate(cmmeta.IssuerReference{Name: "selfsigned", Kind: "Issuer", Group: "example.com"})))
+}
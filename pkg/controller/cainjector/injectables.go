@@ -17,6 +17,9 @@ limitations under the License.
 package cainjector
 
 import (
+	"slices"
+	"time"
+
 	admissionreg "k8s.io/api/admissionregistration/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	applyapiext "k8s.io/apiextensions-apiserver/pkg/client/applyconfiguration/apiextensions/v1"
@@ -28,6 +31,7 @@ import (
 
 	cainjectorbundle "github.com/cert-manager/cert-manager/internal/cainjector/bundle"
 	"github.com/cert-manager/cert-manager/internal/cainjector/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
@@ -76,9 +80,64 @@ type InjectTarget interface {
 	AsApplyObject() runtime.ApplyConfiguration
 
 	// SetCA sets the CA of this target to the given certificate data (in the standard
-	// PEM format used across Kubernetes).  In cases where multiple CA fields exist per
-	// target (like admission webhook configs), all CAs are set to the given value.
-	SetCA(data []byte)
+	// PEM format used across Kubernetes), using the merger to merge the data into
+	// the existing CA.  In cases where multiple CA fields exist per target (like
+	// admission webhook configs), the data is merged into all CAs.
+	SetCA(data []byte, merger *caBundleMerger)
+}
+
+// caBundleMerger merges CA data into the existing CA bundles of an
+// injectable. If the CAInjectorMerging feature gate is disabled, or the
+// merger is nil, the existing CA bundles are replaced with the CA data.
+type caBundleMerger struct {
+	// policy determines which CA certificates that have been removed from the
+	// CA data source are retained.
+	policy cainjectorbundle.RetentionPolicy
+	// status is the status recorded on the injectable by the previous merge.
+	status []cainjectorbundle.CertificateStatus
+	now    time.Time
+
+	// merged is the status of every CA certificate in the merged CA bundles.
+	merged []cainjectorbundle.CertificateStatus
+}
+
+func (m *caBundleMerger) merge(bundle, data []byte) []byte {
+	if m == nil || !utilfeature.DefaultFeatureGate.Enabled(feature.CAInjectorMerging) {
+		return data
+	}
+
+	merged, status, err := cainjectorbundle.MergeCertificatesIntoBundle(bundle, data, m.status, m.policy, m.now)
+	if err != nil {
+		// If we for any reason cannot merge the certificate in, we replace it with
+		// the new certificate.
+		//
+		// This mirrors the old behavior of this function so is a reasonable
+		// fallback
+		merged = data
+		_, status, _ = cainjectorbundle.MergeCertificatesIntoBundle(nil, data, nil, cainjectorbundle.RetentionPolicy{}, m.now)
+	}
+
+	for _, s := range status {
+		if !slices.ContainsFunc(m.merged, func(merged cainjectorbundle.CertificateStatus) bool {
+			return merged.Fingerprint == s.Fingerprint
+		}) {
+			m.merged = append(m.merged, s)
+		}
+	}
+
+	return merged
+}
+
+// managedAnnotations returns the annotations managed by the cainjector on
+// the given injectable, for use in Apply calls.
+func managedAnnotations(obj client.Object) map[string]string {
+	annotations := make(map[string]string)
+	for _, key := range []string{cmapi.InjectCAStatusAnnotation, cmapi.InjectCAFingerprintAnnotation} {
+		if value, ok := obj.GetAnnotations()[key]; ok {
+			annotations[key] = value
+		}
+	}
+	return annotations
 }
 
 // mutatingWebhookTarget knows how to set CA data for all the webhooks
@@ -91,28 +150,15 @@ func (t *mutatingWebhookTarget) AsObject() client.Object {
 	return &t.obj
 }
 
-func (t *mutatingWebhookTarget) SetCA(data []byte) {
+func (t *mutatingWebhookTarget) SetCA(data []byte, merger *caBundleMerger) {
 	for ind := range t.obj.Webhooks {
-		if utilfeature.DefaultFeatureGate.Enabled(feature.CAInjectorMerging) {
-			// If we for any reason cannot merge the certificate in, we replace it with
-			// the new certificate.
-			//
-			// This mirrors the old behavior of this function so is a reasonable
-			// fallback
-			bundle, err := cainjectorbundle.AppendCertificatesToBundle(t.obj.Webhooks[ind].ClientConfig.CABundle, data)
-			if err != nil {
-				bundle = data
-			}
-
-			t.obj.Webhooks[ind].ClientConfig.CABundle = bundle
-		} else {
-			t.obj.Webhooks[ind].ClientConfig.CABundle = data
-		}
+		t.obj.Webhooks[ind].ClientConfig.CABundle = merger.merge(t.obj.Webhooks[ind].ClientConfig.CABundle, data)
 	}
 }
 
 func (t *mutatingWebhookTarget) AsApplyObject() runtime.ApplyConfiguration {
-	patch := applyadmissionreg.MutatingWebhookConfiguration(t.obj.Name)
+	patch := applyadmissionreg.MutatingWebhookConfiguration(t.obj.Name).
+		WithAnnotations(managedAnnotations(&t.obj))
 
 	for i := range t.obj.Webhooks {
 		patch = patch.WithWebhooks(
@@ -140,28 +186,15 @@ func (t *validatingWebhookTarget) AsObject() client.Object {
 	return &t.obj
 }
 
-func (t *validatingWebhookTarget) SetCA(data []byte) {
+func (t *validatingWebhookTarget) SetCA(data []byte, merger *caBundleMerger) {
 	for ind := range t.obj.Webhooks {
-		if utilfeature.DefaultFeatureGate.Enabled(feature.CAInjectorMerging) {
-			// If we for any reason cannot merge the certificate in, we replace it with
-			// the new certificate.
-			//
-			// This mirrors the old behavior of this function so is a reasonable
-			// fallback
-			bundle, err := cainjectorbundle.AppendCertificatesToBundle(t.obj.Webhooks[ind].ClientConfig.CABundle, data)
-			if err != nil {
-				bundle = data
-			}
-
-			t.obj.Webhooks[ind].ClientConfig.CABundle = bundle
-		} else {
-			t.obj.Webhooks[ind].ClientConfig.CABundle = data
-		}
+		t.obj.Webhooks[ind].ClientConfig.CABundle = merger.merge(t.obj.Webhooks[ind].ClientConfig.CABundle, data)
 	}
 }
 
 func (t *validatingWebhookTarget) AsApplyObject() runtime.ApplyConfiguration {
-	patch := applyadmissionreg.ValidatingWebhookConfiguration(t.obj.Name)
+	patch := applyadmissionreg.ValidatingWebhookConfiguration(t.obj.Name).
+		WithAnnotations(managedAnnotations(&t.obj))
 
 	for i := range t.obj.Webhooks {
 		patch = patch.WithWebhooks(
@@ -189,22 +222,8 @@ func (t *apiServiceTarget) AsObject() client.Object {
 	return &t.obj
 }
 
-func (t *apiServiceTarget) SetCA(data []byte) {
-	if utilfeature.DefaultFeatureGate.Enabled(feature.CAInjectorMerging) {
-		// If we for any reason cannot merge the certificate in, we replace it with
-		// the new certificate.
-		//
-		// This mirrors the old behavior of this function so is a reasonable
-		// fallback
-		bundle, err := cainjectorbundle.AppendCertificatesToBundle(t.obj.Spec.CABundle, data)
-		if err != nil {
-			bundle = data
-		}
-
-		t.obj.Spec.CABundle = bundle
-	} else {
-		t.obj.Spec.CABundle = data
-	}
+func (t *apiServiceTarget) SetCA(data []byte, merger *caBundleMerger) {
+	t.obj.Spec.CABundle = merger.merge(t.obj.Spec.CABundle, data)
 }
 
 type apiServiceTargetPatch struct {
@@ -227,7 +246,8 @@ func (t *apiServiceTarget) AsApplyObject() runtime.ApplyConfiguration {
 			WithKind("APIService"),
 		ObjectMetaApplyConfiguration: applymetav1.
 			ObjectMeta().
-			WithName(t.obj.Name),
+			WithName(t.obj.Name).
+			WithAnnotations(managedAnnotations(&t.obj)),
 		Spec: &apiServiceTargetSpecPatch{
 			CABundle: t.obj.Spec.CABundle,
 		},
@@ -243,7 +263,7 @@ func (t *crdConversionTarget) AsObject() client.Object {
 	return &t.obj
 }
 
-func (t *crdConversionTarget) SetCA(data []byte) {
+func (t *crdConversionTarget) SetCA(data []byte, merger *caBundleMerger) {
 	if t.obj.Spec.Conversion == nil || t.obj.Spec.Conversion.Strategy != apiext.WebhookConverter {
 		return
 	}
@@ -254,25 +274,12 @@ func (t *crdConversionTarget) SetCA(data []byte) {
 		t.obj.Spec.Conversion.Webhook.ClientConfig = &apiext.WebhookClientConfig{}
 	}
 
-	if utilfeature.DefaultFeatureGate.Enabled(feature.CAInjectorMerging) {
-		// If we for any reason cannot merge the certificate in, we replace it with
-		// the new certificate.
-		//
-		// This mirrors the old behavior of this function so is a reasonable
-		// fallback
-		bundle, err := cainjectorbundle.AppendCertificatesToBundle(t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle, data)
-		if err != nil {
-			bundle = data
-		}
-
-		t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle = bundle
-	} else {
-		t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle = data
-	}
+	t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle = merger.merge(t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle, data)
 }
 
 func (t *crdConversionTarget) AsApplyObject() runtime.ApplyConfiguration {
-	patch := applyapiext.CustomResourceDefinition(t.obj.Name)
+	patch := applyapiext.CustomResourceDefinition(t.obj.Name).
+		WithAnnotations(managedAnnotations(&t.obj))
 
 	if t.obj.Spec.Conversion != nil && t.obj.Spec.Conversion.Webhook != nil && t.obj.Spec.Conversion.Webhook.ClientConfig != nil {
 		patch = patch.WithSpec(applyapiext.
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// This file contains the metrics exposed by the cainjector reconcilers. They
+// are registered with the controller-runtime registry, which is served by the
+// cainjector's metrics server.
+
+const (
+	injectionResultSuccess   = "success"
+	injectionResultError     = "error"
+	injectionResultForbidden = "forbidden"
+	injectionResultNoSource  = "no_source"
+	injectionResultNoCAData  = "no_ca_data"
+)
+
+var (
+	// injectionsTotal counts the injections attempted, by kind of injectable
+	// and result.
+	injectionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "certmanager",
+			Subsystem: "cainjector",
+			Name:      "injections_total",
+			Help:      "The number of CA injections attempted, by kind of injectable and result.",
+		},
+		[]string{"kind", "result"},
+	)
+
+	// lastInjectionTimestamp is the time of the last successful injection
+	// into each injectable.
+	lastInjectionTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "certmanager",
+			Subsystem: "cainjector",
+			Name:      "last_injection_timestamp_seconds",
+			Help:      "The timestamp of the last successful CA injection into an injectable, expressed in Unix Epoch Time.",
+		},
+		[]string{"kind", "name"},
+	)
+
+	// caBundleCertificates is the number of CA certificates injected into
+	// each injectable by the last successful injection.
+	caBundleCertificates = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "certmanager",
+			Subsystem: "cainjector",
+			Name:      "ca_bundle_certificates",
+			Help:      "The number of CA certificates injected into an injectable by the last successful CA injection.",
+		},
+		[]string{"kind", "name"},
+	)
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		injectionsTotal,
+		lastInjectionTimestamp,
+		caBundleCertificates,
+	)
+}
+
+// removeInjectableMetrics removes the metrics of an injectable which no
+// longer exists.
+func removeInjectableMetrics(kind, name string) {
+	lastInjectionTimestamp.DeleteLabelValues(kind, name)
+	caBundleCertificates.DeleteLabelValues(kind, name)
+}
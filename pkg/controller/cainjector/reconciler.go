@@ -18,20 +18,30 @@ package cainjector
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/set"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	cainjectorbundle "github.com/cert-manager/cert-manager/internal/cainjector/bundle"
 	certmanager "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // This file contains logic to create reconcilers. By default a
@@ -39,6 +49,15 @@ import (
 // Validating/MutatingWebhookConfiguration, APIService and gets triggered for
 // events on those resources as well as on Secrets and Certificates.
 
+// Reasons for the events recorded on injectables
+const (
+	reasonInjected        = "CAInjected"
+	reasonInjectionFailed = "CAInjectionFailed"
+	reasonForbidden       = "CADataSourceForbidden"
+	reasonNoCASource      = "NoCADataSource"
+	reasonNoCAData        = "NoCAData"
+)
+
 // reconciler syncs CA data from source to injectable.
 type reconciler struct {
 	// newInjectableTarget knows how to create a new injectable target for
@@ -63,6 +82,13 @@ type reconciler struct {
 	// fieldManager is the manager name used for the Apply operations.
 	fieldManager string
 
+	// recorder is used to record events on injectables.
+	recorder record.EventRecorder
+
+	// caBundleRetention is the policy used to retain CA certificates which
+	// have been removed from the CA data source in injected CA bundles.
+	caBundleRetention cainjectorbundle.RetentionPolicy
+
 	resourceName string // just used for logging
 }
 
@@ -80,6 +106,7 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if dropNotFound(err) == nil {
 			// don't requeue on deletions, which yield a non-found object
 			log.V(logf.DebugLevel).Info("ignoring", "reason", "not found", "err", err)
+			removeInjectableMetrics(r.resourceName, req.Name)
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "unable to fetch target object to inject into")
@@ -96,26 +123,46 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	dataSource, err := r.caDataSourceFor(log, obj)
 	if err != nil {
 		log.V(logf.DebugLevel).Info("failed to determine ca data source for injectable")
+		injectionsTotal.WithLabelValues(r.resourceName, injectionResultNoSource).Inc()
+		r.recordEvent(obj, corev1.EventTypeWarning, reasonNoCASource, "Could not determine the CA data source, check the cainjector annotations and that the data source is enabled")
 		return ctrl.Result{}, nil //nolint:nilerr
 	}
 
 	caData, err := dataSource.ReadCA(ctx, log, obj, r.namespace, r.ignoreNamespaces)
 	if apierrors.IsForbidden(err) {
 		log.V(logf.InfoLevel).Info("cainjector was forbidden to retrieve the ca data source")
+		injectionsTotal.WithLabelValues(r.resourceName, injectionResultForbidden).Inc()
+		r.recordEvent(obj, corev1.EventTypeWarning, reasonForbidden, "The cainjector was forbidden to read the CA data source: "+err.Error())
 		return ctrl.Result{}, nil
 	}
 	if err != nil {
 		log.Error(err, "failed to read CA from data source")
+		injectionsTotal.WithLabelValues(r.resourceName, injectionResultError).Inc()
+		r.recordEvent(obj, corev1.EventTypeWarning, reasonInjectionFailed, "Failed to read the CA data source: "+err.Error())
 		return ctrl.Result{}, err
 	}
 
 	if caData == nil {
 		log.V(logf.InfoLevel).Info("could not find any ca data in data source for target")
-		return ctrl.Result{}, nil
+		injectionsTotal.WithLabelValues(r.resourceName, injectionResultNoCAData).Inc()
+		r.recordEvent(obj, corev1.EventTypeWarning, reasonNoCAData, "Could not find any CA data in the CA data source")
+		return r.resyncResult(ctx, dataSource, obj), nil
 	}
 
 	// actually do the injection
-	target.SetCA(caData)
+	previousFingerprint := obj.GetAnnotations()[certmanager.InjectCAFingerprintAnnotation]
+	fingerprint := caDataFingerprint(caData)
+	merger := r.caBundleMergerFor(log, obj)
+	target.SetCA(caData, merger)
+	if err := setInjectCAStatus(obj, merger); err != nil {
+		return ctrl.Result{}, fmt.Errorf("when recording injected CA certificates: %w", err)
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[certmanager.InjectCAFingerprintAnnotation] = fingerprint
+	obj.SetAnnotations(annotations)
 
 	// upgrade managed fields from CSA to SSA if required
 	upgradePatch, err := csaupgrade.UpgradeManagedFieldsPatch(obj, sets.New(r.fieldManager), r.fieldManager)
@@ -131,12 +178,31 @@ func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// actually update with injected CA data
 	if err := r.Client.Apply(ctx, target.AsApplyObject(), client.ForceOwnership, client.FieldOwner(r.fieldManager)); err != nil {
 		log.Error(err, "unable to apply target object with CA data")
+		injectionsTotal.WithLabelValues(r.resourceName, injectionResultError).Inc()
+		r.recordEvent(obj, corev1.EventTypeWarning, reasonInjectionFailed, "Failed to inject CA data: "+err.Error())
 		return ctrl.Result{}, err
 	}
 
+	injectionsTotal.WithLabelValues(r.resourceName, injectionResultSuccess).Inc()
+	lastInjectionTimestamp.WithLabelValues(r.resourceName, req.Name).SetToCurrentTime()
+	caBundleCertificates.WithLabelValues(r.resourceName, req.Name).Set(float64(injectedCertificates(caData, merger)))
+	if fingerprint != previousFingerprint {
+		r.recordEvent(obj, corev1.EventTypeNormal, reasonInjected, "Injected CA data with SHA-256 fingerprints "+fingerprint)
+	}
+
 	log.V(logf.InfoLevel).Info("Updated object")
 
-	return ctrl.Result{}, nil
+	return r.resyncResult(ctx, dataSource, obj), nil
+}
+
+// resyncResult returns the result of a reconcile which read the CA data of
+// the injectable from the data source, requeueing the injectable if the data
+// source must be read again periodically.
+func (r *reconciler) resyncResult(ctx context.Context, dataSource caDataSource, obj metav1.Object) ctrl.Result {
+	if s, ok := dataSource.(resyncingCADataSource); ok {
+		return ctrl.Result{RequeueAfter: s.ResyncPeriod(ctx, obj)}
+	}
+	return ctrl.Result{}
 }
 
 func (r *reconciler) caDataSourceFor(log logr.Logger, metaObj metav1.Object) (caDataSource, error) {
@@ -148,6 +214,112 @@ func (r *reconciler) caDataSourceFor(log logr.Logger, metaObj metav1.Object) (ca
 	return nil, fmt.Errorf("could not determine ca data source for resource")
 }
 
+// recordEvent records an event on the injectable, if the reconciler has an
+// event recorder.
+func (r *reconciler) recordEvent(obj runtime.Object, eventType, reason, message string) {
+	if r.recorder == nil {
+		return
+	}
+	r.recorder.Event(obj, eventType, reason, message)
+}
+
+// caDataFingerprint returns the comma separated SHA-256 fingerprints of the
+// certificates in the CA data. If the CA data cannot be parsed, the SHA-256
+// hash of the CA data is returned instead.
+func caDataFingerprint(caData []byte) string {
+	certificates, err := pki.DecodeX509CertificateSetBytes(caData)
+	if err != nil {
+		sum := sha256.Sum256(caData)
+		return hex.EncodeToString(sum[:])
+	}
+
+	fingerprints := make([]string, 0, len(certificates))
+	for _, certificate := range certificates {
+		fingerprints = append(fingerprints, cainjectorbundle.Fingerprint(certificate))
+	}
+	return strings.Join(fingerprints, ",")
+}
+
+// injectedCertificates returns the number of CA certificates injected into
+// the CA bundles of an injectable.
+func injectedCertificates(caData []byte, merger *caBundleMerger) int {
+	if len(merger.merged) > 0 {
+		return len(merger.merged)
+	}
+	certificates, err := pki.DecodeX509CertificateSetBytes(caData)
+	if err != nil {
+		return 0
+	}
+	return len(certificates)
+}
+
+// caBundleMergerFor returns the merger used to merge CA data into the CA
+// bundles of the injectable, taking into account the CA certificates recorded
+// by the previous injection and the CA certificates which the injectable has
+// asked to no longer be retained.
+func (r *reconciler) caBundleMergerFor(log logr.Logger, metaObj metav1.Object) *caBundleMerger {
+	policy := r.caBundleRetention
+	if value := metaObj.GetAnnotations()[certmanager.InjectCARemovedAnnotation]; value != "" {
+		policy.Removed = parseFingerprints(value)
+	}
+
+	var status []cainjectorbundle.CertificateStatus
+	if value, ok := metaObj.GetAnnotations()[certmanager.InjectCAStatusAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &status); err != nil {
+			// The status is only used to work out when retained CA certificates
+			// were removed from the CA data source, so continue as if they were
+			// removed now rather than failing the injection.
+			log.Error(err, "failed to parse annotation, ignoring it", "annotation", certmanager.InjectCAStatusAnnotation)
+			status = nil
+		}
+	}
+
+	return &caBundleMerger{
+		policy: policy,
+		status: status,
+		now:    time.Now(),
+	}
+}
+
+// setInjectCAStatus records the CA certificates in the merged CA bundles in
+// an annotation on the injectable. If no CA data was merged, the annotation is
+// removed.
+func setInjectCAStatus(metaObj metav1.Object, merger *caBundleMerger) error {
+	annotations := metaObj.GetAnnotations()
+	if len(merger.merged) == 0 {
+		if _, ok := annotations[certmanager.InjectCAStatusAnnotation]; ok {
+			delete(annotations, certmanager.InjectCAStatusAnnotation)
+			metaObj.SetAnnotations(annotations)
+		}
+		return nil
+	}
+
+	value, err := json.Marshal(merger.merged)
+	if err != nil {
+		return err
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[certmanager.InjectCAStatusAnnotation] = string(value)
+	metaObj.SetAnnotations(annotations)
+	return nil
+}
+
+// parseFingerprints parses a comma separated list of hex encoded SHA-256
+// fingerprints. Fingerprints may be upper or lower case and may be colon
+// separated, as printed by `openssl x509 -fingerprint -sha256`.
+func parseFingerprints(value string) set.Set[string] {
+	fingerprints := set.New[string]()
+	for fingerprint := range strings.SplitSeq(value, ",") {
+		fingerprint = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+		if fingerprint != "" {
+			fingerprints.Insert(fingerprint)
+		}
+	}
+	return fingerprints
+}
+
 // dropNotFound ignores the given error if it's a not-found error,
 // but otherwise just returns the argument.
 // TODO: we don't use this pattern anywhere else in this project so probably doesn't make sense here either
@@ -0,0 +1,326 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionreg "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/set"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cainjectorbundle "github.com/cert-manager/cert-manager/internal/cainjector/bundle"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestParseFingerprints(t *testing.T) {
+	assert.Equal(t,
+		set.New("ab01", "cd02"),
+		parseFingerprints(" AB:01 ,cd02,,"),
+	)
+}
+
+func TestSetCARetention(t *testing.T) {
+	current := mustCreateCA(t, "current")
+	rotated := mustCreateCA(t, "rotated")
+	removed := mustCreateCA(t, "removed")
+
+	removedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	status, err := json.Marshal([]cainjectorbundle.CertificateStatus{
+		{Fingerprint: mustFingerprint(t, rotated), RemovedFromSource: &removedAt},
+	})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		annotations    map[string]string
+		policy         cainjectorbundle.RetentionPolicy
+		expectedBundle []byte
+	}{
+		"CA certificates removed from the source are retained": {
+			annotations: map[string]string{
+				cmapi.InjectCAStatusAnnotation: string(status),
+			},
+			expectedBundle: joinPEM(rotated, removed, current),
+		},
+		"CA certificates removed from the source are dropped after the grace period": {
+			annotations: map[string]string{
+				cmapi.InjectCAStatusAnnotation: string(status),
+			},
+			policy:         cainjectorbundle.RetentionPolicy{GracePeriod: time.Minute},
+			expectedBundle: joinPEM(removed, current),
+		},
+		"CA certificates listed in the inject-ca-removed annotation are dropped": {
+			annotations: map[string]string{
+				cmapi.InjectCAStatusAnnotation:  string(status),
+				cmapi.InjectCARemovedAnnotation: mustFingerprint(t, removed),
+			},
+			expectedBundle: joinPEM(rotated, current),
+		},
+		"an invalid status annotation is ignored": {
+			annotations: map[string]string{
+				cmapi.InjectCAStatusAnnotation: "invalid",
+			},
+			policy:         cainjectorbundle.RetentionPolicy{GracePeriod: time.Minute},
+			expectedBundle: joinPEM(rotated, removed, current),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			target := &validatingWebhookTarget{
+				obj: admissionreg.ValidatingWebhookConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: test.annotations},
+					Webhooks: []admissionreg.ValidatingWebhook{
+						{ClientConfig: admissionreg.WebhookClientConfig{CABundle: joinPEM(rotated, removed)}},
+					},
+				},
+			}
+			r := &reconciler{caBundleRetention: test.policy}
+
+			merger := r.caBundleMergerFor(logr.Discard(), &target.obj)
+			target.SetCA(current, merger)
+			require.NoError(t, setInjectCAStatus(&target.obj, merger))
+
+			if bundle := target.obj.Webhooks[0].ClientConfig.CABundle; !bytes.Equal(test.expectedBundle, bundle) {
+				t.Errorf("unexpected CA bundle, expected %q, got %q", test.expectedBundle, bundle)
+			}
+
+			var gotStatus []cainjectorbundle.CertificateStatus
+			require.NoError(t, json.Unmarshal([]byte(target.obj.Annotations[cmapi.InjectCAStatusAnnotation]), &gotStatus))
+			var gotFingerprints []string
+			for _, s := range gotStatus {
+				gotFingerprints = append(gotFingerprints, s.Fingerprint)
+			}
+			var expectedFingerprints []string
+			for _, certificate := range mustDecodeCertificates(t, test.expectedBundle) {
+				expectedFingerprints = append(expectedFingerprints, cainjectorbundle.Fingerprint(certificate))
+			}
+			assert.Equal(t, expectedFingerprints, gotFingerprints)
+		})
+	}
+}
+
+func TestReconcileEventsAndMetrics(t *testing.T) {
+	ca := mustCreateCA(t, "ca")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns",
+			Name:        "ca",
+			Annotations: map[string]string{cmapi.AllowsInjectionFromSecretAnnotation: "true"},
+		},
+		Data: map[string][]byte{cmmeta.TLSCAKey: ca},
+	}
+	webhook := func(annotations map[string]string) *admissionreg.ValidatingWebhookConfiguration {
+		return &admissionreg.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "webhook", Annotations: annotations},
+			Webhooks: []admissionreg.ValidatingWebhook{
+				{Name: "webhook.example.com", ClientConfig: admissionreg.WebhookClientConfig{}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		webhook          *admissionreg.ValidatingWebhookConfiguration
+		ignoreNamespaces []string
+		expectedEvents   []string
+		expectedResult   string
+		expectedBundle   []byte
+	}{
+		"CA data is injected": {
+			webhook:        webhook(map[string]string{cmapi.WantInjectFromSecretAnnotation: "ns/ca"}),
+			expectedEvents: []string{"Normal CAInjected Injected CA data with SHA-256 fingerprints " + mustFingerprint(t, ca)},
+			expectedResult: injectionResultSuccess,
+			expectedBundle: ca,
+		},
+		"no event is recorded if the injected CA data is unchanged": {
+			webhook: webhook(map[string]string{
+				cmapi.WantInjectFromSecretAnnotation: "ns/ca",
+				cmapi.InjectCAFingerprintAnnotation:  mustFingerprint(t, ca),
+			}),
+			expectedResult: injectionResultSuccess,
+			expectedBundle: ca,
+		},
+		"the CA data source is forbidden": {
+			webhook:          webhook(map[string]string{cmapi.WantInjectFromSecretAnnotation: "ns/ca"}),
+			ignoreNamespaces: []string{"ns"},
+			expectedEvents:   []string{"Warning CADataSourceForbidden The cainjector was forbidden to read the CA data source: certificates.cert-manager.io \"ca\" is forbidden: cannot read CA data from Secret in namespace ns, namespace is ignored"},
+			expectedResult:   injectionResultForbidden,
+		},
+		"the CA data source has no CA data": {
+			webhook:        webhook(map[string]string{cmapi.WantInjectFromSecretAnnotation: "ns/missing"}),
+			expectedEvents: []string{"Warning NoCAData Could not find any CA data in the CA data source"},
+			expectedResult: injectionResultNoCAData,
+		},
+		"there is no CA data source": {
+			webhook:        webhook(map[string]string{cmapi.WantInjectAnnotation: "ns/crt"}),
+			expectedEvents: []string{"Warning NoCADataSource Could not determine the CA data source, check the cainjector annotations and that the data source is enabled"},
+			expectedResult: injectionResultNoSource,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithObjects(secret, test.webhook).
+				Build()
+			recorder := record.NewFakeRecorder(10)
+			r := &reconciler{
+				newInjectableTarget: newValidatingWebhookInjectable,
+				sources:             []caDataSource{&secretDataSource{client: cl}},
+				log:                 logr.Discard(),
+				Client:              cl,
+				ignoreNamespaces:    sets.New(test.ignoreNamespaces...),
+				fieldManager:        "cert-manager-cainjector",
+				recorder:            recorder,
+				resourceName:        ValidatingWebhookConfigurationName,
+			}
+
+			before := testutil.ToFloat64(injectionsTotal.WithLabelValues(ValidatingWebhookConfigurationName, test.expectedResult))
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "webhook"}})
+			require.NoError(t, err)
+			after := testutil.ToFloat64(injectionsTotal.WithLabelValues(ValidatingWebhookConfigurationName, test.expectedResult))
+			assert.Equal(t, before+1, after)
+
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			assert.Equal(t, test.expectedEvents, events)
+
+			var got admissionreg.ValidatingWebhookConfiguration
+			require.NoError(t, cl.Get(t.Context(), types.NamespacedName{Name: "webhook"}, &got))
+			assert.Equal(t, test.expectedBundle, got.Webhooks[0].ClientConfig.CABundle)
+			if test.expectedBundle != nil {
+				assert.Equal(t, mustFingerprint(t, ca), got.Annotations[cmapi.InjectCAFingerprintAnnotation])
+				assert.Equal(t, float64(1), testutil.ToFloat64(caBundleCertificates.WithLabelValues(ValidatingWebhookConfigurationName, "webhook")))
+			}
+		})
+	}
+}
+
+// resyncingSecretDataSource is a secretDataSource whose CA data must be read
+// again periodically.
+type resyncingSecretDataSource struct {
+	*secretDataSource
+	period time.Duration
+}
+
+func (s *resyncingSecretDataSource) ResyncPeriod(context.Context, metav1.Object) time.Duration {
+	return s.period
+}
+
+func TestReconcileRequeuesResyncingDataSources(t *testing.T) {
+	ca := mustCreateCA(t, "ca")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns",
+			Name:        "ca",
+			Annotations: map[string]string{cmapi.AllowsInjectionFromSecretAnnotation: "true"},
+		},
+		Data: map[string][]byte{cmmeta.TLSCAKey: ca},
+	}
+
+	tests := map[string]struct {
+		secretName     string
+		expectedResult ctrl.Result
+	}{
+		"the injectable is requeued after injecting CA data": {
+			secretName:     "ns/ca",
+			expectedResult: ctrl.Result{RequeueAfter: time.Minute},
+		},
+		"the injectable is requeued if the data source has no CA data": {
+			secretName:     "ns/missing",
+			expectedResult: ctrl.Result{RequeueAfter: time.Minute},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			webhook := &admissionreg.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "webhook",
+					Annotations: map[string]string{cmapi.WantInjectFromSecretAnnotation: test.secretName},
+				},
+				Webhooks: []admissionreg.ValidatingWebhook{{Name: "webhook.example.com"}},
+			}
+			cl := fake.NewClientBuilder().WithObjects(secret, webhook).Build()
+			r := &reconciler{
+				newInjectableTarget: newValidatingWebhookInjectable,
+				sources:             []caDataSource{&resyncingSecretDataSource{secretDataSource: &secretDataSource{client: cl}, period: time.Minute}},
+				log:                 logr.Discard(),
+				Client:              cl,
+				fieldManager:        "cert-manager-cainjector",
+				resourceName:        ValidatingWebhookConfigurationName,
+			}
+
+			result, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "webhook"}})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResult, result)
+		})
+	}
+}
+
+func mustCreateCA(t *testing.T, name string) []byte {
+	pk, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             pk.Public(),
+		IsCA:                  true,
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	certPEM, _, err := pki.SignCertificate(template, template, pk.Public(), pk)
+	require.NoError(t, err)
+	return certPEM
+}
+
+func mustDecodeCertificates(t *testing.T, bundle []byte) []*x509.Certificate {
+	certificates, err := pki.DecodeX509CertificateSetBytes(bundle)
+	require.NoError(t, err)
+	return certificates
+}
+
+func mustFingerprint(t *testing.T, certPEM []byte) string {
+	return cainjectorbundle.Fingerprint(mustDecodeCertificates(t, certPEM)[0])
+}
+
+func joinPEM(certificates ...[]byte) []byte {
+	return bytes.Join(certificates, nil)
+}
@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,7 +35,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	cainjectorbundle "github.com/cert-manager/cert-manager/internal/cainjector/bundle"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/util"
 )
 
@@ -61,7 +65,14 @@ type SetupOptions struct {
 	Namespace                    string
 	IgnoreNamespaces             []string
 	EnableCertificatesDataSource bool
-	EnabledReconcilersFor        map[string]bool
+	EnableIssuersDataSource      bool
+	// ClusterResourceNamespace is the namespace that Secrets referenced by
+	// ClusterIssuers are read from.
+	ClusterResourceNamespace string
+	EnabledReconcilersFor    map[string]bool
+	// CABundleRetention is the policy used to retain CA certificates which
+	// have been removed from the CA data source in injected CA bundles.
+	CABundleRetention cainjectorbundle.RetentionPolicy
 }
 
 var (
@@ -110,6 +121,43 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 	kds := &kubeconfigDataSource{
 		apiserverCABundle: caBundle,
 	}
+	ids := &issuerDataSource{
+		client:                   mgr.GetClient(),
+		apiReader:                mgr.GetAPIReader(),
+		clusterResourceNamespace: opts.ClusterResourceNamespace,
+		venafiClientBuilder:      venaficlient.New,
+		metrics:                  metrics.New(ctrl.Log.WithName("metrics"), clock.RealClock{}),
+		userAgent:                cfg.UserAgent,
+	}
+	sources := []caDataSource{sds, cds, kds}
+	// A cainjector scoped to a namespace cannot read CA data from
+	// ClusterIssuers, so it does not watch them.
+	watchClusterIssuers := opts.Namespace == ""
+	if opts.EnableIssuersDataSource {
+		sources = append(sources, ids)
+
+		// Index issuers with a new field. The field's values are the
+		// namespaced names of the Secrets that the issuer's CA is read
+		// from. This field can then be used as a field selector when
+		// listing the issuers that read their CA from a Secret.
+		if err := mgr.GetFieldIndexer().IndexField(ctx, &cmapi.Issuer{}, issuerSecretsPath, issuerCASecretsIndexerBuilder(opts.ClusterResourceNamespace)); err != nil {
+			return fmt.Errorf("error making issuers indexable by CA secrets: %w", err)
+		}
+		if watchClusterIssuers {
+			if err := mgr.GetFieldIndexer().IndexField(ctx, &cmapi.ClusterIssuer{}, issuerSecretsPath, issuerCASecretsIndexerBuilder(opts.ClusterResourceNamespace)); err != nil {
+				return fmt.Errorf("error making cluster issuers indexable by CA secrets: %w", err)
+			}
+		}
+
+		// Index certificates with a new field. The field's value is the name
+		// of the issuer of the certificate as used in the
+		// inject-ca-from-issuer annotation. This field can then be used as a
+		// field selector when listing the certificates issued by a
+		// SelfSigned issuer.
+		if err := mgr.GetFieldIndexer().IndexField(ctx, &cmapi.Certificate{}, certificateIssuerPath, certificateIssuerIndexer); err != nil {
+			return fmt.Errorf("error making certificates indexable by issuer: %w", err)
+		}
+	}
 	injectorSetups := []setup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup}
 	ignoreNamespacesSet := sets.New(opts.IgnoreNamespaces...)
 	// Registers a c/r controller for each of APIService, CustomResourceDefinition, Mutating/ValidatingWebhookConfiguration
@@ -127,13 +175,10 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 			newInjectableTarget: setup.newInjectableTarget,
 			log:                 log,
 			Client:              mgr.GetClient(),
-			// TODO: refactor
-			sources: []caDataSource{
-				sds,
-				cds,
-				kds,
-			},
-			fieldManager: util.PrefixFromUserAgent(mgr.GetConfig().UserAgent),
+			sources:             sources,
+			fieldManager:        util.PrefixFromUserAgent(mgr.GetConfig().UserAgent),
+			caBundleRetention:   opts.CABundleRetention,
+			recorder:            mgr.GetEventRecorderFor("cert-manager-cainjector"), //nolint:staticcheck // the events.k8s.io recorder requires additional RBAC
 		}
 
 		// Index injectable with a new field. If the injectable's CA is
@@ -204,6 +249,36 @@ func RegisterAllInjectors(ctx context.Context, mgr ctrl.Manager, opts SetupOptio
 				handler.EnqueueRequestsFromMapFunc(certToInjectableMapFuncBuilder(mgr.GetClient(), log, setup)),
 			)
 		}
+		if opts.EnableIssuersDataSource {
+			// Index injectable with a new field. If the injectable's CA is
+			// to be sourced from an issuer, the field's value will be the
+			// name of the issuer as used in the inject-ca-from-issuer annotation.
+			// This field can then be used as a field selector when listing injectables of this type.
+			issuerTyp := setup.newInjectableTarget().AsObject()
+			if err := mgr.GetFieldIndexer().IndexField(ctx, issuerTyp, injectFromIssuerPath, injectableCAFromIssuerIndexer); err != nil {
+				err := fmt.Errorf("error making injectable indexable by inject-ca-from-issuer annotation: %w", err)
+				return err
+			}
+
+			b.Watches(
+				new(corev1.Secret),
+				handler.EnqueueRequestsFromMapFunc(issuerSecretToInjectableMapFuncBuilder(mgr.GetClient(), log, setup, watchClusterIssuers)),
+				// See "Why do we use builder.OnlyMetadata?" above.
+				builder.OnlyMetadata,
+			).Watches(
+				new(cmapi.Issuer),
+				handler.EnqueueRequestsFromMapFunc(issuerToInjectableMapFuncBuilder(mgr.GetClient(), log, setup)),
+			).Watches(
+				new(cmapi.Certificate),
+				handler.EnqueueRequestsFromMapFunc(issuerCertificateToInjectableMapFuncBuilder(mgr.GetClient(), log, setup)),
+			)
+			if watchClusterIssuers {
+				b.Watches(
+					new(cmapi.ClusterIssuer),
+					handler.EnqueueRequestsFromMapFunc(issuerToInjectableMapFuncBuilder(mgr.GetClient(), log, setup)),
+				)
+			}
+		}
 		if err := b.Complete(r); err != nil {
 			return fmt.Errorf("error registering controller for %s: %w", setup.objType.GetName(), err)
 		}
@@ -17,22 +17,37 @@ limitations under the License.
 package cainjector
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // caDataSource knows how to extract CA data given a provided InjectTarget.
@@ -54,6 +69,16 @@ type caDataSource interface {
 	ReadCA(ctx context.Context, log logr.Logger, metaObj metav1.Object, namespace string, ignoreNamespaces sets.Set[string]) (ca []byte, err error)
 }
 
+// resyncingCADataSource is implemented by data sources whose CA data can
+// change without any change to the resources watched by cainjector, so must
+// be read again periodically.
+type resyncingCADataSource interface {
+	// ResyncPeriod returns how long after the CA data of the InjectTarget was
+	// read it should be read again, or zero if it is only read again when a
+	// watched resource changes.
+	ResyncPeriod(ctx context.Context, metaObj metav1.Object) time.Duration
+}
+
 // kubeconfigDataSource reads the ca bundle provided as part of the struct
 // instantiation if it has the 'cert-manager.io/inject-apiserver-ca'
 // annotation.
@@ -224,3 +249,443 @@ func (c *secretDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj
 
 	return caData, nil
 }
+
+// issuerDataSource reads the CA of the Issuer or ClusterIssuer named in the
+// 'cert-manager.io/inject-ca-from-issuer' annotation. Issuers are named in
+// the form 'namespace/name' and ClusterIssuers in the form 'name'.
+// The CAs of CA, Vault, SelfSigned and Venafi issuers can be read. ACME
+// issuers are not supported, as ACME servers do not expose a stable CA.
+type issuerDataSource struct {
+	client client.Reader
+	// apiReader reads CertificateRequests directly from the API server, so
+	// that cainjector does not cache every CertificateRequest in the cluster
+	// to find the ones issued by Venafi issuers.
+	apiReader client.Reader
+	// clusterResourceNamespace is the namespace that Secrets referenced by
+	// ClusterIssuers are read from.
+	clusterResourceNamespace string
+
+	// venafiClientBuilder builds the client used to read the CA chain of
+	// Venafi issuers.
+	venafiClientBuilder venaficlient.VenafiClientBuilder
+	metrics             *metrics.Metrics
+	userAgent           string
+}
+
+func (c *issuerDataSource) Configured(log logr.Logger, metaObj metav1.Object) bool {
+	issuerNameRaw, ok := metaObj.GetAnnotations()[cmapi.WantInjectFromIssuerAnnotation]
+	if !ok {
+		return false
+	}
+	log.V(logf.DebugLevel).Info("Extracting CA from issuer resource", "issuer", issuerNameRaw)
+	return true
+}
+
+func (c *issuerDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj metav1.Object, namespace string, ignoreNamespaces sets.Set[string]) ([]byte, error) {
+	issuerNameRaw := metaObj.GetAnnotations()[cmapi.WantInjectFromIssuerAnnotation]
+	issuerName := splitNamespacedName(issuerNameRaw)
+	log = log.WithValues("issuer", issuerName)
+	if issuerName.Name == "" {
+		err := errors.New("invalid annotation")
+		log.Error(err, "invalid issuer name: expected namespace/name for an Issuer or name for a ClusterIssuer")
+		// don't return an error, requeuing won't help till this is changed
+		return nil, nil
+	}
+
+	var issuer cmapi.GenericIssuer = &cmapi.Issuer{}
+	resource := cmapi.Resource("issuers")
+	resourceNamespace := issuerName.Namespace
+	if issuerName.Namespace == "" {
+		issuer = &cmapi.ClusterIssuer{}
+		resource = cmapi.Resource("clusterissuers")
+		resourceNamespace = c.clusterResourceNamespace
+	}
+
+	if ignoreNamespaces.Has(resourceNamespace) {
+		err := fmt.Errorf("cannot read CA data from issuer resources in namespace %s, namespace is ignored", resourceNamespace)
+		forbiddenErr := apierrors.NewForbidden(resource, issuerName.Name, err)
+		log.Error(forbiddenErr, "cannot read data source")
+		return nil, forbiddenErr
+	}
+
+	if namespace != "" && (issuerName.Namespace == "" || issuerName.Namespace != namespace) {
+		err := fmt.Errorf("cannot read CA data from issuer %s, cainjector is scoped to namespace %s", issuerNameRaw, namespace)
+		forbiddenErr := apierrors.NewForbidden(resource, issuerName.Name, err)
+		log.Error(forbiddenErr, "cannot read data source")
+		return nil, forbiddenErr
+	}
+
+	if err := c.client.Get(ctx, issuerName, issuer); err != nil {
+		log.Error(err, "unable to fetch associated issuer")
+		// don't requeue if we're just not found, we'll get called when the issuer gets created
+		return nil, dropNotFound(err)
+	}
+
+	spec := issuer.GetSpec()
+	switch {
+	case spec.CA != nil:
+		return c.readCAIssuerCA(ctx, log, types.NamespacedName{Namespace: resourceNamespace, Name: spec.CA.SecretName})
+	case spec.Vault != nil:
+		return c.readVaultIssuerCA(ctx, log, spec.Vault, resourceNamespace)
+	case spec.SelfSigned != nil:
+		return c.readSelfSignedIssuerCA(ctx, log, issuerName, ignoreNamespaces)
+	case spec.Venafi != nil:
+		return c.readVenafiIssuerCA(ctx, log, issuer, resourceNamespace, ignoreNamespaces)
+	default:
+		err := errors.New("unsupported CA source")
+		log.Error(err, "the CA can only be read from CA, Vault, SelfSigned and Venafi issuers: ACME servers do not expose a stable CA")
+		// don't requeue, we'll get called when the issuer gets updated
+		return nil, nil
+	}
+}
+
+// readCAIssuerCA reads the root of the certificate chain in the Secret of a
+// CA issuer, which is the CA that the CA issuer returns for the certificates
+// it signs.
+func (c *issuerDataSource) readCAIssuerCA(ctx context.Context, log logr.Logger, secretName types.NamespacedName) ([]byte, error) {
+	log = log.WithValues("secret", secretName)
+	var secret corev1.Secret
+	if err := c.client.Get(ctx, secretName, &secret); err != nil {
+		log.Error(err, "unable to fetch associated secret")
+		// don't requeue if we're just not found, we'll get called when the secret gets created
+		return nil, dropNotFound(err)
+	}
+
+	certs, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		log.Error(err, "secret contains no valid certificate data")
+		// don't requeue, we'll get called when the secret gets updated
+		return nil, nil
+	}
+	if caData := secret.Data[cmmeta.TLSCAKey]; len(caData) > 0 {
+		ca, err := pki.DecodeX509CertificateBytes(caData)
+		if err != nil {
+			log.Error(err, "secret contains invalid CA data")
+			// don't requeue, we'll get called when the secret gets updated
+			return nil, nil
+		}
+		certs = append(certs, ca)
+	}
+
+	bundle, err := pki.ParseSingleCertificateChain(certs)
+	if err != nil {
+		log.Error(err, "secret does not contain a valid certificate chain")
+		// don't requeue, we'll get called when the secret gets updated
+		return nil, nil
+	}
+
+	return bundle.CAPEM, nil
+}
+
+// readVaultIssuerCA reads the CA chain of the Vault PKI secrets engine that a
+// Vault issuer signs certificates with. Vault serves CA chains without
+// authentication, so no Vault credentials are read.
+func (c *issuerDataSource) readVaultIssuerCA(ctx context.Context, log logr.Logger, vault *cmapi.VaultIssuer, resourceNamespace string) ([]byte, error) {
+	caURL := strings.TrimSuffix(vault.Server, "/") + "/v1/" + vaultCAPath(vault.Path)
+	log = log.WithValues("url", caURL)
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: vault.ServerName,
+	}
+	caBundle, err := c.vaultCABundle(ctx, vault, resourceNamespace)
+	if err != nil {
+		log.Error(err, "unable to read the Vault server CA bundle")
+		return nil, dropNotFound(err)
+	}
+	if len(caBundle) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caBundle) {
+			log.Error(errors.New("invalid CA bundle"), "no Vault server CA bundle could be loaded")
+			// don't requeue, we'll get called when the issuer gets updated
+			return nil, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, caURL, nil)
+	if err != nil {
+		log.Error(err, "invalid Vault server URL")
+		// don't requeue, we'll get called when the issuer gets updated
+		return nil, nil
+	}
+	if vault.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", vault.Namespace)
+	}
+
+	httpClient := &http.Client{
+		Timeout: vaultCARequestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		log.Error(err, "unable to fetch the Vault CA chain")
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		log.Error(err, "unable to fetch the Vault CA chain")
+		return nil, err
+	}
+
+	caData, err := io.ReadAll(io.LimitReader(resp.Body, maxVaultCASize))
+	if err != nil {
+		log.Error(err, "unable to read the Vault CA chain")
+		return nil, err
+	}
+	if _, err := pki.DecodeX509CertificateSetBytes(caData); err != nil {
+		log.Error(err, "Vault returned an invalid CA chain")
+		// don't requeue, requeuing won't help till Vault is reconfigured
+		return nil, nil
+	}
+
+	return caData, nil
+}
+
+// readSelfSignedIssuerCA reads the certificates issued by a SelfSigned issuer
+// from the Secrets of the Certificates that reference it. Each of these
+// certificates is its own root, so all of them are injected.
+func (c *issuerDataSource) readSelfSignedIssuerCA(ctx context.Context, log logr.Logger, issuerName types.NamespacedName, ignoreNamespaces sets.Set[string]) ([]byte, error) {
+	var certs cmapi.CertificateList
+	if err := c.client.List(ctx, &certs, client.InNamespace(issuerName.Namespace), client.MatchingFields{certificateIssuerPath: issuerIndexKey(issuerName.Namespace, issuerName.Name)}); err != nil {
+		log.Error(err, "unable to fetch certificates issued by the issuer")
+		return nil, err
+	}
+	slices.SortFunc(certs.Items, func(a, b cmapi.Certificate) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	var caData []byte
+	seen := sets.New[string]()
+	for _, cert := range certs.Items {
+		if ignoreNamespaces.Has(cert.Namespace) {
+			continue
+		}
+		secretName := types.NamespacedName{Namespace: cert.Namespace, Name: cert.Spec.SecretName}
+		log := log.WithValues("certificate", client.ObjectKeyFromObject(&cert), "secret", secretName)
+
+		var secret corev1.Secret
+		if err := c.client.Get(ctx, secretName, &secret); err != nil {
+			if err := dropNotFound(err); err != nil {
+				log.Error(err, "unable to fetch certificate secret")
+				return nil, err
+			}
+			// the certificate has not been issued yet, we'll get called when the secret gets created
+			continue
+		}
+
+		leaf, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			log.V(logf.WarnLevel).Info("secret contains no valid certificate data", "err", err)
+			continue
+		}
+		// the Secret may still hold a certificate issued by a previous issuer
+		// of the Certificate
+		if !bytes.Equal(leaf.RawIssuer, leaf.RawSubject) || leaf.CheckSignature(leaf.SignatureAlgorithm, leaf.RawTBSCertificate, leaf.Signature) != nil {
+			log.V(logf.DebugLevel).Info("secret does not contain a self-signed certificate")
+			continue
+		}
+		if seen.Has(string(leaf.Raw)) {
+			continue
+		}
+		seen.Insert(string(leaf.Raw))
+		caData = append(caData, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw})...)
+	}
+
+	if caData == nil {
+		log.V(logf.WarnLevel).Info("no certificate has been issued by the SelfSigned issuer yet")
+		// don't requeue, we'll get called when a certificate gets issued
+		return nil, nil
+	}
+
+	return caData, nil
+}
+
+// readVenafiIssuerCA reads the CA chain of a Venafi issuer by retrieving the
+// most recent certificate issued by the issuer from Venafi. The chain
+// returned with this certificate is that of the issuing CA of the Venafi
+// zone.
+func (c *issuerDataSource) readVenafiIssuerCA(ctx context.Context, log logr.Logger, issuer cmapi.GenericIssuer, resourceNamespace string, ignoreNamespaces sets.Set[string]) ([]byte, error) {
+	cr, err := c.latestVenafiRequest(ctx, issuer, ignoreNamespaces)
+	if err != nil {
+		log.Error(err, "unable to fetch certificate requests issued by the issuer")
+		return nil, err
+	}
+	if cr == nil {
+		log.V(logf.WarnLevel).Info("no certificate has been issued by the Venafi issuer yet")
+		// don't requeue, the CA chain is read again after issuerCAResyncPeriod
+		return nil, nil
+	}
+	log = log.WithValues("certificaterequest", client.ObjectKeyFromObject(cr))
+
+	secretsLister := &readerSecretLister{ctx: ctx, client: c.client}
+	venafi, err := c.venafiClientBuilder(resourceNamespace, secretsLister, issuer, c.metrics, log, c.userAgent)
+	if err != nil {
+		log.Error(err, "failed to initialise Venafi client")
+		// don't requeue if the credentials Secret is not found, we'll get
+		// called when the issuer gets updated
+		return nil, dropNotFound(err)
+	}
+
+	chainPEM, err := venafi.RetrieveCertificate(cr.Annotations[cmapi.VenafiPickupIDAnnotationKey], cr.Spec.Request, apiutil.DefaultCertDuration(cr.Spec.Duration), nil)
+	if err != nil {
+		log.Error(err, "failed to retrieve certificate chain from Venafi")
+		return nil, err
+	}
+
+	bundle, err := pki.ParseSingleCertificateChainPEM(chainPEM)
+	if err != nil {
+		log.Error(err, "Venafi returned an invalid certificate chain")
+		// don't requeue, the CA chain is read again after issuerCAResyncPeriod
+		return nil, nil
+	}
+
+	return bundle.CAPEM, nil
+}
+
+// latestVenafiRequest returns the most recently created CertificateRequest
+// that was issued by the given Venafi issuer, or nil if there is none.
+// CertificateRequests are read with the API reader, as only the Venafi issuer
+// needs them.
+func (c *issuerDataSource) latestVenafiRequest(ctx context.Context, issuer cmapi.GenericIssuer, ignoreNamespaces sets.Set[string]) (*cmapi.CertificateRequest, error) {
+	var crs cmapi.CertificateRequestList
+	if err := c.apiReader.List(ctx, &crs, client.InNamespace(issuer.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	issuerName := issuerIndexKey(issuer.GetNamespace(), issuer.GetName())
+	var latest *cmapi.CertificateRequest
+	for i := range crs.Items {
+		cr := &crs.Items[i]
+		if ignoreNamespaces.Has(cr.Namespace) ||
+			issuerRefKey(cr.Namespace, cr.Spec.IssuerRef) != issuerName ||
+			cr.Annotations[cmapi.VenafiPickupIDAnnotationKey] == "" ||
+			!apiutil.CertificateRequestHasCondition(cr, cmapi.CertificateRequestCondition{Type: cmapi.CertificateRequestConditionReady, Status: cmmeta.ConditionTrue}) {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&cr.CreationTimestamp) {
+			latest = cr
+		}
+	}
+
+	return latest, nil
+}
+
+// readerSecretLister is an internalinformers.SecretLister that reads Secrets
+// with a controller-runtime client, so that the Venafi client can read the
+// credentials of the issuer.
+type readerSecretLister struct {
+	ctx    context.Context
+	client client.Reader
+}
+
+func (l *readerSecretLister) Secrets(namespace string) corelisters.SecretNamespaceLister {
+	return &readerSecretNamespaceLister{ctx: l.ctx, client: l.client, namespace: namespace}
+}
+
+type readerSecretNamespaceLister struct {
+	ctx       context.Context
+	client    client.Reader
+	namespace string
+}
+
+func (l *readerSecretNamespaceLister) List(selector labels.Selector) ([]*corev1.Secret, error) {
+	var secrets corev1.SecretList
+	if err := l.client.List(l.ctx, &secrets, client.InNamespace(l.namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	ret := make([]*corev1.Secret, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		ret = append(ret, &secrets.Items[i])
+	}
+	return ret, nil
+}
+
+func (l *readerSecretNamespaceLister) Get(name string) (*corev1.Secret, error) {
+	var secret corev1.Secret
+	if err := l.client.Get(l.ctx, types.NamespacedName{Namespace: l.namespace, Name: name}, &secret); err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+// ResyncPeriod returns issuerCAResyncPeriod for injectables whose CA is read
+// from a Vault or Venafi issuer, as the CA of the Vault PKI secrets engine or
+// of the Venafi zone can be rotated without any change to the issuer.
+func (c *issuerDataSource) ResyncPeriod(ctx context.Context, metaObj metav1.Object) time.Duration {
+	issuerName := splitNamespacedName(metaObj.GetAnnotations()[cmapi.WantInjectFromIssuerAnnotation])
+	if issuerName.Name == "" {
+		return 0
+	}
+
+	var issuer cmapi.GenericIssuer = &cmapi.Issuer{}
+	if issuerName.Namespace == "" {
+		issuer = &cmapi.ClusterIssuer{}
+	}
+	if err := c.client.Get(ctx, issuerName, issuer); err != nil {
+		return 0
+	}
+	if spec := issuer.GetSpec(); spec.Vault == nil && spec.Venafi == nil {
+		return 0
+	}
+	return issuerCAResyncPeriod
+}
+
+const (
+	// vaultCARequestTimeout is the timeout for reading a CA chain from Vault.
+	vaultCARequestTimeout = 30 * time.Second
+
+	// issuerCAResyncPeriod is how often the CA chain of a Vault or Venafi
+	// issuer is read again to inject a rotated CA.
+	issuerCAResyncPeriod = 10 * time.Minute
+
+	// maxVaultCASize is the maximum size of a CA chain read from Vault.
+	maxVaultCASize = 1 << 20
+)
+
+// vaultCAPath returns the Vault API path of the CA chain used to sign
+// certificates with the sign endpoint at path. Sign endpoints are either of
+// the form '<mount>/sign/<role>', which signs using the default issuer of the
+// PKI secrets engine, or '<mount>/issuer/<ref>/sign/<role>'.
+func vaultCAPath(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if mount, ref, ok := strings.Cut(path, "/issuer/"); ok {
+		ref, _, _ = strings.Cut(ref, "/")
+		return mount + "/issuer/" + ref + "/pem"
+	}
+	mount, _, _ := strings.Cut(path, "/sign")
+	return mount + "/ca_chain"
+}
+
+// vaultCABundle returns the CA bundle used to verify the Vault server, which
+// is empty if the system trust store should be used. If the key of the Secret
+// CA bundle is not defined, its value defaults to `ca.crt`.
+func (c *issuerDataSource) vaultCABundle(ctx context.Context, vault *cmapi.VaultIssuer, resourceNamespace string) ([]byte, error) {
+	if len(vault.CABundle) > 0 {
+		return vault.CABundle, nil
+	}
+
+	ref := vault.CABundleSecretRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	var secret corev1.Secret
+	if err := c.client.Get(ctx, types.NamespacedName{Namespace: resourceNamespace, Name: ref.Name}, &secret); err != nil {
+		return nil, err
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = cmmeta.TLSCAKey
+	}
+	caBundle, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", key, resourceNamespace, ref.Name)
+	}
+
+	return caBundle, nil
+}
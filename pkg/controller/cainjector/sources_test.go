@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
+	fakevenafi "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestIssuerDataSourceReadCA(t *testing.T) {
+	ca := mustCreateCA(t, "ca")
+	vaultCA := mustCreateCA(t, "vault")
+
+	vaultServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/pki/ca_chain" || r.Header.Get("X-Vault-Namespace") != "team" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(vaultCA)
+	}))
+	defer vaultServer.Close()
+	vaultServerCA := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: vaultServer.Certificate().Raw})
+
+	caSecret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "ca"},
+			Data:       map[string][]byte{corev1.TLSCertKey: ca},
+		}
+	}
+	caIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "ca"},
+		Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+			CA: &cmapi.CAIssuer{SecretName: "ca"},
+		}},
+	}
+	caClusterIssuer := &cmapi.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca"},
+		Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+			CA: &cmapi.CAIssuer{SecretName: "ca"},
+		}},
+	}
+	vaultIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "vault"},
+		Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+			Vault: &cmapi.VaultIssuer{
+				Server:            vaultServer.URL,
+				Path:              "pki/sign/example",
+				Namespace:         "team",
+				CABundleSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "vault-ca"}},
+			},
+		}},
+	}
+	vaultCASecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "vault-ca"},
+		Data:       map[string][]byte{cmmeta.TLSCAKey: vaultServerCA},
+	}
+	selfSignedIssuer := func(name string) *cmapi.Issuer {
+		return &cmapi.Issuer{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+			Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+				SelfSigned: &cmapi.SelfSignedIssuer{},
+			}},
+		}
+	}
+	selfSignedCA := mustCreateCA(t, "selfsigned")
+	certificate := func(name, issuerName string, certPEM []byte) []client.Object {
+		return []client.Object{
+			&cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+				Spec:       cmapi.CertificateSpec{SecretName: name, IssuerRef: cmmeta.IssuerReference{Name: issuerName}},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+				Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+			},
+		}
+	}
+	// a Certificate which was issued by the CA issuer before being moved to
+	// the SelfSigned issuer
+	reissuedCertPEM, venafiChain := mustCreateChain(t)
+
+	venafiIssuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "venafi"},
+		Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+			Venafi: &cmapi.VenafiIssuer{Zone: "zone", Cloud: &cmapi.VenafiCloud{}},
+		}},
+	}
+	venafiRequest := func(name, pickupID string, created time.Time, ready bool) *cmapi.CertificateRequest {
+		cr := &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "ns",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+				Annotations:       map[string]string{cmapi.VenafiPickupIDAnnotationKey: pickupID},
+			},
+			Spec: cmapi.CertificateRequestSpec{
+				Request:   []byte("csr-" + name),
+				IssuerRef: cmmeta.IssuerReference{Name: "venafi", Kind: cmapi.IssuerKind},
+			},
+		}
+		if ready {
+			cr.Status.Conditions = []cmapi.CertificateRequestCondition{{Type: cmapi.CertificateRequestConditionReady, Status: cmmeta.ConditionTrue}}
+		}
+		return cr
+	}
+	now := time.Now().Truncate(time.Second)
+	venafiClient := &fakevenafi.Venafi{
+		RetrieveCertificateFn: func(pickupID string, csrPEM []byte, _ time.Duration, _ []api.CustomField) ([]byte, error) {
+			if pickupID != "new" || string(csrPEM) != "csr-new" {
+				return nil, fmt.Errorf("unexpected certificate request %q", pickupID)
+			}
+			return venafiChain, nil
+		},
+	}
+
+	tests := map[string]struct {
+		issuerName       string
+		namespace        string
+		ignoreNamespaces []string
+		expectedCA       []byte
+		expectedResync   time.Duration
+		expectForbidden  bool
+	}{
+		"the CA of a CA Issuer is read from its Secret": {
+			issuerName: "ns/ca",
+			expectedCA: ca,
+		},
+		"the CA of a CA ClusterIssuer is read from the cluster resource namespace": {
+			issuerName: "ca",
+			expectedCA: ca,
+		},
+		"the CA of a Vault Issuer is read from Vault and read again periodically": {
+			issuerName:     "ns/vault",
+			expectedCA:     vaultCA,
+			expectedResync: issuerCAResyncPeriod,
+		},
+		"the CA of a SelfSigned Issuer is read from the Secrets of the Certificates it issued": {
+			issuerName: "ns/selfsigned",
+			expectedCA: selfSignedCA,
+		},
+		"no CA is read from SelfSigned issuers which have not issued any certificates": {
+			issuerName: "ns/selfsigned-unused",
+		},
+		"the CA of a Venafi Issuer is read from the latest certificate it issued and read again periodically": {
+			issuerName:     "ns/venafi",
+			expectedCA:     mustDecodeCAPEM(t, venafiChain),
+			expectedResync: issuerCAResyncPeriod,
+		},
+		"no CA is read from issuers which do not exist": {
+			issuerName: "ns/missing",
+		},
+		"Issuers in ignored namespaces are forbidden": {
+			issuerName:       "ns/ca",
+			ignoreNamespaces: []string{"ns"},
+			expectForbidden:  true,
+		},
+		"Issuers in other namespaces are forbidden to a namespaced cainjector": {
+			issuerName:      "ns/ca",
+			namespace:       "other",
+			expectForbidden: true,
+		},
+		"ClusterIssuers are forbidden to a namespaced cainjector": {
+			issuerName:      "ca",
+			namespace:       "cert-manager",
+			expectForbidden: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, kscheme.AddToScheme(scheme))
+			require.NoError(t, cmapi.AddToScheme(scheme))
+			cl := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(caSecret("ns"), caSecret("cert-manager"), caIssuer, caClusterIssuer, vaultIssuer, vaultCASecret, selfSignedIssuer("selfsigned"), selfSignedIssuer("selfsigned-unused"), venafiIssuer).
+				WithObjects(certificate("root", "selfsigned", selfSignedCA)...).
+				WithObjects(certificate("reissued", "selfsigned", reissuedCertPEM)...).
+				WithObjects(certificate("pending", "selfsigned", nil)[0]).
+				WithObjects(
+					venafiRequest("old", "old", now.Add(-2*time.Hour), true),
+					venafiRequest("new", "new", now.Add(-time.Hour), true),
+					venafiRequest("failed", "failed", now, false),
+				).
+				WithIndex(&cmapi.Certificate{}, certificateIssuerPath, certificateIssuerIndexer).
+				Build()
+			source := &issuerDataSource{
+				client:                   cl,
+				apiReader:                cl,
+				clusterResourceNamespace: "cert-manager",
+				venafiClientBuilder: func(namespace string, _ internalinformers.SecretLister, issuer cmapi.GenericIssuer, _ *metrics.Metrics, _ logr.Logger, _ string) (venaficlient.Interface, error) {
+					if namespace != "ns" || issuer.GetName() != "venafi" {
+						return nil, fmt.Errorf("unexpected issuer %s/%s", namespace, issuer.GetName())
+					}
+					return venafiClient, nil
+				},
+			}
+
+			webhook := &metav1.ObjectMeta{
+				Name:        "webhook",
+				Annotations: map[string]string{cmapi.WantInjectFromIssuerAnnotation: test.issuerName},
+			}
+			require.True(t, source.Configured(logr.Discard(), webhook))
+
+			got, err := source.ReadCA(t.Context(), logr.Discard(), webhook, test.namespace, sets.New(test.ignoreNamespaces...))
+			if test.expectForbidden {
+				assert.True(t, apierrors.IsForbidden(err), "expected a forbidden error, got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCA, got)
+			assert.Equal(t, test.expectedResync, source.ResyncPeriod(t.Context(), webhook))
+		})
+	}
+}
+
+func TestVaultCAPath(t *testing.T) {
+	tests := map[string]string{
+		"pki/sign/example":                     "pki/ca_chain",
+		"/pki_int/sign-verbatim/example":       "pki_int/ca_chain",
+		"pki/issuer/intermediate/sign/example": "pki/issuer/intermediate/pem",
+	}
+	for path, expected := range tests {
+		assert.Equal(t, expected, vaultCAPath(path), path)
+	}
+}
+
+// mustCreateChain returns a certificate signed by a CA, and a chain of another
+// certificate signed by the same CA followed by the CA.
+func mustCreateChain(t *testing.T) ([]byte, []byte) {
+	caKey, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	caPEM, ca, err := pki.SignCertificate(caTemplate, caTemplate, caKey.Public(), caKey)
+	require.NoError(t, err)
+
+	leaf := func(name string) []byte {
+		key, err := pki.GenerateECPrivateKey(256)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			Subject:   pkix.Name{CommonName: name},
+			NotBefore: time.Now().Add(-time.Hour),
+			NotAfter:  time.Now().Add(24 * time.Hour),
+		}
+		certPEM, _, err := pki.SignCertificate(template, ca, key.Public(), caKey)
+		require.NoError(t, err)
+		return certPEM
+	}
+
+	return leaf("reissued"), joinPEM(leaf("venafi"), caPEM)
+}
+
+func mustDecodeCAPEM(t *testing.T, chainPEM []byte) []byte {
+	bundle, err := pki.ParseSingleCertificateChainPEM(chainPEM)
+	require.NoError(t, err)
+	require.NotEmpty(t, bundle.CAPEM)
+	return bundle.CAPEM
+}
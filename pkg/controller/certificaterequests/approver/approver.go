@@ -23,10 +23,12 @@ import (
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -35,20 +37,31 @@ import (
 
 const (
 	ControllerName = "certificaterequests-approver"
+
+	// pendingApprovalIndex is the name of the CertificateRequest index which
+	// holds the requests that are neither approved, denied nor finished, so
+	// that only these are re-evaluated when a policy changes.
+	pendingApprovalIndex = "cert-manager.io/pending-approval"
+	pendingApprovalValue = "true"
 )
 
 // Controller is a CertificateRequest controller which manages the "Approved"
-// condition. In the absence of any automated policy engine, this controller
-// will _always_ set the "Approved" condition to True. All CertificateRequest
-// signing controllers should wait until the "Approved" condition is set to
-// True before processing.
+// and "Denied" conditions. CertificateRequests referencing an issuer which is
+// selected by a CertificateRequestPolicy are approved if one of the selecting
+// policies permits them, and denied otherwise. All other CertificateRequests
+// are _always_ approved. All CertificateRequest signing controllers should
+// wait until the "Approved" condition is set to True before processing.
 type Controller struct {
 	// logger to be used by this controller
 	log logr.Logger
 
-	certificateRequestLister cmlisters.CertificateRequestLister
-	cmClient                 cmclient.Interface
-	fieldManager             string
+	certificateRequestLister  cmlisters.CertificateRequestLister
+	certificateRequestIndexer cache.Indexer
+	policyLister              cmlisters.CertificateRequestPolicyLister
+	policies                  policyCache
+	namespaceLister           corelisters.NamespaceLister
+	cmClient                  cmclient.Interface
+	fieldManager              string
 
 	recorder record.EventRecorder
 
@@ -81,7 +94,33 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	if _, ok := certificateRequestInformer.Informer().GetIndexer().GetIndexers()[pendingApprovalIndex]; !ok {
+		if err := certificateRequestInformer.Informer().AddIndexers(cache.Indexers{
+			pendingApprovalIndex: pendingApprovalIndexFunc,
+		}); err != nil {
+			return nil, nil, fmt.Errorf("error adding CertificateRequest indexer: %v", err)
+		}
+	}
+
+	// Re-evaluate all pending CertificateRequests whenever a policy changes,
+	// since any of them may now be permitted or denied.
+	policyInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequestPolicies()
+	mustSync = append(mustSync, policyInformer.Informer().HasSynced)
+	if _, err := policyInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(c.enqueuePendingCertificateRequests)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// Namespaces are cluster scoped, so policy namespace selectors can only
+	// be evaluated if the controller is not scoped to a single namespace.
+	if ctx.Namespace == "" {
+		namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
+		c.namespaceLister = namespaceInformer.Lister()
+	}
+
 	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.certificateRequestIndexer = certificateRequestInformer.Informer().GetIndexer()
+	c.policyLister = policyInformer.Lister()
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
 	c.recorder = ctx.Recorder
@@ -91,6 +130,29 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	return c.queue, mustSync, nil
 }
 
+// pendingApprovalIndexFunc indexes the CertificateRequests which are pending
+// approval.
+func pendingApprovalIndexFunc(obj any) ([]string, error) {
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok || !pendingApproval(cr) {
+		return nil, nil
+	}
+	return []string{pendingApprovalValue}, nil
+}
+
+func (c *Controller) enqueuePendingCertificateRequests(_ *cmapi.CertificateRequestPolicy) {
+	objs, err := c.certificateRequestIndexer.ByIndex(pendingApprovalIndex, pendingApprovalValue)
+	if err != nil {
+		c.log.Error(err, "failed to list certificate requests pending approval")
+		return
+	}
+	for _, obj := range objs {
+		if cr, ok := obj.(*cmapi.CertificateRequest); ok {
+			c.queue.Add(types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name})
+		}
+	}
+}
+
 func (c *Controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
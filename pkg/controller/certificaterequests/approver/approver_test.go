@@ -17,10 +17,14 @@ limitations under the License.
 package approver
 
 import (
+	"crypto/x509"
+	"reflect"
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
@@ -28,12 +32,53 @@ import (
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func TestProcessItem(t *testing.T) {
 	// now time is the current time at the start of the test (the clock is fixed)
 	now := time.Now()
 	metaNow := metav1.NewTime(now)
+
+	csrPEM, _, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("foo.example.com", "bar.example.org"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	policyRequest := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+		Spec: cmapi.CertificateRequestSpec{
+			Request:   csrPEM,
+			IssuerRef: cmmeta.IssuerReference{Name: "ca-issuer", Kind: "ClusterIssuer"},
+		},
+	}
+	// policyRequestFor returns a copy of policyRequest requesting the CSR of
+	// the given Certificate spec.
+	policyRequestFor := func(spec cmapi.CertificateSpec) *cmapi.CertificateRequest {
+		csrPEM, _, err := gen.CSRForCertificate(&cmapi.Certificate{Spec: spec})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cr := policyRequest.DeepCopy()
+		cr.Spec.Request = csrPEM
+		return cr
+	}
+	policy := func(name string, mod func(*cmapi.CertificateRequestPolicy)) *cmapi.CertificateRequestPolicy {
+		p := &cmapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: cmapi.CertificateRequestPolicySpec{
+				IssuerRefs: []cmapi.CertificateRequestPolicyIssuerRef{{Name: "ca-*", Kind: "ClusterIssuer"}},
+				Allowed: cmapi.CertificateRequestPolicyAllowed{
+					DNSNames: []string{"*.example.com", "*.example.org"},
+					Usages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment},
+				},
+			},
+		}
+		if mod != nil {
+			mod(p)
+		}
+		return p
+	}
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'CertificateRequest' field will be used.
@@ -44,6 +89,13 @@ func TestProcessItem(t *testing.T) {
 		// if not set, the 'key' will be passed to ProcessItem instead.
 		request *cmapi.CertificateRequest
 
+		// policies are the CertificateRequestPolicies which exist during the
+		// test.
+		policies []runtime.Object
+
+		// namespaces are the Namespaces which exist during the test.
+		namespaces []runtime.Object
+
 		// expectedEvent, if set, is an 'event string' that is expected to be fired.
 		expectedEvent string
 
@@ -170,6 +222,167 @@ func TestProcessItem(t *testing.T) {
 			},
 			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
 		},
+		"approve CertificateRequest whose issuer is not selected by any CertificateRequestPolicy": {
+			request: policyRequest,
+			policies: []runtime.Object{policy("other", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.IssuerRefs = []cmapi.CertificateRequestPolicyIssuerRef{{Name: "ca-issuer", Kind: "Issuer"}}
+				p.Spec.Allowed = cmapi.CertificateRequestPolicyAllowed{}
+			})},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            ApprovedMessage,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
+		},
+		"approve CertificateRequest permitted by a CertificateRequestPolicy": {
+			request: policyRequest,
+			policies: []runtime.Object{
+				policy("a-restrictive", func(p *cmapi.CertificateRequestPolicy) {
+					p.Spec.Allowed.DNSNames = []string{"*.example.com"}
+				}),
+				policy("b-permissive", nil),
+			},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `Certificate request has been approved by CertificateRequestPolicy "b-permissive"`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Normal cert-manager.io Certificate request has been approved by CertificateRequestPolicy "b-permissive"`,
+		},
+		"deny CertificateRequest not permitted by any selecting CertificateRequestPolicy": {
+			request: policyRequest,
+			policies: []runtime.Object{
+				policy("b-restrictive", func(p *cmapi.CertificateRequestPolicy) {
+					p.Spec.Allowed.DNSNames = []string{"*.example.com"}
+					p.Spec.Allowed.KeyAlgorithms = []cmapi.PrivateKeyAlgorithm{cmapi.RSAKeyAlgorithm}
+				}),
+				policy("a-restrictive", func(p *cmapi.CertificateRequestPolicy) {
+					p.Spec.Allowed.Usages = []cmapi.KeyUsage{cmapi.UsageDigitalSignature}
+				}),
+			},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `No CertificateRequestPolicy permits the request: CertificateRequestPolicy "a-restrictive": spec.usages[1] "key encipherment" is not allowed; CertificateRequestPolicy "b-restrictive": spec.request key algorithm "ECDSA" is not allowed, spec.request dnsNames[1] "bar.example.org" is not allowed`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "a-restrictive": spec.usages[1] "key encipherment" is not allowed; CertificateRequestPolicy "b-restrictive": spec.request key algorithm "ECDSA" is not allowed, spec.request dnsNames[1] "bar.example.org" is not allowed`,
+		},
+		"approve CertificateRequest whose subject is allowed by the CertificateRequestPolicy": {
+			request: policyRequestFor(cmapi.CertificateSpec{
+				DNSNames: []string{"foo.example.com"},
+				Subject:  &cmapi.X509Subject{Organizations: []string{"cert-manager"}, Countries: []string{"GB"}},
+			}),
+			policies: []runtime.Object{policy("example", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.Allowed.Subject = &cmapi.CertificateRequestPolicyAllowedSubject{
+					Organizations: []string{"cert-*"},
+					Countries:     []string{"GB"},
+				}
+			})},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `Certificate request has been approved by CertificateRequestPolicy "example"`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Normal cert-manager.io Certificate request has been approved by CertificateRequestPolicy "example"`,
+		},
+		"deny CertificateRequest requesting subject attributes not allowed by the CertificateRequestPolicy": {
+			request: policyRequestFor(cmapi.CertificateSpec{
+				DNSNames: []string{"foo.example.com"},
+				Subject:  &cmapi.X509Subject{Organizations: []string{"cert-manager"}, Countries: []string{"GB"}},
+			}),
+			policies: []runtime.Object{policy("example", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.Allowed.Subject = &cmapi.CertificateRequestPolicyAllowedSubject{
+					Countries: []string{"GB"},
+				}
+			})},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.request subject organization "cert-manager" is not allowed`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.request subject organization "cert-manager" is not allowed`,
+		},
+		"deny CertificateRequest requesting an otherName SAN": {
+			request: policyRequestFor(cmapi.CertificateSpec{
+				DNSNames:   []string{"foo.example.com"},
+				OtherNames: []cmapi.OtherName{{OID: "1.3.6.1.4.1.311.20.2.3", UTF8Value: "alice@example.com"}},
+			}),
+			policies: []runtime.Object{policy("example", nil)},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.request otherName SANs are not allowed`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.request otherName SANs are not allowed`,
+		},
+		"deny CertificateRequest in a namespace not matching the CertificateRequestPolicy's namespaceSelector": {
+			request: policyRequest,
+			policies: []runtime.Object{policy("example", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+			})},
+			namespaces: []runtime.Object{&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "testns", Labels: map[string]string{"team": "b"}},
+			}},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": namespace "testns" does not match the namespaceSelector`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": namespace "testns" does not match the namespaceSelector`,
+		},
+		"deny CertificateRequest from a requester not allowed by the CertificateRequestPolicy": {
+			request: func() *cmapi.CertificateRequest {
+				cr := policyRequest.DeepCopy()
+				cr.Spec.Username = "system:serviceaccount:other:default"
+				cr.Spec.Groups = []string{"system:serviceaccounts"}
+				return cr
+			}(),
+			policies: []runtime.Object{policy("example", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.Requesters = &cmapi.CertificateRequestPolicyRequesters{
+					Usernames: []string{"system:serviceaccount:testns:*"},
+					Groups:    []string{"system:masters"},
+				}
+			})},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.username "system:serviceaccount:other:default" is not an allowed requester`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.username "system:serviceaccount:other:default" is not an allowed requester`,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -181,6 +394,8 @@ func TestProcessItem(t *testing.T) {
 			if test.request != nil {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.request)
 			}
+			builder.CertManagerObjects = append(builder.CertManagerObjects, test.policies...)
+			builder.KubeObjects = append(builder.KubeObjects, test.namespaces...)
 			builder.Init()
 
 			c := new(Controller)
@@ -240,3 +455,59 @@ func TestProcessItem(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingApprovalIndexFunc(t *testing.T) {
+	tests := map[string]struct {
+		conditions []cmapi.CertificateRequestCondition
+		want       []string
+	}{
+		"pending request is indexed": {
+			want: []string{pendingApprovalValue},
+		},
+		"approved request is not indexed": {
+			conditions: []cmapi.CertificateRequestCondition{{Type: cmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue}},
+		},
+		"denied request is not indexed": {
+			conditions: []cmapi.CertificateRequestCondition{{Type: cmapi.CertificateRequestConditionDenied, Status: cmmeta.ConditionTrue}},
+		},
+		"issued request is not indexed": {
+			conditions: []cmapi.CertificateRequestCondition{{Type: cmapi.CertificateRequestConditionReady, Status: cmmeta.ConditionTrue, Reason: cmapi.CertificateRequestReasonIssued}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := pendingApprovalIndexFunc(&cmapi.CertificateRequest{
+				Status: cmapi.CertificateRequestStatus{Conditions: test.conditions},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("unexpected index values, got=%v, exp=%v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPolicyCache(t *testing.T) {
+	policy := &cmapi.CertificateRequestPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", UID: "uid", ResourceVersion: "1"},
+	}
+
+	var pc policyCache
+	first := pc.compiled([]*cmapi.CertificateRequestPolicy{policy})
+	if again := pc.compiled([]*cmapi.CertificateRequestPolicy{policy}); again[0] != first[0] {
+		t.Error("expected an unchanged policy not to be compiled again")
+	}
+
+	updated := policy.DeepCopy()
+	updated.ResourceVersion = "2"
+	if again := pc.compiled([]*cmapi.CertificateRequestPolicy{updated}); again[0] == first[0] {
+		t.Error("expected a changed policy to be compiled again")
+	}
+
+	pc.compiled(nil)
+	if len(pc.policies) != 0 {
+		t.Errorf("expected deleted policies to be evicted, got %v", pc.policies)
+	}
+}
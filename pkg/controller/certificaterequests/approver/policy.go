@@ -0,0 +1,500 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// policyDecision is the result of evaluating the CertificateRequestPolicies
+// against a CertificateRequest.
+type policyDecision struct {
+	// selected is true if at least one policy selects the request's issuer.
+	selected bool
+
+	// permittedBy is the name of the policy permitting the request, if any.
+	permittedBy string
+
+	// violations are the reasons each selected policy does not permit the
+	// request, keyed by policy name.
+	violations map[string][]string
+}
+
+// message returns a human readable description of the violations of each
+// selected policy.
+func (d policyDecision) message() string {
+	names := make([]string, 0, len(d.violations))
+	for name := range d.violations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parts []string
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("CertificateRequestPolicy %q: %s", name, strings.Join(d.violations[name], ", ")))
+	}
+	return "No CertificateRequestPolicy permits the request: " + strings.Join(parts, "; ")
+}
+
+// evaluatePolicies evaluates all CertificateRequestPolicies which select the
+// request's issuer against the request.
+func (c *Controller) evaluatePolicies(cr *cmapi.CertificateRequest) (policyDecision, error) {
+	return evaluatePolicies(c.policyLister, &c.policies, cr.Spec.IssuerRef, func(policy *compiledPolicy) ([]string, error) {
+		return c.policyViolations(policy, cr)
+	})
+}
+
+// evaluatePolicies evaluates all CertificateRequestPolicies which select the
+// given issuer using the violations function of the request's type.
+func evaluatePolicies(policyLister cmlisters.CertificateRequestPolicyLister, cache *policyCache, issuerRef cmmeta.IssuerReference, violationsFn func(*compiledPolicy) ([]string, error)) (policyDecision, error) {
+	policies, err := policyLister.List(labels.Everything())
+	if err != nil {
+		return policyDecision{}, err
+	}
+	// Evaluate policies in a stable order so that the permitting policy is
+	// deterministic.
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+
+	decision := policyDecision{violations: map[string][]string{}}
+	for _, policy := range cache.compiled(policies) {
+		if !policy.selectsIssuer(issuerRef.Name, apiutil.IssuerKind(issuerRef), apiutil.IssuerGroup(issuerRef)) {
+			continue
+		}
+		decision.selected = true
+
+		violations, err := violationsFn(policy)
+		if err != nil {
+			return policyDecision{}, err
+		}
+		if len(violations) == 0 {
+			decision.permittedBy = policy.Name
+			return decision, nil
+		}
+		decision.violations[policy.Name] = violations
+	}
+
+	return decision, nil
+}
+
+// policyViolations returns the reasons the policy does not permit the
+// request, or nil if it does.
+func (c *Controller) policyViolations(policy *compiledPolicy, cr *cmapi.CertificateRequest) ([]string, error) {
+	violations, err := requesterViolations(policy, c.namespaceLister, cr.Namespace, cr.Spec.Username, cr.Spec.Groups)
+	// An invalid namespace selector is the only violation reported.
+	if err != nil || policy.namespaceSelectorErr != nil {
+		return violations, err
+	}
+
+	allowed := policy.Spec.Allowed
+
+	if cr.Spec.IsCA && !allowed.IsCA {
+		violations = append(violations, "spec.isCA is not allowed")
+	}
+
+	if allowed.MaxDuration != nil {
+		if duration := apiutil.DefaultCertDuration(cr.Spec.Duration); duration > allowed.MaxDuration.Duration {
+			violations = append(violations, fmt.Sprintf("spec.duration %s exceeds the maximum of %s", duration, allowed.MaxDuration.Duration))
+		}
+	}
+
+	usages := cr.Spec.Usages
+	if len(usages) == 0 {
+		usages = cmapi.DefaultKeyUsages()
+	}
+	for i, usage := range usages {
+		if !slices.ContainsFunc(allowed.Usages, func(a cmapi.KeyUsage) bool { return usagesEqual(a, usage) }) {
+			violations = append(violations, fmt.Sprintf("spec.usages[%d] %q is not allowed", i, usage))
+		}
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return append(violations, fmt.Sprintf("spec.request could not be decoded: %s", err)), nil
+	}
+
+	if len(allowed.KeyAlgorithms) > 0 {
+		algorithm := keyAlgorithm(csr.PublicKeyAlgorithm)
+		if !slices.Contains(allowed.KeyAlgorithms, algorithm) {
+			violations = append(violations, fmt.Sprintf("spec.request key algorithm %q is not allowed", algorithm))
+		}
+	}
+
+	violations = append(violations, policy.subjectViolations(csr)...)
+
+	for i, dnsName := range csr.DNSNames {
+		if !policy.dnsNames.matchesAny(dnsName) {
+			violations = append(violations, fmt.Sprintf("spec.request dnsNames[%d] %q is not allowed", i, dnsName))
+		}
+	}
+	for i, ip := range csr.IPAddresses {
+		if !ipInRanges(allowed.IPRanges, ip) {
+			violations = append(violations, fmt.Sprintf("spec.request ipAddresses[%d] %q is not allowed", i, ip))
+		}
+	}
+	for i, uri := range csr.URIs {
+		if !policy.uris.matchesAny(uri.String()) {
+			violations = append(violations, fmt.Sprintf("spec.request uris[%d] %q is not allowed", i, uri))
+		}
+	}
+	for i, email := range csr.EmailAddresses {
+		if !policy.emailAddresses.matchesAny(email) {
+			violations = append(violations, fmt.Sprintf("spec.request emailAddresses[%d] %q is not allowed", i, email))
+		}
+	}
+
+	violations = append(violations, unsupportedSANViolations(csr)...)
+
+	return violations, nil
+}
+
+// subjectViolations returns the reasons the subject of the CSR is not
+// permitted by the policy. Every attribute of the subject is checked, so that
+// attributes which are not parsed by crypto/x509 cannot be smuggled in.
+func (p *compiledPolicy) subjectViolations(csr *x509.CertificateRequest) []string {
+	var violations []string
+	for _, atv := range csr.Subject.Names {
+		attribute, ok := subjectAttributeFor(atv.Type)
+		if !ok {
+			violations = append(violations, fmt.Sprintf("spec.request subject attribute %s is not allowed", atv.Type))
+			continue
+		}
+		value, ok := atv.Value.(string)
+		if !ok {
+			violations = append(violations, fmt.Sprintf("spec.request subject %s is not a string", attribute.name))
+			continue
+		}
+		if !p.subject[attribute.name].matchesAny(value) {
+			violations = append(violations, fmt.Sprintf("spec.request subject %s %q is not allowed", attribute.name, value))
+		}
+	}
+	return violations
+}
+
+// unsupportedSANViolations returns a violation for each type of subject
+// alternative name in the CSR which policies cannot allow. These types, such
+// as otherName SANs, are not parsed by crypto/x509, so the raw extension is
+// decoded to find them.
+func unsupportedSANViolations(csr *x509.CertificateRequest) []string {
+	for _, ext := range csr.Extensions {
+		if !ext.Id.Equal(oidExtensionSubjectAltName) {
+			continue
+		}
+		gns, err := pki.UnmarshalSANs(ext.Value)
+		if err != nil {
+			return []string{fmt.Sprintf("spec.request subjectAltName extension could not be decoded: %s", err)}
+		}
+		var violations []string
+		for _, unsupported := range []struct {
+			name  string
+			count int
+		}{
+			{"otherName", len(gns.OtherNames)},
+			{"x400Address", len(gns.X400Addresses)},
+			{"directoryName", len(gns.DirectoryNames)},
+			{"ediPartyName", len(gns.EDIPartyNames)},
+			{"registeredID", len(gns.RegisteredIDs)},
+		} {
+			if unsupported.count > 0 {
+				violations = append(violations, fmt.Sprintf("spec.request %s SANs are not allowed", unsupported.name))
+			}
+		}
+		return violations
+	}
+	return nil
+}
+
+// requesterViolations returns the reasons the policy does not permit
+// requests from the given namespace and requester. If the policy's namespace
+// selector is invalid, only this is reported.
+func requesterViolations(policy *compiledPolicy, namespaceLister corelisters.NamespaceLister, namespace, username string, groups []string) ([]string, error) {
+	var violations []string
+
+	if policy.Spec.NamespaceSelector != nil {
+		if policy.namespaceSelectorErr != nil {
+			return []string{fmt.Sprintf("invalid namespaceSelector: %s", policy.namespaceSelectorErr)}, nil
+		}
+		violation, err := namespaceViolation(namespaceLister, policy.namespaceSelector, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if violation != "" {
+			violations = append(violations, violation)
+		}
+	}
+
+	if requesters := policy.Spec.Requesters; requesters != nil {
+		if !policy.usernames.matchesAny(username) && !slices.ContainsFunc(groups, func(group string) bool {
+			return slices.Contains(requesters.Groups, group)
+		}) {
+			violations = append(violations, fmt.Sprintf("spec.username %q is not an allowed requester", username))
+		}
+	}
+
+	return violations, nil
+}
+
+// namespaceViolation returns why the namespace does not match the selector,
+// or an empty string if it does.
+func namespaceViolation(namespaceLister corelisters.NamespaceLister, selector labels.Selector, namespace string) (string, error) {
+	if namespaceLister == nil {
+		return "namespaceSelector cannot be evaluated when cert-manager is scoped to a single namespace", nil
+	}
+	ns, err := namespaceLister.Get(namespace)
+	switch {
+	case apierrors.IsNotFound(err):
+		return fmt.Sprintf("namespace %q does not exist", namespace), nil
+	case err != nil:
+		return "", err
+	case !selector.Matches(labels.Set(ns.Labels)):
+		return fmt.Sprintf("namespace %q does not match the namespaceSelector", namespace), nil
+	}
+	return "", nil
+}
+
+// pattern is an entry of a CertificateRequestPolicy list, where `*` matches
+// any sequence of characters.
+type pattern struct {
+	value string
+	// re is set if the value contains a wildcard.
+	re *regexp.Regexp
+}
+
+func compilePattern(value string) pattern {
+	p := pattern{value: value}
+	if strings.Contains(value, "*") {
+		p.re = regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(value), `\*`, ".*") + "$")
+	}
+	return p
+}
+
+// matches returns true if value matches the pattern. An empty pattern matches
+// any value.
+func (p pattern) matches(value string) bool {
+	if p.value == "" || p.value == value {
+		return true
+	}
+	return p.re != nil && p.re.MatchString(value)
+}
+
+type patterns []pattern
+
+func compilePatterns(values []string) patterns {
+	ps := make(patterns, 0, len(values))
+	for _, value := range values {
+		ps = append(ps, compilePattern(value))
+	}
+	return ps
+}
+
+// matchesAny returns true if value matches one of the non-empty patterns.
+func (ps patterns) matchesAny(value string) bool {
+	return slices.ContainsFunc(ps, func(p pattern) bool {
+		return p.value != "" && p.matches(value)
+	})
+}
+
+// compiledPolicy is a CertificateRequestPolicy whose patterns and namespace
+// selector have been compiled, so that they are not compiled again for each
+// CertificateRequest.
+type compiledPolicy struct {
+	*cmapi.CertificateRequestPolicy
+
+	issuerRefs           []compiledIssuerRef
+	namespaceSelector    labels.Selector
+	namespaceSelectorErr error
+	usernames            patterns
+	dnsNames             patterns
+	uris                 patterns
+	emailAddresses       patterns
+	sshUserPrincipals    patterns
+	sshHostPrincipals    patterns
+	// subject are the allowed values of each subject attribute, keyed by
+	// the attribute's name.
+	subject map[string]patterns
+}
+
+type compiledIssuerRef struct {
+	name, kind, group pattern
+}
+
+func compilePolicy(policy *cmapi.CertificateRequestPolicy) *compiledPolicy {
+	cp := &compiledPolicy{CertificateRequestPolicy: policy}
+	for _, ref := range policy.Spec.IssuerRefs {
+		cp.issuerRefs = append(cp.issuerRefs, compiledIssuerRef{
+			name:  compilePattern(ref.Name),
+			kind:  compilePattern(ref.Kind),
+			group: compilePattern(ref.Group),
+		})
+	}
+	if policy.Spec.NamespaceSelector != nil {
+		cp.namespaceSelector, cp.namespaceSelectorErr = metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+	}
+	if policy.Spec.Requesters != nil {
+		cp.usernames = compilePatterns(policy.Spec.Requesters.Usernames)
+	}
+
+	allowed := policy.Spec.Allowed
+	cp.dnsNames = compilePatterns(allowed.DNSNames)
+	cp.uris = compilePatterns(allowed.URIs)
+	cp.emailAddresses = compilePatterns(allowed.EmailAddresses)
+	if allowed.SSH != nil {
+		cp.sshUserPrincipals = compilePatterns(allowed.SSH.UserPrincipals)
+		cp.sshHostPrincipals = compilePatterns(allowed.SSH.HostPrincipals)
+	}
+
+	subject := allowed.Subject
+	if subject == nil {
+		subject = &cmapi.CertificateRequestPolicyAllowedSubject{}
+	}
+	cp.subject = map[string]patterns{"commonName": compilePatterns(allowed.CommonNames)}
+	for _, attribute := range subjectAttributes {
+		if attribute.allowed != nil {
+			cp.subject[attribute.name] = compilePatterns(attribute.allowed(subject))
+		}
+	}
+	return cp
+}
+
+// selectsIssuer returns true if one of the policy's issuerRefs matches the
+// given issuer.
+func (p *compiledPolicy) selectsIssuer(name, kind, group string) bool {
+	return slices.ContainsFunc(p.issuerRefs, func(ref compiledIssuerRef) bool {
+		return ref.name.matches(name) && ref.kind.matches(kind) && ref.group.matches(group)
+	})
+}
+
+// policyCache holds the compiled form of each CertificateRequestPolicy until
+// the policy changes.
+type policyCache struct {
+	mu       sync.Mutex
+	policies map[string]*compiledPolicy
+}
+
+// compiled returns the compiled form of the given policies, only compiling
+// those which changed since they were last compiled. Policies which are not
+// given are evicted from the cache.
+func (pc *policyCache) compiled(policies []*cmapi.CertificateRequestPolicy) []*compiledPolicy {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	cached := make(map[string]*compiledPolicy, len(policies))
+	compiled := make([]*compiledPolicy, 0, len(policies))
+	for _, policy := range policies {
+		cp, ok := pc.policies[policy.Name]
+		if !ok || cp.UID != policy.UID || cp.ResourceVersion != policy.ResourceVersion {
+			cp = compilePolicy(policy)
+		}
+		cached[policy.Name] = cp
+		compiled = append(compiled, cp)
+	}
+	pc.policies = cached
+	return compiled
+}
+
+// subjectAttribute is a subject attribute which policies can allow.
+type subjectAttribute struct {
+	name string
+	oid  asn1.ObjectIdentifier
+	// allowed returns the allowed values of the attribute. It is nil for the
+	// common name, whose allowed values are in allowed.commonNames.
+	allowed func(*cmapi.CertificateRequestPolicyAllowedSubject) []string
+}
+
+var (
+	oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidPostalCode              = asn1.ObjectIdentifier{2, 5, 4, 17}
+)
+
+var subjectAttributes = []subjectAttribute{
+	{name: "commonName", oid: pki.OIDConstants.CommonName},
+	{name: "organization", oid: pki.OIDConstants.Organization, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.Organizations }},
+	{name: "organizationalUnit", oid: pki.OIDConstants.OrganizationalUnit, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.OrganizationalUnits }},
+	{name: "country", oid: pki.OIDConstants.Country, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.Countries }},
+	{name: "province", oid: pki.OIDConstants.Province, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.Provinces }},
+	{name: "locality", oid: pki.OIDConstants.Locality, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.Localities }},
+	{name: "streetAddress", oid: pki.OIDConstants.StreetAddress, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.StreetAddresses }},
+	{name: "postalCode", oid: oidPostalCode, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.PostalCodes }},
+	{name: "serialNumber", oid: pki.OIDConstants.SerialNumber, allowed: func(s *cmapi.CertificateRequestPolicyAllowedSubject) []string { return s.SerialNumbers }},
+}
+
+func subjectAttributeFor(oid asn1.ObjectIdentifier) (subjectAttribute, bool) {
+	for _, attribute := range subjectAttributes {
+		if oid.Equal(attribute.oid) {
+			return attribute, true
+		}
+	}
+	return subjectAttribute{}, false
+}
+
+func ipInRanges(ranges []string, ip net.IP) bool {
+	for _, r := range ranges {
+		if _, ipNet, err := net.ParseCIDR(r); err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// usagesEqual returns true if both usages map to the same X.509 key usage or
+// extended key usage, so that aliases such as `signing` and
+// `digital signature` are treated as equal.
+func usagesEqual(a, b cmapi.KeyUsage) bool {
+	if a == b {
+		return true
+	}
+	if aku, ok := apiutil.KeyUsageType(a); ok {
+		bku, ok := apiutil.KeyUsageType(b)
+		return ok && aku == bku
+	}
+	if aeku, ok := apiutil.ExtKeyUsageType(a); ok {
+		beku, ok := apiutil.ExtKeyUsageType(b)
+		return ok && aeku == beku
+	}
+	return false
+}
+
+func keyAlgorithm(algorithm x509.PublicKeyAlgorithm) cmapi.PrivateKeyAlgorithm {
+	switch algorithm {
+	case x509.RSA:
+		return cmapi.RSAKeyAlgorithm
+	case x509.ECDSA:
+		return cmapi.ECDSAKeyAlgorithm
+	case x509.Ed25519:
+		return cmapi.Ed25519KeyAlgorithm
+	default:
+		return cmapi.PrivateKeyAlgorithm(algorithm.String())
+	}
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	SSHControllerName = "sshcertificaterequests-approver"
+
+	SSHApprovedMessage = "SSH certificate request has been approved by cert-manager.io"
+)
+
+// SSHController is an SSHCertificateRequest controller which manages the
+// "Approved" and "Denied" conditions in the same way as Controller does for
+// CertificateRequests. SSHCertificateRequests are only permitted by
+// CertificateRequestPolicies which set `allowed.ssh`.
+type SSHController struct {
+	// logger to be used by this controller
+	log logr.Logger
+
+	sshCertificateRequestLister  cmlisters.SSHCertificateRequestLister
+	sshCertificateRequestIndexer cache.Indexer
+	policyLister                 cmlisters.CertificateRequestPolicyLister
+	policies                     policyCache
+	namespaceLister              corelisters.NamespaceLister
+	cmClient                     cmclient.Interface
+
+	recorder record.EventRecorder
+
+	queue workqueue.TypedRateLimitingInterface[types.NamespacedName]
+}
+
+func init() {
+	// create SSH certificate request approver controller
+	controllerpkg.Register(SSHControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, SSHControllerName).
+			For(new(SSHController)).Complete()
+	})
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *SSHController) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	c.log = logf.FromContext(ctx.RootContext, SSHControllerName)
+	c.queue = workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultItemBasedRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: SSHControllerName,
+		},
+	)
+
+	sshCertificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().SSHCertificateRequests()
+	mustSync := []cache.InformerSynced{sshCertificateRequestInformer.Informer().HasSynced}
+	if _, err := sshCertificateRequestInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(c.queue)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	if _, ok := sshCertificateRequestInformer.Informer().GetIndexer().GetIndexers()[pendingApprovalIndex]; !ok {
+		if err := sshCertificateRequestInformer.Informer().AddIndexers(cache.Indexers{
+			pendingApprovalIndex: sshPendingApprovalIndexFunc,
+		}); err != nil {
+			return nil, nil, fmt.Errorf("error adding SSHCertificateRequest indexer: %v", err)
+		}
+	}
+
+	// Re-evaluate all pending SSHCertificateRequests whenever a policy
+	// changes, since any of them may now be permitted or denied.
+	policyInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequestPolicies()
+	mustSync = append(mustSync, policyInformer.Informer().HasSynced)
+	if _, err := policyInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(c.enqueuePendingSSHCertificateRequests)); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// Namespaces are cluster scoped, so policy namespace selectors can only
+	// be evaluated if the controller is not scoped to a single namespace.
+	if ctx.Namespace == "" {
+		namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
+		c.namespaceLister = namespaceInformer.Lister()
+	}
+
+	c.sshCertificateRequestLister = sshCertificateRequestInformer.Lister()
+	c.sshCertificateRequestIndexer = sshCertificateRequestInformer.Informer().GetIndexer()
+	c.policyLister = policyInformer.Lister()
+	c.cmClient = ctx.CMClient
+	c.recorder = ctx.Recorder
+
+	c.log.V(logf.DebugLevel).Info("SSH certificate request approver controller registered")
+
+	return c.queue, mustSync, nil
+}
+
+// sshPendingApprovalIndexFunc indexes the SSHCertificateRequests which are
+// pending approval.
+func sshPendingApprovalIndexFunc(obj any) ([]string, error) {
+	req, ok := obj.(*cmapi.SSHCertificateRequest)
+	if !ok || !sshPendingApproval(req) {
+		return nil, nil
+	}
+	return []string{pendingApprovalValue}, nil
+}
+
+func (c *SSHController) enqueuePendingSSHCertificateRequests(_ *cmapi.CertificateRequestPolicy) {
+	objs, err := c.sshCertificateRequestIndexer.ByIndex(pendingApprovalIndex, pendingApprovalValue)
+	if err != nil {
+		c.log.Error(err, "failed to list SSH certificate requests pending approval")
+		return
+	}
+	for _, obj := range objs {
+		if req, ok := obj.(*cmapi.SSHCertificateRequest); ok {
+			c.queue.Add(types.NamespacedName{Namespace: req.Namespace, Name: req.Name})
+		}
+	}
+}
+
+func (c *SSHController) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx)
+
+	req, err := c.sshCertificateRequestLister.SSHCertificateRequests(key.Namespace).Get(key.Name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info(fmt.Sprintf("SSH certificate request in work queue no longer exists: %s", err))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx = logf.NewContext(ctx, logf.WithResource(log, req))
+	return c.Sync(ctx, req)
+}
+
+// Sync will set the "Approved" condition to True on synced
+// SSHCertificateRequests, or the "Denied" condition to True if the request's
+// issuer is selected by a CertificateRequestPolicy and none of the selecting
+// policies permit it. If the "Denied", "Approved" or "Ready" condition
+// already exists, exit early.
+func (c *SSHController) Sync(ctx context.Context, req *cmapi.SSHCertificateRequest) error {
+	log := logf.FromContext(ctx, "approver")
+
+	if !sshPendingApproval(req) {
+		return nil
+	}
+
+	decision, err := evaluatePolicies(c.policyLister, &c.policies, req.Spec.IssuerRef, func(policy *compiledPolicy) ([]string, error) {
+		return sshPolicyViolations(policy, c.namespaceLister, req)
+	})
+	if err != nil {
+		return err
+	}
+
+	req = req.DeepCopy()
+
+	if decision.selected && decision.permittedBy == "" {
+		message := decision.message()
+		apiutil.SetSSHCertificateRequestCondition(req,
+			cmapi.CertificateRequestConditionDenied,
+			cmmeta.ConditionTrue,
+			"cert-manager.io",
+			message,
+		)
+
+		if _, err := c.cmClient.CertmanagerV1().SSHCertificateRequests(req.Namespace).UpdateStatus(ctx, req, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		c.recorder.Event(req, corev1.EventTypeWarning, "cert-manager.io", message)
+
+		log.V(logf.DebugLevel).Info("denied SSH certificate request", "reason", message)
+
+		return nil
+	}
+
+	message := SSHApprovedMessage
+	if decision.permittedBy != "" {
+		message = fmt.Sprintf("SSH certificate request has been approved by CertificateRequestPolicy %q", decision.permittedBy)
+	}
+
+	apiutil.SetSSHCertificateRequestCondition(req,
+		cmapi.CertificateRequestConditionApproved,
+		cmmeta.ConditionTrue,
+		"cert-manager.io",
+		message,
+	)
+
+	if _, err := c.cmClient.CertmanagerV1().SSHCertificateRequests(req.Namespace).UpdateStatus(ctx, req, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	c.recorder.Event(req, corev1.EventTypeNormal, "cert-manager.io", message)
+
+	log.V(logf.DebugLevel).Info("approved SSH certificate request")
+
+	return nil
+}
+
+// sshPendingApproval returns false if the SSHCertificateRequest has already
+// been approved or denied, or is "Issued" or "Failed".
+func sshPendingApproval(req *cmapi.SSHCertificateRequest) bool {
+	switch {
+	case
+		apiutil.SSHCertificateRequestIsApproved(req),
+		apiutil.SSHCertificateRequestIsDenied(req),
+		apiutil.SSHCertificateRequestReadyReason(req) == cmapi.CertificateRequestReasonFailed,
+		apiutil.SSHCertificateRequestReadyReason(req) == cmapi.CertificateRequestReasonIssued:
+		return false
+	}
+	return true
+}
+
+// sshPolicyViolations returns the reasons the policy does not permit the
+// SSHCertificateRequest, or nil if it does.
+func sshPolicyViolations(policy *compiledPolicy, namespaceLister corelisters.NamespaceLister, req *cmapi.SSHCertificateRequest) ([]string, error) {
+	violations, err := requesterViolations(policy, namespaceLister, req.Namespace, req.Spec.Username, req.Spec.Groups)
+	// An invalid namespace selector is the only violation reported.
+	if err != nil || policy.namespaceSelectorErr != nil {
+		return violations, err
+	}
+
+	allowed := policy.Spec.Allowed
+	if allowed.SSH == nil {
+		return append(violations, "allowed.ssh is not set"), nil
+	}
+
+	if allowed.MaxDuration != nil {
+		duration := cmapi.DefaultSSHCertificateDuration
+		if req.Spec.Duration != nil {
+			duration = req.Spec.Duration.Duration
+		}
+		if duration > allowed.MaxDuration.Duration {
+			violations = append(violations, fmt.Sprintf("spec.duration %s exceeds the maximum of %s", duration, allowed.MaxDuration.Duration))
+		}
+	}
+
+	if pub, _, _, _, err := ssh.ParseAuthorizedKey(req.Spec.PublicKey); err != nil {
+		violations = append(violations, fmt.Sprintf("spec.publicKey could not be decoded: %s", err))
+	} else if len(allowed.KeyAlgorithms) > 0 {
+		algorithm := sshKeyAlgorithm(pub.Type())
+		if !slices.Contains(allowed.KeyAlgorithms, algorithm) {
+			violations = append(violations, fmt.Sprintf("spec.publicKey key algorithm %q is not allowed", algorithm))
+		}
+	}
+
+	principals, principalType := policy.sshUserPrincipals, "user"
+	extensions := req.Spec.Extensions
+	if req.Spec.Type == cmapi.SSHCertificateTypeHost {
+		principals, principalType = policy.sshHostPrincipals, "host"
+	} else if extensions == nil {
+		extensions = pki.DefaultSSHUserExtensions()
+	}
+	for i, principal := range req.Spec.Principals {
+		if !principals.matchesAny(principal) {
+			violations = append(violations, fmt.Sprintf("spec.principals[%d] %q is not an allowed %s principal", i, principal, principalType))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(extensions)) {
+		if !slices.Contains(allowed.SSH.Extensions, name) {
+			violations = append(violations, fmt.Sprintf("spec.extensions %q is not allowed", name))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(req.Spec.CriticalOptions)) {
+		if !slices.Contains(allowed.SSH.CriticalOptions, name) {
+			violations = append(violations, fmt.Sprintf("spec.criticalOptions %q is not allowed", name))
+		}
+	}
+
+	return violations, nil
+}
+
+func sshKeyAlgorithm(keyType string) cmapi.PrivateKeyAlgorithm {
+	switch keyType {
+	case ssh.KeyAlgoRSA:
+		return cmapi.RSAKeyAlgorithm
+	case ssh.KeyAlgoECDSA256, ssh.KeyAlgoECDSA384, ssh.KeyAlgoECDSA521:
+		return cmapi.ECDSAKeyAlgorithm
+	case ssh.KeyAlgoED25519:
+		return cmapi.Ed25519KeyAlgorithm
+	default:
+		return cmapi.PrivateKeyAlgorithm(keyType)
+	}
+}
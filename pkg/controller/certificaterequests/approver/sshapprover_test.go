@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approver
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestSSHProcessItem(t *testing.T) {
+	// now time is the current time at the start of the test (the clock is fixed)
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	key, err := pki.GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := func(mod func(*cmapi.SSHCertificateRequest)) *cmapi.SSHCertificateRequest {
+		req := &cmapi.SSHCertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+			Spec: cmapi.SSHCertificateRequestSpec{
+				Principals: []string{"alice"},
+				Extensions: map[string]string{"permit-pty": ""},
+				PublicKey:  ssh.MarshalAuthorizedKey(pub),
+				IssuerRef:  cmmeta.IssuerReference{Name: "ca-issuer", Kind: "ClusterIssuer"},
+			},
+		}
+		if mod != nil {
+			mod(req)
+		}
+		return req
+	}
+	policy := func(name string, mod func(*cmapi.CertificateRequestPolicy)) *cmapi.CertificateRequestPolicy {
+		p := &cmapi.CertificateRequestPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: cmapi.CertificateRequestPolicySpec{
+				IssuerRefs: []cmapi.CertificateRequestPolicyIssuerRef{{Name: "ca-*", Kind: "ClusterIssuer"}},
+				Allowed: cmapi.CertificateRequestPolicyAllowed{
+					SSH: &cmapi.CertificateRequestPolicyAllowedSSH{
+						UserPrincipals: []string{"alice", "b*"},
+						HostPrincipals: []string{"*.example.com"},
+						Extensions:     []string{"permit-pty"},
+					},
+				},
+			},
+		}
+		if mod != nil {
+			mod(p)
+		}
+		return p
+	}
+	denied := func(message string) []cmapi.CertificateRequestCondition {
+		return []cmapi.CertificateRequestCondition{{
+			Type:               cmapi.CertificateRequestConditionDenied,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            message,
+			LastTransitionTime: &metaNow,
+		}}
+	}
+
+	tests := map[string]struct {
+		// SSHCertificateRequest to be synced for the test.
+		request *cmapi.SSHCertificateRequest
+
+		// policies are the CertificateRequestPolicies which exist during the
+		// test.
+		policies []runtime.Object
+
+		// expectedEvent, if set, is an 'event string' that is expected to be fired.
+		expectedEvent string
+
+		// expectedConditions is the expected set of conditions on the
+		// SSHCertificateRequest resource if an Update is made.
+		// If nil, no update is expected.
+		expectedConditions []cmapi.CertificateRequestCondition
+	}{
+		"do nothing if the SSHCertificateRequest does not exist": {},
+		"do nothing if the SSHCertificateRequest already has 'Approved' True condition": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Status.Conditions = []cmapi.CertificateRequestCondition{{Type: cmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue}}
+			}),
+		},
+		"approve the SSHCertificateRequest if no policy selects its issuer": {
+			request:  request(nil),
+			policies: []runtime.Object{policy("other", func(p *cmapi.CertificateRequestPolicy) { p.Spec.IssuerRefs[0].Name = "other" })},
+			expectedConditions: []cmapi.CertificateRequestCondition{{
+				Type:               cmapi.CertificateRequestConditionApproved,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "cert-manager.io",
+				Message:            SSHApprovedMessage,
+				LastTransitionTime: &metaNow,
+			}},
+			expectedEvent: "Normal cert-manager.io " + SSHApprovedMessage,
+		},
+		"approve the SSHCertificateRequest if a policy permits it": {
+			request:  request(func(req *cmapi.SSHCertificateRequest) { req.Spec.Principals = []string{"alice", "bob"} }),
+			policies: []runtime.Object{policy("example", nil)},
+			expectedConditions: []cmapi.CertificateRequestCondition{{
+				Type:               cmapi.CertificateRequestConditionApproved,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "cert-manager.io",
+				Message:            `SSH certificate request has been approved by CertificateRequestPolicy "example"`,
+				LastTransitionTime: &metaNow,
+			}},
+			expectedEvent: `Normal cert-manager.io SSH certificate request has been approved by CertificateRequestPolicy "example"`,
+		},
+		"deny the SSHCertificateRequest if the selecting policy does not allow SSH": {
+			request: request(nil),
+			policies: []runtime.Object{policy("x509-only", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.Allowed.SSH = nil
+			})},
+			expectedConditions: denied(`No CertificateRequestPolicy permits the request: CertificateRequestPolicy "x509-only": allowed.ssh is not set`),
+			expectedEvent:      `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "x509-only": allowed.ssh is not set`,
+		},
+		"deny a host SSHCertificateRequest for a user principal": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.Type = cmapi.SSHCertificateTypeHost
+				req.Spec.Extensions = nil
+			}),
+			policies:           []runtime.Object{policy("example", nil)},
+			expectedConditions: denied(`No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.principals[0] "alice" is not an allowed host principal`),
+			expectedEvent:      `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.principals[0] "alice" is not an allowed host principal`,
+		},
+		"deny a user SSHCertificateRequest for the default extensions if they are not allowed": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.Extensions = nil
+				req.Spec.CriticalOptions = map[string]string{"force-command": "/bin/true"}
+			}),
+			policies:           []runtime.Object{policy("example", nil)},
+			expectedConditions: denied(`No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.extensions "permit-X11-forwarding" is not allowed, spec.extensions "permit-agent-forwarding" is not allowed, spec.extensions "permit-port-forwarding" is not allowed, spec.extensions "permit-user-rc" is not allowed, spec.criticalOptions "force-command" is not allowed`),
+			expectedEvent:      `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.extensions "permit-X11-forwarding" is not allowed, spec.extensions "permit-agent-forwarding" is not allowed, spec.extensions "permit-port-forwarding" is not allowed, spec.extensions "permit-user-rc" is not allowed, spec.criticalOptions "force-command" is not allowed`,
+		},
+		"deny the SSHCertificateRequest if its key algorithm and default duration are not allowed": {
+			request: request(nil),
+			policies: []runtime.Object{policy("example", func(p *cmapi.CertificateRequestPolicy) {
+				p.Spec.Allowed.KeyAlgorithms = []cmapi.PrivateKeyAlgorithm{cmapi.ECDSAKeyAlgorithm}
+				p.Spec.Allowed.MaxDuration = &metav1.Duration{Duration: time.Hour}
+			})},
+			expectedConditions: denied(`No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.duration 24h0m0s exceeds the maximum of 1h0m0s, spec.publicKey key algorithm "Ed25519" is not allowed`),
+			expectedEvent:      `Warning cert-manager.io No CertificateRequestPolicy permits the request: CertificateRequestPolicy "example": spec.duration 24h0m0s exceeds the maximum of 1h0m0s, spec.publicKey key algorithm "Ed25519" is not allowed`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Create and initialise a new unit test builder
+			builder := &testpkg.Builder{
+				T:     t,
+				Clock: fakeclock.NewFakeClock(now),
+			}
+			if test.request != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.request)
+			}
+			builder.CertManagerObjects = append(builder.CertManagerObjects, test.policies...)
+			builder.Init()
+
+			c := new(SSHController)
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			if test.expectedConditions != nil {
+				expectedRequest := test.request.DeepCopy()
+				expectedRequest.Status.Conditions = test.expectedConditions
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("sshcertificaterequests"),
+						"status",
+						test.request.Namespace,
+						expectedRequest,
+					)),
+				)
+			}
+			if test.expectedEvent != "" {
+				builder.ExpectedEvents = []string{test.expectedEvent}
+			}
+			// Start the informers and begin processing updates
+			builder.Start()
+			defer builder.Stop()
+
+			if err := c.ProcessItem(t.Context(), types.NamespacedName{Namespace: "testns", Name: "test"}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package approver
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,45 +37,82 @@ const (
 )
 
 // Sync will set the "Approved" condition to True on synced
-// CertificateRequests. If the "Denied", "Approved" or "Ready" condition
+// CertificateRequests, or the "Denied" condition to True if the request's
+// issuer is selected by a CertificateRequestPolicy and none of the selecting
+// policies permit it. If the "Denied", "Approved" or "Ready" condition
 // already exists, exit early.
 func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
 	log := logf.FromContext(ctx, "approver")
 
-	switch {
-	case
-		// If the CertificateRequest has already been approved, exit early.
-		apiutil.CertificateRequestIsApproved(cr),
+	if !pendingApproval(cr) {
+		return nil
+	}
 
-		// If the CertificateRequest has already been denied, exit early.
-		apiutil.CertificateRequestIsDenied(cr),
+	decision, err := c.evaluatePolicies(cr)
+	if err != nil {
+		return err
+	}
+
+	cr = cr.DeepCopy()
+
+	if decision.selected && decision.permittedBy == "" {
+		// Update the CertificateRequest denied condition to true.
+		message := decision.message()
+		apiutil.SetCertificateRequestCondition(cr,
+			cmapi.CertificateRequestConditionDenied,
+			cmmeta.ConditionTrue,
+			"cert-manager.io",
+			message,
+		)
+
+		if err := c.updateStatusOrApply(ctx, cr); err != nil {
+			return err
+		}
+		c.recorder.Event(cr, corev1.EventTypeWarning, "cert-manager.io", message)
+
+		log.V(logf.DebugLevel).Info("denied certificate request", "reason", message)
 
-		// If the CertificateRequest is "Issued" or "Failed", exit early.
-		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonFailed,
-		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonIssued:
 		return nil
 	}
 
+	message := ApprovedMessage
+	if decision.permittedBy != "" {
+		message = fmt.Sprintf("Certificate request has been approved by CertificateRequestPolicy %q", decision.permittedBy)
+	}
+
 	// Update the CertificateRequest approved condition to true.
-	cr = cr.DeepCopy()
 	apiutil.SetCertificateRequestCondition(cr,
 		cmapi.CertificateRequestConditionApproved,
 		cmmeta.ConditionTrue,
 		"cert-manager.io",
-		ApprovedMessage,
+		message,
 	)
 
 	// Update CertificateRequest with
 	if err := c.updateStatusOrApply(ctx, cr); err != nil {
 		return err
 	}
-	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", ApprovedMessage)
+	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", message)
 
 	log.V(logf.DebugLevel).Info("approved certificate request")
 
 	return nil
 }
 
+// pendingApproval returns false if the CertificateRequest has already been
+// approved or denied, or is "Issued" or "Failed".
+func pendingApproval(cr *cmapi.CertificateRequest) bool {
+	switch {
+	case
+		apiutil.CertificateRequestIsApproved(cr),
+		apiutil.CertificateRequestIsDenied(cr),
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonFailed,
+		apiutil.CertificateRequestReadyReason(cr) == cmapi.CertificateRequestReasonIssued:
+		return false
+	}
+	return true
+}
+
 func (c *Controller) updateStatusOrApply(ctx context.Context, cr *cmapi.CertificateRequest) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		return internalcertificaterequests.ApplyStatus(ctx, c.cmClient, c.fieldManager, cr)
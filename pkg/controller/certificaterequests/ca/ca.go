@@ -21,6 +21,7 @@ import (
 	"crypto"
 	"crypto/x509"
 	"fmt"
+	"net/url"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 
@@ -117,6 +118,32 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 	template.IssuingCertificateURL = issuerObj.GetSpec().CA.IssuingCertificateURLs
 
+	var uriSANs []*url.URL
+	if spiffe := issuerObj.GetSpec().CA.SPIFFE; spiffe != nil {
+		csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+		if err != nil {
+			message := "Failed to decode CSR in spec.request"
+			c.reporter.Failed(cr, err, "RequestParsingError", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+
+		uriSANs, err = crutil.SPIFFEURIs(spiffe, cr, csr)
+		if err != nil {
+			message := "The request does not match the SPIFFE identity of its requester"
+			c.reporter.Failed(cr, err, "SPIFFEIdentityMismatch", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+
+		if err := pki.SetCertificateTemplateURIs(template, uriSANs); err != nil {
+			message := "Error setting the SPIFFE ID on the certificate template"
+			c.reporter.Failed(cr, err, "SigningError", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := "Error signing certificate"
@@ -125,6 +152,17 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 		return nil, err
 	}
 
+	// Check that the signed certificate carries exactly the SPIFFE ID of the
+	// requester, whichever SANs the template was built from.
+	if uriSANs != nil {
+		if err := crutil.CheckURIs(bundle.ChainPEM, uriSANs); err != nil {
+			message := "The signed certificate does not match the SPIFFE identity of its requester"
+			c.reporter.Failed(cr, err, "SPIFFEIdentityMismatch", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("certificate issued")
 
 	return &issuerpkg.IssueResponse{
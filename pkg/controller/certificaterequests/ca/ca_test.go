@@ -467,6 +467,13 @@ func TestCA_Sign(t *testing.T) {
 		t.Fatal(err)
 	}
 	testCSR := generateCSR(t, testpk)
+	testCSRWithDNSNames, err := gen.CSRWithSigner(testpk,
+		gen.SetCSRCommonName("test"),
+		gen.SetCSRDNSNames("workload.example.com"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	tests := map[string]struct {
 		givenCASecret    *corev1.Secret
@@ -589,6 +596,51 @@ func TestCA_Sign(t *testing.T) {
 				assert.Equal(t, []string{"http://www.example.com/crl/test.crl"}, gotCA.CRLDistributionPoints)
 			},
 		},
+		"when the Issuer has spiffe set in the Derive mode, the SPIFFE ID of the requester should appear on the signed cert": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+				SPIFFE: &cmapi.SPIFFEIdentity{
+					TrustDomain: "cluster.local",
+					Mode:        cmapi.SPIFFEIdentityModeDerive,
+				},
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestUsername("system:serviceaccount:default:workload"),
+				gen.SetCertificateRequestIssuer(cmmeta.IssuerReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, []string{"spiffe://cluster.local/ns/default/sa/workload"}, pki.URLsToString(got.URIs))
+			},
+		},
+		"when the Issuer has spiffe set in the Derive mode and the CSR has DNS names, the SPIFFE ID should be added to them": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+				SPIFFE: &cmapi.SPIFFEIdentity{
+					TrustDomain: "cluster.local",
+					Mode:        cmapi.SPIFFEIdentityModeDerive,
+				},
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSRWithDNSNames),
+				gen.SetCertificateRequestUsername("system:serviceaccount:default:workload"),
+				gen.SetCertificateRequestIssuer(cmmeta.IssuerReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, []string{"workload.example.com"}, got.DNSNames)
+				assert.Equal(t, []string{"spiffe://cluster.local/ns/default/sa/workload"}, pki.URLsToString(got.URIs))
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -36,6 +36,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 // Issuer implements the functionality to sign a certificate request for a
@@ -98,6 +99,9 @@ type Controller struct {
 	// used for testing
 	clock clock.Clock
 
+	// metrics is used to record issuance latency and failure metrics
+	metrics *metrics.Metrics
+
 	reporter *util.Reporter
 }
 
@@ -198,6 +202,7 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	c.reporter = util.NewReporter(c.clock, c.recorder)
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
+	c.metrics = ctx.Metrics
 
 	// Construct the issuer implementation with the built component context.
 	c.issuer = c.issuerConstructor(ctx)
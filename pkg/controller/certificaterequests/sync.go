@@ -35,10 +35,13 @@ import (
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
+const issuerNotReadyMessage = "Referenced issuer does not have a Ready status condition"
+
 func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
@@ -53,7 +56,9 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	defer func() {
 		if saveErr := c.updateCertificateRequestStatusAndAnnotations(ctx, cr, crCopy); saveErr != nil {
 			err = utilerrors.NewAggregate([]error{saveErr, err})
+			return
 		}
+		c.recordReadyTransitionMetrics(cr, crCopy)
 	}()
 
 	// If CertificateRequest has been denied, mark the CertificateRequest as
@@ -123,8 +128,7 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		Type:   cmapi.IssuerConditionReady,
 		Status: cmmeta.ConditionTrue,
 	}) {
-		c.reporter.Pending(crCopy, nil, "IssuerNotReady",
-			"Referenced issuer does not have a Ready status condition")
+		c.reporter.Pending(crCopy, nil, "IssuerNotReady", issuerNotReadyMessage)
 		return nil
 	}
 
@@ -172,6 +176,34 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	return nil
 }
 
+// recordReadyTransitionMetrics records issuance latency and failure metrics
+// when the Ready condition of the CertificateRequest has changed during this
+// sync. Metrics are only recorded once the new status has been persisted so
+// that retried syncs are not counted twice.
+func (c *Controller) recordReadyTransitionMetrics(oldCR, newCR *cmapi.CertificateRequest) {
+	newReady := apiutil.GetCertificateRequestCondition(newCR, cmapi.CertificateRequestConditionReady)
+	if newReady == nil {
+		return
+	}
+	if oldReady := apiutil.GetCertificateRequestCondition(oldCR, cmapi.CertificateRequestConditionReady); oldReady != nil &&
+		oldReady.Reason == newReady.Reason && oldReady.Message == newReady.Message {
+		return
+	}
+
+	switch newReady.Reason {
+	case cmapi.CertificateRequestReasonIssued:
+		c.metrics.ObserveCertificateRequestSignDuration(c.clock.Since(newCR.CreationTimestamp.Time), newCR.Spec.IssuerRef)
+	case cmapi.CertificateRequestReasonDenied:
+		c.metrics.IncrementIssuanceFailure(metrics.IssuanceFailureReasonDenied, newCR.Spec.IssuerRef)
+	case cmapi.CertificateRequestReasonFailed:
+		c.metrics.IncrementIssuanceFailure(metrics.IssuanceFailureReasonFailed, newCR.Spec.IssuerRef)
+	case cmapi.CertificateRequestReasonPending:
+		if newReady.Message == issuerNotReadyMessage {
+			c.metrics.IncrementIssuanceFailure(metrics.IssuanceFailureReasonIssuerNotReady, newCR.Spec.IssuerRef)
+		}
+	}
+}
+
 func (c *Controller) updateCertificateRequestStatusAndAnnotations(ctx context.Context, oldCR, newCR *cmapi.CertificateRequest) error {
 	log := logf.FromContext(ctx, "updateStatus")
 
@@ -24,9 +24,12 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
@@ -218,6 +221,11 @@ func TestSync(t *testing.T) {
 					)),
 				},
 			},
+			expectedMetrics: `
+# HELP certmanager_issuance_failures_total Total number of certificate issuance failures. Labels: reason (Denied, Failed, IssuerNotReady, OrderInvalid, OrderErrored), issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_issuance_failures_total counter
+certmanager_issuance_failures_total{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",reason="Denied"} 1
+`,
 		},
 		"should overwrite Ready condition with Denied if certificate request is denied": {
 			certificateRequest: gen.CertificateRequestFrom(baseCRNotApproved,
@@ -514,6 +522,11 @@ func TestSync(t *testing.T) {
 					"Normal IssuerNotReady Referenced issuer does not have a Ready status condition",
 				},
 			},
+			expectedMetrics: `
+# HELP certmanager_issuance_failures_total Total number of certificate issuance failures. Labels: reason (Denied, Failed, IssuerNotReady, OrderInvalid, OrderErrored), issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_issuance_failures_total counter
+certmanager_issuance_failures_total{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",reason="IssuerNotReady"} 1
+`,
 		},
 		"exit nil and no action if the issuer type does not match ours (its not meant for us)": {
 			certificateRequest: baseCR.DeepCopy(),
@@ -639,6 +652,62 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		"should record the sign duration when the certificate is issued": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(fixedClockStart.Add(-2*time.Second))),
+			),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(fixedClockStart.Add(-2*time.Second))),
+				)},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(fixedClockStart.Add(-2*time.Second))),
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+			expectedMetrics: `
+# HELP certmanager_certificaterequest_sign_duration_seconds Time in seconds between a CertificateRequest being created and the issuer returning a signed certificate. Labels: issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_certificaterequest_sign_duration_seconds histogram
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="0.1"} 0
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="0.5"} 0
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="1"} 0
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="5"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="10"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="30"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="60"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="120"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="300"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="600"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="1800"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",le="+Inf"} 1
+certmanager_certificaterequest_sign_duration_seconds_sum{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer"} 2
+certmanager_certificaterequest_sign_duration_seconds_count{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer"} 1
+`,
+		},
 		"if calling sign returns a response with an expired RSA certificate then set condition Ready": {
 			certificateRequest: baseCR.DeepCopy(),
 			issuerImpl: &fake.Issuer{
@@ -754,6 +823,9 @@ type testT struct {
 	certificateRequest *cmapi.CertificateRequest
 	helper             *issuerfake.Helper
 	expectedErr        bool
+	// expectedMetrics are the expected values of the issuance metrics
+	// recorded by the sync, in the Prometheus text format.
+	expectedMetrics string
 }
 
 func runTest(t *testing.T, test testT) {
@@ -789,5 +861,27 @@ func runTest(t *testing.T, test testT) {
 	if err == nil && test.expectedErr {
 		t.Errorf("expected to get an error but did not get one")
 	}
+	if test.expectedMetrics != "" {
+		checkMetrics(t, test.builder, test.expectedMetrics)
+	}
 	test.builder.CheckAndFinish(err)
 }
+
+// checkMetrics scrapes the metrics server of the builder's context and
+// compares the issuance metrics with the expected metrics.
+func checkMetrics(t *testing.T, builder *testpkg.Builder, expectedMetrics string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := builder.Metrics.NewServer(ln)
+	go func() { _ = server.Serve(ln) }()
+	defer server.Close()
+
+	if err := testutil.ScrapeAndCompare("http://"+ln.Addr().String()+"/metrics", strings.NewReader(expectedMetrics),
+		"certmanager_issuance_failures_total",
+		"certmanager_certificaterequest_sign_duration_seconds",
+	); err != nil {
+		t.Errorf("unexpected metrics: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// SPIFFEID returns the SPIFFE ID of the ServiceAccount which created the
+// CertificateRequest, in the form
+// `spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>`.
+// An error is returned if the CertificateRequest was not created by a
+// ServiceAccount.
+func SPIFFEID(trustDomain string, cr *cmapi.CertificateRequest) (*url.URL, error) {
+	namespace, name, err := serviceaccount.SplitUsername(cr.Spec.Username)
+	if err != nil {
+		return nil, fmt.Errorf("the CertificateRequest was not created by a ServiceAccount, but by %q", cr.Spec.Username)
+	}
+
+	return &url.URL{
+		Scheme: "spiffe",
+		Host:   trustDomain,
+		Path:   fmt.Sprintf("/ns/%s/sa/%s", namespace, name),
+	}, nil
+}
+
+// SPIFFEURIs checks the CertificateRequest against the SPIFFE identity
+// configuration of its issuer, and returns the URI SANs which the issued
+// certificate must contain. An error is returned if the CertificateRequest
+// must be rejected, i.e. if it was not created by a ServiceAccount, if it
+// requests a CA certificate, or if it requests URI SANs other than the SPIFFE
+// ID of its requester.
+func SPIFFEURIs(spiffe *cmapi.SPIFFEIdentity, cr *cmapi.CertificateRequest, csr *x509.CertificateRequest) ([]*url.URL, error) {
+	id, err := SPIFFEID(spiffe.TrustDomain, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	if cr.Spec.IsCA {
+		return nil, errors.New("a SPIFFE ID cannot be issued to a CA certificate")
+	}
+
+	switch {
+	case len(csr.URIs) == 1 && csr.URIs[0].String() == id.String():
+	case len(csr.URIs) == 0 && spiffe.Mode == cmapi.SPIFFEIdentityModeDerive:
+	case len(csr.URIs) == 0:
+		return nil, fmt.Errorf("the request must contain the SPIFFE ID %q as its URI SAN", id)
+	default:
+		return nil, fmt.Errorf("the requested URI SANs %v do not match the SPIFFE ID %q of the requester", pki.URLsToString(csr.URIs), id)
+	}
+
+	return []*url.URL{id}, nil
+}
+
+// CheckURIs returns an error if the URI SANs of the PEM encoded certificate
+// are not the expected ones. Issuers use it to check that a signed
+// certificate carries exactly the SPIFFE ID of its requester.
+func CheckURIs(certPEM []byte, expected []*url.URL) error {
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return err
+	}
+
+	if got, want := pki.URLsToString(cert.URIs), pki.URLsToString(expected); !slices.Equal(got, want) {
+		return fmt.Errorf("the signed certificate has the URI SANs %v, expected %v", got, want)
+	}
+
+	return nil
+}
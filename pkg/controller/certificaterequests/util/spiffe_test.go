@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"math/big"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSPIFFEURIs(t *testing.T) {
+	const (
+		username = "system:serviceaccount:app:workload"
+		spiffeID = "spiffe://cluster.local/ns/app/sa/workload"
+	)
+
+	mustParseURIs := func(uris ...string) []*url.URL {
+		var parsed []*url.URL
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			require.NoError(t, err)
+			parsed = append(parsed, u)
+		}
+		return parsed
+	}
+
+	tests := map[string]struct {
+		mode         cmapi.SPIFFEIdentityMode
+		username     string
+		isCA         bool
+		requested    []*url.URL
+		expectedURIs []string
+		expectedErr  string
+	}{
+		"the SPIFFE ID of the requester is accepted": {
+			username:     username,
+			requested:    mustParseURIs(spiffeID),
+			expectedURIs: []string{spiffeID},
+		},
+		"the SPIFFE ID of the requester is accepted in the Derive mode": {
+			mode:         cmapi.SPIFFEIdentityModeDerive,
+			username:     username,
+			requested:    mustParseURIs(spiffeID),
+			expectedURIs: []string{spiffeID},
+		},
+		"the SPIFFE ID is derived if no URI SAN is requested in the Derive mode": {
+			mode:         cmapi.SPIFFEIdentityModeDerive,
+			username:     username,
+			expectedURIs: []string{spiffeID},
+		},
+		"a request without URI SAN is rejected in the Constrain mode": {
+			mode:        cmapi.SPIFFEIdentityModeConstrain,
+			username:    username,
+			expectedErr: `the request must contain the SPIFFE ID "spiffe://cluster.local/ns/app/sa/workload" as its URI SAN`,
+		},
+		"the SPIFFE ID of another ServiceAccount is rejected": {
+			mode:        cmapi.SPIFFEIdentityModeDerive,
+			username:    username,
+			requested:   mustParseURIs("spiffe://cluster.local/ns/app/sa/other"),
+			expectedErr: `the requested URI SANs [spiffe://cluster.local/ns/app/sa/other] do not match the SPIFFE ID "spiffe://cluster.local/ns/app/sa/workload" of the requester`,
+		},
+		"additional URI SANs are rejected": {
+			username:    username,
+			requested:   mustParseURIs(spiffeID, "https://example.com"),
+			expectedErr: `the requested URI SANs [spiffe://cluster.local/ns/app/sa/workload https://example.com] do not match the SPIFFE ID "spiffe://cluster.local/ns/app/sa/workload" of the requester`,
+		},
+		"requests which were not created by a ServiceAccount are rejected": {
+			mode:        cmapi.SPIFFEIdentityModeDerive,
+			username:    "alice",
+			expectedErr: `the CertificateRequest was not created by a ServiceAccount, but by "alice"`,
+		},
+		"requests for CA certificates are rejected": {
+			username:    username,
+			isCA:        true,
+			requested:   mustParseURIs(spiffeID),
+			expectedErr: "a SPIFFE ID cannot be issued to a CA certificate",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := gen.CertificateRequest("test",
+				gen.SetCertificateRequestUsername(test.username),
+				gen.SetCertificateRequestIsCA(test.isCA),
+			)
+			spiffe := &cmapi.SPIFFEIdentity{TrustDomain: "cluster.local", Mode: test.mode}
+
+			uris, err := SPIFFEURIs(spiffe, cr, &x509.CertificateRequest{URIs: test.requested})
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedURIs, pki.URLsToString(uris))
+		})
+	}
+}
+
+func TestCheckURIs(t *testing.T) {
+	spiffeID, err := url.Parse("spiffe://cluster.local/ns/app/sa/workload")
+	require.NoError(t, err)
+	other, err := url.Parse("spiffe://cluster.local/ns/app/sa/other")
+	require.NoError(t, err)
+
+	pk, err := pki.GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	certPEM, _, err := pki.SignCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		URIs:         []*url.URL{spiffeID},
+	}, &x509.Certificate{}, pk.Public(), pk)
+	require.NoError(t, err)
+
+	assert.NoError(t, CheckURIs(certPEM, []*url.URL{spiffeID}))
+	assert.EqualError(t, CheckURIs(certPEM, []*url.URL{other}),
+		"the signed certificate has the URI SANs [spiffe://cluster.local/ns/app/sa/workload], expected [spiffe://cluster.local/ns/app/sa/other]")
+}
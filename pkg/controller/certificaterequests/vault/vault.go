@@ -18,6 +18,7 @@ package vault
 
 import (
 	"context"
+	"net/url"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 const (
@@ -79,6 +81,26 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 
 	resourceNamespace := v.issuerOptions.ResourceNamespace(issuerObj)
 
+	var uriSANs []*url.URL
+	spiffe := issuerObj.GetSpec().Vault.SPIFFE
+	if spiffe != nil {
+		csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+		if err != nil {
+			message := "Failed to decode CSR in spec.request"
+			v.reporter.Failed(cr, err, "RequestParsingError", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+
+		uriSANs, err = crutil.SPIFFEURIs(spiffe, cr, csr)
+		if err != nil {
+			message := "The request does not match the SPIFFE identity of its requester"
+			v.reporter.Failed(cr, err, "SPIFFEIdentityMismatch", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+	}
+
 	client, err := v.vaultClientBuilder(ctx, resourceNamespace, v.createTokenFn, v.secretsLister, issuerObj, v.issuerOptions.CanUseAmbientCredentials(issuerObj))
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
@@ -101,7 +123,7 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 	}
 
 	certDuration := apiutil.DefaultCertDuration(cr.Spec.Duration)
-	certPem, caPem, err := client.Sign(cr.Spec.Request, certDuration)
+	certPem, caPem, err := client.Sign(cr.Spec.Request, certDuration, uriSANs)
 	if err != nil {
 		message := "Vault failed to sign certificate"
 
@@ -111,6 +133,19 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		return nil, nil
 	}
 
+	// The Vault role may ignore the requested URI SANs, so check that the
+	// signed certificate carries exactly the SPIFFE ID of the requester.
+	if spiffe != nil {
+		if err := crutil.CheckURIs(certPem, uriSANs); err != nil {
+			message := "Vault issued a certificate which does not match the SPIFFE identity of its requester"
+
+			v.reporter.Failed(cr, err, "SPIFFEIdentityMismatch", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("certificate issued")
 
 	return &issuer.IssueResponse{
@@ -133,6 +133,11 @@ func TestSign(t *testing.T) {
 		}),
 	)
 
+	spiffeCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestIsCA(false),
+		gen.SetCertificateRequestUsername("system:serviceaccount:default-unit-test-ns:workload"),
+	)
+
 	rsaPEMCert, err := generateSelfSignedCertFromCR(baseCR, rsaSK)
 	if err != nil {
 		t.Error(err)
@@ -534,6 +539,92 @@ func TestSign(t *testing.T) {
 			},
 			fakeVault: fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
 		},
+		"a request which was not created by a ServiceAccount should be rejected by a SPIFFE issuer": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), gen.IssuerFrom(baseIssuer,
+					gen.SetIssuerVault(cmapi.VaultIssuer{
+						Auth: cmapi.VaultAuth{
+							TokenSecretRef: &cmmeta.SecretKeySelector{
+								Key: "my-token-key",
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "token-secret",
+								},
+							},
+						},
+						SPIFFE: &cmapi.SPIFFEIdentity{
+							TrustDomain: "cluster.local",
+							Mode:        cmapi.SPIFFEIdentityModeDerive,
+						},
+					}),
+				)},
+				ExpectedEvents: []string{
+					"Warning SPIFFEIdentityMismatch The request does not match the SPIFFE identity of its requester: the CertificateRequest was not created by a ServiceAccount, but by \"\"",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "The request does not match the SPIFFE identity of its requester: the CertificateRequest was not created by a ServiceAccount, but by \"\"",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeVault: fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
+		},
+		"a certificate without the SPIFFE ID of the requester signed by Vault should report fail": {
+			certificateRequest: spiffeCR.DeepCopy(),
+			builder: &testpkg.Builder{
+				KubeObjects: []runtime.Object{tokenSecret},
+				CertManagerObjects: []runtime.Object{spiffeCR.DeepCopy(), gen.IssuerFrom(baseIssuer,
+					gen.SetIssuerVault(cmapi.VaultIssuer{
+						Auth: cmapi.VaultAuth{
+							TokenSecretRef: &cmmeta.SecretKeySelector{
+								Key: "my-token-key",
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "token-secret",
+								},
+							},
+						},
+						SPIFFE: &cmapi.SPIFFEIdentity{
+							TrustDomain: "cluster.local",
+							Mode:        cmapi.SPIFFEIdentityModeDerive,
+						},
+					}),
+				)},
+				ExpectedEvents: []string{
+					"Warning SPIFFEIdentityMismatch Vault issued a certificate which does not match the SPIFFE identity of its requester: the signed certificate has the URI SANs [], expected [spiffe://cluster.local/ns/default-unit-test-ns/sa/workload]",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(spiffeCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Vault issued a certificate which does not match the SPIFFE identity of its requester: the signed certificate has the URI SANs [], expected [spiffe://cluster.local/ns/default-unit-test-ns/sa/workload]",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeVault: fakevault.New().WithSign(rsaPEMCert, rsaPEMCert, nil),
+		},
 	}
 
 	for name, test := range tests {
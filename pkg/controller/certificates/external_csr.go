@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// ExternalCSR fetches the PEM encoded certificate signing request referenced
+// by the Certificate's `spec.externalCSR` from the given listers, and returns
+// it along with the decoded request. The signature of the request is verified,
+// so that the request is known to be signed by the holder of the private key.
+// NotFound errors returned by the listers are returned as-is.
+func ExternalCSR(crt *cmapi.Certificate, secretLister internalinformers.SecretLister, configMapLister corelisters.ConfigMapLister) ([]byte, *x509.CertificateRequest, error) {
+	ref := crt.Spec.ExternalCSR
+	if ref == nil {
+		return nil, nil, fmt.Errorf("certificate does not reference an external certificate signing request")
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = cmapi.CertificateSigningRequestKey
+	}
+
+	var csrPEM []byte
+	switch ref.Kind {
+	case "", "Secret":
+		secret, err := secretLister.Secrets(crt.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		csrPEM = secret.Data[key]
+	case "ConfigMap":
+		configMap, err := configMapLister.ConfigMaps(crt.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		csrPEM = []byte(configMap.Data[key])
+	default:
+		return nil, nil, fmt.Errorf("unsupported external certificate signing request kind %q", ref.Kind)
+	}
+
+	if len(csrPEM) == 0 {
+		return nil, nil, fmt.Errorf("%s %q does not contain a certificate signing request in key %q", kindOrDefault(ref.Kind), ref.Name, key)
+	}
+
+	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %q contains an invalid certificate signing request in key %q: %w", kindOrDefault(ref.Kind), ref.Name, key, err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, nil, fmt.Errorf("%s %q contains a certificate signing request with an invalid signature in key %q: %w", kindOrDefault(ref.Kind), ref.Name, key, err)
+	}
+
+	return csrPEM, csr, nil
+}
+
+func kindOrDefault(kind string) string {
+	if kind == "" {
+		return "Secret"
+	}
+	return kind
+}
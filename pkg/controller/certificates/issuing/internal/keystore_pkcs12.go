@@ -0,0 +1,387 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file defines methods used for encoding PKCS#12 keystores whose private
+// key entry has its own password and friendly name, which are not supported
+// by the PKCS#12 library used for the other profiles. Keystores are encoded
+// using the same algorithms as the Modern2023 profile: the certificates and
+// the private key are encrypted using PBES2 with PBKDF2-HMAC-SHA256 and
+// AES-256-CBC, and the MAC uses HMAC-SHA256.
+
+package internal
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 -- SHA-1 is only used for the local key ID, as required by PKCS#12 consumers
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"unicode/utf16"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	pkcs12Iterations = 2048
+	pkcs12SaltSize   = 16
+)
+
+var (
+	oidPBES2                    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2                   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256           = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC                = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidSHA256                   = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidDataContentType          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEncryptedDataContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+	oidPKCS8ShroudedKeyBag      = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag                  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509CertificateBag       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidFriendlyName             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidLocalKeyID               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+)
+
+type pkcs12PFX struct {
+	Version  int
+	AuthSafe pkcs12ContentInfo
+	MacData  pkcs12MacData
+}
+
+type pkcs12ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit"`
+}
+
+type pkcs12EncryptedData struct {
+	Version              int
+	EncryptedContentInfo pkcs12EncryptedContentInfo
+}
+
+type pkcs12EncryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0"`
+}
+
+type pkcs12MacData struct {
+	Mac        pkcs12DigestInfo
+	MacSalt    []byte
+	Iterations int
+}
+
+type pkcs12DigestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type pkcs12SafeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue `asn1:"set"`
+}
+
+type pkcs12CertBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+// encodePKCS12KeystoreWithKeyPassword will encode a PKCS12 keystore using the
+// password provided, whose private key entry is encrypted using the key
+// password and has the given friendly name. The key, certificate and CA data
+// must be provided in PKCS1 or PKCS8 PEM format. If the certificate data
+// contains multiple certificates, the remaining certificates will be
+// prepended to the list of CAs in the resulting keystore.
+func encodePKCS12KeystoreWithKeyPassword(password, keyPassword []byte, friendlyName string, rawKey []byte, certPem []byte, caPem []byte) ([]byte, error) {
+	key, err := pki.DecodePrivateKeyBytes(rawKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := pki.DecodeX509CertificateChainBytes(certPem)
+	if err != nil {
+		return nil, err
+	}
+	if len(caPem) > 0 {
+		cas, err := pki.DecodeX509CertificateSetBytes(caPem)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cas...)
+	}
+
+	// The private key entry and the certificate of the private key are
+	// linked using the local key ID attribute.
+	localKeyID := sha1.Sum(certs[0].Raw)
+	attributes, err := pkcs12EntryAttributes(localKeyID[:], friendlyName)
+	if err != nil {
+		return nil, err
+	}
+
+	certBags := make([]pkcs12SafeBag, len(certs))
+	for i, cert := range certs {
+		certBagDER, err := asn1.Marshal(pkcs12CertBag{ID: oidX509CertificateBag, Data: cert.Raw})
+		if err != nil {
+			return nil, err
+		}
+		certBags[i] = pkcs12SafeBag{ID: oidCertBag, Value: asn1.RawValue{FullBytes: explicitTag0(certBagDER)}}
+	}
+	certBags[0].Attributes = attributes
+
+	keyEncryptionAlgorithm, encryptedKey, err := pkcs12Encrypt(keyPassword, keyDER)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKeyDER, err := asn1.Marshal(encryptedPrivateKeyInfo{Algorithm: keyEncryptionAlgorithm, EncryptedData: encryptedKey})
+	if err != nil {
+		return nil, err
+	}
+	keyBag := pkcs12SafeBag{ID: oidPKCS8ShroudedKeyBag, Value: asn1.RawValue{FullBytes: explicitTag0(encryptedKeyDER)}, Attributes: attributes}
+
+	// The certificates are stored in an encrypted safe, and the shrouded
+	// private key in an unencrypted safe.
+	certSafeDER, err := asn1.Marshal(certBags)
+	if err != nil {
+		return nil, err
+	}
+	certSafeEncryptionAlgorithm, encryptedCertSafe, err := pkcs12Encrypt(password, certSafeDER)
+	if err != nil {
+		return nil, err
+	}
+	encryptedCertSafeDER, err := asn1.Marshal(pkcs12EncryptedData{
+		EncryptedContentInfo: pkcs12EncryptedContentInfo{
+			ContentType:                oidDataContentType,
+			ContentEncryptionAlgorithm: certSafeEncryptionAlgorithm,
+			EncryptedContent:           encryptedCertSafe,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keySafeDER, err := asn1.Marshal([]pkcs12SafeBag{keyBag})
+	if err != nil {
+		return nil, err
+	}
+	keySafeContent, err := asn1.Marshal(keySafeDER)
+	if err != nil {
+		return nil, err
+	}
+
+	authSafeDER, err := asn1.Marshal([]pkcs12ContentInfo{
+		{ContentType: oidEncryptedDataContentType, Content: asn1.RawValue{FullBytes: explicitTag0(encryptedCertSafeDER)}},
+		{ContentType: oidDataContentType, Content: asn1.RawValue{FullBytes: explicitTag0(keySafeContent)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	authSafeContent, err := asn1.Marshal(authSafeDER)
+	if err != nil {
+		return nil, err
+	}
+
+	macSalt := make([]byte, pkcs12SaltSize)
+	if _, err := rand.Read(macSalt); err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, pkcs12SHA256MACKey(password, macSalt, pkcs12Iterations))
+	mac.Write(authSafeDER)
+
+	return asn1.Marshal(pkcs12PFX{
+		Version:  3,
+		AuthSafe: pkcs12ContentInfo{ContentType: oidDataContentType, Content: asn1.RawValue{FullBytes: explicitTag0(authSafeContent)}},
+		MacData: pkcs12MacData{
+			Mac: pkcs12DigestInfo{
+				Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    macSalt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
+
+// pkcs12EntryAttributes returns the attributes of the private key entry and
+// its certificate, with the friendly name omitted if empty.
+func pkcs12EntryAttributes(localKeyID []byte, friendlyName string) ([]pkcs12Attribute, error) {
+	localKeyIDDER, err := asn1.Marshal(localKeyID)
+	if err != nil {
+		return nil, err
+	}
+	attributes := []pkcs12Attribute{{ID: oidLocalKeyID, Value: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: localKeyIDDER}}}
+
+	if friendlyName != "" {
+		// The friendly name is a BMPString, which is the null terminated
+		// encoding of PKCS#12 passwords without the terminator.
+		name := bmpPassword([]byte(friendlyName))
+		friendlyNameDER, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagBMPString, Bytes: name[:len(name)-2]})
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, pkcs12Attribute{ID: oidFriendlyName, Value: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: friendlyNameDER}})
+	}
+
+	return attributes, nil
+}
+
+// pkcs12Encrypt encrypts the given data using PBES2 with PBKDF2-HMAC-SHA256
+// and AES-256-CBC, returning the algorithm used along with the encrypted data.
+func pkcs12Encrypt(password, data []byte) (pkix.AlgorithmIdentifier, []byte, error) {
+	kdf, kdfParams, err := newPBKDF2Algorithm(oidHMACWithSHA256, pkcs12Iterations, pkcs12SaltSize, 0)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	key, err := pbkdf2.Key(sha256.New, string(password), kdfParams.Salt, kdfParams.IterationCount, 32)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	encryptionScheme, err := algorithmIdentifier(oidAES256CBC, iv)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	algorithm, err := algorithmIdentifier(oidPBES2, pbes2Params{KeyDerivationFunc: kdf, EncryptionScheme: encryptionScheme})
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, nil, err
+	}
+	padding := aes.BlockSize - len(data)%aes.BlockSize
+	encrypted := append(bytes.Clone(data), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	return algorithm, encrypted, nil
+}
+
+// pkcs12SHA256MACKey derives the key of a HMAC-SHA256 PKCS#12 MAC from the
+// password using the key derivation function defined in RFC 7292 Appendix B.2.
+// The key is the size of a single SHA-256 output, so only the first block of
+// the function is computed.
+func pkcs12SHA256MACKey(password, salt []byte, iterations int) []byte {
+	const v = 64 // the block size of SHA-256
+
+	input := bytes.Repeat([]byte{3}, v) // the diversifier of MAC keys
+	input = append(input, fillWithRepeats(salt, v)...)
+	input = append(input, fillWithRepeats(bmpPassword(password), v)...)
+
+	sum := sha256.Sum256(input)
+	for range iterations - 1 {
+		sum = sha256.Sum256(sum[:])
+	}
+	return sum[:]
+}
+
+// fillWithRepeats returns v*ceiling(len(pattern)/v) bytes consisting of
+// repeats of pattern.
+func fillWithRepeats(pattern []byte, v int) []byte {
+	if len(pattern) == 0 {
+		return nil
+	}
+	size := v * ((len(pattern) + v - 1) / v)
+	return bytes.Repeat(pattern, (size+len(pattern)-1)/len(pattern))[:size]
+}
+
+// explicitTag0 returns the given DER encoded value wrapped in an explicit
+// context specific tag 0.
+func explicitTag0(der []byte) []byte {
+	wrapped, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der})
+	return wrapped
+}
+
+// pbes2Params is the PBES2-params structure defined in RFC 8018.
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+// pbkdf2Params is the PBKDF2-params structure defined in RFC 8018.
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// encryptedPrivateKeyInfo is the EncryptedPrivateKeyInfo structure defined in
+// RFC 5958.
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+// newPBKDF2Algorithm returns the AlgorithmIdentifier of PBKDF2 using the given
+// PRF, number of iterations and key length, and a random salt of the given
+// size.
+func newPBKDF2Algorithm(prf asn1.ObjectIdentifier, iterations, saltSize, keyLength int) (pkix.AlgorithmIdentifier, pbkdf2Params, error) {
+	params := pbkdf2Params{
+		Salt:           make([]byte, saltSize),
+		IterationCount: iterations,
+		KeyLength:      keyLength,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: prf, Parameters: asn1.NullRawValue},
+	}
+	if _, err := rand.Read(params.Salt); err != nil {
+		return pkix.AlgorithmIdentifier{}, pbkdf2Params{}, err
+	}
+
+	algorithm, err := algorithmIdentifier(oidPBKDF2, params)
+	return algorithm, params, err
+}
+
+// algorithmIdentifier returns the AlgorithmIdentifier of the given algorithm
+// with the given parameters.
+func algorithmIdentifier(algorithm asn1.ObjectIdentifier, params any) (pkix.AlgorithmIdentifier, error) {
+	paramsDER, err := asn1.Marshal(params)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, err
+	}
+	return pkix.AlgorithmIdentifier{Algorithm: algorithm, Parameters: asn1.RawValue{FullBytes: paramsDER}}, nil
+}
+
+// bmpPassword returns the given password encoded as a null terminated
+// big-endian UTF-16 string, as defined for PKCS#12 passwords in RFC 7292. An
+// empty password is encoded as an empty byte slice.
+func bmpPassword(password []byte) []byte {
+	if len(password) == 0 {
+		return []byte{}
+	}
+
+	codeUnits := utf16.Encode([]rune(string(password)))
+	encoded := make([]byte, 0, 2*len(codeUnits)+2)
+	for _, c := range codeUnits {
+		encoded = append(encoded, byte(c>>8), byte(c))
+	}
+	return append(encoded, 0, 0)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"software.sslmate.com/src/go-pkcs12"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// decodePKCS12ShroudedKey returns the decrypted private key entry of a
+// keystore encoded by encodePKCS12KeystoreWithKeyPassword, along with its
+// attributes.
+func decodePKCS12ShroudedKey(t *testing.T, keystore, keyPassword []byte) ([]byte, []pkcs12Attribute) {
+	var pfx pkcs12PFX
+	_, err := asn1.Unmarshal(keystore, &pfx)
+	require.NoError(t, err)
+
+	var authSafeDER []byte
+	_, err = asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafeDER)
+	require.NoError(t, err)
+	var authSafe []pkcs12ContentInfo
+	_, err = asn1.Unmarshal(authSafeDER, &authSafe)
+	require.NoError(t, err)
+	require.Len(t, authSafe, 2)
+	require.True(t, authSafe[1].ContentType.Equal(oidDataContentType))
+
+	var keySafeDER []byte
+	_, err = asn1.Unmarshal(authSafe[1].Content.Bytes, &keySafeDER)
+	require.NoError(t, err)
+	var bags []pkcs12SafeBag
+	_, err = asn1.Unmarshal(keySafeDER, &bags)
+	require.NoError(t, err)
+	require.Len(t, bags, 1)
+	require.True(t, bags[0].ID.Equal(oidPKCS8ShroudedKeyBag))
+
+	var keyInfo encryptedPrivateKeyInfo
+	_, err = asn1.Unmarshal(bags[0].Value.Bytes, &keyInfo)
+	require.NoError(t, err)
+	require.True(t, keyInfo.Algorithm.Algorithm.Equal(oidPBES2))
+
+	var params pbes2Params
+	_, err = asn1.Unmarshal(keyInfo.Algorithm.Parameters.FullBytes, &params)
+	require.NoError(t, err)
+	var kdfParams pbkdf2Params
+	_, err = asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams)
+	require.NoError(t, err)
+	require.True(t, params.EncryptionScheme.Algorithm.Equal(oidAES256CBC))
+	var iv []byte
+	_, err = asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv)
+	require.NoError(t, err)
+
+	key, err := pbkdf2.Key(sha256.New, string(keyPassword), kdfParams.Salt, kdfParams.IterationCount, 32)
+	require.NoError(t, err)
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	decrypted := make([]byte, len(keyInfo.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, keyInfo.EncryptedData)
+
+	padding := int(decrypted[len(decrypted)-1])
+	require.True(t, padding > 0 && padding <= aes.BlockSize, "invalid padding, wrong key password?")
+	return decrypted[:len(decrypted)-padding], bags[0].Attributes
+}
+
+func TestEncodePKCS12KeystoreWithKeyPassword(t *testing.T) {
+	chain := mustLeafWithChain(t)
+	keyBlock, _ := pem.Decode(chain.leaf.keyPEM)
+
+	t.Run("with the same key password and a friendly name", func(t *testing.T) {
+		out, err := encodePKCS12KeystoreWithKeyPassword([]byte("password"), []byte("password"), "my-alias", chain.leaf.keyPEM, chain.leaf.certPEM, chain.cas.certsToPEM())
+		require.NoError(t, err)
+
+		key, cert, caCerts, err := pkcs12.DecodeChain(out, "password")
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, keyBlock.Bytes, keyDER)
+		assert.True(t, cert.Equal(chain.leaf.cert))
+		require.Len(t, caCerts, len(chain.cas))
+		for i, ca := range chain.cas {
+			assert.True(t, caCerts[i].Equal(ca.cert))
+		}
+
+		blocks, err := pkcs12.ToPEM(out, "password")
+		require.NoError(t, err)
+		var friendlyNames []string
+		for _, block := range blocks {
+			if name, ok := block.Headers["friendlyName"]; ok {
+				friendlyNames = append(friendlyNames, block.Type+"="+name)
+			}
+		}
+		assert.ElementsMatch(t, []string{"PRIVATE KEY=my-alias", "CERTIFICATE=my-alias"}, friendlyNames)
+	})
+
+	t.Run("with a separate key password", func(t *testing.T) {
+		out, err := encodePKCS12KeystoreWithKeyPassword([]byte("password"), []byte("key-password"), "", chain.leaf.keyPEM, chain.leaf.certPEM, nil)
+		require.NoError(t, err)
+
+		// The private key entry cannot be decrypted using the keystore
+		// password.
+		_, _, _, err = pkcs12.DecodeChain(out, "password")
+		assert.Error(t, err)
+
+		keyDER, attributes := decodePKCS12ShroudedKey(t, out, []byte("key-password"))
+		assert.Equal(t, keyBlock.Bytes, keyDER)
+		require.Len(t, attributes, 1)
+		assert.True(t, attributes[0].ID.Equal(oidLocalKeyID))
+	})
+}
+
+func TestPKCS12SHA256MACKey(t *testing.T) {
+	// The MAC key is verified against go-pkcs12, which fails to decode a
+	// keystore whose MAC does not match.
+	out, err := encodePKCS12KeystoreWithKeyPassword([]byte("pässwörd"), []byte("pässwörd"), "", mustGeneratePrivateKey(t, cmapi.PKCS8), mustSelfSignCertificate(t), nil)
+	require.NoError(t, err)
+
+	_, _, err = pkcs12.Decode(out, "pässwörd")
+	require.NoError(t, err)
+	_, _, err = pkcs12.Decode(out, "password")
+	require.ErrorIs(t, err, pkcs12.ErrIncorrectPassword)
+}
@@ -19,6 +19,7 @@ package internal
 import (
 	"context"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"maps"
 
@@ -29,6 +30,7 @@ import (
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
@@ -45,11 +47,16 @@ var (
 	certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
 )
 
+// ErrTrustConfigMapConflict is returned when the Certificate's TrustConfigMap
+// already exists and is not managed by the Certificate.
+var ErrTrustConfigMapConflict = errors.New("trust ConfigMap conflict")
+
 // SecretsManager creates and updates secrets with certificate and key data.
 type SecretsManager struct {
-	secretClient coreclient.SecretsGetter
-	secretLister internalinformers.SecretLister
-	recorder     record.EventRecorder
+	secretClient    coreclient.SecretsGetter
+	configMapClient coreclient.ConfigMapsGetter
+	secretLister    internalinformers.SecretLister
+	recorder        record.EventRecorder
 
 	// fieldManager is the manager name used for the Apply operations on Secrets.
 	fieldManager string
@@ -66,6 +73,11 @@ type SecretData struct {
 	PrivateKey, Certificate, CA         []byte // #nosec G117 -- holds runtime certificate material; not a hardcoded secret
 	CertificateName                     string
 	IssuerName, IssuerKind, IssuerGroup string
+
+	// NextPrivateKey and NextCertificate are set during a staged private key
+	// rotation to publish the next private key and certificate alongside the
+	// current ones.
+	NextPrivateKey, NextCertificate []byte // #nosec G117 -- holds runtime certificate material; not a hardcoded secret
 }
 
 // NewSecretsManager returns a new SecretsManager. Setting
@@ -73,6 +85,7 @@ type SecretData struct {
 // when the corresponding Certificate is deleted.
 func NewSecretsManager(
 	secretClient coreclient.SecretsGetter,
+	configMapClient coreclient.ConfigMapsGetter,
 	secretLister internalinformers.SecretLister,
 	recorder record.EventRecorder,
 	fieldManager string,
@@ -80,6 +93,7 @@ func NewSecretsManager(
 ) *SecretsManager {
 	return &SecretsManager{
 		secretClient:                secretClient,
+		configMapClient:             configMapClient,
 		secretLister:                secretLister,
 		recorder:                    recorder,
 		fieldManager:                fieldManager,
@@ -90,7 +104,8 @@ func NewSecretsManager(
 // UpdateData will ensure the Secret resource contains the given secret data as
 // well as appropriate metadata using an Apply call.
 // If the Secret resource does not exist, it will be created on Apply.
-// UpdateData will also update deprecated annotations if they exist.
+// UpdateData will also update deprecated annotations if they exist, and the
+// Certificate's TrustConfigMap if one is configured.
 func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
 	secret, err := s.getCertificateSecret(crt)
 	if err != nil {
@@ -114,12 +129,7 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 	// in a no-op if the Secret already exists and has the owner reference set,
 	// and visa-versa.
 	if s.enableSecretOwnerReferences {
-		ref := *metav1.NewControllerRef(crt, certificateGvk)
-		applyCnf = applyCnf.WithOwnerReferences(&applymetav1.OwnerReferenceApplyConfiguration{
-			APIVersion: &ref.APIVersion, Kind: &ref.Kind,
-			Name: &ref.Name, UID: &ref.UID,
-			Controller: ref.Controller, BlockOwnerDeletion: ref.BlockOwnerDeletion,
-		})
+		applyCnf = applyCnf.WithOwnerReferences(certificateOwnerReference(crt))
 	}
 
 	log.V(logf.DebugLevel).Info("applying secret")
@@ -129,6 +139,86 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 		return fmt.Errorf("failed to apply secret %s/%s: %w", secret.Namespace, secret.Name, err)
 	}
 
+	return s.updateTrustConfigMap(ctx, crt, data)
+}
+
+// updateTrustConfigMap will ensure the Certificate's TrustConfigMap, if one is
+// configured, contains the CA and optionally the certificate from the given
+// secret data using an Apply call. The ConfigMap is given the same owner
+// reference as the Secret.
+// An existing ConfigMap is only updated if it is annotated with the
+// Certificate's name, otherwise ErrTrustConfigMapConflict is returned. The
+// ConfigMap is fetched from the API server, as ConfigMaps which are not
+// managed by cert-manager may not be cached.
+func (s *SecretsManager) updateTrustConfigMap(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
+	if crt.Spec.TrustConfigMap == nil {
+		return nil
+	}
+
+	name := crt.Spec.TrustConfigMap.Name
+	log := logf.FromContext(ctx).WithName("secrets_manager").WithValues("configmap", name)
+
+	existing, err := s.configMapClient.ConfigMaps(crt.Namespace).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to get configmap %s/%s: %w", crt.Namespace, name, err)
+	case existing.Annotations[cmapi.CertificateNameKey] != crt.Name:
+		return fmt.Errorf("%w: ConfigMap %q already exists and is not managed by this Certificate", ErrTrustConfigMapConflict, name)
+	}
+
+	applyOpts := metav1.ApplyOptions{FieldManager: s.fieldManager, Force: true}
+	applyCnf := applycorev1.ConfigMap(name, crt.Namespace).
+		WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
+		WithAnnotations(map[string]string{cmapi.CertificateNameKey: crt.Name}).
+		WithData(certificates.TrustConfigMapData(crt, data.CA, data.Certificate))
+
+	if s.enableSecretOwnerReferences {
+		applyCnf = applyCnf.WithOwnerReferences(certificateOwnerReference(crt))
+	}
+
+	log.V(logf.DebugLevel).Info("applying trust configmap")
+
+	_, err = s.configMapClient.ConfigMaps(crt.Namespace).Apply(ctx, applyCnf, applyOpts)
+	if err != nil {
+		return fmt.Errorf("failed to apply configmap %s/%s: %w", crt.Namespace, name, err)
+	}
+
+	return nil
+}
+
+// certificateOwnerReference returns the controller owner reference to the
+// given Certificate which is set on the resources it manages when owner
+// references are enabled.
+func certificateOwnerReference(crt *cmapi.Certificate) *applymetav1.OwnerReferenceApplyConfiguration {
+	ref := *metav1.NewControllerRef(crt, certificateGvk)
+	return &applymetav1.OwnerReferenceApplyConfiguration{
+		APIVersion: &ref.APIVersion, Kind: &ref.Kind,
+		Name: &ref.Name, UID: &ref.UID,
+		Controller: ref.Controller, BlockOwnerDeletion: ref.BlockOwnerDeletion,
+	}
+}
+
+// UpdateReplica will ensure the given replica of a Certificate's Secret exists
+// in its namespace with the given data and metadata using an Apply call.
+// Owner references are never set on replicas, since they cannot refer to a
+// Certificate in another namespace.
+func (s *SecretsManager) UpdateReplica(ctx context.Context, replica *corev1.Secret) error {
+	log := logf.FromContext(ctx).WithName("secrets_manager")
+	log = logf.WithResource(log, replica)
+
+	applyOpts := metav1.ApplyOptions{FieldManager: s.fieldManager, Force: true}
+	applyCnf := applycorev1.Secret(replica.Name, replica.Namespace).
+		WithAnnotations(replica.Annotations).WithLabels(replica.Labels).
+		WithData(replica.Data).WithType(replica.Type)
+
+	log.V(logf.DebugLevel).Info("applying secret replica")
+
+	_, err := s.secretClient.Secrets(replica.Namespace).Apply(ctx, applyCnf, applyOpts)
+	if err != nil {
+		return fmt.Errorf("failed to apply secret replica %s/%s: %w", replica.Namespace, replica.Name, err)
+	}
+
 	return nil
 }
 
@@ -155,6 +245,10 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 	if len(data.CA) > 0 {
 		secret.Data[cmmeta.TLSCAKey] = data.CA
 	}
+	if len(data.NextPrivateKey) > 0 && len(data.NextCertificate) > 0 {
+		secret.Data[cmapi.NextPrivateKeySecretKey] = data.NextPrivateKey
+		secret.Data[cmapi.NextCertificateSecretKey] = data.NextCertificate
+	}
 
 	if secret.Annotations == nil {
 		secret.Annotations = make(map[string]string)
@@ -243,56 +337,54 @@ func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.S
 }
 
 // setKeystores will set extra Secret Data keys according to any Keystores
-// which have been configured.
+// which have been configured. A fingerprint of each JKS and PKCS12 keystore
+// is stored in the Secret's annotations, so that a change of the keystore's
+// password, alias or profile can be detected.
 func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
 	if crt.Spec.Keystores == nil {
 		return nil
 	}
 
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+
 	// Handle PKCS#12 keystores
 	if crt.Spec.Keystores.PKCS12 != nil && crt.Spec.Keystores.PKCS12.Create {
-		var pw []byte
-
-		ref := crt.Spec.Keystores.PKCS12.PasswordSecretRef
-
-		switch {
-		case ref.Name != "":
-			pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
-
-			if apierrors.IsNotFound(err) {
-				s.recorder.Eventf(crt, corev1.EventTypeWarning, "KeystorePasswordSecretNotFound", "PKCS12 keystore password Secret %q not found", ref.Name)
-			}
-
-			if err != nil {
-				return fmt.Errorf("fetching PKCS12 keystore password from Secret: %v", err)
-			}
-
-			if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
-				return fmt.Errorf("PKCS12 keystore password Secret contains no data for key %q", ref.Key)
-			}
-
-			pw = pwSecret.Data[ref.Key]
-
-		case crt.Spec.Keystores.PKCS12.Password != nil:
-			if len(*crt.Spec.Keystores.PKCS12.Password) == 0 {
-				return fmt.Errorf("PKCS12 literal password cannot be empty")
-			}
-
-			pw = []byte(*crt.Spec.Keystores.PKCS12.Password)
-
-		default:
-			return fmt.Errorf("either passwordSecretRef or password must be set for PKCS#12 keystore")
+		pw, err := s.keystorePassword(crt, "PKCS12", crt.Spec.Keystores.PKCS12.PasswordSecretRef, crt.Spec.Keystores.PKCS12.Password)
+		if err != nil {
+			return err
 		}
 
 		profile := crt.Spec.Keystores.PKCS12.Profile
+		alias := crt.Spec.Keystores.PKCS12.Alias
+		keyPasswordSecretRef := crt.Spec.Keystores.PKCS12.KeyPasswordSecretRef
+
+		var keyPw []byte
+		var keystoreData []byte
+		if alias != nil || keyPasswordSecretRef != nil {
+			// The private key entry is encrypted using the keystore password
+			// unless it has its own password.
+			entryPw := pw
+			if keyPasswordSecretRef != nil {
+				keyPw, err = s.keystorePassword(crt, "PKCS12 key", *keyPasswordSecretRef, nil)
+				if err != nil {
+					return err
+				}
+				entryPw = keyPw
+			}
 
-		keystoreData, err := encodePKCS12Keystore(profile, string(pw), data.PrivateKey, data.Certificate, data.CA)
+			keystoreData, err = encodePKCS12KeystoreWithKeyPassword(pw, entryPw, ptr.Deref(alias, ""), data.PrivateKey, data.Certificate, data.CA)
+		} else {
+			keystoreData, err = encodePKCS12Keystore(profile, string(pw), data.PrivateKey, data.Certificate, data.CA)
+		}
 		if err != nil {
 			return fmt.Errorf("error encoding PKCS12 bundle: %w", err)
 		}
 
 		// always overwrite the keystore entry for now
 		secret.Data[cmapi.PKCS12SecretKey] = keystoreData
+		secret.Annotations[cmapi.PKCS12KeystoreFingerprintAnnotationKey] = certificates.PKCS12KeystoreFingerprint(crt, pw, keyPw, keystoreData)
 
 		if len(data.CA) > 0 {
 			truststoreData, err := encodePKCS12Truststore(profile, string(pw), data.CA)
@@ -306,43 +398,12 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 
 	// Handle JKS keystores
 	if crt.Spec.Keystores.JKS != nil && crt.Spec.Keystores.JKS.Create {
-		var pw []byte
-
-		ref := crt.Spec.Keystores.JKS.PasswordSecretRef
-
-		switch {
-		case ref.Name != "":
-			pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
-
-			if apierrors.IsNotFound(err) {
-				s.recorder.Eventf(crt, corev1.EventTypeWarning, "KeystorePasswordSecretNotFound", "JKS keystore password Secret %q not found", ref.Name)
-			}
-
-			if err != nil {
-				return fmt.Errorf("fetching JKS keystore password from Secret: %v", err)
-			}
-
-			if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
-				return fmt.Errorf("JKS keystore password Secret contains no data for key %q", ref.Key)
-			}
-
-			pw = pwSecret.Data[ref.Key]
-
-		case crt.Spec.Keystores.JKS.Password != nil:
-			if len(*crt.Spec.Keystores.JKS.Password) == 0 {
-				return fmt.Errorf("JKS literal password cannot be empty")
-			}
-
-			pw = []byte(*crt.Spec.Keystores.JKS.Password)
-
-		default:
-			return fmt.Errorf("either passwordSecretRef or password must be set for JKS keystore")
+		pw, err := s.keystorePassword(crt, "JKS", crt.Spec.Keystores.JKS.PasswordSecretRef, crt.Spec.Keystores.JKS.Password)
+		if err != nil {
+			return err
 		}
 
-		alias := "certificate"
-		if crt.Spec.Keystores.JKS.Alias != nil {
-			alias = *crt.Spec.Keystores.JKS.Alias
-		}
+		alias := certificates.JKSKeystoreAlias(crt)
 
 		keystoreData, err := encodeJKSKeystore(pw, alias, data.PrivateKey, data.Certificate, data.CA)
 		if err != nil {
@@ -351,6 +412,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 
 		// always overwrite the keystore entry
 		secret.Data[cmapi.JKSSecretKey] = keystoreData
+		secret.Annotations[cmapi.JKSKeystoreFingerprintAnnotationKey] = certificates.JKSKeystoreFingerprint(crt, pw, keystoreData)
 
 		if len(data.CA) > 0 {
 			truststoreData, err := encodeJKSTruststore(pw, data.CA)
@@ -362,9 +424,30 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 		}
 	}
 
+	// Handle PEM truststores
+	if crt.Spec.Keystores.PEMTruststore != nil && crt.Spec.Keystores.PEMTruststore.Create {
+		truststoreData, err := certificates.PEMTruststore(data.Certificate, data.CA)
+		if err != nil {
+			return fmt.Errorf("error encoding PEM trust store bundle: %w", err)
+		}
+		if len(truststoreData) > 0 {
+			secret.Data[cmapi.PEMTruststoreKey] = truststoreData
+		}
+	}
+
 	return nil
 }
 
+// keystorePassword returns the password of the Certificate's keystore of the
+// given type, firing an Event if the referenced password Secret is not found.
+func (s *SecretsManager) keystorePassword(crt *cmapi.Certificate, keystoreType string, ref cmmeta.SecretKeySelector, password *string) ([]byte, error) {
+	pw, err := certificates.KeystorePassword(s.secretLister, crt.Namespace, keystoreType, ref, password)
+	if apierrors.IsNotFound(err) {
+		s.recorder.Eventf(crt, corev1.EventTypeWarning, "KeystorePasswordSecretNotFound", "%s keystore password Secret %q not found", keystoreType, ref.Name)
+	}
+	return pw, err
+}
+
 // setAdditionalOutputFormat will set extra Secret Data keys with additional
 // output formats according to any OutputFormats which have been configured.
 func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
@@ -30,9 +30,11 @@ import (
 	apitypes "k8s.io/apimachinery/pkg/types"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	"github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -98,6 +100,20 @@ func Test_SecretsManager(t *testing.T) {
 		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{Create: true, Password: &keystorePassword}}),
 	)
 
+	baseCertWithPKCS12FriendlyName := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{
+			Create:   true,
+			Profile:  cmapi.Modern2023PKCS12Profile,
+			Password: &keystorePassword,
+			Alias:    new("my-alias"),
+		}}),
+	)
+
+	testCA := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), gen.Certificate("ca", gen.SetCertificateCommonName("ca")))
+	baseCertWithPEMTruststore := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{PEMTruststore: &cmapi.PEMTruststore{Create: true}}),
+	)
+
 	block, _, _ := pem.SafeDecodePrivateKey(baseCertBundle.PrivateKeyBytes)
 	tlsDerContent := block.Bytes
 
@@ -166,6 +182,30 @@ func Test_SecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if next certificate and private key are given, store them alongside the current ones": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertBundle.Certificate,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+				NextCertificate: []byte("next-cert"), NextPrivateKey: []byte("next-key"),
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string][]byte{
+						corev1.TLSCertKey:              baseCertBundle.CertBytes,
+						corev1.TLSPrivateKeyKey:        []byte("test-key"),
+						cmmeta.TLSCAKey:                []byte("test-ca"),
+						cmapi.NextCertificateSecretKey: []byte("next-cert"),
+						cmapi.NextPrivateKeySecretKey:  []byte("next-key"),
+					}, gotCnf.Data)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
 		"if secret does not exist, create new Secret, with owner enabled": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: true},
 			certificate:        baseCertBundle.Certificate,
@@ -779,6 +819,8 @@ func Test_SecretsManager(t *testing.T) {
 			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
 				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
 					assert.NotNil(t, gotCnf.Data[cmapi.JKSSecretKey])
+					assert.Equal(t, certificates.KeystoreFingerprint([]byte(keystorePassword), gotCnf.Data[cmapi.JKSSecretKey], "certificate"),
+						gotCnf.Annotations[cmapi.JKSKeystoreFingerprintAnnotationKey])
 					return nil, nil
 				}
 			},
@@ -796,6 +838,44 @@ func Test_SecretsManager(t *testing.T) {
 			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
 				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
 					assert.NotNil(t, gotCnf.Data[cmapi.PKCS12SecretKey])
+					assert.Equal(t, certificates.KeystoreFingerprint([]byte(keystorePassword), gotCnf.Data[cmapi.PKCS12SecretKey], ""),
+						gotCnf.Annotations[cmapi.PKCS12KeystoreFingerprintAnnotationKey])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if secret does not exist, create new Secret with PKCS12 keystore with a friendly name": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithPKCS12FriendlyName,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.NotNil(t, gotCnf.Data[cmapi.PKCS12SecretKey])
+					assert.Equal(t, certificates.KeystoreFingerprint([]byte(keystorePassword), gotCnf.Data[cmapi.PKCS12SecretKey], "Modern2023", "my-alias", ""),
+						gotCnf.Annotations[cmapi.PKCS12KeystoreFingerprintAnnotationKey])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if secret does not exist, create new Secret with PEM truststore containing only the CA": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithPEMTruststore,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes, CA: testCA,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, testCA, gotCnf.Data[cmapi.PEMTruststoreKey])
 					return nil, nil
 				}
 			},
@@ -816,7 +896,7 @@ func Test_SecretsManager(t *testing.T) {
 			secretLister := testcorelisters.NewFakeSecretLister(mod)
 
 			testManager := NewSecretsManager(
-				secretClient, secretLister,
+				secretClient, fake.NewClientset().CoreV1(), secretLister,
 				record.NewFakeRecorder(10),
 				testpkg.FieldManager,
 				test.certificateOptions.EnableOwnerRef,
@@ -833,6 +913,123 @@ func Test_SecretsManager(t *testing.T) {
 	}
 }
 
+func Test_SecretsManager_trustConfigMap(t *testing.T) {
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateNamespace("test-namespace"),
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateUID(apitypes.UID("test-uid")),
+	)
+	bundle := testcrypto.MustCreateCryptoBundle(t, baseCert, fixedClock)
+	data := SecretData{Certificate: bundle.CertBytes, CA: []byte("test-ca"), PrivateKey: bundle.PrivateKeyBytes}
+
+	tests := map[string]struct {
+		trustConfigMap *cmapi.CertificateTrustConfigMap
+		enableOwnerRef bool
+		existing       *corev1.ConfigMap
+
+		expectedErr       error
+		expectedData      map[string]string
+		expectedOwnerRefs []metav1.OwnerReference
+	}{
+		"if no trustConfigMap is set, should not create a ConfigMap": {},
+		"if a trustConfigMap is set, should publish the CA": {
+			trustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "output-ca"},
+			expectedData:   map[string]string{cmmeta.TLSCAKey: "test-ca"},
+		},
+		"if a trustConfigMap includes the certificate, should publish the CA and certificate": {
+			trustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "output-ca", IncludeCertificate: true},
+			expectedData:   map[string]string{cmmeta.TLSCAKey: "test-ca", corev1.TLSCertKey: string(bundle.CertBytes)},
+		},
+		"if owner references are enabled, should set the owner reference on the ConfigMap": {
+			trustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "output-ca"},
+			enableOwnerRef: true,
+			expectedData:   map[string]string{cmmeta.TLSCAKey: "test-ca"},
+			expectedOwnerRefs: []metav1.OwnerReference{
+				*metav1.NewControllerRef(baseCert, cmapi.SchemeGroupVersion.WithKind("Certificate")),
+			},
+		},
+		"if the ConfigMap exists and is managed by the Certificate, should update it": {
+			trustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "output-ca"},
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "output-ca",
+					Annotations: map[string]string{cmapi.CertificateNameKey: "test"},
+				},
+				Data: map[string]string{cmmeta.TLSCAKey: "old-ca"},
+			},
+			expectedData: map[string]string{cmmeta.TLSCAKey: "test-ca"},
+		},
+		"if the ConfigMap exists and is not managed by the Certificate, should not update it": {
+			trustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "output-ca"},
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "output-ca"},
+				Data:       map[string]string{"user-data": "foo"},
+			},
+			expectedErr: ErrTrustConfigMapConflict,
+		},
+		"if the ConfigMap exists and is managed by another Certificate, should not update it": {
+			trustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "output-ca"},
+			existing: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "output-ca",
+					Annotations: map[string]string{cmapi.CertificateNameKey: "other"},
+				},
+				Data: map[string]string{cmmeta.TLSCAKey: "other-ca"},
+			},
+			expectedErr: ErrTrustConfigMapConflict,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.CertificateFrom(baseCert)
+			crt.Spec.TrustConfigMap = test.trustConfigMap
+
+			client := fake.NewClientset()
+			if test.existing != nil {
+				client = fake.NewClientset(test.existing)
+			}
+			secretClient := testcoreclients.NewFakeSecretsGetter(testcoreclients.SetFakeSecretsGetterApplyFn(
+				func(context.Context, *applycorev1.SecretApplyConfiguration, metav1.ApplyOptions) (*corev1.Secret, error) {
+					return nil, nil
+				},
+			))
+			secretLister := testcorelisters.NewFakeSecretLister(
+				testcorelisters.SetFakeSecretNamespaceListerGet(nil, apierrors.NewNotFound(corev1.Resource("secret"), "not found")),
+			)
+
+			testManager := NewSecretsManager(
+				secretClient, client.CoreV1(), secretLister,
+				record.NewFakeRecorder(10),
+				testpkg.FieldManager,
+				test.enableOwnerRef,
+			)
+			err := testManager.UpdateData(t.Context(), crt, data)
+			assert.ErrorIs(t, err, test.expectedErr)
+
+			configMaps, err := client.CoreV1().ConfigMaps(crt.Namespace).List(t.Context(), metav1.ListOptions{})
+			assert.NoError(t, err)
+			if test.expectedErr != nil {
+				assert.Equal(t, []corev1.ConfigMap{*test.existing}, configMaps.Items)
+				return
+			}
+			if test.trustConfigMap == nil {
+				assert.Empty(t, configMaps.Items)
+				return
+			}
+
+			assert.Len(t, configMaps.Items, 1)
+			configMap := configMaps.Items[0]
+			assert.Equal(t, test.trustConfigMap.Name, configMap.Name)
+			assert.Equal(t, test.expectedData, configMap.Data)
+			assert.Equal(t, map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}, configMap.Labels)
+			assert.Equal(t, map[string]string{cmapi.CertificateNameKey: "test"}, configMap.Annotations)
+			assert.Equal(t, test.expectedOwnerRefs, configMap.OwnerReferences)
+		})
+	}
+}
+
 func Test_getCertificateSecret(t *testing.T) {
 	crt := &cmapi.Certificate{
 		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-certificate"},
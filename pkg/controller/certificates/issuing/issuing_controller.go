@@ -27,6 +27,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -45,6 +47,8 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	utilkube "github.com/cert-manager/cert-manager/pkg/util/kube"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -64,20 +68,51 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             internalinformers.SecretLister
+	secretIndexer            cache.Indexer
+	configMapLister          corev1listers.ConfigMapLister
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
 
-	client cmclient.Interface
+	// scheduledWorkQueue is used to re-process Certificates once the overlap
+	// period of a staged private key rotation has passed.
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
+	// namespaceLister is nil if the controller is scoped to a single
+	// namespace, in which case Secret replicas are not managed.
+	namespaceLister corev1listers.NamespaceLister
+
+	client        cmclient.Interface
+	secretsClient coreclient.SecretsGetter
+
+	// metrics is used to record the time taken to issue certificates
+	metrics *metrics.Metrics
 
 	// secretsUpdateData is used by the SecretTemplate controller for
 	// re-reconciling Secrets where the SecretTemplate is not up to date with a
 	// Certificate's secret.
 	secretsUpdateData func(context.Context, *cmapi.Certificate, internal.SecretData) error
 
+	// secretsUpdateReplica is used to create or update replicas of a
+	// Certificate's Secret in other namespaces.
+	secretsUpdateReplica func(context.Context, *corev1.Secret) error
+
+	// enableSecretOwnerReferences is true if Secrets are deleted along with
+	// their Certificate, in which case Secret replicas are deleted too.
+	enableSecretOwnerReferences bool
+
 	// postIssuancePolicyChain is the policies chain to ensure that all Secret
 	// metadata and output formats are kept are present and correct.
 	postIssuancePolicyChain policies.Chain
 
+	// adoptionPolicyChain is the policies chain which must pass for the
+	// existing Secret of a Certificate requesting adoption to be adopted as
+	// its first revision.
+	adoptionPolicyChain policies.Chain
+
+	// secretReplicaPolicyChain is the policies chain to ensure that replicas
+	// of a Certificate's Secret are kept up to date with the Secret.
+	secretReplicaPolicyChain policies.Chain
+
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Apply API calls.
@@ -104,6 +139,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	configMapInformer := ctx.KubeSharedInformerFactory.ConfigMaps()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -142,16 +178,77 @@ func NewController(
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to the Secrets referenced by `spec.keystores.*.passwordSecretRef`
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[*corev1.Secret](predicate.CertificateKeystorePasswordSecretName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to replicas of the Secret
+			enqueueCertificateForSecretReplica(queue),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// Index the replicas of Secrets by the Certificate they are a replica
+	// of, so that stale replicas can be found without listing all Secrets.
+	if _, ok := secretsInformer.Informer().GetIndexer().GetIndexers()[secretReplicaOfIndex]; !ok {
+		if err := secretsInformer.Informer().AddIndexers(cache.Indexers{
+			secretReplicaOfIndex: secretReplicaOfIndexFunc,
+		}); err != nil {
+			return nil, nil, nil, fmt.Errorf("error adding Secret indexer: %v", err)
+		}
+	}
+
+	if _, err := configMapInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Issuer reconciles on changes to the ConfigMap named `spec.trustConfigMap.name`,
+			// including ConfigMaps which are not managed by cert-manager
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[metav1.Object](predicate.CertificateTrustConfigMapName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
+		configMapInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
 	}
 
+	// Namespaces are cluster scoped, so Secret replicas can only be managed
+	// if the controller is not scoped to a single namespace.
+	var namespaceLister corev1listers.NamespaceLister
+	if ctx.Namespace == "" {
+		namespaceInformer := ctx.KubeSharedInformerFactory.Namespaces()
+		if _, err := namespaceInformer.Informer().AddEventHandler(
+			controllerpkg.BlockingEventHandler(
+				enqueueCertificatesWithSecretReplicas(log, queue, certificateInformer.Lister()),
+			),
+		); err != nil {
+			return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+		}
+		namespaceLister = namespaceInformer.Lister()
+		mustSync = append(mustSync, namespaceInformer.Informer().HasSynced)
+	}
+
 	secretsManager := internal.NewSecretsManager(
-		ctx.Client.CoreV1(), secretsInformer.Lister(),
+		ctx.Client.CoreV1(), ctx.Client.CoreV1(), secretsInformer.Lister(),
 		ctx.Recorder, ctx.FieldManager, ctx.CertificateOptions.EnableOwnerRef,
 	)
 
@@ -159,16 +256,26 @@ func NewController(
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		secretIndexer:            secretsInformer.Informer().GetIndexer(),
+		configMapLister:          configMapInformer.Lister(),
+		namespaceLister:          namespaceLister,
 		client:                   ctx.CMClient,
+		secretsClient:            ctx.Client.CoreV1(),
 		recorder:                 ctx.Recorder,
 		clock:                    ctx.Clock,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		metrics:                  ctx.Metrics,
 		secretsUpdateData:        secretsManager.UpdateData,
+		secretsUpdateReplica:     secretsManager.UpdateReplica,
 		postIssuancePolicyChain: policies.NewSecretPostIssuancePolicyChain(
 			ctx.CertificateOptions.EnableOwnerRef,
 			ctx.FieldManager,
 		),
-		fieldManager:         ctx.FieldManager,
-		localTemporarySigner: utilpki.GenerateLocallySignedTemporaryCertificate,
+		adoptionPolicyChain:         policies.NewSecretAdoptionPolicyChain(ctx.Clock),
+		secretReplicaPolicyChain:    policies.NewSecretReplicaPolicyChain(),
+		enableSecretOwnerReferences: ctx.CertificateOptions.EnableOwnerRef,
+		fieldManager:                ctx.FieldManager,
+		localTemporarySigner:        utilpki.GenerateLocallySignedTemporaryCertificate,
 	}, queue, mustSync, nil
 }
 
@@ -186,6 +293,11 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
+	if crt == nil && c.enableSecretOwnerReferences {
+		// Secret replicas cannot be owned by the Certificate as they live in
+		// other namespaces, so they are deleted here instead.
+		return c.deleteSecretReplicas(ctx, log, key)
+	}
 	if crt == nil || crt.DeletionTimestamp != nil {
 		// If the Certificate object was/ is being deleted, we don't want to update its status or
 		// create/ update any Secret resources.
@@ -205,36 +317,54 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return c.ensureSecretData(ctx, log, crt)
 	}
 
-	if crt.Status.NextPrivateKeySecretName == nil ||
-		len(*crt.Status.NextPrivateKeySecretName) == 0 {
-		// Do nothing if the next private key secret name is not set
-		return nil
-	}
+	// The private key of a Certificate issued from an external CSR is held
+	// outside of cert-manager, in which case pk is nil and the issued
+	// certificate is verified against the public key of the external CSR.
+	var (
+		pk        crypto.Signer
+		publicKey crypto.PublicKey
+	)
+	if crt.Spec.ExternalCSR != nil {
+		_, externalCSR, err := certificates.ExternalCSR(crt, c.secretLister, c.configMapLister)
+		if err != nil {
+			// If the external CSR cannot be used, do nothing (requestmanager will report this).
+			log.V(logf.DebugLevel).Info("External CSR cannot be used, waiting for requestmanager controller", "error", err)
+			return nil
+		}
+		publicKey = externalCSR.PublicKey
+	} else {
+		if crt.Status.NextPrivateKeySecretName == nil ||
+			len(*crt.Status.NextPrivateKeySecretName) == 0 {
+			// Do nothing if the next private key secret name is not set
+			return nil
+		}
 
-	// Fetch and parse the 'next private key secret'
-	nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
-	if apierrors.IsNotFound(err) {
-		log.V(logf.DebugLevel).Info("Next private key secret does not exist, waiting for keymanager controller")
-		// If secret does not exist, do nothing (keymanager will handle this).
-		return nil
-	}
-	if err != nil {
-		return err
-	}
-	if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
-		logf.WithResource(log, nextPrivateKeySecret).Info("Next private key secret does not contain any private key data, waiting for keymanager controller")
-		return nil
-	}
-	pk, _, err := utilkube.ParseTLSKeyFromSecret(nextPrivateKeySecret, corev1.TLSPrivateKeyKey)
-	if err != nil {
-		// If the private key cannot be parsed here, do nothing as the key manager will handle this.
-		logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
-		return nil
-	}
-	pkViolations := utilpki.PrivateKeyMatchesSpec(pk, crt.Spec)
-	if len(pkViolations) > 0 {
-		logf.WithResource(log, nextPrivateKeySecret).Info("stored next private key does not match requirements on Certificate resource, waiting for keymanager controller", "violations", pkViolations)
-		return nil
+		// Fetch and parse the 'next private key secret'
+		nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
+		if apierrors.IsNotFound(err) {
+			log.V(logf.DebugLevel).Info("Next private key secret does not exist, waiting for keymanager controller")
+			// If secret does not exist, do nothing (keymanager will handle this).
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+			logf.WithResource(log, nextPrivateKeySecret).Info("Next private key secret does not contain any private key data, waiting for keymanager controller")
+			return nil
+		}
+		pk, _, err = utilkube.ParseTLSKeyFromSecret(nextPrivateKeySecret, corev1.TLSPrivateKeyKey)
+		if err != nil {
+			// If the private key cannot be parsed here, do nothing as the key manager will handle this.
+			logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
+			return nil
+		}
+		pkViolations := utilpki.PrivateKeyMatchesSpec(pk, crt.Spec)
+		if len(pkViolations) > 0 {
+			logf.WithResource(log, nextPrivateKeySecret).Info("stored next private key does not match requirements on Certificate resource, waiting for keymanager controller", "violations", pkViolations)
+			return nil
+		}
+		publicKey = pk.Public()
 	}
 
 	// CertificateRequest revisions begin from 1. If no revision is set on the
@@ -279,12 +409,12 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		return err
 	}
-	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
+	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(publicKey, csr)
 	if err != nil {
 		return err
 	}
 	if !publicKeyMatchesCSR {
-		logf.WithResource(log, nextPrivateKeySecret).Info("next private key does not match CSR public key, waiting for requestmanager controller")
+		log.Info("next private key or external CSR does not match CSR public key, waiting for requestmanager controller")
 		return nil
 	}
 
@@ -393,14 +523,23 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 			})
 		}
 
+		// If the private key rotation is staged, the issuance is completed
+		// once the rotation overlap has passed.
+		if staged, err := c.stageRotation(ctx, log, crt, req, pk); err != nil || staged {
+			return err
+		}
+
 		return c.issueCertificate(ctx, nextRevision, crt, req, pk)
 	}
 
 	// Issue temporary certificate if needed. If a certificate was issued, then
 	// return early - we will sync again since the target Secret has been
 	// updated.
-	if issued, err := c.ensureTemporaryCertificate(ctx, crt, pk); err != nil || issued {
-		return err
+	// A temporary certificate cannot be issued without the private key.
+	if pk != nil {
+		if issued, err := c.ensureTemporaryCertificate(ctx, crt, pk); err != nil || issued {
+			return err
+		}
 	}
 
 	// CertificateRequest is not in a final state so do nothing.
@@ -450,9 +589,15 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
 
-	pkData, err := utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
-	if err != nil {
-		return err
+	// The private key of a Certificate issued from an external CSR is not
+	// stored, but the empty `tls.key` entry is kept as it is required for
+	// Secrets of type kubernetes.io/tls.
+	pkData := []byte{}
+	if pk != nil {
+		var err error
+		if pkData, err = utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding); err != nil {
+			return err
+		}
 	}
 	secretData := internal.SecretData{
 		PrivateKey:      pkData,
@@ -464,13 +609,22 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		IssuerGroup:     req.Spec.IssuerRef.Group,
 	}
 
-	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
+	if _, err := c.updateSecretData(ctx, crt, secretData); err != nil {
 		return err
 	}
 
 	// Set status.revision to revision of the CertificateRequest
 	crt.Status.Revision = &nextRevision
 
+	// Record when issuance was triggered before the condition is removed so
+	// that the end-to-end issuance duration can be observed. The duration of
+	// a staged private key rotation has already been observed when the next
+	// certificate was published, and does not include the rotation overlap.
+	var issuingSince time.Time
+	if crt.Status.NextPrivateKeyPublishedTime == nil {
+		issuingSince = issuingConditionTime(crt)
+	}
+
 	// Remove Issuing status condition
 	// TODO @joshvanl: Once we move to only server-side apply API calls, this
 	// should be changed to setting the Issuing condition to False.
@@ -482,10 +636,17 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 	// Clear status.lastFailureTime (if set)
 	crt.Status.LastFailureTime = nil
 
+	// Clear status.nextPrivateKeyPublishedTime (if set)
+	crt.Status.NextPrivateKeyPublishedTime = nil
+
 	if err := c.updateOrApplyStatus(ctx, crt, true); err != nil {
 		return err
 	}
 
+	if !issuingSince.IsZero() {
+		c.metrics.ObserveCertificateIssuanceDuration(c.clock.Since(issuingSince), req.Spec.IssuerRef)
+	}
+
 	message := "The certificate has been successfully issued"
 	c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", message)
 
@@ -493,6 +654,15 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 
 }
 
+// issuingConditionTime returns the time at which the Certificate's Issuing
+// condition was set, or the zero time if it is not set.
+func issuingConditionTime(crt *cmapi.Certificate) time.Time {
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil && cond.LastTransitionTime != nil {
+		return cond.LastTransitionTime.Time
+	}
+	return time.Time{}
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
@@ -511,17 +681,23 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 		}
 
 		var conditions []cmapi.CertificateCondition
-		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
-			conditions = []cmapi.CertificateCondition{*cond}
+		for _, conditionType := range []cmapi.CertificateConditionType{
+			cmapi.CertificateConditionIssuing,
+			cmapi.CertificateConditionTrustConfigMapConflict,
+		} {
+			if cond := apiutil.GetCertificateCondition(crt, conditionType); cond != nil {
+				conditions = append(conditions, *cond)
+			}
 		}
 
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status: cmapi.CertificateStatus{
-				Revision:               crt.Status.Revision,
-				LastFailureTime:        crt.Status.LastFailureTime,
-				FailedIssuanceAttempts: crt.Status.FailedIssuanceAttempts,
-				Conditions:             conditions,
+				Revision:                    crt.Status.Revision,
+				LastFailureTime:             crt.Status.LastFailureTime,
+				FailedIssuanceAttempts:      crt.Status.FailedIssuanceAttempts,
+				NextPrivateKeyPublishedTime: crt.Status.NextPrivateKeyPublishedTime,
+				Conditions:                  conditions,
 			},
 		})
 	} else {
@@ -20,9 +20,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -61,6 +64,12 @@ func TestIssuingController(t *testing.T) {
 		certificate             *cmapi.Certificate
 		expSecretUpdateDataCall *internal.SecretData
 
+		// checkMetrics is true if the issuance duration metrics recorded by
+		// the controller should be compared with expectedMetrics, in the
+		// Prometheus text format.
+		checkMetrics    bool
+		expectedMetrics string
+
 		expectedErr bool
 	}
 
@@ -89,6 +98,34 @@ func TestIssuingController(t *testing.T) {
 		}),
 	)
 
+	externalCSRCert := gen.CertificateFrom(issuingCert.DeepCopy(),
+		gen.SetCertificateExternalCSR(cmapi.CertificateExternalCSR{Name: "csr"}),
+		func(crt *cmapi.Certificate) { crt.Status.NextPrivateKeySecretName = nil },
+	)
+
+	stagedRotationCert := gen.CertificateFrom(issuingCert.DeepCopy(),
+		gen.SetCertificateKeyRotationOverlap(time.Hour),
+	)
+	metaFixedClockStartMinusTwoHours := metav1.NewTime(fixedClockStart.Add(-2 * time.Hour))
+	metaFixedClockStartMinusThreeHours := metav1.NewTime(fixedClockStart.Add(-3 * time.Hour))
+	currentSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: exampleBundle.Certificate.Namespace,
+			Name:      "output",
+			Annotations: map[string]string{
+				cmapi.CertificateNameKey:       "test",
+				cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+				cmapi.IssuerKindAnnotationKey:  "Issuer",
+				cmapi.IssuerGroupAnnotationKey: "foo.io",
+			},
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       exampleBundleAlt.CertBytes,
+			corev1.TLSPrivateKeyKey: exampleBundleAlt.PrivateKeyBytes,
+		},
+		Type: corev1.SecretTypeTLS,
+	}
+
 	tests := map[string]testT{
 		"if certificate is not in Issuing state, then do nothing": {
 			certificate: exampleBundle.Certificate,
@@ -613,6 +650,80 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is in Issuing state with an external CSR, one CertificateRequest, and is ready, store the signed certificate and ca with an empty private key to a new secret, and log an event": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(externalCSRCert),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "csr",
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							cmapi.CertificateSigningRequestKey: exampleBundle.CertificateRequestReady.Spec.Request,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateExternalCSR(cmapi.CertificateExternalCSR{Name: "csr"}),
+							func(crt *cmapi.Certificate) { crt.Status.NextPrivateKeySecretName = nil },
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:      []byte{},
+				CA:              nil,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+			expectedErr: false,
+		},
+		"if certificate is in Issuing state with an external CSR, one CertificateRequest, and is ready, but the external CSR does not match that of the CertificateRequest, do nothing": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(externalCSRCert),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "csr",
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							cmapi.CertificateSigningRequestKey: exampleBundleAlt.CertificateRequestReady.Spec.Request,
+						},
+					},
+				},
+				ExpectedEvents: []string{},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is in Issuing state, one CertificateRequests, and is ready, store the signed certificate, ca, and private key to an existing secret, and log an event": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -670,6 +781,165 @@ func TestIssuingController(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		"if certificate is in Issuing state with a rotation overlap, one CertificateRequest, and is ready with a new private key, publish the next certificate and private key to the existing secret": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(stagedRotationCert),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+					currentSecret.DeepCopy(),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(stagedRotationCert,
+							gen.SetCertificateNextPrivateKeyPublishedTime(metaFixedClockStart),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					`Normal RotationStaged Published the next certificate and private key to the "next.crt" and "next.key" entries of Secret "output", they will be promoted at ` + fixedClockStart.Add(time.Hour).UTC().Format(time.RFC3339),
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundleAlt.CertBytes,
+				PrivateKey:      exampleBundleAlt.PrivateKeyBytes,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+				NextCertificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				NextPrivateKey:  exampleBundle.PrivateKeyBytes,
+			},
+			checkMetrics: true,
+			expectedMetrics: `
+# HELP certmanager_certificate_issuance_duration_seconds Time in seconds between a Certificate being marked as Issuing and the signed certificate being stored in its Secret, or published as the next certificate of a staged private key rotation. Labels: issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_certificate_issuance_duration_seconds histogram
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="1"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="5"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="10"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="30"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="60"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="120"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="300"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="600"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="1800"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="3600"} 1
+certmanager_certificate_issuance_duration_seconds_bucket{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer",le="+Inf"} 1
+certmanager_certificate_issuance_duration_seconds_sum{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer"} 0
+certmanager_certificate_issuance_duration_seconds_count{issuer_group="foo.io",issuer_kind="Issuer",issuer_name="ca-issuer"} 1
+`,
+			expectedErr: false,
+		},
+		"if certificate is in Issuing state with a rotation overlap, and the next certificate and private key have been published, do nothing until the overlap has passed": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(stagedRotationCert,
+						gen.SetCertificateNextPrivateKeyPublishedTime(metaFixedClockStart),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+					func() *corev1.Secret {
+						secret := currentSecret.DeepCopy()
+						secret.Data[cmapi.NextCertificateSecretKey] = exampleBundle.CertificateRequestReady.Status.Certificate
+						secret.Data[cmapi.NextPrivateKeySecretKey] = exampleBundle.PrivateKeyBytes
+						return secret
+					}(),
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+		"if certificate is in Issuing state with a rotation overlap which has passed, promote the next certificate and private key": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(baseCert,
+						gen.SetCertificateKeyRotationOverlap(time.Hour),
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:               cmapi.CertificateConditionIssuing,
+							Status:             cmmeta.ConditionTrue,
+							ObservedGeneration: 3,
+							LastTransitionTime: &metaFixedClockStartMinusThreeHours,
+						}),
+						gen.SetCertificateNextPrivateKeyPublishedTime(metaFixedClockStartMinusTwoHours),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+					currentSecret.DeepCopy(),
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateKeyRotationOverlap(time.Hour),
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate:     exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:      exampleBundle.PrivateKeyBytes,
+				CA:              nil,
+				CertificateName: "test",
+				IssuerName:      "ca-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "foo.io",
+			},
+			// The issuance duration was observed when the next certificate
+			// was published, so the rotation overlap is not observed.
+			checkMetrics: true,
+			expectedErr:  false,
+		},
 		"if certificate is in Issuing state, one ready CertificateRequest and has last failure time set from previous issuance, set the Issuing condition to true, remove last failure time and store the signed certificate, ca, and private key to an existing secret, and log an event": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -1599,11 +1869,32 @@ func TestIssuingController(t *testing.T) {
 			if err == nil && test.expectedErr {
 				t.Errorf("expected to get an error but did not get one")
 			}
+			if test.checkMetrics {
+				checkMetrics(t, test.builder, test.expectedMetrics)
+			}
 			test.builder.CheckAndFinish(err)
 		})
 	}
 }
 
+// checkMetrics scrapes the metrics server of the builder's context and
+// compares the issuance duration metrics with the expected metrics.
+func checkMetrics(t *testing.T, builder *testpkg.Builder, expectedMetrics string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := builder.Metrics.NewServer(ln)
+	go func() { _ = server.Serve(ln) }()
+	defer server.Close()
+
+	if err := testutil.ScrapeAndCompare("http://"+ln.Addr().String()+"/metrics", strings.NewReader(expectedMetrics),
+		"certmanager_certificate_issuance_duration_seconds",
+	); err != nil {
+		t.Errorf("unexpected metrics: %v", err)
+	}
+}
+
 // applyStatusFieldMap returns the status object from an ApplyStatus JSON patch.
 func applyStatusFieldMap(t *testing.T, patch []byte) map[string]any {
 	t.Helper()
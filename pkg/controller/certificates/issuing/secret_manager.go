@@ -19,18 +19,32 @@ package issuing
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+const (
+	// reasonAdopted is the reason of the Event fired when a Certificate
+	// adopts its existing Secret.
+	reasonAdopted = "Adopted"
+
+	// reasonTrustConfigMapConflict is the reason of the Event fired, and of
+	// the TrustConfigMapConflict condition set, when the Certificate's
+	// TrustConfigMap exists and is not managed by the Certificate.
+	reasonTrustConfigMapConflict = "TrustConfigMapConflict"
+)
+
 // ensureSecretData ensures that the Certificate's Secret is up to date with
 // non-issuing condition related data.
 // Reconciles over the Certificate's SecretTemplate, and
@@ -54,12 +68,25 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 
 	log = log.WithValues("secret", secret.Name)
 
+	// If the Certificate has requested adoption of its existing Secret, and
+	// the Secret is valid for the Certificate, adopt it rather than leaving
+	// the Certificate to be issued.
+	if policies.SecretAdoptionRequested(crt) {
+		if _, _, violation := c.adoptionPolicyChain.Evaluate(policies.Input{
+			Certificate: crt,
+			Secret:      secret,
+		}); !violation {
+			return c.adoptSecret(ctx, log, crt, secret)
+		}
+	}
+
 	// If there is no certificate or private key data available at the target
 	// Secret then exit early. The absence of these keys should cause an issuance
 	// of the Certificate, so there is no need to run post issuance checks.
+	// Certificates issued from an external CSR have no private key data.
 	if secret.Data == nil ||
 		len(secret.Data[corev1.TLSCertKey]) == 0 ||
-		len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		(len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 && crt.Spec.ExternalCSR == nil) {
 		log.V(logf.DebugLevel).Info("secret doesn't contain both certificate and private key data",
 			"cert_data_len", len(secret.Data[corev1.TLSCertKey]), "key_data_len", len(secret.Data[corev1.TLSPrivateKeyKey]))
 		return nil
@@ -75,14 +102,31 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		IssuerGroup:     secret.Annotations[cmapi.IssuerGroupAnnotationKey],
 	}
 
+	configMap, err := c.getTrustConfigMap(crt)
+	if err != nil {
+		return err
+	}
+
 	// Check whether the Certificate's Secret has correct output format and
-	// metadata.
+	// metadata, and whether its TrustConfigMap is up to date.
 	reason, message, isViolation := c.postIssuancePolicyChain.Evaluate(policies.Input{
-		Certificate: crt,
-		Secret:      secret,
+		Certificate:       crt,
+		Secret:            secret,
+		ConfigMap:         configMap,
+		KeystorePasswords: c.keystorePasswords(crt),
 	})
 
-	if isViolation {
+	if !isViolation {
+		// The TrustConfigMap, if any, is managed by this Certificate so any
+		// previous conflict has been resolved.
+		if apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionTrustConfigMapConflict) != nil {
+			crt = crt.DeepCopy()
+			c.setTrustConfigMapConflict(crt, "")
+			if err := c.updateOrApplyStatus(ctx, crt, false); err != nil {
+				return err
+			}
+		}
+	} else {
 		switch reason {
 		case policies.InvalidCertificate, policies.ManagedFieldsParseError:
 			// An error here indicates that the managed fields are malformed and the
@@ -96,11 +140,126 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 
 			// Here the Certificate need to be re-reconciled.
 			log.Info("applying Secret data", "message", message)
-			return c.secretsUpdateData(ctx, crt, data)
+			crt = crt.DeepCopy()
+			changed, err := c.updateSecretData(ctx, crt, data)
+			if err != nil || !changed {
+				return err
+			}
+			return c.updateOrApplyStatus(ctx, crt, false)
 		}
 	}
 
-	// No Secret violations, nothing to do.
+	// No Secret violations, so make sure the replicas of the Secret are up to
+	// date.
+	return c.ensureSecretReplicas(ctx, log, crt, secret)
+}
+
+// adoptSecret stores the managed annotations and labels of the Certificate on
+// its existing Secret, and sets the Certificate's revision to 1 so that the
+// certificate already stored in the Secret is treated as issued by
+// cert-manager until its normal renewal time.
+func (c *controller) adoptSecret(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, secret *corev1.Secret) error {
+	crt = crt.DeepCopy()
+
+	data := internal.SecretData{
+		PrivateKey:      secret.Data[corev1.TLSPrivateKeyKey],
+		Certificate:     secret.Data[corev1.TLSCertKey],
+		CA:              secret.Data[cmmeta.TLSCAKey],
+		CertificateName: crt.Name,
+		IssuerName:      crt.Spec.IssuerRef.Name,
+		IssuerKind:      crt.Spec.IssuerRef.Kind,
+		IssuerGroup:     crt.Spec.IssuerRef.Group,
+	}
+	if _, err := c.updateSecretData(ctx, crt, data); err != nil {
+		return err
+	}
+
+	revision := 1
+	crt.Status.Revision = &revision
+	if err := c.updateOrApplyStatus(ctx, crt, false); err != nil {
+		return err
+	}
+
+	log.V(logf.InfoLevel).Info("adopted existing Secret as the first revision of the Certificate")
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonAdopted, "Adopted the existing certificate in Secret %q as revision 1", secret.Name)
 
 	return nil
 }
+
+// updateSecretData updates the Certificate's Secret and TrustConfigMap with
+// the given data. If the TrustConfigMap exists and is not managed by the
+// Certificate it is left untouched, and the TrustConfigMapConflict condition
+// is set on the given Certificate, otherwise the condition is removed.
+// It returns true if the condition was changed, in which case the caller must
+// update the Certificate's status.
+func (c *controller) updateSecretData(ctx context.Context, crt *cmapi.Certificate, data internal.SecretData) (bool, error) {
+	err := c.secretsUpdateData(ctx, crt, data)
+	if errors.Is(err, internal.ErrTrustConfigMapConflict) {
+		return c.setTrustConfigMapConflict(crt, fmt.Sprintf(
+			"ConfigMap %q already exists and is not managed by this Certificate, it will not be updated", crt.Spec.TrustConfigMap.Name)), nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return c.setTrustConfigMapConflict(crt, ""), nil
+}
+
+// setTrustConfigMapConflict sets the TrustConfigMapConflict condition on the
+// given Certificate with the given message, or removes it if the message is
+// empty. A Warning Event is fired when the condition is set or its message
+// changes, so that a conflict is only reported once. It returns true if the
+// condition was changed.
+func (c *controller) setTrustConfigMapConflict(crt *cmapi.Certificate, message string) bool {
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionTrustConfigMapConflict)
+	if message == "" {
+		if cond == nil {
+			return false
+		}
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionTrustConfigMapConflict)
+		return true
+	}
+
+	if cond != nil && cond.Message == message {
+		return false
+	}
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionTrustConfigMapConflict, cmmeta.ConditionTrue, reasonTrustConfigMapConflict, message)
+	c.recorder.Event(crt, corev1.EventTypeWarning, reasonTrustConfigMapConflict, message)
+	return true
+}
+
+// keystorePasswords returns the current passwords of the Certificate's
+// keystores. A password which cannot be resolved is returned as nil, since the
+// error will be surfaced when the keystore is next encoded.
+func (c *controller) keystorePasswords(crt *cmapi.Certificate) internalcertificates.KeystorePasswords {
+	var passwords internalcertificates.KeystorePasswords
+	keystores := crt.Spec.Keystores
+	if keystores == nil {
+		return passwords
+	}
+
+	if keystores.JKS != nil && keystores.JKS.Create {
+		passwords.JKS, _ = internalcertificates.KeystorePassword(c.secretLister, crt.Namespace, "JKS", keystores.JKS.PasswordSecretRef, keystores.JKS.Password)
+	}
+	if keystores.PKCS12 != nil && keystores.PKCS12.Create {
+		passwords.PKCS12, _ = internalcertificates.KeystorePassword(c.secretLister, crt.Namespace, "PKCS12", keystores.PKCS12.PasswordSecretRef, keystores.PKCS12.Password)
+		if keystores.PKCS12.KeyPasswordSecretRef != nil {
+			passwords.PKCS12Key, _ = internalcertificates.KeystorePassword(c.secretLister, crt.Namespace, "PKCS12 key", *keystores.PKCS12.KeyPasswordSecretRef, nil)
+		}
+	}
+
+	return passwords
+}
+
+// getTrustConfigMap returns the Certificate's TrustConfigMap, or nil if the
+// Certificate has no TrustConfigMap or it does not exist.
+func (c *controller) getTrustConfigMap(crt *cmapi.Certificate) (*corev1.ConfigMap, error) {
+	if crt.Spec.TrustConfigMap == nil {
+		return nil, nil
+	}
+
+	configMap, err := c.configMapLister.ConfigMaps(crt.Namespace).Get(crt.Spec.TrustConfigMap.Name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return configMap, err
+}
@@ -18,12 +18,15 @@ package issuing
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	"github.com/cert-manager/cert-manager/internal/pem"
@@ -57,6 +60,9 @@ func Test_ensureSecretData(t *testing.T) {
 		// secret is the optional secret to be loaded into the fake clientset.
 		secret *corev1.Secret
 
+		// configMap is the optional configmap to be loaded into the fake clientset.
+		configMap *corev1.ConfigMap
+
 		// expectedAction is true if the test expects that the controller should
 		// reconcile the Secret.
 		expectedAction bool
@@ -303,6 +309,130 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			expectedAction: false,
 		},
+		"if Certificate has a TrustConfigMap which does not exist, should reconcile Secret": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+				Spec: cmapi.CertificateSpec{
+					SecretName:     "test-secret",
+					TrustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "test-configmap", IncludeCertificate: true},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+					Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager: fieldManager,
+						FieldsV1: metav1.NewFieldsV1(`{"f:metadata": {
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {}
+							},
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {}
+							}
+						}}`)},
+					},
+				},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			expectedAction: true,
+		},
+		"if Certificate has a TrustConfigMap which is up to date, should do nothing": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+				Spec: cmapi.CertificateSpec{
+					SecretName:     "test-secret",
+					TrustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "test-configmap", IncludeCertificate: true},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+					Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager: fieldManager,
+						FieldsV1: metav1.NewFieldsV1(`{"f:metadata": {
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {}
+							},
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {}
+							}
+						}}`)},
+					},
+				},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-configmap",
+					Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager:  fieldManager,
+						FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.crt": {}}}`)},
+					},
+				},
+				Data: map[string]string{"tls.crt": string(cert)},
+			},
+			expectedAction: false,
+		},
+		"if Certificate has a TrustConfigMap with extra data owned by the field manager, should reconcile Secret": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+				Spec: cmapi.CertificateSpec{
+					SecretName:     "test-secret",
+					TrustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "test-configmap", IncludeCertificate: true},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+					Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager: fieldManager,
+						FieldsV1: metav1.NewFieldsV1(`{"f:metadata": {
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {}
+							},
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {}
+							}
+						}}`)},
+					},
+				},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-configmap",
+					Labels: map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager:  fieldManager,
+						FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.crt": {}, "f:ca.crt": {}}}`)},
+					},
+				},
+				Data: map[string]string{"tls.crt": string(cert)},
+			},
+			expectedAction: true,
+		},
 		"if Certificate exists in a false Issuing condition, Secret exists but does not match SecretTemplate, should apply the Labels and Annotations": {
 			cert: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
@@ -1110,6 +1240,9 @@ func Test_ensureSecretData(t *testing.T) {
 				// Ensures secret is loaded into the builder's fake clientset.
 				builder.KubeObjects = append(builder.KubeObjects, test.secret)
 			}
+			if test.configMap != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.configMap)
+			}
 
 			// Initialise with RESTConfig which is used to discover the User Agent.
 			builder.InitWithRESTConfig()
@@ -1151,3 +1284,231 @@ func Test_ensureSecretData(t *testing.T) {
 		})
 	}
 }
+
+func Test_ensureSecretData_adoption(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	cert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "test"}})
+
+	adoptingCert := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test-namespace",
+			Name:        "test-name",
+			Annotations: map[string]string{cmapi.AdoptSecretAnnotationKey: "true"},
+		},
+		Spec: cmapi.CertificateSpec{
+			CommonName: "test",
+			SecretName: "test-secret",
+			IssuerRef:  cmmeta.IssuerReference{Name: "test-issuer", Kind: "Issuer", Group: "cert-manager.io"},
+		},
+	}
+	existingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: pk,
+		},
+	}
+
+	tests := map[string]struct {
+		cert   *cmapi.Certificate
+		secret *corev1.Secret
+
+		expectedSecretData *internal.SecretData
+		expectedEvents     []string
+	}{
+		"if the Certificate requests adoption and the Secret matches the spec, should adopt the Secret as revision 1": {
+			cert:   adoptingCert,
+			secret: existingSecret,
+			expectedSecretData: &internal.SecretData{
+				PrivateKey:      pk,
+				Certificate:     cert,
+				CertificateName: "test-name",
+				IssuerName:      "test-issuer",
+				IssuerKind:      "Issuer",
+				IssuerGroup:     "cert-manager.io",
+			},
+			expectedEvents: []string{`Normal Adopted Adopted the existing certificate in Secret "test-secret" as revision 1`},
+		},
+		"if the Certificate does not request adoption, should not adopt the Secret": {
+			cert: func() *cmapi.Certificate {
+				crt := adoptingCert.DeepCopy()
+				crt.Annotations = nil
+				return crt
+			}(),
+			secret: existingSecret,
+		},
+		"if the Certificate has already been issued, should not adopt the Secret": {
+			cert: func() *cmapi.Certificate {
+				crt := adoptingCert.DeepCopy()
+				crt.Status.Revision = new(3)
+				return crt
+			}(),
+			secret: existingSecret,
+		},
+		"if the certificate in the Secret does not match the spec, should not adopt the Secret": {
+			cert: func() *cmapi.Certificate {
+				crt := adoptingCert.DeepCopy()
+				crt.Spec.CommonName = "other"
+				return crt
+			}(),
+			secret: existingSecret,
+		},
+		"if the Secret was issued for another Certificate, should not adopt the Secret": {
+			cert: adoptingCert,
+			secret: func() *corev1.Secret {
+				secret := existingSecret.DeepCopy()
+				secret.Annotations = map[string]string{cmapi.CertificateNameKey: "other"}
+				return secret
+			}(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.cert},
+				KubeObjects:        []runtime.Object{test.secret},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.expectedSecretData != nil {
+				expectedCert := test.cert.DeepCopy()
+				expectedCert.Status.Revision = new(1)
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						test.cert.Namespace,
+						expectedCert,
+					)),
+				)
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			_, _, err := w.Register(builder.Context)
+			assert.NoError(t, err)
+
+			var adoptedData *internal.SecretData
+			w.secretsUpdateData = func(_ context.Context, _ *cmapi.Certificate, data internal.SecretData) error {
+				// The post issuance policy chain also updates the metadata of
+				// Secrets which have not been adopted, using the issuer
+				// annotations already present on the Secret.
+				if data.IssuerName != "" {
+					adoptedData = &data
+				}
+				return nil
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err = w.controller.ProcessItem(t.Context(), types.NamespacedName{Namespace: test.cert.Namespace, Name: test.cert.Name})
+			assert.NoError(t, err)
+
+			assert.Equal(t, test.expectedSecretData, adoptedData)
+			builder.CheckAndFinish()
+		})
+	}
+}
+
+func Test_ensureSecretData_trustConfigMapConflict(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	cert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "test"}})
+
+	fixedNow := metav1.NewTime(fixedClockStart)
+	conflictMessage := `ConfigMap "test-configmap" already exists and is not managed by this Certificate, it will not be updated`
+	conflictCondition := cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionTrustConfigMapConflict,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             reasonTrustConfigMapConflict,
+		Message:            conflictMessage,
+		LastTransitionTime: &fixedNow,
+	}
+
+	baseCert := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+		Spec: cmapi.CertificateSpec{
+			SecretName:     "test-secret",
+			TrustConfigMap: &cmapi.CertificateTrustConfigMap{Name: "test-configmap"},
+		},
+	}
+	// The Secret is missing the managed label, so the Secret data is always
+	// re-applied.
+	outdatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: pk,
+		},
+	}
+
+	tests := map[string]struct {
+		conditions []cmapi.CertificateCondition
+		updateErr  error
+
+		expectedStatusUpdate bool
+		expectedConditions   []cmapi.CertificateCondition
+		expectedEvents       []string
+	}{
+		"if the TrustConfigMap is not managed by the Certificate, should set the condition and fire an Event": {
+			updateErr:            fmt.Errorf("%w: test", internal.ErrTrustConfigMapConflict),
+			expectedStatusUpdate: true,
+			expectedConditions:   []cmapi.CertificateCondition{conflictCondition},
+			expectedEvents:       []string{"Warning TrustConfigMapConflict " + conflictMessage},
+		},
+		"if the conflict has already been reported, should not update the Certificate or fire an Event": {
+			conditions: []cmapi.CertificateCondition{conflictCondition},
+			updateErr:  fmt.Errorf("%w: test", internal.ErrTrustConfigMapConflict),
+		},
+		"if the conflict has been resolved, should remove the condition": {
+			conditions:           []cmapi.CertificateCondition{conflictCondition},
+			expectedStatusUpdate: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := baseCert.DeepCopy()
+			crt.Status.Conditions = test.conditions
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				CertManagerObjects: []runtime.Object{crt},
+				KubeObjects:        []runtime.Object{outdatedSecret},
+				ExpectedEvents:     test.expectedEvents,
+			}
+			if test.expectedStatusUpdate {
+				expectedCert := crt.DeepCopy()
+				expectedCert.Status.Conditions = test.expectedConditions
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						crt.Namespace,
+						expectedCert,
+					)),
+				)
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			_, _, err := w.Register(builder.Context)
+			assert.NoError(t, err)
+
+			w.secretsUpdateData = func(context.Context, *cmapi.Certificate, internal.SecretData) error {
+				return test.updateErr
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err = w.controller.ProcessItem(t.Context(), types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name})
+			assert.NoError(t, err)
+
+			builder.CheckAndFinish()
+		})
+	}
+}
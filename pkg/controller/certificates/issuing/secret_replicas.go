@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// reasonSecretReplicaUpdated is the reason of the Event fired when a
+	// replica of a Certificate's Secret is created or updated.
+	reasonSecretReplicaUpdated = "SecretReplicaUpdated"
+
+	// reasonSecretReplicaDeleted is the reason of the Event fired when a
+	// replica of a Certificate's Secret is deleted because its namespace is
+	// no longer a replica target.
+	reasonSecretReplicaDeleted = "SecretReplicaDeleted"
+
+	// reasonSecretReplicaConflict is the reason of the Event fired when a
+	// Secret which is not a replica of the Certificate's Secret already
+	// exists in a target namespace.
+	reasonSecretReplicaConflict = "SecretReplicaConflict"
+
+	// secretReplicaOfIndex is the name of the Secret index which maps the
+	// value of the SecretReplicaOfAnnotationKey annotation to the replicas.
+	secretReplicaOfIndex = "cert-manager.io/secret-replica-of"
+)
+
+// secretReplicaOfIndexFunc indexes replica Secrets by the Certificate which
+// they are a replica of.
+func secretReplicaOfIndexFunc(obj any) ([]string, error) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil, nil
+	}
+	replicaOf, ok := secret.Annotations[cmapi.SecretReplicaOfAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	return []string{replicaOf}, nil
+}
+
+// ensureSecretReplicas ensures that a replica of the Certificate's Secret
+// exists in every namespace selected by the Certificate's SecretReplicas
+// which has opted in to receiving replicas from the Certificate's namespace,
+// and that replicas in any other namespace are removed.
+func (c *controller) ensureSecretReplicas(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, secret *corev1.Secret) error {
+	// Replicas can only be managed if the controller watches all namespaces.
+	if c.namespaceLister == nil {
+		return nil
+	}
+
+	targets, err := c.secretReplicaNamespaces(crt)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range targets {
+		replica, err := c.secretLister.Secrets(namespace).Get(secret.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if apierrors.IsNotFound(err) {
+			replica = nil
+		}
+
+		// Never overwrite a Secret which is not a replica of this
+		// Certificate's Secret.
+		if replica != nil && replica.Annotations[cmapi.SecretReplicaOfAnnotationKey] != internalcertificates.SecretReplicaOf(crt) {
+			c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretReplicaConflict,
+				"Not replicating Secret to namespace %q as a Secret named %q which is not a replica already exists", namespace, secret.Name)
+			continue
+		}
+
+		reason, message, violation := c.secretReplicaPolicyChain.Evaluate(policies.Input{
+			Certificate:   crt,
+			Secret:        secret,
+			SecretReplica: replica,
+		})
+		if !violation {
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("applying Secret replica", "namespace", namespace, "reason", reason, "message", message)
+		if err := c.secretsUpdateReplica(ctx, internalcertificates.SecretReplicaFor(crt, secret, namespace)); err != nil {
+			return err
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonSecretReplicaUpdated, "Updated replica of Secret %q in namespace %q: %s", secret.Name, namespace, message)
+	}
+
+	// Remove replicas from namespaces which are no longer targets.
+	deleted, err := c.pruneSecretReplicas(ctx, log, internalcertificates.SecretReplicaOf(crt), sets.New(targets...))
+	if err != nil {
+		return err
+	}
+	for _, replica := range deleted {
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonSecretReplicaDeleted, "Deleted replica of Secret %q in namespace %q", replica.Name, replica.Namespace)
+	}
+
+	return nil
+}
+
+// deleteSecretReplicas deletes all replicas of the Secret of the deleted
+// Certificate with the given key.
+func (c *controller) deleteSecretReplicas(ctx context.Context, log logr.Logger, key types.NamespacedName) error {
+	if c.namespaceLister == nil {
+		return nil
+	}
+	_, err := c.pruneSecretReplicas(ctx, log, key.String(), nil)
+	return err
+}
+
+// pruneSecretReplicas deletes the Secrets which are replicas of the Secret of
+// the Certificate identified by replicaOf, and which are not in one of the
+// namespaces to keep. The deleted replicas are returned.
+func (c *controller) pruneSecretReplicas(ctx context.Context, log logr.Logger, replicaOf string, keep sets.Set[string]) ([]*corev1.Secret, error) {
+	replicas, err := c.secretIndexer.ByIndex(secretReplicaOfIndex, replicaOf)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []*corev1.Secret
+	for _, obj := range replicas {
+		replica, ok := obj.(*corev1.Secret)
+		if !ok || keep.Has(replica.Namespace) {
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("deleting Secret replica", "namespace", replica.Namespace, "name", replica.Name)
+		if err := c.secretsClient.Secrets(replica.Namespace).Delete(ctx, replica.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete secret replica %s/%s: %w", replica.Namespace, replica.Name, err)
+		}
+		deleted = append(deleted, replica)
+	}
+
+	return deleted, nil
+}
+
+// secretReplicaNamespaces returns the sorted names of the existing namespaces
+// which are selected by the Certificate's SecretReplicas and have opted in to
+// receiving replicas from the Certificate's namespace.
+func (c *controller) secretReplicaNamespaces(crt *cmapi.Certificate) ([]string, error) {
+	if crt.Spec.SecretReplicas == nil {
+		return nil, nil
+	}
+
+	var candidates []*corev1.Namespace
+	for _, name := range crt.Spec.SecretReplicas.Namespaces {
+		namespace, err := c.namespaceLister.Get(name)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, namespace)
+	}
+
+	if crt.Spec.SecretReplicas.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(crt.Spec.SecretReplicas.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secretReplicas namespaceSelector: %w", err)
+		}
+		selected, err := c.namespaceLister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, selected...)
+	}
+
+	targets := sets.New[string]()
+	for _, namespace := range candidates {
+		if namespace.Name == crt.Namespace || namespace.DeletionTimestamp != nil {
+			continue
+		}
+		if internalcertificates.SecretReplicasAllowedFrom(namespace, crt.Namespace) {
+			targets.Insert(namespace.Name)
+		}
+	}
+
+	names := targets.UnsortedList()
+	slices.Sort(names)
+	return names, nil
+}
+
+// enqueueCertificateForSecretReplica enqueues the Certificate referenced by
+// the SecretReplicaOfAnnotationKey annotation of a replica Secret, so that
+// changes to or deletion of the replica are reconciled.
+func enqueueCertificateForSecretReplica(queue workqueue.TypedInterface[types.NamespacedName]) func(*corev1.Secret) {
+	return func(secret *corev1.Secret) {
+		replicaOf, ok := secret.Annotations[cmapi.SecretReplicaOfAnnotationKey]
+		if !ok {
+			return
+		}
+		namespace, name, err := cache.SplitMetaNamespaceKey(replicaOf)
+		if err != nil || namespace == "" {
+			return
+		}
+		queue.Add(types.NamespacedName{Namespace: namespace, Name: name})
+	}
+}
+
+// enqueueCertificatesWithSecretReplicas enqueues all Certificates which
+// replicate their Secret, so that changes to namespaces are reconciled.
+func enqueueCertificatesWithSecretReplicas(
+	log logr.Logger, queue workqueue.TypedInterface[types.NamespacedName], lister cmlisters.CertificateLister,
+) func(*corev1.Namespace) {
+	return func(_ *corev1.Namespace) {
+		crts, err := lister.List(labels.Everything())
+		if err != nil {
+			log.Error(err, "Failed listing Certificate resources")
+			return
+		}
+		for _, crt := range crts {
+			if crt.Spec.SecretReplicas != nil {
+				queue.Add(types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name})
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+)
+
+func Test_ensureSecretReplicas(t *testing.T) {
+	namespace := func(name, allowFrom string, labels map[string]string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		if allowFrom != "" {
+			ns.Annotations = map[string]string{cmapi.AllowSecretReplicasFromAnnotationKey: allowFrom}
+		}
+		return ns
+	}
+
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+		Spec: cmapi.CertificateSpec{
+			SecretName: "test-secret",
+			SecretReplicas: &cmapi.CertificateSecretReplicas{
+				Namespaces:        []string{"allowed", "not-allowed", "missing"},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"replicas": "true"}},
+			},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test-namespace",
+			Name:        "test-secret",
+			Labels:      map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"},
+			Annotations: map[string]string{cmapi.CertificateNameKey: "test-name"},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")},
+	}
+	replicaIn := func(namespace string) *corev1.Secret {
+		return internalcertificates.SecretReplicaFor(crt, secret, namespace)
+	}
+
+	tests := map[string]struct {
+		crt             *cmapi.Certificate
+		kubeObjects     []runtime.Object
+		namespaced      bool
+		expectedApplied []string
+		expectedDeleted []string
+		expectedEvents  []string
+	}{
+		"should replicate to listed and selected namespaces which have opted in": {
+			crt: crt,
+			kubeObjects: []runtime.Object{
+				namespace("allowed", "test-namespace", nil),
+				namespace("not-allowed", "other-namespace", nil),
+				namespace("selected", "*", map[string]string{"replicas": "true"}),
+				namespace("selected-not-allowed", "", map[string]string{"replicas": "true"}),
+			},
+			expectedApplied: []string{"allowed", "selected"},
+			expectedEvents: []string{
+				`Normal SecretReplicaUpdated Updated replica of Secret "test-secret" in namespace "allowed": Secret replica does not exist`,
+				`Normal SecretReplicaUpdated Updated replica of Secret "test-secret" in namespace "selected": Secret replica does not exist`,
+			},
+		},
+		"should not update replicas which are up to date": {
+			crt: crt,
+			kubeObjects: []runtime.Object{
+				namespace("allowed", "test-namespace", nil),
+				replicaIn("allowed"),
+			},
+		},
+		"should update replicas whose data has drifted": {
+			crt: crt,
+			kubeObjects: []runtime.Object{
+				namespace("allowed", "test-namespace", nil),
+				func() *corev1.Secret {
+					replica := replicaIn("allowed")
+					replica.Data[corev1.TLSCertKey] = []byte("other")
+					return replica
+				}(),
+			},
+			expectedApplied: []string{"allowed"},
+			expectedEvents: []string{
+				`Normal SecretReplicaUpdated Updated replica of Secret "test-secret" in namespace "allowed": Secret replica data does not match the Secret for keys: [tls.crt]`,
+			},
+		},
+		"should not overwrite a Secret which is not a replica": {
+			crt: crt,
+			kubeObjects: []runtime.Object{
+				namespace("allowed", "test-namespace", nil),
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "allowed", Name: "test-secret"}},
+			},
+			expectedEvents: []string{
+				`Warning SecretReplicaConflict Not replicating Secret to namespace "allowed" as a Secret named "test-secret" which is not a replica already exists`,
+			},
+		},
+		"should delete replicas in namespaces which are no longer targets": {
+			crt: crt,
+			kubeObjects: []runtime.Object{
+				namespace("not-allowed", "other-namespace", nil),
+				replicaIn("not-allowed"),
+			},
+			expectedDeleted: []string{"not-allowed"},
+			expectedEvents: []string{
+				`Normal SecretReplicaDeleted Deleted replica of Secret "test-secret" in namespace "not-allowed"`,
+			},
+		},
+		"should not manage replicas if the controller is scoped to a namespace": {
+			crt:         crt,
+			namespaced:  true,
+			kubeObjects: []runtime.Object{namespace("allowed", "test-namespace", nil)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.crt},
+				KubeObjects:        append([]runtime.Object{secret}, test.kubeObjects...),
+				ExpectedEvents:     test.expectedEvents,
+			}
+			for _, ns := range test.expectedDeleted {
+				builder.ExpectedActions = append(builder.ExpectedActions,
+					testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), ns, secret.Name)))
+			}
+			builder.Init()
+			if test.namespaced {
+				builder.Context.Namespace = test.crt.Namespace
+			}
+
+			w := &controllerWrapper{}
+			_, _, err := w.Register(builder.Context)
+			assert.NoError(t, err)
+
+			var applied []string
+			w.secretsUpdateReplica = func(_ context.Context, replica *corev1.Secret) error {
+				assert.Equal(t, replicaIn(replica.Namespace), replica)
+				applied = append(applied, replica.Namespace)
+				return nil
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err = w.controller.ensureSecretReplicas(t.Context(), logr.Discard(), test.crt, secret)
+			assert.NoError(t, err)
+
+			assert.Equal(t, test.expectedApplied, applied)
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// reasonRotationStaged is the reason of the Event fired when the next
+	// certificate and private key of a staged private key rotation have been
+	// published to the Certificate's Secret.
+	reasonRotationStaged = "RotationStaged"
+)
+
+// stageRotation publishes the issued certificate and its private key to the
+// `next.crt` and `next.key` entries of the Certificate's Secret if the
+// Certificate has a private key rotation overlap configured, and the issuance
+// rotates the private key currently stored in the Secret. It returns true
+// while the overlap period has not passed, in which case the issuance must not
+// be completed yet. Once it returns false, the issued certificate and private
+// key should be promoted to `tls.crt` and `tls.key`.
+func (c *controller) stageRotation(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) (bool, error) {
+	if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.RotationOverlap == nil {
+		return false, nil
+	}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// A rotation can only be staged if the Secret contains a current
+	// certificate and private key, and the private key is being rotated.
+	currentCert, err := utilpki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return false, nil
+	}
+	currentPK, err := utilpki.DecodePrivateKeyBytes(secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return false, nil
+	}
+	if equal, _ := utilpki.PublicKeysEqual(currentPK.Public(), pk.Public()); equal {
+		return false, nil
+	}
+
+	// The published time is only valid for the ongoing issuance if it was
+	// recorded after the Issuing condition was set.
+	now := c.clock.Now()
+	publishedTime := now
+	published := false
+	if t := crt.Status.NextPrivateKeyPublishedTime; t != nil {
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil && !t.Before(cond.LastTransitionTime) {
+			publishedTime = t.Time
+			published = true
+		}
+	}
+
+	// Never keep serving the current certificate after it has expired.
+	promotionTime := publishedTime.Add(crt.Spec.PrivateKey.RotationOverlap.Duration)
+	if currentCert.NotAfter.Before(promotionTime) {
+		promotionTime = currentCert.NotAfter
+	}
+	if !now.Before(promotionTime) {
+		log.V(logf.InfoLevel).Info("rotation overlap has passed, promoting next certificate and private key")
+		return false, nil
+	}
+
+	pkData, err := utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
+	if err != nil {
+		return false, err
+	}
+	crt = crt.DeepCopy()
+	conditionChanged := false
+	if !bytes.Equal(secret.Data[cmapi.NextCertificateSecretKey], req.Status.Certificate) ||
+		!bytes.Equal(secret.Data[cmapi.NextPrivateKeySecretKey], pkData) {
+		log.V(logf.InfoLevel).Info("publishing next certificate and private key to Secret", "promotion_time", promotionTime)
+		if conditionChanged, err = c.updateSecretData(ctx, crt, internal.SecretData{
+			PrivateKey:      secret.Data[corev1.TLSPrivateKeyKey],
+			Certificate:     secret.Data[corev1.TLSCertKey],
+			CA:              secret.Data[cmmeta.TLSCAKey],
+			CertificateName: secret.Annotations[cmapi.CertificateNameKey],
+			IssuerName:      secret.Annotations[cmapi.IssuerNameAnnotationKey],
+			IssuerKind:      secret.Annotations[cmapi.IssuerKindAnnotationKey],
+			IssuerGroup:     secret.Annotations[cmapi.IssuerGroupAnnotationKey],
+			NextPrivateKey:  pkData,
+			NextCertificate: req.Status.Certificate,
+		}); err != nil {
+			return false, err
+		}
+	}
+
+	if !published {
+		crt.Status.NextPrivateKeyPublishedTime = &metav1.Time{Time: publishedTime}
+	}
+	if !published || conditionChanged {
+		if err := c.updateOrApplyStatus(ctx, crt, false); err != nil {
+			return false, err
+		}
+	}
+	if !published {
+		// The next certificate has been issued, so the issuance duration is
+		// observed now rather than once the rotation overlap has passed.
+		if issuingSince := issuingConditionTime(crt); !issuingSince.IsZero() {
+			c.metrics.ObserveCertificateIssuanceDuration(publishedTime.Sub(issuingSince), req.Spec.IssuerRef)
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRotationStaged,
+			"Published the next certificate and private key to the %q and %q entries of Secret %q, they will be promoted at %s",
+			cmapi.NextCertificateSecretKey, cmapi.NextPrivateKeySecretKey, secret.Name, promotionTime.UTC().Format(time.RFC3339))
+	}
+
+	c.scheduledWorkQueue.Add(types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}, promotionTime.Sub(now))
+
+	return true, nil
+}
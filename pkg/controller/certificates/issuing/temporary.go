@@ -72,9 +72,15 @@ func (c *controller) ensureTemporaryCertificate(ctx context.Context, crt *cmapi.
 		PrivateKey:      pkData,
 		CertificateName: crt.Name,
 	}
-	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
+	changed, err := c.updateSecretData(ctx, crt, secretData)
+	if err != nil {
 		return false, err
 	}
+	if changed {
+		if err := c.updateOrApplyStatus(ctx, crt, false); err != nil {
+			return false, err
+		}
+	}
 
 	c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", "Issued temporary certificate")
 
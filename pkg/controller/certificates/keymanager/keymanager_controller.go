@@ -186,11 +186,25 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return c.setNextPrivateKeySecretName(ctx, crt, nil)
 	}
 
+	// The private key of a Certificate issued from an external CSR is held
+	// outside of cert-manager, so no private key is generated.
+	if crt.Spec.ExternalCSR != nil {
+		log.V(logf.DebugLevel).Info("Cleaning up Secret resources and unsetting nextPrivateKeySecretName as the Certificate is issued from an external CSR")
+		if err := c.deleteSecretResources(ctx, secrets); err != nil {
+			return err
+		}
+		return c.setNextPrivateKeySecretName(ctx, crt, nil)
+	}
+
 	// if there is no existing Secret resource, create a new one
 	if len(secrets) == 0 {
 		// PrivateKey is a pointer, but it will never be nil because we called
 		// the SetRuntimeDefaults function at the start of this function.
 		rotationPolicy := crt.Spec.PrivateKey.RotationPolicy
+		if privateKeyRotationRequested(crt) {
+			log.V(logf.DebugLevel).Info("Creating new nextPrivateKeySecretName Secret as private key rotation was requested by a renewal request")
+			return c.createAndSetNextPrivateKey(ctx, crt)
+		}
 		switch rotationPolicy {
 		case cmapi.RotationPolicyNever:
 			return c.createNextPrivateKeyRotationPolicyNever(ctx, crt)
@@ -245,6 +259,20 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	return nil
 }
 
+// privateKeyRotationRequested returns true if the ongoing issuance was
+// triggered by a renewal request in spec.renewalRequest which asked for the
+// private key to be rotated. The renewal request is handled at the same time
+// as the Issuing condition is set, so a request handled before the Issuing
+// condition was last set belongs to an earlier issuance.
+func privateKeyRotationRequested(crt *cmapi.Certificate) bool {
+	last := crt.Status.LastRenewalRequest
+	if last == nil || !last.RotatePrivateKey || last.HandledTime == nil {
+		return false
+	}
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
+	return cond != nil && cond.LastTransitionTime != nil && !last.HandledTime.Before(cond.LastTransitionTime)
+}
+
 func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context, crt *cmapi.Certificate) error {
 	log := logf.FromContext(ctx)
 	s, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
@@ -86,6 +86,9 @@ type controller struct {
 	policyEvaluator policyEvaluatorFunc
 	// renewalTimeCalculator calculates renewal time of a certificate
 	renewalTimeCalculator pki.RenewalTimeFunc
+	// maxRenewalJitter is the maximum amount by which the renewal time of a
+	// certificate is brought forward to spread out renewals.
+	maxRenewalJitter time.Duration
 
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
@@ -119,6 +122,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	configMapInformer := ctx.KubeSharedInformerFactory.ConfigMaps()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -155,6 +159,7 @@ func NewController(
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
+		configMapInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
 		issuerInformer.Informer().HasSynced,
 	}
@@ -171,9 +176,11 @@ func NewController(
 		gatherer: &policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
 			SecretLister:             secretsInformer.Lister(),
+			ConfigMapLister:          configMapInformer.Lister(),
 		},
 		policyEvaluator:       policyEvaluator,
 		renewalTimeCalculator: renewalTimeCalculator,
+		maxRenewalJitter:      ctx.MaxRenewalJitter,
 		fieldManager:          ctx.FieldManager,
 		clock:                 ctx.Clock,
 		scheduledWorkQueue:    scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
@@ -228,7 +235,8 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 
 		// If there is no renewal time from ARI or if the featuregate is disabled.
 		if renewalTime == nil || renewalTime.IsZero() {
-			renewalTime, err = c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage, crt.Spec.Renewal)
+			renewalTime, err = c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage, crt.Spec.Renewal,
+				pki.WithRenewalJitter(key.String(), c.maxRenewalJitter))
 		}
 		if err != nil {
 			reason := policies.WindowError
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestmanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// issuanceBudget limits the rate at which new CertificateRequests are created,
+// both across all issuers and for each individual issuer. Each budget allows
+// up to `limit` CertificateRequests to be created per interval.
+// A nil *issuanceBudget allows all requests.
+type issuanceBudget struct {
+	clock          clock.Clock
+	interval       time.Duration
+	perIssuerLimit int
+
+	// global is nil if there is no global limit.
+	global *rate.Limiter
+
+	lock      sync.Mutex
+	perIssuer map[string]*rate.Limiter
+}
+
+// newIssuanceBudget returns a new issuanceBudget, or nil if neither a global
+// nor a per issuer limit is configured.
+func newIssuanceBudget(c clock.Clock, interval time.Duration, globalLimit, perIssuerLimit int) *issuanceBudget {
+	if interval <= 0 || (globalLimit <= 0 && perIssuerLimit <= 0) {
+		return nil
+	}
+
+	b := &issuanceBudget{
+		clock:          c,
+		interval:       interval,
+		perIssuerLimit: perIssuerLimit,
+		perIssuer:      make(map[string]*rate.Limiter),
+	}
+	if globalLimit > 0 {
+		b.global = newBudgetLimiter(interval, globalLimit)
+	}
+	return b
+}
+
+// newBudgetLimiter returns a limiter allowing up to limit tokens to be taken
+// per interval. The rate is computed in floating point so that limits larger
+// than the number of nanoseconds in the interval do not round it to zero,
+// which rate.Every would treat as an infinite rate.
+func newBudgetLimiter(interval time.Duration, limit int) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(float64(limit)/interval.Seconds()), limit)
+}
+
+// issuanceReservation holds the tokens taken from the issuance budgets for a
+// single CertificateRequest. A nil *issuanceReservation holds no tokens.
+type issuanceReservation struct {
+	clock        clock.Clock
+	reservations []*rate.Reservation
+}
+
+// cancel returns the tokens held by the reservation to their budgets. It must
+// be called if the CertificateRequest the tokens were taken for is not
+// created, so that failed attempts do not drain the budgets.
+func (r *issuanceReservation) cancel() {
+	if r == nil {
+		return
+	}
+
+	now := r.clock.Now()
+	for _, reservation := range r.reservations {
+		reservation.CancelAt(now)
+	}
+}
+
+// reserve takes a token from the global and issuer budgets for the given
+// Certificate. If either budget is exhausted, no tokens are taken and a
+// description of the exhausted budget is returned alongside how long to wait
+// before trying again.
+func (b *issuanceBudget) reserve(crt *cmapi.Certificate) (*issuanceReservation, time.Duration, string) {
+	if b == nil {
+		return nil, 0, ""
+	}
+
+	now := b.clock.Now()
+	issuer := issuerBudgetKey(crt)
+
+	reservation := &issuanceReservation{clock: b.clock}
+	var delay time.Duration
+	var exhausted string
+	take := func(l *rate.Limiter, name string) {
+		r := l.ReserveN(now, 1)
+		reservation.reservations = append(reservation.reservations, r)
+		if d := r.DelayFrom(now); d > delay {
+			delay = d
+			exhausted = fmt.Sprintf("%s issuance budget of %d CertificateRequests per %s", name, l.Burst(), b.interval)
+		}
+	}
+
+	if b.global != nil {
+		take(b.global, "global")
+	}
+	if l := b.issuerLimiter(issuer, now); l != nil {
+		take(l, fmt.Sprintf("issuer %q", issuer))
+	}
+
+	if delay > 0 {
+		reservation.cancel()
+		return nil, delay, exhausted
+	}
+
+	return reservation, 0, ""
+}
+
+func (b *issuanceBudget) issuerLimiter(issuer string, now time.Time) *rate.Limiter {
+	if b.perIssuerLimit <= 0 {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	l, ok := b.perIssuer[issuer]
+	if !ok {
+		b.prune(now)
+		l = newBudgetLimiter(b.interval, b.perIssuerLimit)
+		b.perIssuer[issuer] = l
+	}
+	return l
+}
+
+// prune removes the limiters of issuers whose budget has fully refilled, as
+// they behave the same as a newly created limiter. It is called whenever a
+// limiter is added so that the number of limiters is bounded by the number of
+// issuers which have been used within the last interval.
+// The caller must hold the lock.
+func (b *issuanceBudget) prune(now time.Time) {
+	for issuer, l := range b.perIssuer {
+		if l.TokensAt(now) >= float64(b.perIssuerLimit) {
+			delete(b.perIssuer, issuer)
+		}
+	}
+}
+
+// issuerBudgetKey returns a key uniquely identifying the issuer referenced by
+// the given Certificate, defaulting the issuer kind and group. Issuers are
+// assumed to be namespaced unless their kind is ClusterIssuer.
+func issuerBudgetKey(crt *cmapi.Certificate) string {
+	kind, group := crt.Spec.IssuerRef.Kind, crt.Spec.IssuerRef.Group
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	if group == "" {
+		group = certmanager.GroupName
+	}
+
+	if kind == cmapi.ClusterIssuerKind {
+		return fmt.Sprintf("%s.%s/%s", kind, group, crt.Spec.IssuerRef.Name)
+	}
+	return fmt.Sprintf("%s.%s/%s/%s", kind, group, crt.Namespace, crt.Spec.IssuerRef.Name)
+}
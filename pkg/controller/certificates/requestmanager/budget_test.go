@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestmanager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_newIssuanceBudget(t *testing.T) {
+	c := fakeclock.NewFakeClock(time.Now())
+	assert.Nil(t, newIssuanceBudget(c, time.Minute, 0, 0), "expected no budget if no limits are set")
+	assert.Nil(t, newIssuanceBudget(c, 0, 1, 1), "expected no budget if no interval is set")
+	assert.NotNil(t, newIssuanceBudget(c, time.Minute, 1, 0))
+	assert.NotNil(t, newIssuanceBudget(c, time.Minute, 0, 1))
+
+	var b *issuanceBudget
+	reservation, delay, _ := b.reserve(gen.Certificate("test"))
+	assert.Zero(t, delay, "expected a nil budget to allow all requests")
+	reservation.cancel()
+}
+
+func Test_newBudgetLimiter(t *testing.T) {
+	assert.Equal(t, rate.Limit(3.0/3600), newBudgetLimiter(time.Hour, 3).Limit())
+	assert.Equal(t, rate.Limit(2e9), newBudgetLimiter(time.Microsecond, 2000).Limit(),
+		"expected a limit larger than the interval in nanoseconds not to be treated as an infinite rate")
+}
+
+func Test_issuanceBudget_reserve(t *testing.T) {
+	crtWithIssuer := func(namespace, name string, ref cmmeta.IssuerReference) *cmapi.Certificate {
+		return gen.Certificate(name, gen.SetCertificateNamespace(namespace), gen.SetCertificateIssuer(ref))
+	}
+	issuerA := cmmeta.IssuerReference{Name: "a"}
+	issuerB := cmmeta.IssuerReference{Name: "b"}
+
+	t.Run("global limit applies across issuers", func(t *testing.T) {
+		c := fakeclock.NewFakeClock(time.Now())
+		b := newIssuanceBudget(c, time.Minute, 2, 0)
+
+		_, delay, _ := b.reserve(crtWithIssuer("ns", "1", issuerA))
+		assert.Zero(t, delay)
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "2", issuerB))
+		assert.Zero(t, delay)
+
+		_, delay, budget := b.reserve(crtWithIssuer("ns", "3", issuerA))
+		assert.Equal(t, 30*time.Second, delay)
+		assert.Equal(t, "global issuance budget of 2 CertificateRequests per 1m0s", budget)
+
+		c.Step(30 * time.Second)
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "3", issuerA))
+		assert.Zero(t, delay)
+	})
+
+	t.Run("per issuer limit applies to each issuer separately", func(t *testing.T) {
+		c := fakeclock.NewFakeClock(time.Now())
+		b := newIssuanceBudget(c, time.Minute, 0, 1)
+
+		_, delay, _ := b.reserve(crtWithIssuer("ns", "1", issuerA))
+		assert.Zero(t, delay)
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "2", issuerB))
+		assert.Zero(t, delay)
+		_, delay, _ = b.reserve(crtWithIssuer("other-ns", "1", issuerA))
+		assert.Zero(t, delay, "expected Issuers in different namespaces to have separate budgets")
+
+		_, delay, budget := b.reserve(crtWithIssuer("ns", "3", issuerA))
+		assert.Equal(t, time.Minute, delay)
+		assert.Equal(t, `issuer "Issuer.cert-manager.io/ns/a" issuance budget of 1 CertificateRequests per 1m0s`, budget)
+	})
+
+	t.Run("exhausted issuer budget does not consume the global budget", func(t *testing.T) {
+		c := fakeclock.NewFakeClock(time.Now())
+		b := newIssuanceBudget(c, time.Minute, 2, 1)
+
+		_, delay, _ := b.reserve(crtWithIssuer("ns", "1", issuerA))
+		assert.Zero(t, delay)
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "2", issuerA))
+		assert.Equal(t, time.Minute, delay)
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "3", issuerB))
+		assert.Zero(t, delay)
+	})
+
+	t.Run("cancelled reservations return their tokens to the budgets", func(t *testing.T) {
+		c := fakeclock.NewFakeClock(time.Now())
+		b := newIssuanceBudget(c, time.Minute, 1, 1)
+
+		reservation, delay, _ := b.reserve(crtWithIssuer("ns", "1", issuerA))
+		assert.Zero(t, delay)
+		reservation.cancel()
+
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "2", issuerA))
+		assert.Zero(t, delay, "expected the tokens of the cancelled reservation to be available")
+	})
+
+	t.Run("limiters of issuers whose budget has refilled are pruned", func(t *testing.T) {
+		c := fakeclock.NewFakeClock(time.Now())
+		b := newIssuanceBudget(c, time.Minute, 0, 1)
+
+		_, delay, _ := b.reserve(crtWithIssuer("ns", "1", issuerA))
+		assert.Zero(t, delay)
+		assert.Len(t, b.perIssuer, 1)
+
+		c.Step(30 * time.Second)
+		_, delay, _ = b.reserve(crtWithIssuer("ns", "2", issuerB))
+		assert.Zero(t, delay)
+		assert.Len(t, b.perIssuer, 2, "expected the limiter of an issuer which is still refilling to be kept")
+
+		c.Step(time.Minute)
+		_, delay, _ = b.reserve(crtWithIssuer("other-ns", "1", issuerA))
+		assert.Zero(t, delay)
+		assert.Len(t, b.perIssuer, 1, "expected the limiters of refilled issuers to be pruned")
+	})
+}
+
+func Test_issuerBudgetKey(t *testing.T) {
+	tests := map[string]struct {
+		ref  cmmeta.IssuerReference
+		want string
+	}{
+		"defaults kind and group": {
+			ref:  cmmeta.IssuerReference{Name: "issuer"},
+			want: "Issuer.cert-manager.io/ns/issuer",
+		},
+		"ClusterIssuers are not namespaced": {
+			ref:  cmmeta.IssuerReference{Name: "issuer", Kind: cmapi.ClusterIssuerKind},
+			want: "ClusterIssuer.cert-manager.io/issuer",
+		},
+		"external issuers are namespaced": {
+			ref:  cmmeta.IssuerReference{Name: "issuer", Kind: "AWSPCAIssuer", Group: "awspca.cert-manager.io"},
+			want: "AWSPCAIssuer.awspca.cert-manager.io/ns/issuer",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.Certificate("test", gen.SetCertificateNamespace("ns"), gen.SetCertificateIssuer(test.ref))
+			assert.Equal(t, test.want, issuerBudgetKey(crt))
+		})
+	}
+}
+
+func TestProcessItem_issuanceBudget(t *testing.T) {
+	bundle := mustCreateCryptoBundle(t, &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: "test"},
+		Spec:       cmapi.CertificateSpec{CommonName: "test-bundle", IssuerRef: cmmeta.IssuerReference{Name: "ca-issuer"}},
+	})
+	fixedClock := fakeclock.NewFakeClock(time.Now())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "exists"},
+		Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle.privateKeyBytes},
+	}
+	request := gen.CertificateRequestFrom(bundle.certificateRequest,
+		gen.SetCertificateRequestName("test-1"),
+		gen.SetCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+			cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+		}),
+	)
+	const message = `Issuance is rate limited by the issuer "Issuer.cert-manager.io/testns/ca-issuer" issuance budget of 1 CertificateRequests per 1h0m0s, a CertificateRequest will be created once the budget allows`
+	rateLimitedCondition := cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue, Reason: reasonIssuanceRateLimited, Message: message}
+
+	tests := map[string]struct {
+		issuingCondition cmapi.CertificateCondition
+		requests         []runtime.Object
+		// exhausted exhausts the issuer's budget before the Certificate is
+		// processed.
+		exhausted bool
+		// createErr is returned when creating a CertificateRequest.
+		createErr error
+
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+		expectedErr     bool
+		// expectBudgetAvailable checks that the issuer's budget still allows a
+		// CertificateRequest to be created after the Certificate is processed.
+		expectBudgetAvailable bool
+	}{
+		"set the Issuing condition reason and fire an Event if the budget is exhausted": {
+			issuingCondition: cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue, Reason: "ManuallyTriggered"},
+			exhausted:        true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(bundle.certificate,
+						gen.SetCertificateNextPrivateKeySecretName("exists"),
+						gen.SetCertificateStatusCondition(rateLimitedCondition),
+					),
+				)),
+			},
+			expectedEvents: []string{"Normal IssuanceRateLimited " + message},
+		},
+		"do not update the Issuing condition or fire an Event if it is already rate limited": {
+			issuingCondition: rateLimitedCondition,
+			exhausted:        true,
+		},
+		"clear the rate limited Issuing condition reason once the CertificateRequest exists": {
+			issuingCondition: rateLimitedCondition,
+			requests:         []runtime.Object{request},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(bundle.certificate,
+						gen.SetCertificateNextPrivateKeySecretName("exists"),
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:    cmapi.CertificateConditionIssuing,
+							Status:  cmmeta.ConditionTrue,
+							Reason:  reasonRequested,
+							Message: `Created new CertificateRequest resource "test-1"`,
+						}),
+					),
+				)),
+			},
+		},
+		"return the budget if the CertificateRequest cannot be created": {
+			issuingCondition: cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue, Reason: "ManuallyTriggered"},
+			createErr:        errors.New("this is a create error"),
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", nil),
+					func(coretesting.Action, coretesting.Action) error { return nil },
+				),
+			},
+			expectedEvents:        []string{"Warning RequestFailed Failed to create CertificateRequest: this is a create error"},
+			expectedErr:           true,
+			expectBudgetAvailable: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := gen.CertificateFrom(bundle.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(test.issuingCondition),
+			)
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				KubeObjects:        []runtime.Object{secret},
+				CertManagerObjects: append([]runtime.Object{crt}, test.requests...),
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.CertificateOptions.IssuanceRateLimitInterval = time.Hour
+			builder.CertificateOptions.IssuancePerIssuerLimit = 1
+			if test.createErr != nil {
+				builder.FakeCMClient().PrependReactor("create", "certificaterequests", func(coretesting.Action) (bool, runtime.Object, error) {
+					return true, nil, test.createErr
+				})
+			}
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			if test.exhausted {
+				if _, delay, _ := w.issuanceBudget.reserve(crt); delay != 0 {
+					t.Fatalf("expected budget to be available, got delay %s", delay)
+				}
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key := types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}
+			err := w.controller.ProcessItem(t.Context(), key)
+			if test.expectedErr != (err != nil) {
+				t.Errorf("unexpected error, exp=%t got=%v", test.expectedErr, err)
+			}
+
+			if test.expectBudgetAvailable {
+				if _, delay, _ := w.issuanceBudget.reserve(crt); delay != 0 {
+					t.Errorf("expected the budget to be available, got delay %s", delay)
+				}
+			}
+
+			builder.CheckAndFinish()
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"strconv"
@@ -31,11 +32,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -46,6 +49,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
@@ -55,6 +59,15 @@ const (
 	ControllerName      = "certificates-request-manager"
 	reasonRequestFailed = "RequestFailed"
 	reasonRequested     = "Requested"
+
+	// reasonExternalCSRInvalid is the reason of the Event fired when the
+	// external CSR of a Certificate cannot be used to request a certificate.
+	reasonExternalCSRInvalid = "ExternalCSRInvalid"
+
+	// reasonIssuanceRateLimited is set as the reason of the Issuing condition
+	// whilst a Certificate is waiting for the issuance budget to allow a new
+	// CertificateRequest to be created.
+	reasonIssuanceRateLimited = "IssuanceRateLimited"
 )
 
 var (
@@ -65,6 +78,7 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             internalinformers.SecretLister
+	configMapLister          corelisters.ConfigMapLister
 	client                   cmclient.Interface
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
@@ -74,6 +88,11 @@ type controller struct {
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Create or Apply API calls.
 	fieldManager string
+
+	// issuanceBudget limits the rate at which new CertificateRequests are
+	// created. It is nil if issuance is not rate limited.
+	issuanceBudget     *issuanceBudget
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
 }
 
 func NewController(
@@ -90,6 +109,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	configMapInformer := ctx.KubeSharedInformerFactory.ConfigMaps()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -116,11 +136,36 @@ func NewController(
 	); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Trigger reconciles on changes to the Secret named `spec.externalCSR.name`,
+			// which may not be labelled as being part of cert-manager
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[metav1.Object](predicate.CertificateExternalCSRSecretName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := configMapInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			// Trigger reconciles on changes to the ConfigMap named `spec.externalCSR.name`,
+			// which may not be labelled as being part of cert-manager
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[metav1.Object](predicate.CertificateExternalCSRConfigMapName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		secretsInformer.Informer().HasSynced,
+		configMapInformer.Informer().HasSynced,
 		certificateRequestInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
 	}
@@ -129,11 +174,18 @@ func NewController(
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		configMapLister:          configMapInformer.Lister(),
 		client:                   ctx.CMClient,
 		recorder:                 ctx.Recorder,
 		clock:                    ctx.Clock,
 		copiedAnnotationPrefixes: ctx.CertificateOptions.CopiedAnnotationPrefixes,
 		fieldManager:             ctx.FieldManager,
+		issuanceBudget: newIssuanceBudget(ctx.Clock,
+			ctx.CertificateOptions.IssuanceRateLimitInterval,
+			ctx.CertificateOptions.IssuanceGlobalLimit,
+			ctx.CertificateOptions.IssuancePerIssuerLimit,
+		),
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
 	}, queue, mustSync, nil
 }
 
@@ -160,27 +212,46 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
-	// Check for and fetch the 'status.nextPrivateKeySecretName' secret
-	if crt.Status.NextPrivateKeySecretName == nil {
-		log.V(logf.DebugLevel).Info("status.nextPrivateKeySecretName not yet set, waiting for keymanager before processing certificate")
-		return nil
-	}
-	nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
-	if apierrors.IsNotFound(err) {
-		log.V(logf.DebugLevel).Info("nextPrivateKeySecretName Secret resource does not exist, waiting for keymanager to create it before continuing")
-		return nil
-	}
-	if err != nil {
-		return err
-	}
-	if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
-		log.V(logf.DebugLevel).Info("Next private key secret does not contain any valid data, waiting for keymanager before processing certificate")
-		return nil
-	}
-	pk, err := pki.DecodePrivateKeyBytes(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey])
-	if err != nil {
-		log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
-		return nil
+	var (
+		pk                       crypto.Signer
+		publicKey                crypto.PublicKey
+		externalCSR              []byte
+		nextPrivateKeySecretName string
+	)
+	if crt.Spec.ExternalCSR != nil {
+		// The CertificateRequest is created from the external CSR, as the
+		// private key is held outside of cert-manager.
+		var csr *x509.CertificateRequest
+		externalCSR, csr = c.fetchExternalCSR(ctx, crt)
+		if csr == nil {
+			return nil
+		}
+		publicKey = csr.PublicKey
+	} else {
+		// Check for and fetch the 'status.nextPrivateKeySecretName' secret
+		if crt.Status.NextPrivateKeySecretName == nil {
+			log.V(logf.DebugLevel).Info("status.nextPrivateKeySecretName not yet set, waiting for keymanager before processing certificate")
+			return nil
+		}
+		nextPrivateKeySecret, err := c.secretLister.Secrets(crt.Namespace).Get(*crt.Status.NextPrivateKeySecretName)
+		if apierrors.IsNotFound(err) {
+			log.V(logf.DebugLevel).Info("nextPrivateKeySecretName Secret resource does not exist, waiting for keymanager to create it before continuing")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if nextPrivateKeySecret.Data == nil || len(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+			log.V(logf.DebugLevel).Info("Next private key secret does not contain any valid data, waiting for keymanager before processing certificate")
+			return nil
+		}
+		pk, err = pki.DecodePrivateKeyBytes(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
+			return nil
+		}
+		publicKey = pk.Public()
+		nextPrivateKeySecretName = nextPrivateKeySecret.Name
 	}
 
 	// Discover all 'owned' CertificateRequests
@@ -209,7 +280,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return err
 	}
 
-	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, pk.Public(), requests...)
+	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, publicKey, requests...)
 	if err != nil {
 		return err
 	}
@@ -228,12 +299,122 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	}
 
 	if len(requests) == 1 {
-		// Nothing to do as we've already verified that the CertificateRequest
-		// is up to date above.
+		// Nothing else to do as we've already verified that the
+		// CertificateRequest is up to date above.
+		return c.clearIssuanceRateLimited(ctx, crt, requests[0])
+	}
+
+	reservation, delay, budget := c.issuanceBudget.reserve(crt)
+	if delay > 0 {
+		return c.waitForIssuanceBudget(ctx, key, crt, budget, delay)
+	}
+
+	csrPEM := externalCSR
+	if csrPEM == nil {
+		if csrPEM, err = c.generateCSR(ctx, crt, pk); err != nil || csrPEM == nil {
+			reservation.cancel()
+			return err
+		}
+	}
+
+	return c.createNewCertificateRequest(ctx, crt, reservation, csrPEM, nextRevision, nextPrivateKeySecretName)
+}
+
+// fetchExternalCSR returns the external CSR of the Certificate, along with the
+// decoded request, if it can be used to request a certificate for the
+// Certificate's spec. Otherwise, a Warning Event is fired and nil is returned.
+// The Certificate is re-queued once the Secret or ConfigMap containing the CSR
+// changes.
+func (c *controller) fetchExternalCSR(ctx context.Context, crt *cmapi.Certificate) ([]byte, *x509.CertificateRequest) {
+	log := logf.FromContext(ctx)
+
+	csrPEM, csr, err := certificates.ExternalCSR(crt, c.secretLister, c.configMapLister)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("External CSR cannot be used, waiting for it to be updated", "error", err)
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonExternalCSRInvalid, "Failed to fetch the external CSR: %s", err)
+		return nil, nil
+	}
+
+	violations, err := pki.RequestMatchesSpec(&cmapi.CertificateRequest{
+		Spec: cmapi.CertificateRequestSpec{
+			IssuerRef: crt.Spec.IssuerRef,
+			Request:   csrPEM,
+			IsCA:      crt.Spec.IsCA,
+			Usages:    apiutil.CertificateUsages(&crt.Spec),
+		},
+	}, crt.Spec)
+	if err != nil {
+		// this case cannot happen as the CSR has already been decoded
+		log.Error(err, "Failed to check if the external CSR matches spec")
+		return nil, nil
+	}
+	if len(violations) > 0 {
+		log.V(logf.DebugLevel).Info("External CSR does not match requirements on certificate.spec, waiting for it to be updated", "violations", violations)
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonExternalCSRInvalid, "The external CSR does not match the Certificate spec: %v", violations)
+		return nil, nil
+	}
+
+	return csrPEM, csr
+}
+
+// waitForIssuanceBudget marks the Certificate's Issuing condition as being
+// rate limited by the given budget, and requeues the Certificate once the
+// budget is expected to allow a new CertificateRequest to be created. An
+// Event is only fired when the condition changes, so that requeues of a
+// waiting Certificate do not repeat it.
+func (c *controller) waitForIssuanceBudget(ctx context.Context, key types.NamespacedName, crt *cmapi.Certificate, budget string, delay time.Duration) error {
+	log := logf.FromContext(ctx)
+	log.V(logf.DebugLevel).Info("Issuance budget exhausted, delaying creation of CertificateRequest", "budget", budget, "delay", delay)
+
+	c.scheduledWorkQueue.Add(key, delay)
+
+	message := fmt.Sprintf("Issuance is rate limited by the %s, a CertificateRequest will be created once the budget allows", budget)
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil &&
+		cond.Reason == reasonIssuanceRateLimited && cond.Message == message {
+		return nil
+	}
+
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, reasonIssuanceRateLimited, message)
+	if err := c.updateOrApplyStatus(ctx, crt); err != nil {
+		return err
+	}
+	c.recorder.Event(crt, corev1.EventTypeNormal, reasonIssuanceRateLimited, message)
+	return nil
+}
+
+// clearIssuanceRateLimited replaces the rate limited reason of the
+// Certificate's Issuing condition once the CertificateRequest it was waiting
+// to create exists. The Certificate is requeued when the CertificateRequest
+// is created, so the condition is cleared even if the CertificateRequest was
+// created by an earlier attempt whose status update failed.
+func (c *controller) clearIssuanceRateLimited(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest) error {
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond == nil || cond.Reason != reasonIssuanceRateLimited {
 		return nil
 	}
 
-	return c.createNewCertificateRequest(ctx, crt, pk, nextRevision, nextPrivateKeySecret.Name)
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue,
+		reasonRequested, fmt.Sprintf("Created new CertificateRequest resource %q", req.Name))
+	return c.updateOrApplyStatus(ctx, crt)
+}
+
+// updateOrApplyStatus will update the Certificate's Issuing condition. If the
+// ServerSideApply feature is enabled, the condition will instead get applied
+// using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		var conditions []cmapi.CertificateCondition
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
+			conditions = []cmapi.CertificateCondition{*cond}
+		}
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Conditions: conditions},
+		})
+	}
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
 }
 
 func (c *controller) deleteCurrentFailedRequests(ctx context.Context, crt *cmapi.Certificate, reqs ...*cmapi.CertificateRequest) ([]*cmapi.CertificateRequest, error) {
@@ -364,7 +545,10 @@ func (c *controller) deleteRequestsNotMatchingSpec(ctx context.Context, crt *cma
 	return remaining, nil
 }
 
-func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer, nextRevision int, nextPrivateKeySecretName string) error {
+// generateCSR returns a PEM encoded CSR for the Certificate's spec, signed by
+// the given private key. If the CSR cannot be generated for the spec, a
+// Warning Event is fired and nil is returned.
+func (c *controller) generateCSR(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) ([]byte, error) {
 	log := logf.FromContext(ctx)
 
 	x509CSR, err := pki.GenerateCSR(
@@ -377,22 +561,33 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	if err != nil {
 		log.Error(err, "Failed to generate CSR - will not retry")
 		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "Failed to generate CSR: %s - will not retry", err.Error())
-		return nil
+		return nil, nil
 	}
 	csrDER, err := pki.EncodeCSR(x509CSR, pk)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	csrPEM := bytes.NewBuffer([]byte{})
 	err = pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	return csrPEM.Bytes(), nil
+}
+
+// createNewCertificateRequest creates a CertificateRequest for the given CSR.
+// The tokens held by the issuance reservation are returned to the budget if
+// the CertificateRequest cannot be created.
+func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi.Certificate, reservation *issuanceReservation, csrPEM []byte, nextRevision int, nextPrivateKeySecretName string) error {
 	annotations := controllerpkg.BuildAnnotationsToCopy(crt.Annotations, c.copiedAnnotationPrefixes)
 	annotations[cmapi.CertificateRequestRevisionAnnotationKey] = strconv.Itoa(nextRevision)
-	annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = nextPrivateKeySecretName
+	// The private key annotation is not set if the CSR was created outside of
+	// cert-manager.
+	if nextPrivateKeySecretName != "" {
+		annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = nextPrivateKeySecretName
+	}
 	annotations[cmapi.CertificateNameKey] = crt.Name
 
 	cr := &cmapi.CertificateRequest{
@@ -409,9 +604,9 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 		Spec: cmapi.CertificateRequestSpec{
 			Duration:  crt.Spec.Duration,
 			IssuerRef: crt.Spec.IssuerRef,
-			Request:   csrPEM.Bytes(),
+			Request:   csrPEM,
 			IsCA:      crt.Spec.IsCA,
-			Usages:    crt.Spec.Usages,
+			Usages:    apiutil.CertificateUsages(&crt.Spec),
 		},
 	}
 
@@ -427,14 +622,16 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 		// name as follows: <first-168-chars-of-certificate-name>-<64-char-hash>-<19-char-nextRevision>
 		crName, err := apiutil.ComputeSecureUniqueDeterministicNameFromData(crt.Name, 233)
 		if err != nil {
+			reservation.cancel()
 			return err
 		}
 
 		cr.ObjectMeta.Name = fmt.Sprintf("%s-%d", crName, nextRevision)
 	}
 
-	cr, err = c.client.CertmanagerV1().CertificateRequests(cr.Namespace).Create(ctx, cr, metav1.CreateOptions{FieldManager: c.fieldManager})
+	cr, err := c.client.CertmanagerV1().CertificateRequests(cr.Namespace).Create(ctx, cr, metav1.CreateOptions{FieldManager: c.fieldManager})
 	if err != nil {
+		reservation.cancel()
 		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonRequestFailed, "Failed to create CertificateRequest: %s", err.Error())
 		return err
 	}
@@ -17,6 +17,7 @@ limitations under the License.
 package requestmanager
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"strings"
@@ -242,6 +243,60 @@ func TestProcessItem(t *testing.T) {
 					)), relaxedCertificateRequestMatcher),
 			},
 		},
+		"create a CertificateRequest from the external CSR if none exists": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle3.certificate.Namespace, Name: "csr"},
+					Data:       map[string][]byte{cmapi.CertificateSigningRequestKey: bundle3.csrBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle3.certificate,
+				gen.SetCertificateExternalCSR(cmapi.CertificateExternalCSR{Name: "csr"}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-1"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle3.certificateRequest,
+						gen.SetCertificateRequestName("test-1"),
+						gen.SetCertificateRequestCSR(bundle3.csrBytes),
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "1",
+						}),
+						func(cr *cmapi.CertificateRequest) {
+							delete(cr.Annotations, cmapi.CertificateRequestPrivateKeyAnnotationKey)
+						},
+					)), func(l coretesting.Action, r coretesting.Action) error {
+					// The external CSR must be used as-is.
+					reqL := l.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest).Spec.Request
+					reqR := r.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest).Spec.Request
+					if !bytes.Equal(reqL, reqR) {
+						return fmt.Errorf("unexpected CSR, exp=%q, got=%q", reqL, reqR)
+					}
+					return relaxedCertificateRequestMatcher(l, r)
+				}),
+			},
+		},
+		"record a Warning event if the external CSR does not exist": {
+			certificate: gen.CertificateFrom(bundle3.certificate,
+				gen.SetCertificateExternalCSR(cmapi.CertificateExternalCSR{Name: "csr"}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Warning ExternalCSRInvalid Failed to fetch the external CSR: secret "csr" not found`},
+		},
+		"record a Warning event if the external CSR does not match the spec": {
+			secrets: []runtime.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle3.certificate.Namespace, Name: "csr"},
+					Data:       map[string]string{"request.pem": string(bundle1.csrBytes)},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle3.certificate,
+				gen.SetCertificateExternalCSR(cmapi.CertificateExternalCSR{Kind: "ConfigMap", Name: "csr", Key: "request.pem"}),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Warning ExternalCSRInvalid The external CSR does not match the Certificate spec: [spec.commonName]`},
+		},
 		"create a CertificateRequest if none exists (with long name)": {
 			secrets: []runtime.Object{
 				&corev1.Secret{
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -44,6 +46,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/scheduler"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -52,6 +55,15 @@ import (
 
 const (
 	ControllerName = "certificates-trigger"
+
+	// reasonSecretDrift is the reason of the Warning Event fired when a
+	// Certificate's Secret has been modified outside of cert-manager.
+	reasonSecretDrift = "SecretDrift"
+
+	// reasonSecretNotAdopted is the reason of the Warning Event fired when a
+	// Certificate requests adoption of its existing Secret, but the Secret
+	// cannot be adopted.
+	reasonSecretNotAdopted = "SecretNotAdopted"
 )
 
 // This controller observes the state of the certificate's currently
@@ -65,6 +77,7 @@ type controller struct {
 	secretLister                             internalinformers.SecretLister
 	client                                   cmclient.Interface
 	recorder                                 record.EventRecorder
+	metrics                                  *metrics.Metrics
 	scheduledWorkQueue                       scheduler.ScheduledWorkQueue[types.NamespacedName]
 	certificateRequestMinimumBackoffDuration time.Duration
 	certificateRequestMaximumBackoffDuration time.Duration
@@ -74,6 +87,22 @@ type controller struct {
 	// Apply API calls.
 	fieldManager string
 
+	// adoptionPolicyChain is the policy chain which must pass for the
+	// existing Secret of a Certificate requesting adoption to be adopted by
+	// the issuing controller rather than a new certificate being issued.
+	adoptionPolicyChain policies.Chain
+
+	// renewalPolicy is evaluated on its own when the Certificate's Secret
+	// has drifted and the drift policy is AlertOnly, so that the Certificate
+	// is still renewed before it expires.
+	renewalPolicy policies.Func
+
+	// observedSecretDrift records the Secret drift most recently alerted on
+	// for each Certificate, so that the Event and metric are only recorded
+	// once per change to the Secret rather than on every resync.
+	observedSecretDriftLock sync.Mutex
+	observedSecretDrift     map[types.NamespacedName]string
+
 	// The following are used for testing purposes.
 	clock              clock.Clock
 	shouldReissue      policies.Func
@@ -97,6 +126,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	configMapInformer := ctx.KubeSharedInformerFactory.ConfigMaps()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -125,12 +155,38 @@ func NewController(
 	); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	// When the Secret or ConfigMap containing the external CSR of a
+	// Certificate changes, enqueue the Certificate. Only the name of the
+	// resource is used, so that changes to resources which are not labelled
+	// as being part of cert-manager, and so only have their metadata cached,
+	// also enqueue the Certificate.
+	if _, err := secretsInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[metav1.Object](predicate.CertificateExternalCSRSecretName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := configMapInformer.Informer().AddEventHandler(
+		controllerpkg.BlockingEventHandler(
+			certificates.EnqueueCertificatesForResourceUsingPredicates(
+				log, queue, certificateInformer.Lister(),
+				predicate.ExtractResourceName[metav1.Object](predicate.CertificateExternalCSRConfigMapName),
+			),
+		),
+	); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
+		configMapInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
 	}
 
@@ -140,8 +196,12 @@ func NewController(
 		secretLister:                             secretsInformer.Lister(),
 		client:                                   ctx.CMClient,
 		recorder:                                 ctx.Recorder,
+		metrics:                                  ctx.Metrics,
 		scheduledWorkQueue:                       scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
 		fieldManager:                             ctx.FieldManager,
+		adoptionPolicyChain:                      policies.NewSecretAdoptionPolicyChain(ctx.Clock),
+		renewalPolicy:                            policies.CurrentCertificateNearingExpiry(ctx.Clock, ctx.MaxRenewalJitter),
+		observedSecretDrift:                      make(map[types.NamespacedName]string),
 		certificateRequestMinimumBackoffDuration: ctx.CertificateRequestMinimumBackoffDuration,
 		certificateRequestMaximumBackoffDuration: ctx.CertificateRequestMaximumBackoffDuration,
 
@@ -151,6 +211,7 @@ func NewController(
 		dataForCertificate: (&policies.Gatherer{
 			CertificateRequestLister: certificateRequestInformer.Lister(),
 			SecretLister:             secretsInformer.Lister(),
+			ConfigMapLister:          configMapInformer.Lister(),
 		}).DataForCertificate,
 	}, queue, mustSync, nil
 }
@@ -167,6 +228,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if crt == nil || crt.DeletionTimestamp != nil {
 		// If the Certificate object was/ is being deleted, we don't want to start scheduling
 		// renewals.
+		c.forgetSecretDrift(key)
 		return nil
 	}
 
@@ -204,6 +266,12 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return err
 	}
 
+	// A manual renewal request is handled regardless of any back-off due to
+	// previously failed issuances, or of the Certificate's renewal policy.
+	if reason, message, requested := policies.CertificateRenewalRequested(input); requested {
+		return c.handleRenewalRequest(ctx, log, crt, reason, message)
+	}
+
 	// Don't trigger issuance if we need to back off due to previous failures and Certificate's spec has not changed.
 	backoff, delay := shouldBackoffReissuingOnFailure(log, c.clock, input.Certificate, input.NextRevisionRequest, c.certificateRequestMinimumBackoffDuration, c.certificateRequestMaximumBackoffDuration)
 	if backoff {
@@ -228,9 +296,49 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	}
 
 	if !reissue {
+		c.forgetSecretDrift(key)
 		return nil
 	}
 
+	if policies.SecretAdoptionRequested(crt) {
+		_, adoptionMessage, violation := c.adoptionPolicyChain.Evaluate(input)
+		if !violation {
+			// The issuing controller adopts the existing Secret as the
+			// first revision, after which the Secret's annotations will
+			// match and no issuance is needed.
+			log.V(logf.DebugLevel).Info("Certificate has requested adoption of its existing Secret, waiting for the issuing controller to adopt it")
+			return nil
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretNotAdopted,
+			"Existing Secret %q cannot be adopted, a new certificate will be issued: %s", crt.Spec.SecretName, adoptionMessage)
+	}
+
+	drift, err := policies.SecretDriftForViolation(input, c.fieldManager, reason)
+	if err != nil {
+		log.Error(err, "failed to determine whether Secret has drifted")
+	}
+	if drift == nil {
+		c.forgetSecretDrift(key)
+	} else {
+		alertOnly := crt.Annotations[cmapi.SecretDriftPolicyAnnotationKey] == cmapi.SecretDriftPolicyAlertOnly
+		if c.observeSecretDrift(key, input.Secret, drift) {
+			c.recordSecretDrift(log, crt, drift, message, alertOnly)
+		}
+		if alertOnly {
+			// The drift itself must not cause a re-issuance, but the
+			// Certificate must still be renewed once it nears expiry.
+			var renew bool
+			reason, message, renew = c.renewalPolicy(input)
+			if !renew || policies.IsSecretDriftReason(reason) {
+				return nil
+			}
+			if reason == policies.WindowError {
+				message = fmt.Sprintf("Renewing certificate without satisfying renewal windows at: %s", crt.Status.RenewalTime)
+				reason = policies.Renewing
+			}
+		}
+	}
+
 	// Although the below recorder.Event already logs the event, the log
 	// line is quite unreadable (very long). Since this information is very
 	// important for the user and the operator, we log the following
@@ -247,6 +355,74 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	return nil
 }
 
+// handleRenewalRequest triggers an issuance for the Certificate's manual
+// renewal request, and records the request in status.lastRenewalRequest so
+// that it is only handled once.
+func (c *controller) handleRenewalRequest(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, reason, message string) error {
+	log.V(logf.InfoLevel).Info("Certificate must be re-issued", "reason", reason, "message", message)
+
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, reason, message)
+	request := crt.Spec.RenewalRequest
+	crt.Status.LastRenewalRequest = &cmapi.CertificateRenewalRequestStatus{
+		ID:               request.ID,
+		Reason:           request.Reason,
+		RequestedBy:      request.RequestedBy,
+		RotatePrivateKey: request.RotatePrivateKey,
+		// The keymanager controller compares this time with the time the
+		// Issuing condition was set to determine whether the requested
+		// private key rotation applies to the current issuance.
+		HandledTime: apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing).LastTransitionTime,
+	}
+	if err := c.updateOrApplyStatus(ctx, crt); err != nil {
+		return err
+	}
+	c.recorder.Event(crt, corev1.EventTypeNormal, reason, message)
+
+	return nil
+}
+
+// recordSecretDrift fires a Warning Event and increments the Secret drift
+// metric for a Certificate whose Secret has been modified outside of
+// cert-manager.
+func (c *controller) recordSecretDrift(log logr.Logger, crt *cmapi.Certificate, drift *policies.SecretDrift, message string, alertOnly bool) {
+	managers := strings.Join(drift.Managers, ",")
+	log.V(logf.InfoLevel).Info("Secret was modified outside of cert-manager", "check", drift.Check, "keys", drift.Keys, "field_managers", drift.Managers, "alert_only", alertOnly)
+
+	eventMessage := fmt.Sprintf("Secret %q was modified outside of cert-manager (check: %s, keys: %s, field managers: %s): %s",
+		crt.Spec.SecretName, drift.Check, strings.Join(drift.Keys, ","), managers, message)
+	if alertOnly {
+		eventMessage += fmt.Sprintf(". Not re-issuing as the %s annotation is set to %s", cmapi.SecretDriftPolicyAnnotationKey, cmapi.SecretDriftPolicyAlertOnly)
+	}
+	c.recorder.Event(crt, corev1.EventTypeWarning, reasonSecretDrift, eventMessage)
+
+	c.metrics.IncrementCertificateSecretDrift(crt.Name, crt.Namespace, drift.Check, managers)
+}
+
+// observeSecretDrift records the given drift of the Certificate's Secret and
+// returns true if it has not already been observed for the current version
+// of the Secret.
+func (c *controller) observeSecretDrift(key types.NamespacedName, secret *corev1.Secret, drift *policies.SecretDrift) bool {
+	observed := fmt.Sprintf("%s/%s/%s/%s/%s", secret.UID, secret.ResourceVersion, drift.Check,
+		strings.Join(drift.Keys, ","), strings.Join(drift.Managers, ","))
+
+	c.observedSecretDriftLock.Lock()
+	defer c.observedSecretDriftLock.Unlock()
+	if c.observedSecretDrift[key] == observed {
+		return false
+	}
+	c.observedSecretDrift[key] = observed
+	return true
+}
+
+// forgetSecretDrift removes any drift observed for the Certificate's Secret,
+// so that it is alerted on again if it recurs.
+func (c *controller) forgetSecretDrift(key types.NamespacedName) {
+	c.observedSecretDriftLock.Lock()
+	defer c.observedSecretDriftLock.Unlock()
+	delete(c.observedSecretDrift, key)
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
@@ -258,7 +434,7 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 		}
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
-			Status:     cmapi.CertificateStatus{Conditions: conditions},
+			Status:     cmapi.CertificateStatus{Conditions: conditions, LastRenewalRequest: crt.Status.LastRenewalRequest},
 		})
 	} else {
 		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
@@ -387,7 +563,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Type
 
 	ctrl, queue, mustSync, err := NewController(log,
 		ctx,
-		policies.NewTriggerPolicyChain(ctx.Clock).Evaluate,
+		policies.NewTriggerPolicyChain(ctx.Clock, ctx.MaxRenewalJitter).Evaluate,
 	)
 	c.controller = ctrl
 
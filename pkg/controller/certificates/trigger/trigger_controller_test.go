@@ -47,6 +47,9 @@ func Test_controller_ProcessItem(t *testing.T) {
 		return testcrypto.MustCreateCryptoBundle(t, crt, fixedClock).CertificateRequest
 	}
 
+	adoptionPK := testcrypto.MustCreatePEMPrivateKey(t)
+	adoptionCert := testcrypto.MustCreateCert(t, adoptionPK, gen.Certificate("cert-1", gen.SetCertificateCommonName("example.com")))
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem. If not set, the
 		// 'namespace/name' of the 'Certificate' field will be used. If neither
@@ -78,6 +81,10 @@ func Test_controller_ProcessItem(t *testing.T) {
 		// If empty, an update to the empty set/nil is expected.
 		wantConditions []cmapi.CertificateCondition
 
+		// wantLastRenewalRequest is the expected status.lastRenewalRequest
+		// on the Certificate resource if an Update is made.
+		wantLastRenewalRequest *cmapi.CertificateRenewalRequestStatus
+
 		// wantErr is the expected error text returned by the controller, if any.
 		wantErr string
 	}{
@@ -168,6 +175,192 @@ func Test_controller_ProcessItem(t *testing.T) {
 				ObservedGeneration: 42,
 			}},
 		},
+		"should set Issuing=True and record the renewal request if a renewal has been requested": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateRenewalRequest(cmapi.CertificateRenewalRequest{
+					ID: "incident-123", Reason: "key compromise", RequestedBy: "alice", RotatePrivateKey: true,
+				}),
+				gen.SetCertificateLastRenewalRequest(cmapi.CertificateRenewalRequestStatus{ID: "incident-122"}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantEvent:                    []string{`Normal RenewalRequested Renewal "incident-123" requested by alice: key compromise (private key rotation requested)`},
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "RenewalRequested",
+				Message:            `Renewal "incident-123" requested by alice: key compromise (private key rotation requested)`,
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+			wantLastRenewalRequest: &cmapi.CertificateRenewalRequestStatus{
+				ID: "incident-123", Reason: "key compromise", RequestedBy: "alice", RotatePrivateKey: true,
+				HandledTime: &fixedNow,
+			},
+		},
+		"should not handle a renewal request which has already been handled": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateRenewalRequest(cmapi.CertificateRenewalRequest{ID: "incident-123", Reason: "key compromise"}),
+				gen.SetCertificateLastRenewalRequest(cmapi.CertificateRenewalRequestStatus{ID: "incident-123"}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "", "", false
+				}
+			},
+		},
+		"should fire a SecretDrift event and set Issuing=True if the Secret was modified outside of cert-manager": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateRevision(1),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				Secret: gen.Secret("secret-1", gen.SetSecretNamespace("testns"), gen.SetSecretManagedFields([]metav1.ManagedFieldsEntry{
+					{Manager: "kubectl-edit", FieldsV1: metav1.NewFieldsV1(`{"f:data": {"f:tls.key": {}}}`)},
+				})),
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.InvalidKeyPair, "Issuing certificate as Secret contains a private key that does not match the certificate", true
+				}
+			},
+			wantEvent: []string{
+				`Warning SecretDrift Secret "secret-1" was modified outside of cert-manager (check: InvalidKeyPair, keys: data[tls.key], field managers: kubectl-edit): Issuing certificate as Secret contains a private key that does not match the certificate`,
+				"Normal Issuing Issuing certificate as Secret contains a private key that does not match the certificate",
+			},
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "InvalidKeyPair",
+				Message:            "Issuing certificate as Secret contains a private key that does not match the certificate",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
+		"should fire a SecretDrift event but not set Issuing=True if the Secret drift policy is AlertOnly": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateRevision(1),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.SecretDriftPolicyAnnotationKey: cmapi.SecretDriftPolicyAlertOnly}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				Secret: gen.Secret("secret-1", gen.SetSecretNamespace("testns"), gen.SetSecretManagedFields([]metav1.ManagedFieldsEntry{
+					{Manager: "kubectl-annotate", FieldsV1: metav1.NewFieldsV1(`{"f:metadata": {"f:annotations": {"f:cert-manager.io/issuer-name": {}}}}`)},
+				})),
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.IncorrectIssuer, `Issuing certificate as Secret was previously issued by "Issuer.cert-manager.io/other"`, true
+				}
+			},
+			wantEvent: []string{
+				`Warning SecretDrift Secret "secret-1" was modified outside of cert-manager (check: IncorrectIssuer, keys: annotations[cert-manager.io/issuer-name], field managers: kubectl-annotate): Issuing certificate as Secret was previously issued by "Issuer.cert-manager.io/other". Not re-issuing as the cert-manager.io/secret-drift-policy annotation is set to AlertOnly`,
+			},
+		},
+		"should fire a SecretDrift event and set Issuing=True if the Secret drift policy is AlertOnly but the certificate is due for renewal": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateRevision(1),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.SecretDriftPolicyAnnotationKey: cmapi.SecretDriftPolicyAlertOnly}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				Secret: gen.Secret("secret-1", gen.SetSecretNamespace("testns"),
+					gen.SetSecretData(map[string][]byte{
+						corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, adoptionPK,
+							gen.Certificate("cert-1", gen.SetCertificateCommonName("example.com")),
+							fixedNow.Add(-90*24*time.Hour), fixedNow.Add(-time.Hour)),
+						corev1.TLSPrivateKeyKey: adoptionPK,
+					}),
+					gen.SetSecretManagedFields([]metav1.ManagedFieldsEntry{
+						{Manager: "kubectl-annotate", FieldsV1: metav1.NewFieldsV1(`{"f:metadata": {"f:annotations": {"f:cert-manager.io/issuer-name": {}}}}`)},
+					}),
+				),
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.IncorrectIssuer, `Issuing certificate as Secret was previously issued by "Issuer.cert-manager.io/other"`, true
+				}
+			},
+			wantEvent: []string{
+				`Warning SecretDrift Secret "secret-1" was modified outside of cert-manager (check: IncorrectIssuer, keys: annotations[cert-manager.io/issuer-name], field managers: kubectl-annotate): Issuing certificate as Secret was previously issued by "Issuer.cert-manager.io/other". Not re-issuing as the cert-manager.io/secret-drift-policy annotation is set to AlertOnly`,
+				"Normal Issuing Renewing certificate as renewal was scheduled at <nil>",
+			},
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "Renewing",
+				Message:            "Renewing certificate as renewal was scheduled at <nil>",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
+		"should not set Issuing=True if the Certificate requests adoption and the Secret can be adopted": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateCommonName("example.com"),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.AdoptSecretAnnotationKey: "true"}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				Secret: gen.Secret("secret-1", gen.SetSecretNamespace("testns"), gen.SetSecretData(map[string][]byte{
+					corev1.TLSCertKey:       adoptionCert,
+					corev1.TLSPrivateKeyKey: adoptionPK,
+				})),
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.IncorrectIssuer, `Issuing certificate as Secret was previously issued by ""`, true
+				}
+			},
+		},
+		"should fire a SecretNotAdopted event and set Issuing=True if the Certificate requests adoption but the Secret cannot be adopted": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateCommonName("other.example.com"),
+				gen.SetCertificateGeneration(42),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.AdoptSecretAnnotationKey: "true"}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				Secret: gen.Secret("secret-1", gen.SetSecretNamespace("testns"), gen.SetSecretData(map[string][]byte{
+					corev1.TLSCertKey:       adoptionCert,
+					corev1.TLSPrivateKeyKey: adoptionPK,
+				})),
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.IncorrectIssuer, `Issuing certificate as Secret was previously issued by ""`, true
+				}
+			},
+			wantEvent: []string{
+				`Warning SecretNotAdopted Existing Secret "secret-1" cannot be adopted, a new certificate will be issued: Existing certificate is not up to date for spec: [spec.commonName]`,
+				`Normal Issuing Issuing certificate as Secret was previously issued by ""`,
+			},
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "IncorrectIssuer",
+				Message:            `Issuing certificate as Secret was previously issued by ""`,
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
 		// The combinations of number of failed issuances and last
 		// failed issuance time that do or do not result in re-issuance
 		// are tested in Test_shouldBackoffReissuingOnFailure below
@@ -465,6 +658,9 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 				expectedCert := test.existingCertificate.DeepCopy()
 				expectedCert.Status.Conditions = test.wantConditions
+				if test.wantLastRenewalRequest != nil {
+					expectedCert.Status.LastRenewalRequest = test.wantLastRenewalRequest
+				}
 				builder.ExpectedActions = append(builder.ExpectedActions,
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
 						cmapi.SchemeGroupVersion.WithResource("certificates"),
@@ -973,3 +1169,21 @@ func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 		})
 	}
 }
+
+func Test_observeSecretDrift(t *testing.T) {
+	c := &controller{observedSecretDrift: make(map[types.NamespacedName]string)}
+	key := types.NamespacedName{Namespace: "testns", Name: "cert-1"}
+	drift := &policies.SecretDrift{Check: policies.IncorrectIssuer, Keys: []string{"annotations[cert-manager.io/issuer-name]"}, Managers: []string{"kubectl-annotate"}}
+	secret := gen.Secret("secret-1", gen.SetSecretNamespace("testns"))
+	secret.ResourceVersion = "1"
+
+	assert.True(t, c.observeSecretDrift(key, secret, drift), "first observation of the drift")
+	assert.False(t, c.observeSecretDrift(key, secret, drift), "drift observed again on resync")
+
+	changed := secret.DeepCopy()
+	changed.ResourceVersion = "2"
+	assert.True(t, c.observeSecretDrift(key, changed, drift), "drift observed after the Secret changed")
+
+	c.forgetSecretDrift(key)
+	assert.True(t, c.observeSecretDrift(key, changed, drift), "drift observed again after being forgotten")
+}
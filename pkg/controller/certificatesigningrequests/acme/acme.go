@@ -39,6 +39,7 @@ import (
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmacmeclientset "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/acme/v1"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
@@ -286,6 +287,15 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 		CommonName:  req.Subject.CommonName,
 		DNSNames:    dnsNames,
 		IPAddresses: ipAddresses,
+		Profile:     iss.GetSpec().ACME.Profile,
+	}
+
+	if profile, ok := csr.Annotations[experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey]; ok {
+		spec.Profile = profile
+	}
+
+	if preferredChain, ok := csr.Annotations[experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey]; ok {
+		spec.PreferredChain = preferredChain
 	}
 
 	if iss.GetSpec().ACME.EnableDurationFeature {
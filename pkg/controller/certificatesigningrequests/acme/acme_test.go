@@ -18,6 +18,7 @@ package acme
 
 import (
 	"crypto/x509"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -38,6 +39,7 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	experimentalapi "github.com/cert-manager/cert-manager/pkg/apis/experimental/v1alpha1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
@@ -254,11 +256,125 @@ func Test_ProcessItem(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	csrPEMIP, _, err := gen.CSR(x509.ECDSA,
+		gen.SetCSRCommonName("10.0.0.1"),
+		gen.SetCSRIPAddressesFromStrings("10.0.0.1"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqIP, err := pki.DecodeX509CertificateRequestBytes(csrPEMIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ipCSR := gen.CertificateSigningRequestFrom(baseCSR,
+		gen.SetCertificateSigningRequestRequest(csrPEMIP),
+	)
+	ipOrder, err := new(ACME).buildOrder(ipCSR, reqIP, baseIssuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	annotatedCSR := gen.CertificateSigningRequestFrom(baseCSR,
+		gen.AddCertificateSigningRequestAnnotations(map[string]string{
+			experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey:        "tlsserver",
+			experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey: "ISRG Root X1",
+		}),
+	)
+	annotatedOrder, err := new(ACME).buildOrder(annotatedCSR, req, baseIssuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subjectAccessReviewAction := testpkg.NewAction(coretesting.NewCreateAction(
+		authzv1.SchemeGroupVersion.WithResource("subjectaccessreviews"),
+		"",
+		&authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				User:   "user-1",
+				Groups: []string{"group-1", "group-2"},
+				Extra: map[string]authzv1.ExtraValue{
+					"extra": []string{"1", "2"},
+				},
+				UID: "uid-1",
+
+				ResourceAttributes: &authzv1.ResourceAttributes{
+					Group:     certmanager.GroupName,
+					Resource:  "signers",
+					Verb:      "reference",
+					Namespace: baseIssuer.Namespace,
+					Name:      baseIssuer.Name,
+					Version:   "*",
+				},
+			},
+		},
+	))
+
+	approved := gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+		Type:   certificatesv1.CertificateApproved,
+		Status: corev1.ConditionTrue,
+	})
+
 	tests := map[string]struct {
-		builder     *testpkg.Builder
-		csr         *certificatesv1.CertificateSigningRequest
-		expectedErr bool
+		builder        *testpkg.Builder
+		csr            *certificatesv1.CertificateSigningRequest
+		orderCreateErr error
+		expectedErr    bool
 	}{
+		"an approved CSR where the common name is one of the IP addresses should create the order": {
+			csr: gen.CertificateSigningRequestFrom(ipCSR, approved),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal OrderCreated Created Order resource default-unit-test-ns/" + ipOrder.Name,
+				},
+				ExpectedActions: []testpkg.Action{
+					subjectAccessReviewAction,
+					testpkg.NewAction(coretesting.NewCreateActionWithOptions(
+						cmacme.SchemeGroupVersion.WithResource("orders"),
+						gen.DefaultTestNamespace,
+						ipOrder,
+						metav1.CreateOptions{FieldManager: testpkg.FieldManager},
+					)),
+				},
+			},
+		},
+		"an approved CSR with ACME annotations should create the order with the requested profile and preferred chain": {
+			csr: gen.CertificateSigningRequestFrom(annotatedCSR, approved),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal OrderCreated Created Order resource default-unit-test-ns/" + annotatedOrder.Name,
+				},
+				ExpectedActions: []testpkg.Action{
+					subjectAccessReviewAction,
+					testpkg.NewAction(coretesting.NewCreateActionWithOptions(
+						cmacme.SchemeGroupVersion.WithResource("orders"),
+						gen.DefaultTestNamespace,
+						annotatedOrder,
+						metav1.CreateOptions{FieldManager: testpkg.FieldManager},
+					)),
+				},
+			},
+		},
+		"an approved CSR where the order fails to be created should return an error to retry": {
+			csr:            gen.CertificateSigningRequestFrom(baseCSR, approved),
+			orderCreateErr: errors.New("connection refused"),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer.DeepCopy()},
+				ExpectedEvents:     []string{},
+				ExpectedActions: []testpkg.Action{
+					subjectAccessReviewAction,
+					testpkg.NewAction(coretesting.NewCreateActionWithOptions(
+						cmacme.SchemeGroupVersion.WithResource("orders"),
+						gen.DefaultTestNamespace,
+						baseOrder,
+						metav1.CreateOptions{FieldManager: testpkg.FieldManager},
+					)),
+				},
+			},
+			expectedErr: true,
+		},
 		"a CertificateSigningRequest without an approved condition should fire an event": {
 			csr: gen.CertificateSigningRequestFrom(baseCSR),
 			builder: &testpkg.Builder{
@@ -918,6 +1034,12 @@ func Test_ProcessItem(t *testing.T) {
 				}, nil
 			})
 
+			if test.orderCreateErr != nil {
+				test.builder.FakeCMClient().PrependReactor("create", "orders", func(coretesting.Action) (bool, runtime.Object, error) {
+					return true, nil, test.orderCreateErr
+				})
+			}
+
 			defer test.builder.Stop()
 
 			controller := controllerBuilder()
@@ -961,6 +1083,8 @@ func Test_buildOrder(t *testing.T) {
 
 	tests := map[string]struct {
 		enableDurationFeature bool
+		profile               string
+		annotations           map[string]string
 
 		want    *cmacme.Order
 		wantErr bool
@@ -998,15 +1122,76 @@ func Test_buildOrder(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		"Building with the issuer's profile": {
+			profile: "shortlived",
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Profile:    "shortlived",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Building with a preferred chain annotation": {
+			annotations: map[string]string{
+				experimentalapi.CertificateSigningRequestACMEPreferredChainAnnotationKey: "ISRG Root X1",
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:        csrPEM,
+					CommonName:     "example.com",
+					DNSNames:       []string{"example.com"},
+					PreferredChain: "ISRG Root X1",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"Building with a profile annotation overriding the issuer's profile": {
+			profile: "shortlived",
+			annotations: map[string]string{
+				experimentalapi.CertificateSigningRequestACMEProfileAnnotationKey: "tlsserver",
+			},
+			want: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					Request:    csrPEM,
+					CommonName: "example.com",
+					DNSNames:   []string{"example.com"},
+					Profile:    "tlsserver",
+					IssuerRef: cmmeta.IssuerReference{
+						Name:  "test-name",
+						Kind:  "Issuer",
+						Group: "cert-manager.io",
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			csr := csr.DeepCopy()
+			for k, v := range test.annotations {
+				csr.Annotations[k] = v
+			}
 			got, err := new(ACME).buildOrder(csr, req, &cmapi.Issuer{
 				Spec: cmapi.IssuerSpec{
 					IssuerConfig: cmapi.IssuerConfig{
 						ACME: &cmacme.ACMEIssuer{
 							EnableDurationFeature: test.enableDurationFeature,
+							Profile:               test.profile,
 						},
 					},
 				},
@@ -115,7 +115,7 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 		return nil
 	}
 
-	certPEM, _, err := client.Sign(csr.Spec.Request, duration)
+	certPEM, _, err := client.Sign(csr.Spec.Request, duration, nil)
 	if err != nil {
 		message := fmt.Sprintf("Vault failed to sign: %s", err)
 		log.Error(err, message)
@@ -163,6 +163,7 @@ type ContextOptions struct {
 	CertificateOptions
 	SchedulerOptions
 	ConfigOptions
+	TLSSecretExpiryOptions
 }
 
 type ConfigOptions struct {
@@ -259,6 +260,31 @@ type CertificateOptions struct {
 	// the minimum backoff duration and is exponentially increased with
 	// each consecutive failure, but will never exceed this maximum (default 32h).
 	CertificateRequestMaximumBackoffDuration time.Duration
+	// IssuanceRateLimitInterval is the period over which the global and
+	// per-issuer issuance limits apply.
+	IssuanceRateLimitInterval time.Duration
+	// IssuanceGlobalLimit is the maximum number of CertificateRequests
+	// created for Certificates across all issuers in each interval. 0
+	// disables the limit.
+	IssuanceGlobalLimit int
+	// IssuancePerIssuerLimit is the maximum number of CertificateRequests
+	// created for Certificates referencing the same issuer in each interval.
+	// 0 disables the limit.
+	IssuancePerIssuerLimit int
+	// MaxRenewalJitter is the maximum duration by which the renewal time of a
+	// Certificate is brought forward. 0 disables jitter.
+	MaxRenewalJitter time.Duration
+}
+
+// TLSSecretExpiryOptions configure the tls-secret-expiry controller which
+// notifies about the expiry of kubernetes.io/tls Secrets that are not managed
+// by a Certificate.
+type TLSSecretExpiryOptions struct {
+	// Thresholds are the durations before a Secret's certificate expires at
+	// which a notification is sent.
+	Thresholds []time.Duration
+	// WebhookURL is an optional URL to which notifications are POSTed.
+	WebhookURL string
 }
 
 type SchedulerOptions struct {
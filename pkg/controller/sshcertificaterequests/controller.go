@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshcertificaterequests
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	vaultinternal "github.com/cert-manager/cert-manager/internal/vault"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "sshcertificaterequests-issuer"
+)
+
+// This controller signs approved SSHCertificateRequests which reference a
+// CA issuer with `ssh` set or a Vault issuer, storing the signed SSH
+// certificate on the request's status.
+type controller struct {
+	sshCertificateRequestLister cmlisters.SSHCertificateRequestLister
+	secretLister                internalinformers.SecretLister
+	issuerHelper                issuer.Helper
+
+	cmClient cmclient.Interface
+	recorder record.EventRecorder
+	clock    clock.Clock
+
+	issuerOptions      controllerpkg.IssuerOptions
+	createTokenFn      func(ns string) vaultinternal.CreateToken
+	vaultClientBuilder vaultinternal.ClientBuilder
+}
+
+func NewController(ctx *controllerpkg.Context) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultItemBasedRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	sshCertificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().SSHCertificateRequests()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+
+	if _, err := sshCertificateRequestInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the
+	// Register method.  the controller will only begin processing items once all
+	// of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		sshCertificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+	// obtain a lister for clusterissuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	return &controller{
+		sshCertificateRequestLister: sshCertificateRequestInformer.Lister(),
+		secretLister:                secretsInformer.Lister(),
+		issuerHelper:                issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		cmClient:                    ctx.CMClient,
+		recorder:                    ctx.Recorder,
+		clock:                       ctx.Clock,
+		issuerOptions:               ctx.IssuerOptions,
+		createTokenFn: func(ns string) vaultinternal.CreateToken {
+			return ctx.Client.CoreV1().ServiceAccounts(ns).CreateToken
+		},
+		vaultClientBuilder: vaultinternal.New,
+	}, queue, mustSync, nil
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	req, err := c.sshCertificateRequestLister.SSHCertificateRequests(key.Namespace).Get(key.Name)
+	if k8sErrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch apiutil.SSHCertificateRequestReadyReason(req) {
+	case cmapi.CertificateRequestReasonIssued, cmapi.CertificateRequestReasonFailed, cmapi.CertificateRequestReasonDenied:
+		return nil
+	}
+
+	if apiutil.SSHCertificateRequestIsDenied(req) {
+		log.V(logf.DebugLevel).Info("SSHCertificateRequest has been denied, marking as failed")
+		now := metav1.NewTime(c.clock.Now())
+		return c.updateStatus(ctx, req, func(status *cmapi.SSHCertificateRequest) {
+			status.Status.FailureTime = &now
+			apiutil.SetSSHCertificateRequestCondition(status, cmapi.CertificateRequestConditionReady, cmmeta.ConditionFalse,
+				cmapi.CertificateRequestReasonDenied, "The SSHCertificateRequest was denied by an approval controller")
+		})
+	}
+
+	if !apiutil.SSHCertificateRequestIsApproved(req) {
+		log.V(logf.DebugLevel).Info("SSHCertificateRequest has not been approved yet, ignoring")
+		return nil
+	}
+
+	issuerObj, err := c.issuerHelper.GetGenericIssuer(req.Spec.IssuerRef, req.Namespace)
+	if k8sErrors.IsNotFound(err) {
+		// Retry using the rate limited queue until the issuer exists
+		// rather than failing the request.
+		statusErr := c.updateStatus(ctx, req, func(status *cmapi.SSHCertificateRequest) {
+			apiutil.SetSSHCertificateRequestCondition(status, cmapi.CertificateRequestConditionReady, cmmeta.ConditionFalse, cmapi.CertificateRequestReasonPending,
+				fmt.Sprintf("Referenced %q not found: %v", apiutil.IssuerKind(req.Spec.IssuerRef), err))
+		})
+		if statusErr != nil {
+			return statusErr
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if !apiutil.IssuerHasCondition(issuerObj, cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue}) {
+		statusErr := c.updateStatus(ctx, req, func(status *cmapi.SSHCertificateRequest) {
+			apiutil.SetSSHCertificateRequestCondition(status, cmapi.CertificateRequestConditionReady, cmmeta.ConditionFalse, cmapi.CertificateRequestReasonPending,
+				fmt.Sprintf("Referenced %q does not have a Ready status condition", apiutil.IssuerKind(req.Spec.IssuerRef)))
+		})
+		if statusErr != nil {
+			return statusErr
+		}
+		return fmt.Errorf("referenced %q %q is not ready", apiutil.IssuerKind(req.Spec.IssuerRef), req.Spec.IssuerRef.Name)
+	}
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(req.Spec.PublicKey)
+	if err != nil {
+		return c.failed(ctx, req, fmt.Sprintf("Failed to decode public key: %v", err))
+	}
+
+	cert, err := c.sign(ctx, req, issuerObj, pub)
+	if err != nil {
+		return c.failed(ctx, req, fmt.Sprintf("Failed to sign SSH certificate: %v", err))
+	}
+
+	log.V(logf.InfoLevel).Info("signed SSH certificate")
+	c.recorder.Event(req, corev1.EventTypeNormal, cmapi.CertificateRequestReasonIssued, "SSH certificate signed successfully")
+
+	return c.updateStatus(ctx, req, func(status *cmapi.SSHCertificateRequest) {
+		status.Status.Certificate = ssh.MarshalAuthorizedKey(cert)
+		apiutil.SetSSHCertificateRequestCondition(status, cmapi.CertificateRequestConditionReady, cmmeta.ConditionTrue,
+			cmapi.CertificateRequestReasonIssued, "SSH certificate signed successfully")
+	})
+}
+
+// failed marks the SSHCertificateRequest as failed. Failed requests are not
+// retried, the SSHCertificate which created the request will create a new
+// one once its back-off has passed.
+func (c *controller) failed(ctx context.Context, req *cmapi.SSHCertificateRequest, message string) error {
+	c.recorder.Event(req, corev1.EventTypeWarning, cmapi.CertificateRequestReasonFailed, message)
+
+	now := metav1.NewTime(c.clock.Now())
+	return c.updateStatus(ctx, req, func(status *cmapi.SSHCertificateRequest) {
+		status.Status.FailureTime = &now
+		apiutil.SetSSHCertificateRequestCondition(status, cmapi.CertificateRequestConditionReady, cmmeta.ConditionFalse,
+			cmapi.CertificateRequestReasonFailed, message)
+	})
+}
+
+// updateStatus applies the given mutation to a copy of the
+// SSHCertificateRequest and updates its status if it changed.
+func (c *controller) updateStatus(ctx context.Context, req *cmapi.SSHCertificateRequest, mutate func(*cmapi.SSHCertificateRequest)) error {
+	updated := req.DeepCopy()
+	mutate(updated)
+
+	if apiequality.Semantic.DeepEqual(req.Status, updated.Status) {
+		return nil
+	}
+	_, err := c.cmClient.CertmanagerV1().SSHCertificateRequests(updated.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	ctrl, queue, mustSync, err := NewController(ctx)
+	c.controller = ctrl
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
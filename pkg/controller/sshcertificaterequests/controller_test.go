@@ -0,0 +1,361 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshcertificaterequests
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	vaultinternal "github.com/cert-manager/cert-manager/internal/vault"
+	fakevault "github.com/cert-manager/cert-manager/internal/vault/fake"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_controller_ProcessItem(t *testing.T) {
+	fixedNow := time.Now().Truncate(time.Second)
+	fixedClock := fakeclock.NewFakeClock(fixedNow)
+
+	caKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+	caKeyPEM, err := pki.EncodePKCS8PrivateKey(caKey)
+	require.NoError(t, err)
+	caSigner, err := ssh.NewSignerFromSigner(caKey)
+	require.NoError(t, err)
+
+	key, err := pki.GenerateEd25519PrivateKey()
+	require.NoError(t, err)
+	pub, err := ssh.NewPublicKey(key.Public())
+	require.NoError(t, err)
+
+	// otherCert is an SSH certificate for a different public key.
+	otherKey, err := pki.GenerateEd25519PrivateKey()
+	require.NoError(t, err)
+	otherPub, err := ssh.NewPublicKey(otherKey.Public())
+	require.NoError(t, err)
+	otherCert := &ssh.Certificate{Key: otherPub, CertType: ssh.UserCert, ValidPrincipals: []string{"alice"}}
+	require.NoError(t, otherCert.SignCert(rand.Reader, caSigner))
+
+	ready := gen.AddIssuerCondition(cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue})
+	caSecret := gen.Secret("ca-secret",
+		gen.SetSecretNamespace("test-namespace"),
+		gen.SetSecretData(map[string][]byte{corev1.TLSPrivateKeyKey: caKeyPEM}),
+	)
+	caIssuer := gen.Issuer("ca-issuer",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerCA(cmapi.CAIssuer{
+			SecretName: "ca-secret",
+			SSH: &cmapi.CAIssuerSSH{
+				AllowedUserPrincipals: []string{"alice", "b*"},
+				AllowedHostPrincipals: []string{"*.example.com"},
+			},
+		}),
+		ready,
+	)
+	caIssuerWithoutSSH := gen.Issuer("ca-issuer-without-ssh",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca-secret"}),
+		ready,
+	)
+	notReadyIssuer := gen.Issuer("not-ready",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca-secret"}),
+	)
+	selfSignedIssuer := gen.Issuer("self-signed",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+		ready,
+	)
+	vaultIssuer := gen.Issuer("vault",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerVault(cmapi.VaultIssuer{Server: "https://vault.example.com", SSHPath: "ssh/sign/role"}),
+		ready,
+	)
+
+	approved := cmapi.CertificateRequestCondition{Type: cmapi.CertificateRequestConditionApproved, Status: cmmeta.ConditionTrue}
+	request := func(mods ...func(*cmapi.SSHCertificateRequest)) *cmapi.SSHCertificateRequest {
+		req := &cmapi.SSHCertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test"},
+			Spec: cmapi.SSHCertificateRequestSpec{
+				Principals: []string{"alice"},
+				PublicKey:  ssh.MarshalAuthorizedKey(pub),
+				IssuerRef:  cmmeta.IssuerReference{Name: "ca-issuer", Kind: cmapi.IssuerKind, Group: "cert-manager.io"},
+			},
+			Status: cmapi.SSHCertificateRequestStatus{
+				Conditions: []cmapi.CertificateRequestCondition{approved},
+			},
+		}
+		for _, mod := range mods {
+			mod(req)
+		}
+		return req
+	}
+
+	sshCertificateRequestsGVR := cmapi.SchemeGroupVersion.WithResource("sshcertificaterequests")
+
+	// updateStatusAction expects the SSHCertificateRequest's status to be
+	// updated and runs the given check against it.
+	updateStatusAction := func(check func(*cmapi.SSHCertificateRequestStatus) error) testpkg.Action {
+		return testpkg.NewCustomMatch(
+			coretesting.NewUpdateSubresourceAction(sshCertificateRequestsGVR, "status", "test-namespace", nil),
+			func(_, act coretesting.Action) error {
+				return check(&act.(coretesting.UpdateAction).GetObject().(*cmapi.SSHCertificateRequest).Status)
+			},
+		)
+	}
+	readyStatus := func(reason string, failed bool) func(*cmapi.SSHCertificateRequestStatus) error {
+		return func(status *cmapi.SSHCertificateRequestStatus) error {
+			i := slices.IndexFunc(status.Conditions, func(cond cmapi.CertificateRequestCondition) bool {
+				return cond.Type == cmapi.CertificateRequestConditionReady
+			})
+			if i < 0 || status.Conditions[i].Reason != reason {
+				return fmt.Errorf("unexpected conditions %v", status.Conditions)
+			}
+			if failed != (status.FailureTime != nil) {
+				return fmt.Errorf("unexpected failureTime %v", status.FailureTime)
+			}
+			return nil
+		}
+	}
+	// issuedStatus expects the status to contain an SSH certificate of the
+	// given type, signed by the CA for the given principals.
+	issuedStatus := func(certType uint32, principals ...string) func(*cmapi.SSHCertificateRequestStatus) error {
+		return func(status *cmapi.SSHCertificateRequestStatus) error {
+			if err := readyStatus(cmapi.CertificateRequestReasonIssued, false)(status); err != nil {
+				return err
+			}
+			cert, err := pki.ParseSSHCertificate(status.Certificate)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(cert.Key.Marshal(), pub.Marshal()) {
+				return errors.New("SSH certificate does not match the public key")
+			}
+			if !bytes.Equal(cert.SignatureKey.Marshal(), caSigner.PublicKey().Marshal()) {
+				return errors.New("SSH certificate is not signed by the CA")
+			}
+			if cert.CertType != certType || !slices.Equal(cert.ValidPrincipals, principals) {
+				return fmt.Errorf("unexpected certificate type %d or principals %v", cert.CertType, cert.ValidPrincipals)
+			}
+			if cert.KeyId != "test-namespace/test" {
+				return fmt.Errorf("unexpected key ID %q", cert.KeyId)
+			}
+			if time.Unix(int64(cert.ValidBefore), 0).Sub(fixedNow) != cmapi.DefaultSSHCertificateDuration { // #nosec G115 -- test times are positive
+				return fmt.Errorf("unexpected validity %d", cert.ValidBefore)
+			}
+			if _, ok := cert.Extensions["permit-pty"]; ok != (certType == ssh.UserCert) {
+				return fmt.Errorf("unexpected extensions %v", cert.Extensions)
+			}
+			return nil
+		}
+	}
+
+	tests := map[string]struct {
+		request *cmapi.SSHCertificateRequest
+		vault   *fakevault.Vault
+
+		wantActions []testpkg.Action
+		wantEvents  []string
+		wantErr     bool
+	}{
+		"if the SSHCertificateRequest does not exist, should do nothing": {},
+		"if the SSHCertificateRequest is not approved, should do nothing": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Status.Conditions = nil
+			}),
+		},
+		"if the SSHCertificateRequest is already issued, should do nothing": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Status.Conditions = append(req.Status.Conditions, cmapi.CertificateRequestCondition{
+					Type: cmapi.CertificateRequestConditionReady, Status: cmmeta.ConditionTrue, Reason: cmapi.CertificateRequestReasonIssued,
+				})
+			}),
+		},
+		"if the SSHCertificateRequest is denied, should mark it as denied": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Status.Conditions = []cmapi.CertificateRequestCondition{{Type: cmapi.CertificateRequestConditionDenied, Status: cmmeta.ConditionTrue}}
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonDenied, true)),
+			},
+		},
+		"if the SSHCertificateRequest is approved, should sign a user certificate": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.Principals = []string{"alice", "bob"}
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(issuedStatus(ssh.UserCert, "alice", "bob")),
+			},
+			wantEvents: []string{"Normal Issued SSH certificate signed successfully"},
+		},
+		"if a host certificate is requested, should check the allowed host principals": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.Type = cmapi.SSHCertificateTypeHost
+				req.Spec.Principals = []string{"host.example.com"}
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(issuedStatus(ssh.HostCert, "host.example.com")),
+			},
+			wantEvents: []string{"Normal Issued SSH certificate signed successfully"},
+		},
+		"if a user principal is requested for a host certificate, should fail": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.Type = cmapi.SSHCertificateTypeHost
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonFailed, true)),
+			},
+			wantEvents: []string{`Warning Failed Failed to sign SSH certificate: principal "alice" is not allowed by spec.ca.ssh.allowedHostPrincipals of the issuer`},
+		},
+		"if a principal is not allowed by the CA issuer, should fail": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.Principals = []string{"alice", "root"}
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonFailed, true)),
+			},
+			wantEvents: []string{`Warning Failed Failed to sign SSH certificate: principal "root" is not allowed by spec.ca.ssh.allowedUserPrincipals of the issuer`},
+		},
+		"if the CA issuer is not configured to sign SSH certificates, should fail": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.IssuerRef.Name = "ca-issuer-without-ssh"
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonFailed, true)),
+			},
+			wantEvents: []string{"Warning Failed Failed to sign SSH certificate: CA issuer is not configured to sign SSH certificates, set spec.ca.ssh to allow its private key to be used as an SSH certificate authority"},
+		},
+		"if the issuer cannot sign SSH certificates, should fail": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.IssuerRef.Name = "self-signed"
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonFailed, true)),
+			},
+			wantEvents: []string{"Warning Failed Failed to sign SSH certificate: only CA and Vault issuers can sign SSHCertificateRequests"},
+		},
+		"if the issuer does not exist, should set the request pending and retry": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.IssuerRef.Name = "does-not-exist"
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonPending, false)),
+			},
+			wantErr: true,
+		},
+		"if the issuer is not ready, should set the request pending and retry": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.IssuerRef.Name = "not-ready"
+			}),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonPending, false)),
+			},
+			wantErr: true,
+		},
+		"if vault returns an SSH certificate for a different key, should fail": {
+			request: request(func(req *cmapi.SSHCertificateRequest) {
+				req.Spec.IssuerRef.Name = "vault"
+			}),
+			vault: fakevault.New().WithSignSSH(ssh.MarshalAuthorizedKey(otherCert), nil),
+			wantActions: []testpkg.Action{
+				updateStatusAction(readyStatus(cmapi.CertificateRequestReasonFailed, true)),
+			},
+			wantEvents: []string{"Warning Failed Failed to sign SSH certificate: SSH certificate returned by vault does not match the requested public key"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				KubeObjects:        []runtime.Object{caSecret},
+				CertManagerObjects: []runtime.Object{caIssuer, caIssuerWithoutSSH, notReadyIssuer, selfSignedIssuer, vaultIssuer},
+				ExpectedActions:    test.wantActions,
+				ExpectedEvents:     test.wantEvents,
+			}
+			if test.request != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.request)
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			if test.vault != nil {
+				w.controller.vaultClientBuilder = func(context.Context, string, func(string) vaultinternal.CreateToken, internalinformers.SecretLister, cmapi.GenericIssuer, bool) (vaultinternal.Interface, error) {
+					return test.vault, nil
+				}
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(t.Context(), types.NamespacedName{Namespace: "test-namespace", Name: "test"})
+			assert.Equal(t, test.wantErr, err != nil, "unexpected error: %v", err)
+
+			builder.CheckAndFinish()
+		})
+	}
+}
+
+func Test_globMatch(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		want           bool
+	}{
+		{"alice", "alice", true},
+		{"alice", "bob", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"b*", "bob", true},
+		{"b*", "alice", false},
+		{"*.example.com", "host.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "host.example.com.evil", false},
+		{"a*b*c", "aXbYbZc", true},
+		{"a*b*c", "aXbYcZ", false},
+		{"**", "x", true},
+		{"a*", "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern+"/"+test.value, func(t *testing.T) {
+			assert.Equal(t, test.want, globMatch(test.pattern, test.value))
+		})
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshcertificaterequests
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// sign signs the public key of the SSHCertificateRequest using the given
+// issuer. Only CA issuers with `ssh` set and Vault issuers are supported.
+func (c *controller) sign(ctx context.Context, req *cmapi.SSHCertificateRequest, issuerObj cmapi.GenericIssuer, pub ssh.PublicKey) (*ssh.Certificate, error) {
+	spec := issuerObj.GetSpec()
+	switch {
+	case spec.CA != nil:
+		return c.signWithCA(ctx, req, issuerObj, pub)
+	case spec.Vault != nil:
+		return c.signWithVault(ctx, req, issuerObj, pub)
+	default:
+		return nil, errors.New("only CA and Vault issuers can sign SSHCertificateRequests")
+	}
+}
+
+// signWithCA signs the public key using the private key stored in the CA
+// issuer's Secret. The issuer must opt in to signing SSH certificates and
+// every requested principal must be allowed for the certificate type.
+func (c *controller) signWithCA(ctx context.Context, req *cmapi.SSHCertificateRequest, issuerObj cmapi.GenericIssuer, pub ssh.PublicKey) (*ssh.Certificate, error) {
+	caSpec := issuerObj.GetSpec().CA
+	if caSpec.SSH == nil {
+		return nil, errors.New("CA issuer is not configured to sign SSH certificates, set spec.ca.ssh to allow its private key to be used as an SSH certificate authority")
+	}
+
+	certType := uint32(ssh.UserCert)
+	allowed, allowedField := caSpec.SSH.AllowedUserPrincipals, "allowedUserPrincipals"
+	if sshCertificateType(req) == cmapi.SSHCertificateTypeHost {
+		certType = ssh.HostCert
+		allowed, allowedField = caSpec.SSH.AllowedHostPrincipals, "allowedHostPrincipals"
+	}
+	for _, principal := range req.Spec.Principals {
+		if !principalAllowed(allowed, principal) {
+			return nil, fmt.Errorf("principal %q is not allowed by spec.ca.ssh.%s of the issuer", principal, allowedField)
+		}
+	}
+
+	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
+	caKey, err := kube.SecretTLSKey(ctx, c.secretLister, resourceNamespace, caSpec.SecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA private key: %w", err)
+	}
+
+	caSigner, err := ssh.NewSignerFromSigner(caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use CA private key for SSH signing: %w", err)
+	}
+
+	serial := make([]byte, 8)
+	if _, err := rand.Read(serial); err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	now := c.clock.Now()
+	cert := &ssh.Certificate{
+		Key:             pub,
+		Serial:          binary.BigEndian.Uint64(serial),
+		CertType:        certType,
+		KeyId:           keyID(req),
+		ValidPrincipals: req.Spec.Principals,
+		ValidAfter:      uint64(now.Unix()),                             // #nosec G115 -- the current time is positive
+		ValidBefore:     uint64(now.Add(requestedDuration(req)).Unix()), // #nosec G115 -- the expiry time is positive
+		Permissions: ssh.Permissions{
+			CriticalOptions: req.Spec.CriticalOptions,
+			Extensions:      extensions(req),
+		},
+	}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		return nil, fmt.Errorf("failed to sign SSH certificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+// signWithVault signs the public key using the Vault SSH secrets engine
+// configured on the Vault issuer.
+func (c *controller) signWithVault(ctx context.Context, req *cmapi.SSHCertificateRequest, issuerObj cmapi.GenericIssuer, pub ssh.PublicKey) (*ssh.Certificate, error) {
+	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
+	client, err := c.vaultClientBuilder(ctx, resourceNamespace, c.createTokenFn, c.secretLister, issuerObj, c.issuerOptions.CanUseAmbientCredentials(issuerObj))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialise vault client for signing: %w", err)
+	}
+
+	// The default user extensions are requested explicitly so that the
+	// issued certificate matches what was approved.
+	spec := req.Spec.DeepCopy()
+	spec.Extensions = extensions(req)
+
+	signed, err := client.SignSSH(keyID(req), requestedDuration(req), spec)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := pki.ParseSSHCertificate(signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH certificate returned by vault: %w", err)
+	}
+	if !bytes.Equal(cert.Key.Marshal(), pub.Marshal()) {
+		return nil, errors.New("SSH certificate returned by vault does not match the requested public key")
+	}
+
+	return cert, nil
+}
+
+// principalAllowed returns true if the principal matches one of the allowed
+// principals.
+func principalAllowed(allowed []string, principal string) bool {
+	return slices.ContainsFunc(allowed, func(pattern string) bool {
+		return pattern != "" && globMatch(pattern, principal)
+	})
+}
+
+// globMatch returns true if value matches pattern, where each `*` in the
+// pattern matches any sequence of characters. On a mismatch, the last `*`
+// is retried against one more character of the value, so that no pattern
+// needs to be compiled.
+func globMatch(pattern, value string) bool {
+	p, v := 0, 0
+	star, retry := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, retry = p, v
+			p++
+		case p < len(pattern) && pattern[p] == value[v]:
+			p++
+			v++
+		case star >= 0:
+			retry++
+			p, v = star+1, retry
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// sshCertificateType returns the type of the SSHCertificateRequest,
+// defaulting to User.
+func sshCertificateType(req *cmapi.SSHCertificateRequest) cmapi.SSHCertificateType {
+	if req.Spec.Type == "" {
+		return cmapi.SSHCertificateTypeUser
+	}
+	return req.Spec.Type
+}
+
+// extensions returns the extensions to set on the SSH certificate. User
+// certificates are issued with the OpenSSH default extensions if none are
+// requested.
+func extensions(req *cmapi.SSHCertificateRequest) map[string]string {
+	if req.Spec.Extensions == nil && sshCertificateType(req) == cmapi.SSHCertificateTypeUser {
+		return pki.DefaultSSHUserExtensions()
+	}
+	return req.Spec.Extensions
+}
+
+// keyID returns the key ID of the SSHCertificateRequest, defaulting to
+// `<namespace>/<name>`.
+func keyID(req *cmapi.SSHCertificateRequest) string {
+	if req.Spec.KeyID != "" {
+		return req.Spec.KeyID
+	}
+	return req.Namespace + "/" + req.Name
+}
+
+// requestedDuration returns the requested lifetime of the
+// SSHCertificateRequest, defaulting to 24 hours.
+func requestedDuration(req *cmapi.SSHCertificateRequest) time.Duration {
+	if req.Spec.Duration != nil {
+		return req.Spec.Duration.Duration
+	}
+	return cmapi.DefaultSSHCertificateDuration
+}
@@ -0,0 +1,615 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshcertificates
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "sshcertificates"
+
+	reasonIssued    = "Issued"
+	reasonFailed    = "Failed"
+	reasonReady     = "Ready"
+	reasonPending   = "Pending"
+	reasonRequested = "Requested"
+	reasonGenerated = "Generated"
+)
+
+var sshCertificateGvk = cmapi.SchemeGroupVersion.WithKind(cmapi.SSHCertificateKind)
+
+// This controller issues and renews the SSH certificates of SSHCertificate
+// resources, storing them in a Secret alongside a managed private key.
+// Like Certificates, each issuance creates an SSHCertificateRequest for the
+// public key of the next private key, which is only signed once it has been
+// approved.
+type controller struct {
+	sshCertificateLister        cmlisters.SSHCertificateLister
+	sshCertificateRequestLister cmlisters.SSHCertificateRequestLister
+	secretLister                internalinformers.SecretLister
+
+	cmClient     cmclient.Interface
+	secretClient coreclient.SecretsGetter
+	recorder     record.EventRecorder
+	clock        clock.Clock
+
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+
+	fieldManager                string
+	enableSecretOwnerReferences bool
+	minimumBackoffDuration      time.Duration
+	maximumBackoffDuration      time.Duration
+}
+
+func NewController(ctx *controllerpkg.Context) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultItemBasedRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	sshCertificateInformer := ctx.SharedInformerFactory.Certmanager().V1().SSHCertificates()
+	sshCertificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().SSHCertificateRequests()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	if _, err := sshCertificateInformer.Informer().AddEventHandler(controllerpkg.QueuingEventHandler(queue)); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	sshCertificateLister := sshCertificateInformer.Lister()
+	if _, err := sshCertificateRequestInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(
+		controllerpkg.HandleOwnedResourceNamespacedFunc(logf.FromContext(ctx.RootContext, ControllerName), queue, sshCertificateGvk,
+			func(namespace, name string) (*cmapi.SSHCertificate, error) {
+				return sshCertificateLister.SSHCertificates(namespace).Get(name)
+			}),
+	)); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	if _, err := secretsInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(func(obj metav1.Object) {
+		// Secrets, including the Secrets holding the next private key, are
+		// owned by the SSHCertificate named in their annotation.
+		name, ok := obj.GetAnnotations()[cmapi.SSHCertificateNameKey]
+		if !ok {
+			return
+		}
+		queue.Add(types.NamespacedName{Namespace: obj.GetNamespace(), Name: name})
+	})); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the
+	// Register method.  the controller will only begin processing items once all
+	// of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		sshCertificateInformer.Informer().HasSynced,
+		sshCertificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		sshCertificateLister:        sshCertificateLister,
+		sshCertificateRequestLister: sshCertificateRequestInformer.Lister(),
+		secretLister:                secretsInformer.Lister(),
+		cmClient:                    ctx.CMClient,
+		secretClient:                ctx.Client.CoreV1(),
+		recorder:                    ctx.Recorder,
+		clock:                       ctx.Clock,
+		scheduledWorkQueue:          scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		fieldManager:                ctx.FieldManager,
+		enableSecretOwnerReferences: ctx.CertificateOptions.EnableOwnerRef,
+		minimumBackoffDuration:      ctx.CertificateOptions.CertificateRequestMinimumBackoffDuration,
+		maximumBackoffDuration:      ctx.CertificateOptions.CertificateRequestMaximumBackoffDuration,
+	}, queue, mustSync, nil
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	sshCrt, err := c.sshCertificateLister.SSHCertificates(key.Namespace).Get(key.Name)
+	if k8sErrors.IsNotFound(err) {
+		c.scheduledWorkQueue.Forget(key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if sshCrt.DeletionTimestamp != nil {
+		return nil
+	}
+
+	secret, err := c.secretLister.Secrets(sshCrt.Namespace).Get(sshCrt.Spec.SecretName)
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+	if k8sErrors.IsNotFound(err) {
+		secret = nil
+	}
+
+	existing := parseSecret(secret)
+	reason, message := c.needsIssuance(sshCrt, secret, existing)
+	if reason == "" {
+		if err := c.ensureSecretMetadata(ctx, sshCrt, secret); err != nil {
+			return err
+		}
+		if err := c.cleanup(ctx, sshCrt); err != nil {
+			return err
+		}
+		return c.updateStatus(ctx, sshCrt, func(status *cmapi.SSHCertificate) {
+			status.Status.NextPrivateKeySecretName = nil
+			c.setIssuedStatus(status, existing.cert)
+		})
+	}
+
+	if delay := c.backoffDelay(sshCrt); delay > 0 {
+		log.V(logf.InfoLevel).Info("SSHCertificate needs issuance but the last issuance failed, backing off", "reason", reason, "retry_delay", delay)
+		c.scheduledWorkQueue.Add(key, delay)
+		return nil
+	}
+
+	log.V(logf.InfoLevel).Info("issuing SSH certificate", "reason", reason, "message", message)
+	return c.issue(ctx, sshCrt, existing.privateKey, reason)
+}
+
+// existingData holds the private key and certificate currently stored in
+// an SSHCertificate's Secret. Either may be nil if missing or invalid.
+type existingData struct {
+	privateKey    crypto.Signer
+	privateKeyErr error
+	cert          *ssh.Certificate
+	certErr       error
+}
+
+func parseSecret(secret *corev1.Secret) existingData {
+	var data existingData
+	if secret == nil {
+		return data
+	}
+
+	data.privateKey, data.privateKeyErr = parsePrivateKey(secret)
+
+	if len(secret.Data[cmapi.SSHCertificateSecretKey]) == 0 {
+		data.certErr = fmt.Errorf("missing %q", cmapi.SSHCertificateSecretKey)
+	} else {
+		data.cert, data.certErr = pki.ParseSSHCertificate(secret.Data[cmapi.SSHCertificateSecretKey])
+	}
+
+	return data
+}
+
+// parsePrivateKey parses the OpenSSH private key stored in the given Secret.
+func parsePrivateKey(secret *corev1.Secret) (crypto.Signer, error) {
+	if len(secret.Data[cmapi.SSHPrivateKeySecretKey]) == 0 {
+		return nil, fmt.Errorf("missing %q", cmapi.SSHPrivateKeySecretKey)
+	}
+	key, err := ssh.ParseRawPrivateKey(secret.Data[cmapi.SSHPrivateKeySecretKey])
+	if err != nil {
+		return nil, err
+	}
+	if edKey, ok := key.(*ed25519.PrivateKey); ok {
+		// Ed25519 keys are parsed as pointers but only marshalled as values.
+		return *edKey, nil
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// needsIssuance returns a reason and message if the SSHCertificate needs to
+// be issued, or an empty reason if the stored SSH certificate is up to date.
+func (c *controller) needsIssuance(sshCrt *cmapi.SSHCertificate, secret *corev1.Secret, existing existingData) (string, string) {
+	if secret == nil {
+		return "DoesNotExist", "Issuing SSH certificate as Secret does not exist"
+	}
+	if existing.privateKeyErr != nil {
+		return "InvalidKey", fmt.Sprintf("Issuing SSH certificate as Secret does not contain a valid private key: %v", existing.privateKeyErr)
+	}
+	if existing.certErr != nil {
+		return "InvalidCertificate", fmt.Sprintf("Issuing SSH certificate as Secret does not contain a valid SSH certificate: %v", existing.certErr)
+	}
+
+	pub, err := ssh.NewPublicKey(existing.privateKey.Public())
+	if err != nil || !bytes.Equal(pub.Marshal(), existing.cert.Key.Marshal()) {
+		return "InvalidKeyPair", "Issuing SSH certificate as the stored private key does not match the SSH certificate"
+	}
+
+	issuerRef := sshCrt.Spec.IssuerRef
+	if secret.Annotations[cmapi.IssuerNameAnnotationKey] != issuerRef.Name ||
+		secret.Annotations[cmapi.IssuerKindAnnotationKey] != apiutil.IssuerKind(issuerRef) ||
+		secret.Annotations[cmapi.IssuerGroupAnnotationKey] != issuerRef.Group {
+		return "IncorrectIssuer", "Issuing SSH certificate as it was previously issued by a different issuer"
+	}
+
+	if mismatches := specMismatches(sshCrt, existing); len(mismatches) > 0 {
+		return "SpecModified", fmt.Sprintf("Issuing SSH certificate as the fields %v have changed", mismatches)
+	}
+
+	notBefore, notAfter := validity(existing.cert)
+	now := c.clock.Now()
+	if !now.Before(notAfter) {
+		return "Expired", fmt.Sprintf("Issuing SSH certificate as it expired on %s", notAfter.Format(time.RFC3339))
+	}
+	renewalTime, err := pki.RenewalTime(notBefore, notAfter, sshCrt.Spec.RenewBefore, sshCrt.Spec.RenewBeforePercentage, nil)
+	if err != nil {
+		return "Renewing", fmt.Sprintf("Issuing SSH certificate as its renewal time cannot be calculated: %v", err)
+	}
+	if !now.Before(renewalTime.Time) {
+		return "Renewing", fmt.Sprintf("Renewing SSH certificate as renewal was scheduled at %s", renewalTime.Format(time.RFC3339))
+	}
+
+	return "", ""
+}
+
+// specMismatches returns the names of the spec fields which do not match the
+// stored SSH certificate and private key.
+func specMismatches(sshCrt *cmapi.SSHCertificate, existing existingData) []string {
+	var mismatches []string
+
+	certType := uint32(ssh.UserCert)
+	if sshCertificateType(sshCrt) == cmapi.SSHCertificateTypeHost {
+		certType = ssh.HostCert
+	}
+	if existing.cert.CertType != certType {
+		mismatches = append(mismatches, "spec.type")
+	}
+	if existing.cert.KeyId != keyID(sshCrt) {
+		mismatches = append(mismatches, "spec.keyID")
+	}
+	if !slices.Equal(slices.Sorted(slices.Values(existing.cert.ValidPrincipals)), slices.Sorted(slices.Values(sshCrt.Spec.Principals))) {
+		mismatches = append(mismatches, "spec.principals")
+	}
+	// When unset, the extensions and critical options are defaulted by the
+	// issuer so any value is accepted.
+	if sshCrt.Spec.Extensions != nil && !maps.Equal(existing.cert.Extensions, sshCrt.Spec.Extensions) {
+		mismatches = append(mismatches, "spec.extensions")
+	}
+	if sshCrt.Spec.CriticalOptions != nil && !maps.Equal(existing.cert.CriticalOptions, sshCrt.Spec.CriticalOptions) {
+		mismatches = append(mismatches, "spec.criticalOptions")
+	}
+	if !privateKeyMatchesSpec(sshCrt, existing.privateKey) {
+		mismatches = append(mismatches, "spec.privateKey")
+	}
+
+	return mismatches
+}
+
+// backoffDelay returns how long to wait before retrying a failed issuance.
+// No back-off is applied if the SSHCertificate has changed since the
+// failure.
+func (c *controller) backoffDelay(sshCrt *cmapi.SSHCertificate) time.Duration {
+	if sshCrt.Status.LastFailureTime == nil {
+		return 0
+	}
+	if cond := apiutil.GetSSHCertificateCondition(sshCrt, cmapi.CertificateConditionReady); cond != nil && cond.ObservedGeneration != sshCrt.Generation {
+		return 0
+	}
+
+	delay := c.minimumBackoffDuration
+	if attempts := sshCrt.Status.FailedIssuanceAttempts; attempts != nil && *attempts > 1 {
+		computed := c.minimumBackoffDuration * time.Duration(math.Pow(2, float64(*attempts-1)))
+		if computed < c.minimumBackoffDuration || computed > c.maximumBackoffDuration {
+			delay = c.maximumBackoffDuration
+		} else {
+			delay = computed
+		}
+	}
+
+	return sshCrt.Status.LastFailureTime.Add(delay).Sub(c.clock.Now())
+}
+
+// issue obtains the next private key and requests an SSH certificate for it
+// by creating an SSHCertificateRequest. Once the request has been approved
+// and signed, the private key and SSH certificate are stored in the
+// SSHCertificate's Secret.
+func (c *controller) issue(ctx context.Context, sshCrt *cmapi.SSHCertificate, existingKey crypto.Signer, reason string) error {
+	log := logf.FromContext(ctx)
+
+	key, err := c.nextPrivateKey(ctx, sshCrt, existingKey)
+	if err != nil || key == nil {
+		// The SSHCertificate is processed again once the Secret holding the
+		// next private key has been observed.
+		return err
+	}
+
+	pub, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return c.failed(ctx, sshCrt, fmt.Sprintf("Failed to encode public key: %v", err))
+	}
+
+	revision := nextRevision(sshCrt)
+	req, err := c.requestForRevision(sshCrt, revision)
+	if err != nil {
+		return err
+	}
+	if req == nil {
+		req, err = c.createRequest(ctx, sshCrt, revision, pub)
+		if err != nil {
+			return err
+		}
+		c.recorder.Eventf(sshCrt, corev1.EventTypeNormal, reasonRequested, "Created new SSHCertificateRequest resource %q", req.Name)
+		return c.pending(ctx, sshCrt, req, reason)
+	}
+	if req.DeletionTimestamp != nil {
+		return nil
+	}
+	log = logf.WithRelatedResource(log, req)
+
+	if !requestMatches(req, buildRequestSpec(sshCrt, pub)) {
+		log.V(logf.DebugLevel).Info("deleting SSHCertificateRequest as it does not match the SSHCertificate")
+		return c.deleteRequest(ctx, req)
+	}
+
+	switch {
+	case apiutil.SSHCertificateRequestIsDenied(req):
+		return c.failedRequest(ctx, sshCrt, req, fmt.Sprintf("The SSHCertificateRequest %q was denied", req.Name))
+	case apiutil.SSHCertificateRequestReadyReason(req) == cmapi.CertificateRequestReasonFailed:
+		message := ""
+		if cond := apiutil.GetSSHCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady); cond != nil {
+			message = cond.Message
+		}
+		return c.failedRequest(ctx, sshCrt, req, fmt.Sprintf("The SSHCertificateRequest %q failed: %s", req.Name, message))
+	case apiutil.SSHCertificateRequestReadyReason(req) != cmapi.CertificateRequestReasonIssued:
+		return c.pending(ctx, sshCrt, req, reason)
+	}
+
+	cert, err := pki.ParseSSHCertificate(req.Status.Certificate)
+	if err != nil {
+		return c.failedRequest(ctx, sshCrt, req, fmt.Sprintf("The SSHCertificateRequest %q contains an invalid SSH certificate: %v", req.Name, err))
+	}
+	if !bytes.Equal(cert.Key.Marshal(), pub.Marshal()) {
+		return c.failedRequest(ctx, sshCrt, req, fmt.Sprintf("The SSH certificate of the SSHCertificateRequest %q does not match the private key", req.Name))
+	}
+
+	keyPEM, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return c.failed(ctx, sshCrt, fmt.Sprintf("Failed to encode private key: %v", err))
+	}
+
+	if err := c.applySecret(ctx, sshCrt, map[string][]byte{
+		cmapi.SSHPrivateKeySecretKey:  pem.EncodeToMemory(keyPEM),
+		cmapi.SSHPublicKeySecretKey:   ssh.MarshalAuthorizedKey(pub),
+		cmapi.SSHCertificateSecretKey: ssh.MarshalAuthorizedKey(cert),
+		cmapi.SSHCAPublicKeySecretKey: ssh.MarshalAuthorizedKey(cert.SignatureKey),
+	}); err != nil {
+		return err
+	}
+
+	log.V(logf.InfoLevel).Info("issued SSH certificate", "reason", reason)
+	c.recorder.Event(sshCrt, corev1.EventTypeNormal, reasonIssued, "The SSH certificate has been successfully issued")
+
+	if err := c.deleteNextPrivateKeySecret(ctx, sshCrt); err != nil {
+		return err
+	}
+
+	return c.updateStatus(ctx, sshCrt, func(status *cmapi.SSHCertificate) {
+		status.Status.Revision = &revision
+		status.Status.NextPrivateKeySecretName = nil
+		status.Status.LastFailureTime = nil
+		status.Status.FailedIssuanceAttempts = nil
+		c.setIssuedStatus(status, cert)
+	})
+}
+
+// pending records that the SSHCertificate is waiting for the given request
+// to be approved and signed. A stored SSH certificate which is only being
+// renewed remains Ready in the meantime.
+func (c *controller) pending(ctx context.Context, sshCrt *cmapi.SSHCertificate, req *cmapi.SSHCertificateRequest, reason string) error {
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("waiting for SSHCertificateRequest to be approved and signed", "request", req.Name)
+	if reason == "Renewing" {
+		return nil
+	}
+	return c.updateStatus(ctx, sshCrt, func(status *cmapi.SSHCertificate) {
+		apiutil.SetSSHCertificateCondition(status, status.Generation, cmapi.CertificateConditionReady, cmmeta.ConditionFalse, reasonPending,
+			fmt.Sprintf("Waiting for SSHCertificateRequest %q to be approved and signed", req.Name))
+	})
+}
+
+// failedRequest records a failed issuance and deletes the request, so that a
+// new request is created once the back-off has passed.
+func (c *controller) failedRequest(ctx context.Context, sshCrt *cmapi.SSHCertificate, req *cmapi.SSHCertificateRequest, message string) error {
+	if err := c.failed(ctx, sshCrt, message); err != nil {
+		return err
+	}
+	return c.deleteRequest(ctx, req)
+}
+
+// failed records a failed issuance on the SSHCertificate's status and
+// schedules it to be retried after the back-off.
+func (c *controller) failed(ctx context.Context, sshCrt *cmapi.SSHCertificate, message string) error {
+	c.recorder.Event(sshCrt, corev1.EventTypeWarning, reasonFailed, message)
+
+	now := metav1.NewTime(c.clock.Now())
+	return c.updateStatus(ctx, sshCrt, func(status *cmapi.SSHCertificate) {
+		attempts := 1
+		if status.Status.FailedIssuanceAttempts != nil {
+			attempts = *status.Status.FailedIssuanceAttempts + 1
+		}
+		status.Status.FailedIssuanceAttempts = &attempts
+		status.Status.LastFailureTime = &now
+		apiutil.SetSSHCertificateCondition(status, status.Generation, cmapi.CertificateConditionReady, cmmeta.ConditionFalse, reasonFailed, message)
+	})
+}
+
+// setIssuedStatus sets the validity, renewal time and Ready condition of the
+// SSHCertificate from the given issued SSH certificate.
+func (c *controller) setIssuedStatus(sshCrt *cmapi.SSHCertificate, cert *ssh.Certificate) {
+	notBefore, notAfter := validity(cert)
+	sshCrt.Status.NotBefore = &metav1.Time{Time: notBefore}
+	sshCrt.Status.NotAfter = &metav1.Time{Time: notAfter}
+	// The renewal time can only fail to be calculated for invalid
+	// SSHCertificates, which are rejected by the webhook.
+	sshCrt.Status.RenewalTime, _ = pki.RenewalTime(notBefore, notAfter, sshCrt.Spec.RenewBefore, sshCrt.Spec.RenewBeforePercentage, nil)
+	apiutil.SetSSHCertificateCondition(sshCrt, sshCrt.Generation, cmapi.CertificateConditionReady, cmmeta.ConditionTrue, reasonReady, "SSH certificate is up to date and has not expired")
+}
+
+// updateStatus applies the given mutation to a copy of the SSHCertificate,
+// updates its status if it changed and schedules the next renewal or retry.
+func (c *controller) updateStatus(ctx context.Context, sshCrt *cmapi.SSHCertificate, mutate func(*cmapi.SSHCertificate)) error {
+	updated := sshCrt.DeepCopy()
+	mutate(updated)
+
+	c.scheduleNext(logf.FromContext(ctx), updated)
+
+	if apiequality.Semantic.DeepEqual(sshCrt.Status, updated.Status) {
+		return nil
+	}
+	_, err := c.cmClient.CertmanagerV1().SSHCertificates(updated.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// scheduleNext schedules the SSHCertificate to be processed again at its
+// renewal time, or once the back-off has passed if the last issuance failed.
+func (c *controller) scheduleNext(log logr.Logger, sshCrt *cmapi.SSHCertificate) {
+	key := types.NamespacedName{Namespace: sshCrt.Namespace, Name: sshCrt.Name}
+	if delay := c.backoffDelay(sshCrt); delay > 0 {
+		c.scheduledWorkQueue.Add(key, delay)
+		return
+	}
+	// A renewal time which has passed is handled by the ongoing issuance.
+	if sshCrt.Status.RenewalTime != nil && sshCrt.Status.RenewalTime.After(c.clock.Now()) {
+		delay := sshCrt.Status.RenewalTime.Sub(c.clock.Now())
+		log.V(logf.DebugLevel).Info("scheduling SSH certificate renewal", "renewal_time", sshCrt.Status.RenewalTime.Time)
+		c.scheduledWorkQueue.Add(key, delay)
+	}
+}
+
+// ensureSecretMetadata re-applies an up to date Secret if it is missing any
+// of the labels or annotations from the SSHCertificate's secret template.
+func (c *controller) ensureSecretMetadata(ctx context.Context, sshCrt *cmapi.SSHCertificate, secret *corev1.Secret) error {
+	tmpl := sshCrt.Spec.SecretTemplate
+	if tmpl == nil || (containsAll(secret.Labels, tmpl.Labels) && containsAll(secret.Annotations, tmpl.Annotations)) {
+		return nil
+	}
+	return c.applySecret(ctx, sshCrt, secret.Data)
+}
+
+func containsAll(have, want map[string]string) bool {
+	for k, v := range want {
+		if got, ok := have[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// applySecret applies the SSHCertificate's Secret with the given data.
+func (c *controller) applySecret(ctx context.Context, sshCrt *cmapi.SSHCertificate, data map[string][]byte) error {
+	labels := map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}
+	annotations := map[string]string{
+		cmapi.SSHCertificateNameKey:    sshCrt.Name,
+		cmapi.IssuerNameAnnotationKey:  sshCrt.Spec.IssuerRef.Name,
+		cmapi.IssuerKindAnnotationKey:  apiutil.IssuerKind(sshCrt.Spec.IssuerRef),
+		cmapi.IssuerGroupAnnotationKey: sshCrt.Spec.IssuerRef.Group,
+	}
+	if tmpl := sshCrt.Spec.SecretTemplate; tmpl != nil {
+		maps.Copy(labels, tmpl.Labels)
+		maps.Copy(annotations, tmpl.Annotations)
+	}
+
+	// The type of an existing Secret is immutable, so it is preserved.
+	secretType := corev1.SecretTypeSSHAuth
+	if existing, err := c.secretLister.Secrets(sshCrt.Namespace).Get(sshCrt.Spec.SecretName); err == nil && existing.Type != "" {
+		secretType = existing.Type
+	}
+
+	applyCnf := applycorev1.Secret(sshCrt.Spec.SecretName, sshCrt.Namespace).
+		WithLabels(labels).WithAnnotations(annotations).
+		WithData(data).WithType(secretType)
+	if c.enableSecretOwnerReferences {
+		ref := *metav1.NewControllerRef(sshCrt, sshCertificateGvk)
+		applyCnf = applyCnf.WithOwnerReferences(&applymetav1.OwnerReferenceApplyConfiguration{
+			APIVersion: &ref.APIVersion, Kind: &ref.Kind,
+			Name: &ref.Name, UID: &ref.UID,
+			Controller: ref.Controller, BlockOwnerDeletion: ref.BlockOwnerDeletion,
+		})
+	}
+
+	_, err := c.secretClient.Secrets(sshCrt.Namespace).Apply(ctx, applyCnf, metav1.ApplyOptions{FieldManager: c.fieldManager, Force: true})
+	if err != nil {
+		return fmt.Errorf("failed to apply secret %s/%s: %w", sshCrt.Namespace, sshCrt.Spec.SecretName, err)
+	}
+	return nil
+}
+
+// validity returns the validity period of the given SSH certificate.
+func validity(cert *ssh.Certificate) (time.Time, time.Time) {
+	notBefore := time.Unix(int64(min(cert.ValidAfter, math.MaxInt64)), 0) // #nosec G115 -- clamped to MaxInt64
+	notAfter := time.Unix(int64(min(cert.ValidBefore, math.MaxInt64)), 0) // #nosec G115 -- clamped to MaxInt64
+	return notBefore, notAfter
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	ctrl, queue, mustSync, err := NewController(ctx)
+	c.controller = ctrl
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,508 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshcertificates
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_controller_ProcessItem(t *testing.T) {
+	fixedNow := time.Now().Truncate(time.Second)
+	fixedClock := fakeclock.NewFakeClock(fixedNow)
+
+	caKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+	caSigner, err := ssh.NewSignerFromSigner(caKey)
+	require.NoError(t, err)
+
+	sshCertificate := func(mods ...func(*cmapi.SSHCertificate)) *cmapi.SSHCertificate {
+		sshCrt := &cmapi.SSHCertificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test", UID: "test-uid", Generation: 1},
+			Spec: cmapi.SSHCertificateSpec{
+				Principals: []string{"alice"},
+				SecretName: "test-secret",
+				IssuerRef:  cmmeta.IssuerReference{Name: "ca-issuer", Kind: cmapi.IssuerKind, Group: "cert-manager.io"},
+			},
+		}
+		for _, mod := range mods {
+			mod(sshCrt)
+		}
+		return sshCrt
+	}
+	withNextPrivateKeySecret := func(sshCrt *cmapi.SSHCertificate) {
+		sshCrt.Status.NextPrivateKeySecretName = new("test-next")
+	}
+
+	// signedCertificate returns an SSH certificate for the given key signed by
+	// the CA with the given validity.
+	signedCertificate := func(sshCrt *cmapi.SSHCertificate, key crypto.Signer, notBefore, notAfter time.Time) *ssh.Certificate {
+		pub, err := ssh.NewPublicKey(key.Public())
+		require.NoError(t, err)
+		cert := &ssh.Certificate{
+			Key:             pub,
+			CertType:        ssh.UserCert,
+			KeyId:           keyID(sshCrt),
+			ValidPrincipals: sshCrt.Spec.Principals,
+			ValidAfter:      uint64(notBefore.Unix()), // #nosec G115 -- test times are positive
+			ValidBefore:     uint64(notAfter.Unix()),  // #nosec G115 -- test times are positive
+			Permissions:     ssh.Permissions{Extensions: pki.DefaultSSHUserExtensions()},
+		}
+		require.NoError(t, cert.SignCert(rand.Reader, caSigner))
+		return cert
+	}
+	marshalKey := func(key crypto.Signer) []byte {
+		keyPEM, err := ssh.MarshalPrivateKey(key, "")
+		require.NoError(t, err)
+		return pem.EncodeToMemory(keyPEM)
+	}
+
+	existingKey, err := generatePrivateKey(sshCertificate())
+	require.NoError(t, err)
+	nextKey, err := generatePrivateKey(sshCertificate())
+	require.NoError(t, err)
+	nextPub, err := ssh.NewPublicKey(nextKey.Public())
+	require.NoError(t, err)
+
+	// issuedSecret returns a Secret containing an SSH certificate signed by the
+	// CA with the given validity.
+	issuedSecret := func(sshCrt *cmapi.SSHCertificate, notBefore, notAfter time.Time) *corev1.Secret {
+		cert := signedCertificate(sshCrt, existingKey, notBefore, notAfter)
+		return gen.Secret("test-secret",
+			gen.SetSecretNamespace("test-namespace"),
+			gen.SetSecretType(corev1.SecretTypeSSHAuth),
+			gen.SetSecretAnnotations(map[string]string{
+				cmapi.SSHCertificateNameKey:    "test",
+				cmapi.IssuerNameAnnotationKey:  "ca-issuer",
+				cmapi.IssuerKindAnnotationKey:  cmapi.IssuerKind,
+				cmapi.IssuerGroupAnnotationKey: "cert-manager.io",
+			}),
+			gen.SetSecretData(map[string][]byte{
+				cmapi.SSHPrivateKeySecretKey:  marshalKey(existingKey),
+				cmapi.SSHCertificateSecretKey: ssh.MarshalAuthorizedKey(cert),
+			}),
+		)
+	}
+	nextPrivateKeySecret := gen.Secret("test-next",
+		gen.SetSecretNamespace("test-namespace"),
+		gen.SetSecretAnnotations(map[string]string{cmapi.SSHCertificateNameKey: "test"}),
+		gen.SetSecretData(map[string][]byte{cmapi.SSHPrivateKeySecretKey: marshalKey(nextKey)}),
+	)
+
+	// request returns the SSHCertificateRequest of the given revision for the
+	// next private key.
+	request := func(revision string, mods ...func(*cmapi.SSHCertificateRequest)) *cmapi.SSHCertificateRequest {
+		req := &cmapi.SSHCertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "test-namespace",
+				Name:            "test-" + revision,
+				Annotations:     map[string]string{cmapi.CertificateRequestRevisionAnnotationKey: revision},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(sshCertificate(), sshCertificateGvk)},
+			},
+			Spec: buildRequestSpec(sshCertificate(), nextPub),
+		}
+		req.Spec.Username = "system:serviceaccount:cert-manager:cert-manager"
+		for _, mod := range mods {
+			mod(req)
+		}
+		return req
+	}
+	requestCondition := func(conditionType cmapi.CertificateRequestConditionType, status cmmeta.ConditionStatus, reason string) func(*cmapi.SSHCertificateRequest) {
+		return func(req *cmapi.SSHCertificateRequest) {
+			req.Status.Conditions = append(req.Status.Conditions, cmapi.CertificateRequestCondition{
+				Type: conditionType, Status: status, Reason: reason, Message: "some message",
+			})
+		}
+	}
+	issuedRequest := request("1",
+		requestCondition(cmapi.CertificateRequestConditionApproved, cmmeta.ConditionTrue, "cert-manager.io"),
+		requestCondition(cmapi.CertificateRequestConditionReady, cmmeta.ConditionTrue, cmapi.CertificateRequestReasonIssued),
+		func(req *cmapi.SSHCertificateRequest) {
+			req.Status.Certificate = ssh.MarshalAuthorizedKey(signedCertificate(sshCertificate(), nextKey, fixedNow, fixedNow.Add(cmapi.DefaultSSHCertificateDuration)))
+		},
+	)
+
+	secretsGVR := corev1.SchemeGroupVersion.WithResource("secrets")
+	sshCertificatesGVR := cmapi.SchemeGroupVersion.WithResource("sshcertificates")
+	sshCertificateRequestsGVR := cmapi.SchemeGroupVersion.WithResource("sshcertificaterequests")
+
+	// applySecretAction expects the Secret to be applied with an SSH
+	// certificate signed by the CA for the given principals.
+	applySecretAction := func(principals ...string) testpkg.Action {
+		return testpkg.NewCustomMatch(
+			coretesting.NewPatchAction(secretsGVR, "test-namespace", "test-secret", types.ApplyPatchType, nil),
+			func(_, act coretesting.Action) error {
+				var secret corev1.Secret
+				if err := json.Unmarshal(act.(coretesting.PatchAction).GetPatch(), &secret); err != nil {
+					return err
+				}
+				if secret.Type != corev1.SecretTypeSSHAuth {
+					return fmt.Errorf("unexpected Secret type %q", secret.Type)
+				}
+				if secret.Annotations[cmapi.SSHCertificateNameKey] != "test" {
+					return errors.New("missing SSHCertificate name annotation")
+				}
+				return verifySecret(&secret, caSigner.PublicKey(), principals)
+			},
+		)
+	}
+	// createNextPrivateKeySecretAction expects a Secret holding a new private
+	// key to be created for the SSHCertificate.
+	createNextPrivateKeySecretAction := testpkg.NewCustomMatch(
+		coretesting.NewCreateAction(secretsGVR, "test-namespace", nil),
+		func(_, act coretesting.Action) error {
+			secret := act.(coretesting.CreateAction).GetObject().(*corev1.Secret)
+			if secret.GenerateName != "test-" || secret.Labels[cmapi.IsNextPrivateKeySecretLabelKey] != "true" || secret.Annotations[cmapi.SSHCertificateNameKey] != "test" {
+				return fmt.Errorf("unexpected metadata %v", secret.ObjectMeta)
+			}
+			if !metav1.IsControlledBy(secret, sshCertificate()) {
+				return errors.New("Secret is not owned by the SSHCertificate")
+			}
+			if _, err := parsePrivateKey(secret); err != nil {
+				return err
+			}
+			return nil
+		},
+	)
+	// createRequestAction expects an SSHCertificateRequest of the given
+	// revision to be created for the given public key.
+	createRequestAction := func(revision string, pub ssh.PublicKey) testpkg.Action {
+		return testpkg.NewCustomMatch(
+			coretesting.NewCreateAction(sshCertificateRequestsGVR, "test-namespace", nil),
+			func(_, act coretesting.Action) error {
+				req := act.(coretesting.CreateAction).GetObject().(*cmapi.SSHCertificateRequest)
+				if req.GenerateName != "test-" || req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey] != revision {
+					return fmt.Errorf("unexpected metadata %v", req.ObjectMeta)
+				}
+				if !metav1.IsControlledBy(req, sshCertificate()) {
+					return errors.New("SSHCertificateRequest is not owned by the SSHCertificate")
+				}
+				if !requestMatches(req, buildRequestSpec(sshCertificate(), pub)) {
+					return fmt.Errorf("unexpected spec %v", req.Spec)
+				}
+				return nil
+			},
+		)
+	}
+	deleteRequestAction := func(name string) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewDeleteAction(sshCertificateRequestsGVR, "test-namespace", name))
+	}
+	// updateStatusAction expects the SSHCertificate's status to be updated
+	// and runs the given check against it.
+	updateStatusAction := func(check func(*cmapi.SSHCertificateStatus) error) testpkg.Action {
+		return testpkg.NewCustomMatch(
+			coretesting.NewUpdateSubresourceAction(sshCertificatesGVR, "status", "test-namespace", nil),
+			func(_, act coretesting.Action) error {
+				return check(&act.(coretesting.UpdateAction).GetObject().(*cmapi.SSHCertificate).Status)
+			},
+		)
+	}
+	issuedStatus := func(revision int) func(*cmapi.SSHCertificateStatus) error {
+		return func(status *cmapi.SSHCertificateStatus) error {
+			if status.Revision == nil || *status.Revision != revision {
+				return fmt.Errorf("unexpected revision %v", status.Revision)
+			}
+			if status.NotAfter == nil || !status.NotAfter.Equal(&metav1.Time{Time: fixedNow.Add(cmapi.DefaultSSHCertificateDuration)}) {
+				return fmt.Errorf("unexpected notAfter %v", status.NotAfter)
+			}
+			// The default renewal time is at 2/3 of the lifetime.
+			if status.RenewalTime == nil || !status.RenewalTime.Equal(&metav1.Time{Time: fixedNow.Add(16 * time.Hour)}) {
+				return fmt.Errorf("unexpected renewalTime %v", status.RenewalTime)
+			}
+			if len(status.Conditions) != 1 || status.Conditions[0].Status != cmmeta.ConditionTrue {
+				return fmt.Errorf("unexpected conditions %v", status.Conditions)
+			}
+			if status.LastFailureTime != nil || status.FailedIssuanceAttempts != nil || status.NextPrivateKeySecretName != nil {
+				return errors.New("failure and next private key should be cleared")
+			}
+			return nil
+		}
+	}
+	pendingStatus := func(requestName string) func(*cmapi.SSHCertificateStatus) error {
+		return func(status *cmapi.SSHCertificateStatus) error {
+			message := fmt.Sprintf("Waiting for SSHCertificateRequest %q to be approved and signed", requestName)
+			if len(status.Conditions) != 1 || status.Conditions[0].Reason != reasonPending || status.Conditions[0].Message != message {
+				return fmt.Errorf("unexpected conditions %v", status.Conditions)
+			}
+			return nil
+		}
+	}
+	failedStatus := func(message string) func(*cmapi.SSHCertificateStatus) error {
+		return func(status *cmapi.SSHCertificateStatus) error {
+			if status.FailedIssuanceAttempts == nil || *status.FailedIssuanceAttempts != 1 {
+				return fmt.Errorf("unexpected failedIssuanceAttempts %v", status.FailedIssuanceAttempts)
+			}
+			if status.LastFailureTime == nil || !status.LastFailureTime.Equal(&metav1.Time{Time: fixedNow}) {
+				return fmt.Errorf("unexpected lastFailureTime %v", status.LastFailureTime)
+			}
+			if len(status.Conditions) != 1 || status.Conditions[0].Reason != reasonFailed || status.Conditions[0].Message != message {
+				return fmt.Errorf("unexpected conditions %v", status.Conditions)
+			}
+			return nil
+		}
+	}
+
+	tests := map[string]struct {
+		sshCertificate *cmapi.SSHCertificate
+		secrets        []runtime.Object
+		requests       []runtime.Object
+
+		wantActions []testpkg.Action
+		wantEvents  []string
+		wantErr     bool
+	}{
+		"if the SSHCertificate does not exist, should do nothing": {},
+		"if the Secret does not exist, should store a new private key in a temporary Secret": {
+			sshCertificate: sshCertificate(),
+			wantActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewGetAction(sshCertificatesGVR, "test-namespace", "test")),
+				createNextPrivateKeySecretAction,
+				updateStatusAction(func(status *cmapi.SSHCertificateStatus) error {
+					if status.NextPrivateKeySecretName == nil || *status.NextPrivateKeySecretName != "test-notrandom" {
+						return fmt.Errorf("unexpected nextPrivateKeySecretName %v", status.NextPrivateKeySecretName)
+					}
+					return nil
+				}),
+			},
+			wantEvents: []string{`Normal Generated Stored new private key in temporary Secret resource "test-notrandom"`},
+		},
+		"if the next private key exists, should create an SSHCertificateRequest for it": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret),
+			secrets:        []runtime.Object{nextPrivateKeySecret},
+			wantActions: []testpkg.Action{
+				createRequestAction("1", nextPub),
+				updateStatusAction(pendingStatus("test-notrandom")),
+			},
+			wantEvents: []string{`Normal Requested Created new SSHCertificateRequest resource "test-notrandom"`},
+		},
+		"if the SSHCertificateRequest is pending, should wait for it to be approved and signed": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret),
+			secrets:        []runtime.Object{nextPrivateKeySecret},
+			requests:       []runtime.Object{request("1")},
+			wantActions: []testpkg.Action{
+				updateStatusAction(pendingStatus("test-1")),
+			},
+		},
+		"if the SSHCertificateRequest does not match the SSHCertificate, should delete it": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret, func(sshCrt *cmapi.SSHCertificate) {
+				sshCrt.Spec.Principals = []string{"alice", "bob"}
+			}),
+			secrets:  []runtime.Object{nextPrivateKeySecret},
+			requests: []runtime.Object{request("1")},
+			wantActions: []testpkg.Action{
+				deleteRequestAction("test-1"),
+			},
+		},
+		"if the SSHCertificateRequest has been issued, should store the SSH certificate": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret),
+			secrets:        []runtime.Object{nextPrivateKeySecret},
+			requests:       []runtime.Object{issuedRequest},
+			wantActions: []testpkg.Action{
+				applySecretAction("alice"),
+				testpkg.NewAction(coretesting.NewDeleteAction(secretsGVR, "test-namespace", "test-next")),
+				updateStatusAction(issuedStatus(1)),
+			},
+			wantEvents: []string{"Normal Issued The SSH certificate has been successfully issued"},
+		},
+		"if the SSHCertificateRequest has been denied, should record a failure and delete it": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret),
+			secrets:        []runtime.Object{nextPrivateKeySecret},
+			requests: []runtime.Object{request("1",
+				requestCondition(cmapi.CertificateRequestConditionDenied, cmmeta.ConditionTrue, "cert-manager.io"),
+			)},
+			wantActions: []testpkg.Action{
+				updateStatusAction(failedStatus(`The SSHCertificateRequest "test-1" was denied`)),
+				deleteRequestAction("test-1"),
+			},
+			wantEvents: []string{`Warning Failed The SSHCertificateRequest "test-1" was denied`},
+		},
+		"if the SSHCertificateRequest has failed, should record a failure and delete it": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret),
+			secrets:        []runtime.Object{nextPrivateKeySecret},
+			requests: []runtime.Object{request("1",
+				requestCondition(cmapi.CertificateRequestConditionApproved, cmmeta.ConditionTrue, "cert-manager.io"),
+				requestCondition(cmapi.CertificateRequestConditionReady, cmmeta.ConditionFalse, cmapi.CertificateRequestReasonFailed),
+			)},
+			wantActions: []testpkg.Action{
+				updateStatusAction(failedStatus(`The SSHCertificateRequest "test-1" failed: some message`)),
+				deleteRequestAction("test-1"),
+			},
+			wantEvents: []string{`Warning Failed The SSHCertificateRequest "test-1" failed: some message`},
+		},
+		"if the SSH certificate is up to date, should only update the status and delete old requests": {
+			sshCertificate: sshCertificate(func(sshCrt *cmapi.SSHCertificate) {
+				sshCrt.Status.Revision = new(2)
+			}),
+			secrets:  []runtime.Object{issuedSecret(sshCertificate(), fixedNow.Add(-time.Hour), fixedNow.Add(23*time.Hour))},
+			requests: []runtime.Object{request("1"), request("2")},
+			wantActions: []testpkg.Action{
+				deleteRequestAction("test-1"),
+				updateStatusAction(func(status *cmapi.SSHCertificateStatus) error {
+					if len(status.Conditions) != 1 || status.Conditions[0].Status != cmmeta.ConditionTrue {
+						return fmt.Errorf("unexpected conditions %v", status.Conditions)
+					}
+					return nil
+				}),
+			},
+		},
+		"if the SSH certificate is due for renewal and the private key is never rotated, should request an SSH certificate for the existing key": {
+			sshCertificate: sshCertificate(func(sshCrt *cmapi.SSHCertificate) {
+				sshCrt.Spec.PrivateKey = &cmapi.SSHCertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyNever}
+				sshCrt.Status.Revision = new(1)
+			}),
+			secrets: []runtime.Object{issuedSecret(sshCertificate(), fixedNow.Add(-20*time.Hour), fixedNow.Add(4*time.Hour))},
+			wantActions: []testpkg.Action{
+				createRequestAction("2", func() ssh.PublicKey {
+					pub, err := ssh.NewPublicKey(existingKey.Public())
+					require.NoError(t, err)
+					return pub
+				}()),
+			},
+			wantEvents: []string{`Normal Requested Created new SSHCertificateRequest resource "test-notrandom"`},
+		},
+		"if the last issuance failed recently, should back off": {
+			sshCertificate: sshCertificate(func(sshCrt *cmapi.SSHCertificate) {
+				sshCrt.Status.LastFailureTime = &metav1.Time{Time: fixedNow.Add(-time.Minute)}
+				sshCrt.Status.FailedIssuanceAttempts = new(1)
+				sshCrt.Status.Conditions = []cmapi.CertificateCondition{{
+					Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionFalse, Reason: reasonFailed, ObservedGeneration: 1,
+				}}
+			}),
+		},
+		"if the SSHCertificate changed since the last failure, should not back off": {
+			sshCertificate: sshCertificate(withNextPrivateKeySecret, func(sshCrt *cmapi.SSHCertificate) {
+				sshCrt.Generation = 2
+				sshCrt.Status.LastFailureTime = &metav1.Time{Time: fixedNow.Add(-time.Minute)}
+				sshCrt.Status.FailedIssuanceAttempts = new(1)
+				sshCrt.Status.Conditions = []cmapi.CertificateCondition{{
+					Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionFalse, Reason: reasonFailed, ObservedGeneration: 1,
+				}}
+			}),
+			secrets:  []runtime.Object{nextPrivateKeySecret},
+			requests: []runtime.Object{issuedRequest},
+			wantActions: []testpkg.Action{
+				applySecretAction("alice"),
+				testpkg.NewAction(coretesting.NewDeleteAction(secretsGVR, "test-namespace", "test-next")),
+				updateStatusAction(issuedStatus(1)),
+			},
+			wantEvents: []string{"Normal Issued The SSH certificate has been successfully issued"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				KubeObjects:        test.secrets,
+				CertManagerObjects: test.requests,
+				ExpectedActions:    test.wantActions,
+				ExpectedEvents:     test.wantEvents,
+				StringGenerator:    func(int) string { return "notrandom" },
+			}
+			if test.sshCertificate != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.sshCertificate)
+			}
+			builder.Init()
+			builder.CertificateOptions.CertificateRequestMinimumBackoffDuration = time.Hour
+			builder.CertificateOptions.CertificateRequestMaximumBackoffDuration = 32 * time.Hour
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(t.Context(), types.NamespacedName{Namespace: "test-namespace", Name: "test"})
+			assert.Equal(t, test.wantErr, err != nil, "unexpected error: %v", err)
+
+			builder.CheckAndFinish()
+		})
+	}
+}
+
+// verifySecret checks that the given Secret contains a private key and an SSH
+// certificate for it, signed by the given CA for the given principals.
+func verifySecret(secret *corev1.Secret, caPublicKey ssh.PublicKey, principals []string) error {
+	key, err := ssh.ParseRawPrivateKey(secret.Data[cmapi.SSHPrivateKeySecretKey])
+	if err != nil {
+		return fmt.Errorf("invalid private key: %w", err)
+	}
+	pub, err := ssh.NewPublicKey(key.(crypto.Signer).Public())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(ssh.MarshalAuthorizedKey(pub), secret.Data[cmapi.SSHPublicKeySecretKey]) {
+		return errors.New("public key does not match the private key")
+	}
+	if !bytes.Equal(ssh.MarshalAuthorizedKey(caPublicKey), secret.Data[cmapi.SSHCAPublicKeySecretKey]) {
+		return errors.New("unexpected CA public key")
+	}
+
+	cert, err := pki.ParseSSHCertificate(secret.Data[cmapi.SSHCertificateSecretKey])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(cert.Key.Marshal(), pub.Marshal()) {
+		return errors.New("SSH certificate does not match the private key")
+	}
+
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return bytes.Equal(auth.Marshal(), caPublicKey.Marshal())
+		},
+		// The certificate is checked against the real clock.
+		Clock: func() time.Time { return time.Unix(int64(cert.ValidAfter), 0) }, // #nosec G115 -- test times are positive
+	}
+	for _, principal := range principals {
+		if err := checker.CheckCert(principal, cert); err != nil {
+			return err
+		}
+	}
+	if len(cert.ValidPrincipals) != len(principals) {
+		return fmt.Errorf("unexpected principals %v", cert.ValidPrincipals)
+	}
+	if _, ok := cert.Extensions["permit-pty"]; !ok {
+		return errors.New("default user extensions are not set")
+	}
+	return nil
+}
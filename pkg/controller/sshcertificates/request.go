@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sshcertificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+// nextPrivateKey returns the private key to request the next SSH certificate
+// for. The existing private key is reused if the rotation policy is Never,
+// otherwise a new private key is stored in a temporary Secret until the SSH
+// certificate has been issued. nil is returned while this Secret is being
+// created.
+func (c *controller) nextPrivateKey(ctx context.Context, sshCrt *cmapi.SSHCertificate, existingKey crypto.Signer) (crypto.Signer, error) {
+	log := logf.FromContext(ctx)
+
+	if existingKey != nil && privateKeyMatchesSpec(sshCrt, existingKey) &&
+		sshCrt.Spec.PrivateKey != nil && sshCrt.Spec.PrivateKey.RotationPolicy == cmapi.RotationPolicyNever {
+		return existingKey, nil
+	}
+
+	if name := sshCrt.Status.NextPrivateKeySecretName; name != nil {
+		secret, err := c.secretLister.Secrets(sshCrt.Namespace).Get(*name)
+		if err != nil && !k8sErrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			key, err := parsePrivateKey(secret)
+			if err == nil && privateKeyMatchesSpec(sshCrt, key) {
+				return key, nil
+			}
+			log.V(logf.DebugLevel).Info("deleting the next private key Secret as it does not contain a private key matching the spec", "secret", *name)
+			return nil, c.deleteNextPrivateKeySecret(ctx, sshCrt)
+		}
+	}
+
+	key, err := generatePrivateKey(sshCrt)
+	if err != nil {
+		return nil, c.failed(ctx, sshCrt, fmt.Sprintf("Failed to generate private key: %v", err))
+	}
+	secret, err := c.createNextPrivateKeySecret(ctx, sshCrt, key)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.Eventf(sshCrt, corev1.EventTypeNormal, reasonGenerated, "Stored new private key in temporary Secret resource %q", secret.Name)
+
+	return nil, c.updateStatus(ctx, sshCrt, func(status *cmapi.SSHCertificate) {
+		status.Status.NextPrivateKeySecretName = &secret.Name
+	})
+}
+
+// createNextPrivateKeySecret stores the given private key in a temporary
+// Secret owned by the SSHCertificate.
+func (c *controller) createNextPrivateKeySecret(ctx context.Context, sshCrt *cmapi.SSHCertificate, key crypto.Signer) (*corev1.Secret, error) {
+	// The status may have been updated with the name of the Secret since
+	// the SSHCertificate was cached, so the latest object is fetched to
+	// avoid creating the Secret twice.
+	latest, err := c.cmClient.CertmanagerV1().SSHCertificates(sshCrt.Namespace).Get(ctx, sshCrt.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	name := ptr.Deref(latest.Status.NextPrivateKeySecretName, "")
+
+	keyPEM, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       sshCrt.Namespace,
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(sshCrt, sshCertificateGvk)},
+			Labels: map[string]string{
+				cmapi.IsNextPrivateKeySecretLabelKey:      "true",
+				cmapi.PartOfCertManagerControllerLabelKey: "true",
+			},
+			Annotations: map[string]string{cmapi.SSHCertificateNameKey: sshCrt.Name},
+		},
+		Data: map[string][]byte{cmapi.SSHPrivateKeySecretKey: pem.EncodeToMemory(keyPEM)},
+	}
+	if name == "" {
+		secret.GenerateName = sshCrt.Name + "-"
+	}
+
+	created, err := c.secretClient.Secrets(sshCrt.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if k8sErrors.IsAlreadyExists(err) && name != "" {
+		return c.secretClient.Secrets(sshCrt.Namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	return created, err
+}
+
+// deleteNextPrivateKeySecret deletes the temporary Secret holding the next
+// private key, if any.
+func (c *controller) deleteNextPrivateKeySecret(ctx context.Context, sshCrt *cmapi.SSHCertificate) error {
+	name := sshCrt.Status.NextPrivateKeySecretName
+	if name == nil {
+		return nil
+	}
+	err := c.secretClient.Secrets(sshCrt.Namespace).Delete(ctx, *name, metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// nextRevision returns the revision of the next issuance of the
+// SSHCertificate.
+func nextRevision(sshCrt *cmapi.SSHCertificate) int {
+	if sshCrt.Status.Revision == nil {
+		return 1
+	}
+	return *sshCrt.Status.Revision + 1
+}
+
+// ownedRequests returns the SSHCertificateRequests owned by the
+// SSHCertificate.
+func (c *controller) ownedRequests(sshCrt *cmapi.SSHCertificate) ([]*cmapi.SSHCertificateRequest, error) {
+	reqs, err := c.sshCertificateRequestLister.SSHCertificateRequests(sshCrt.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	owned := predicate.ResourceOwnedBy[*cmapi.SSHCertificateRequest](sshCrt)
+	return slices.DeleteFunc(reqs, func(req *cmapi.SSHCertificateRequest) bool { return !owned(req) }), nil
+}
+
+// requestForRevision returns the SSHCertificateRequest of the given
+// revision, or nil if it does not exist.
+func (c *controller) requestForRevision(sshCrt *cmapi.SSHCertificate, revision int) (*cmapi.SSHCertificateRequest, error) {
+	reqs, err := c.ownedRequests(sshCrt)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range reqs {
+		if req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey] == strconv.Itoa(revision) {
+			return req, nil
+		}
+	}
+	return nil, nil
+}
+
+// createRequest creates the SSHCertificateRequest of the given revision for
+// the public key.
+func (c *controller) createRequest(ctx context.Context, sshCrt *cmapi.SSHCertificate, revision int, pub ssh.PublicKey) (*cmapi.SSHCertificateRequest, error) {
+	req := &cmapi.SSHCertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       sshCrt.Namespace,
+			GenerateName:    sshCrt.Name + "-",
+			Annotations:     map[string]string{cmapi.CertificateRequestRevisionAnnotationKey: strconv.Itoa(revision)},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(sshCrt, sshCertificateGvk)},
+		},
+		Spec: buildRequestSpec(sshCrt, pub),
+	}
+	return c.cmClient.CertmanagerV1().SSHCertificateRequests(sshCrt.Namespace).Create(ctx, req, metav1.CreateOptions{})
+}
+
+func (c *controller) deleteRequest(ctx context.Context, req *cmapi.SSHCertificateRequest) error {
+	err := c.cmClient.CertmanagerV1().SSHCertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// cleanup deletes the Secret holding the next private key and the
+// SSHCertificateRequests of previous issuances, keeping only the request of
+// the stored SSH certificate.
+func (c *controller) cleanup(ctx context.Context, sshCrt *cmapi.SSHCertificate) error {
+	if err := c.deleteNextPrivateKeySecret(ctx, sshCrt); err != nil {
+		return err
+	}
+
+	reqs, err := c.ownedRequests(sshCrt)
+	if err != nil {
+		return err
+	}
+	current := ""
+	if sshCrt.Status.Revision != nil {
+		current = strconv.Itoa(*sshCrt.Status.Revision)
+	}
+	for _, req := range reqs {
+		if req.DeletionTimestamp != nil || req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey] == current {
+			continue
+		}
+		if err := c.deleteRequest(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildRequestSpec returns the spec of the SSHCertificateRequest for the
+// SSHCertificate and public key.
+func buildRequestSpec(sshCrt *cmapi.SSHCertificate, pub ssh.PublicKey) cmapi.SSHCertificateRequestSpec {
+	return cmapi.SSHCertificateRequestSpec{
+		Type:            sshCrt.Spec.Type,
+		KeyID:           keyID(sshCrt),
+		Principals:      sshCrt.Spec.Principals,
+		Duration:        sshCrt.Spec.Duration,
+		Extensions:      sshCrt.Spec.Extensions,
+		CriticalOptions: sshCrt.Spec.CriticalOptions,
+		PublicKey:       ssh.MarshalAuthorizedKey(pub),
+		IssuerRef:       sshCrt.Spec.IssuerRef,
+	}
+}
+
+// requestMatches returns true if the SSHCertificateRequest requests the
+// given spec. The requester identity set by the webhook is ignored.
+func requestMatches(req *cmapi.SSHCertificateRequest, spec cmapi.SSHCertificateRequestSpec) bool {
+	got := *req.Spec.DeepCopy()
+	got.Username, got.UID, got.Groups, got.Extra = "", "", nil, nil
+	if got.Type == "" {
+		got.Type = cmapi.SSHCertificateTypeUser
+	}
+	if spec.Type == "" {
+		spec.Type = cmapi.SSHCertificateTypeUser
+	}
+	if got.IssuerRef.Name != spec.IssuerRef.Name ||
+		!apiutil.IssuerKindsEqual(got.IssuerRef.Kind, spec.IssuerRef.Kind) ||
+		!apiutil.IssuerGroupsEqual(got.IssuerRef.Group, spec.IssuerRef.Group) {
+		return false
+	}
+	got.IssuerRef = spec.IssuerRef
+	return apiequality.Semantic.DeepEqual(got, spec)
+}
+
+// generatePrivateKey generates a private key for the SSHCertificate with the
+// configured algorithm and size, defaulting to Ed25519.
+func generatePrivateKey(sshCrt *cmapi.SSHCertificate) (crypto.Signer, error) {
+	algorithm, size := privateKeyAlgorithmAndSize(sshCrt)
+	switch algorithm {
+	case cmapi.RSAKeyAlgorithm:
+		return pki.GenerateRSAPrivateKey(size)
+	case cmapi.ECDSAKeyAlgorithm:
+		return pki.GenerateECPrivateKey(size)
+	case cmapi.Ed25519KeyAlgorithm:
+		return pki.GenerateEd25519PrivateKey()
+	default:
+		return nil, fmt.Errorf("unsupported private key algorithm specified: %s", algorithm)
+	}
+}
+
+// privateKeyMatchesSpec returns true if the given private key has the
+// algorithm and size configured on the SSHCertificate.
+func privateKeyMatchesSpec(sshCrt *cmapi.SSHCertificate, key crypto.Signer) bool {
+	algorithm, size := privateKeyAlgorithmAndSize(sshCrt)
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		return algorithm == cmapi.RSAKeyAlgorithm && pub.N.BitLen() == size
+	case *ecdsa.PublicKey:
+		return algorithm == cmapi.ECDSAKeyAlgorithm && pub.Curve.Params().BitSize == size
+	case ed25519.PublicKey:
+		return algorithm == cmapi.Ed25519KeyAlgorithm
+	default:
+		return false
+	}
+}
+
+func privateKeyAlgorithmAndSize(sshCrt *cmapi.SSHCertificate) (cmapi.PrivateKeyAlgorithm, int) {
+	algorithm := cmapi.Ed25519KeyAlgorithm
+	size := 0
+	if pk := sshCrt.Spec.PrivateKey; pk != nil {
+		if pk.Algorithm != "" {
+			algorithm = pk.Algorithm
+		}
+		size = pk.Size
+	}
+
+	if size == 0 {
+		switch algorithm {
+		case cmapi.RSAKeyAlgorithm:
+			size = pki.MinRSAKeySize
+		case cmapi.ECDSAKeyAlgorithm:
+			size = pki.ECCurve256
+		}
+	}
+
+	return algorithm, size
+}
+
+// sshCertificateType returns the type of the SSHCertificate, defaulting to
+// User.
+func sshCertificateType(sshCrt *cmapi.SSHCertificate) cmapi.SSHCertificateType {
+	if sshCrt.Spec.Type == "" {
+		return cmapi.SSHCertificateTypeUser
+	}
+	return sshCrt.Spec.Type
+}
+
+// keyID returns the key ID of the SSHCertificate, defaulting to
+// `<namespace>/<name>`.
+func keyID(sshCrt *cmapi.SSHCertificate) string {
+	if sshCrt.Spec.KeyID != "" {
+		return sshCrt.Spec.KeyID
+	}
+	return sshCrt.Namespace + "/" + sshCrt.Name
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlssecretexpiry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the string used to refer to this controller
+	// when enabling or disabling it from command line flags.
+	ControllerName = "tls-secret-expiry"
+
+	// reasonCertificateExpiring is the reason of the Warning Event fired when
+	// the certificate in a Secret crosses one of the configured thresholds.
+	reasonCertificateExpiring = "CertificateExpiring"
+
+	// reasonCertificateExpired is the reason of the Warning Event fired when
+	// the certificate in a Secret has expired.
+	reasonCertificateExpired = "CertificateExpired"
+)
+
+// This controller observes kubernetes.io/tls Secrets which are not managed by
+// a Certificate. It exposes the expiry of the stored certificate as a metric,
+// and fires a Warning Event on the Secret and optionally POSTs to a webhook
+// each time the certificate crosses one of the configured thresholds before
+// expiry.
+// Which thresholds have already been notified is held in memory, so a
+// notification for the current threshold is sent again after a restart.
+// When the SecretsFilteredCaching feature is enabled, only Secrets labelled
+// with controller.cert-manager.io/fao=true are observed, as fetching every
+// other Secret from the API server would defeat the filtered cache.
+type controller struct {
+	secretLister       internalinformers.SecretLister
+	recorder           record.EventRecorder
+	metrics            *metrics.Metrics
+	scheduledWorkQueue scheduler.ScheduledWorkQueue[types.NamespacedName]
+	clock              clock.Clock
+
+	// thresholds are the configured notification thresholds, sorted in
+	// descending order and terminated by 0 which represents expiry.
+	thresholds []time.Duration
+
+	// notifier, if set, is used to POST notifications to a webhook.
+	notifier notifier
+
+	notifiedLock sync.Mutex
+	// notified holds the last notified threshold for each Secret.
+	notified map[types.NamespacedName]notifiedThreshold
+}
+
+// notifiedThreshold records the threshold which was last notified for the
+// certificate with the given expiry.
+type notifiedThreshold struct {
+	notAfter  time.Time
+	threshold time.Duration
+}
+
+func NewController(ctx *controllerpkg.Context) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultItemBasedRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller.
+	// When the SecretsFilteredCaching feature is enabled, Secrets which are
+	// not labelled as being part of cert-manager are only cached as metadata.
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	if _, err := secretsInformer.Informer().AddEventHandler(controllerpkg.BlockingEventHandler(func(obj metav1.Object) {
+		// Skip Secrets which are only cached as metadata, as the lister
+		// would have to fetch them from the API server, and Secrets which
+		// are not of the TLS type.
+		secret, ok := obj.(*corev1.Secret)
+		if !ok || secret.Type != corev1.SecretTypeTLS {
+			return
+		}
+		queue.Add(cache.MetaObjectToName(obj).AsNamespacedName())
+	})); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the
+	// Register method.  the controller will only begin processing items once all
+	// of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+	}
+
+	thresholds := slices.Clone(ctx.TLSSecretExpiryOptions.Thresholds)
+	slices.Sort(thresholds)
+	slices.Reverse(thresholds)
+	thresholds = append(thresholds, 0)
+
+	var n notifier
+	if ctx.TLSSecretExpiryOptions.WebhookURL != "" {
+		n = newWebhookNotifier(ctx.TLSSecretExpiryOptions.WebhookURL)
+	}
+
+	return &controller{
+		secretLister:       secretsInformer.Lister(),
+		recorder:           ctx.Recorder,
+		metrics:            ctx.Metrics,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		clock:              ctx.Clock,
+		thresholds:         slices.Compact(thresholds),
+		notifier:           n,
+		notified:           make(map[types.NamespacedName]notifiedThreshold),
+	}, queue, mustSync, nil
+}
+
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+	ctx = logf.NewContext(ctx, log)
+
+	secret, err := c.secretLister.Secrets(key.Namespace).Get(key.Name)
+	if k8sErrors.IsNotFound(err) {
+		c.forget(key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if secret.Type != corev1.SecretTypeTLS {
+		c.forget(key)
+		return nil
+	}
+
+	// A Secret which is no longer labelled is not cached when the
+	// SecretsFilteredCaching feature is enabled, so it is not observed.
+	if utilfeature.DefaultFeatureGate.Enabled(feature.SecretsFilteredCaching) &&
+		secret.Labels[cmapi.PartOfCertManagerControllerLabelKey] != "true" {
+		c.forget(key)
+		return nil
+	}
+
+	// Secrets managed by a Certificate are already covered by the
+	// Certificate metrics and renewal.
+	if _, ok := secret.Annotations[cmapi.CertificateNameKey]; ok {
+		c.forget(key)
+		return nil
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		log.V(logf.DebugLevel).Info("Secret does not contain a valid certificate, skipping", "error", err.Error())
+		c.forget(key)
+		return nil
+	}
+
+	c.metrics.UpdateTLSSecretExpiry(key.Namespace, key.Name, cert.NotAfter)
+
+	now := c.clock.Now()
+	remaining := cert.NotAfter.Sub(now)
+
+	if threshold, crossed := c.crossedThreshold(remaining); crossed && !c.hasNotified(key, cert.NotAfter, threshold) {
+		if err := c.notify(ctx, secret, cert.Subject.CommonName, cert.DNSNames, cert.NotAfter, threshold, remaining); err != nil {
+			return err
+		}
+		c.setNotified(key, cert.NotAfter, threshold)
+	}
+
+	c.scheduleNextCheck(log, key, cert.NotAfter, now)
+
+	return nil
+}
+
+// notify POSTs to the webhook, if configured, and fires a Warning Event on
+// the Secret for the given threshold. The Event is only fired once the
+// webhook has been successfully notified so that a failed webhook call which
+// is retried does not fire duplicate Events.
+func (c *controller) notify(ctx context.Context, secret *corev1.Secret, commonName string, dnsNames []string, notAfter time.Time, threshold, remaining time.Duration) error {
+	expired := threshold == 0
+
+	if c.notifier != nil {
+		if err := c.notifier.Notify(ctx, notification{
+			Namespace:  secret.Namespace,
+			Name:       secret.Name,
+			CommonName: commonName,
+			DNSNames:   dnsNames,
+			NotAfter:   notAfter,
+			Threshold:  threshold.String(),
+			Expired:    expired,
+		}); err != nil {
+			return fmt.Errorf("failed to send expiry notification for Secret: %w", err)
+		}
+	}
+
+	if expired {
+		c.recorder.Eventf(secret, corev1.EventTypeWarning, reasonCertificateExpired,
+			"Certificate in Secret expired at %s", notAfter.Format(time.RFC3339))
+		return nil
+	}
+
+	c.recorder.Eventf(secret, corev1.EventTypeWarning, reasonCertificateExpiring,
+		"Certificate in Secret expires in %s at %s, which is within the %s notification threshold",
+		remaining.Round(time.Second), notAfter.Format(time.RFC3339), threshold)
+	return nil
+}
+
+// crossedThreshold returns the smallest configured threshold which the given
+// remaining duration until expiry is within. A threshold of 0 is returned if
+// the certificate has expired.
+func (c *controller) crossedThreshold(remaining time.Duration) (time.Duration, bool) {
+	for i := len(c.thresholds) - 1; i >= 0; i-- {
+		if remaining <= c.thresholds[i] {
+			return c.thresholds[i], true
+		}
+	}
+	return 0, false
+}
+
+// scheduleNextCheck schedules the Secret to be processed again when it
+// crosses the next threshold, or expires.
+func (c *controller) scheduleNextCheck(log logr.Logger, key types.NamespacedName, notAfter, now time.Time) {
+	for _, threshold := range c.thresholds {
+		next := notAfter.Add(-threshold)
+		if next.After(now) {
+			log.V(logf.DebugLevel).Info("scheduling next expiry check", "next_check", next)
+			c.scheduledWorkQueue.Add(key, next.Sub(now))
+			return
+		}
+	}
+}
+
+func (c *controller) hasNotified(key types.NamespacedName, notAfter time.Time, threshold time.Duration) bool {
+	c.notifiedLock.Lock()
+	defer c.notifiedLock.Unlock()
+
+	last, ok := c.notified[key]
+	return ok && last.notAfter.Equal(notAfter) && last.threshold == threshold
+}
+
+func (c *controller) setNotified(key types.NamespacedName, notAfter time.Time, threshold time.Duration) {
+	c.notifiedLock.Lock()
+	defer c.notifiedLock.Unlock()
+
+	c.notified[key] = notifiedThreshold{notAfter: notAfter, threshold: threshold}
+}
+
+// forget removes all state held for the given Secret, for example because it
+// has been deleted or is no longer a TLS Secret.
+func (c *controller) forget(key types.NamespacedName) {
+	c.notifiedLock.Lock()
+	delete(c.notified, key)
+	c.notifiedLock.Unlock()
+
+	c.scheduledWorkQueue.Forget(key)
+	c.metrics.RemoveTLSSecretExpiry(key.Namespace, key.Name)
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	ctrl, queue, mustSync, err := NewController(ctx)
+	c.controller = ctrl
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlssecretexpiry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_controller_ProcessItem(t *testing.T) {
+	fixedNow := time.Now().Truncate(time.Second)
+	fixedClock := fakeclock.NewFakeClock(fixedNow)
+
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	spec := gen.Certificate("test",
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+
+	tlsSecret := func(notAfter time.Time, mods ...gen.SecretModifier) *corev1.Secret {
+		return gen.Secret("test-secret", append([]gen.SecretModifier{
+			gen.SetSecretNamespace("test-namespace"),
+			gen.SetSecretType(corev1.SecretTypeTLS),
+			gen.SetSecretLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}),
+			gen.SetSecretData(map[string][]byte{
+				corev1.TLSCertKey:       testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, spec, fixedNow.Add(-time.Hour), notAfter),
+				corev1.TLSPrivateKeyKey: pk,
+			}),
+		}, mods...)...)
+	}
+
+	tests := map[string]struct {
+		existingSecret *corev1.Secret
+		// alreadyNotified, if set, is the threshold which has already been
+		// notified for the existing Secret.
+		alreadyNotified *time.Duration
+		webhookStatus   int
+		// filteredCachingDisabled disables the SecretsFilteredCaching
+		// feature, under which only labelled Secrets are observed.
+		filteredCachingDisabled bool
+
+		wantEvents        []string
+		wantNotifications []notification
+		wantErr           bool
+	}{
+		"if the Secret does not exist, should do nothing": {},
+		"if the Secret is not a TLS Secret, should do nothing": {
+			existingSecret: tlsSecret(fixedNow.Add(time.Hour), gen.SetSecretType(corev1.SecretTypeOpaque)),
+		},
+		"if the Secret is managed by a Certificate, should do nothing": {
+			existingSecret: tlsSecret(fixedNow.Add(time.Hour), gen.SetSecretAnnotations(map[string]string{
+				cmapi.CertificateNameKey: "test",
+			})),
+		},
+		"if the Secret does not contain a valid certificate, should do nothing": {
+			existingSecret: gen.Secret("test-secret",
+				gen.SetSecretNamespace("test-namespace"),
+				gen.SetSecretType(corev1.SecretTypeTLS),
+				gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: []byte("invalid")}),
+			),
+		},
+		"if the Secret is not labelled and filtered caching is enabled, should do nothing": {
+			existingSecret: tlsSecret(fixedNow.Add(72*time.Hour), gen.SetSecretLabels(nil)),
+		},
+		"if the Secret is not labelled and filtered caching is disabled, should fire an Event and call the webhook": {
+			existingSecret:          tlsSecret(fixedNow.Add(72*time.Hour), gen.SetSecretLabels(nil)),
+			filteredCachingDisabled: true,
+			wantEvents: []string{
+				"Warning CertificateExpiring Certificate in Secret expires in 72h0m0s at " +
+					fixedNow.Add(72*time.Hour).UTC().Format(time.RFC3339) +
+					", which is within the 168h0m0s notification threshold",
+			},
+			wantNotifications: []notification{{
+				Namespace:  "test-namespace",
+				Name:       "test-secret",
+				CommonName: "example.com",
+				DNSNames:   []string{"example.com"},
+				NotAfter:   fixedNow.Add(72 * time.Hour).UTC(),
+				Threshold:  "168h0m0s",
+			}},
+		},
+		"if the certificate is not within any threshold, should not notify": {
+			existingSecret: tlsSecret(fixedNow.Add(60 * 24 * time.Hour)),
+		},
+		"if the certificate is within a threshold, should fire an Event and call the webhook": {
+			existingSecret: tlsSecret(fixedNow.Add(72 * time.Hour)),
+			wantEvents: []string{
+				"Warning CertificateExpiring Certificate in Secret expires in 72h0m0s at " +
+					fixedNow.Add(72*time.Hour).UTC().Format(time.RFC3339) +
+					", which is within the 168h0m0s notification threshold",
+			},
+			wantNotifications: []notification{{
+				Namespace:  "test-namespace",
+				Name:       "test-secret",
+				CommonName: "example.com",
+				DNSNames:   []string{"example.com"},
+				NotAfter:   fixedNow.Add(72 * time.Hour).UTC(),
+				Threshold:  "168h0m0s",
+			}},
+		},
+		"if the threshold has already been notified, should not notify again": {
+			existingSecret:  tlsSecret(fixedNow.Add(72 * time.Hour)),
+			alreadyNotified: new(168 * time.Hour),
+		},
+		"if a larger threshold has been notified, should notify the smaller threshold": {
+			existingSecret:  tlsSecret(fixedNow.Add(12 * time.Hour)),
+			alreadyNotified: new(168 * time.Hour),
+			wantEvents: []string{
+				"Warning CertificateExpiring Certificate in Secret expires in 12h0m0s at " +
+					fixedNow.Add(12*time.Hour).UTC().Format(time.RFC3339) +
+					", which is within the 24h0m0s notification threshold",
+			},
+			wantNotifications: []notification{{
+				Namespace:  "test-namespace",
+				Name:       "test-secret",
+				CommonName: "example.com",
+				DNSNames:   []string{"example.com"},
+				NotAfter:   fixedNow.Add(12 * time.Hour).UTC(),
+				Threshold:  "24h0m0s",
+			}},
+		},
+		"if the certificate has expired, should fire an expired Event": {
+			existingSecret: tlsSecret(fixedNow.Add(-time.Minute)),
+			wantEvents: []string{
+				"Warning CertificateExpired Certificate in Secret expired at " +
+					fixedNow.Add(-time.Minute).UTC().Format(time.RFC3339),
+			},
+			wantNotifications: []notification{{
+				Namespace:  "test-namespace",
+				Name:       "test-secret",
+				CommonName: "example.com",
+				DNSNames:   []string{"example.com"},
+				NotAfter:   fixedNow.Add(-time.Minute).UTC(),
+				Threshold:  "0s",
+				Expired:    true,
+			}},
+		},
+		"if the webhook fails, should return an error and not fire an Event": {
+			existingSecret: tlsSecret(fixedNow.Add(72 * time.Hour)),
+			webhookStatus:  http.StatusInternalServerError,
+			wantNotifications: []notification{{
+				Namespace:  "test-namespace",
+				Name:       "test-secret",
+				CommonName: "example.com",
+				DNSNames:   []string{"example.com"},
+				NotAfter:   fixedNow.Add(72 * time.Hour).UTC(),
+				Threshold:  "168h0m0s",
+			}},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.filteredCachingDisabled {
+				featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.SecretsFilteredCaching, false)
+			}
+
+			var (
+				lock          sync.Mutex
+				notifications []notification
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var n notification
+				if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+					t.Errorf("failed to decode notification: %v", err)
+				}
+				lock.Lock()
+				notifications = append(notifications, n)
+				lock.Unlock()
+				if test.webhookStatus != 0 {
+					w.WriteHeader(test.webhookStatus)
+				}
+			}))
+			defer server.Close()
+
+			builder := &testpkg.Builder{
+				T:              t,
+				Clock:          fixedClock,
+				ExpectedEvents: test.wantEvents,
+			}
+			if test.existingSecret != nil {
+				builder.KubeObjects = []runtime.Object{test.existingSecret}
+			}
+			builder.Init()
+			builder.TLSSecretExpiryOptions.Thresholds = []time.Duration{24 * time.Hour, 30 * 24 * time.Hour, 7 * 24 * time.Hour}
+			builder.TLSSecretExpiryOptions.WebhookURL = server.URL
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			key := types.NamespacedName{Namespace: "test-namespace", Name: "test-secret"}
+			if test.alreadyNotified != nil {
+				cert, err := pki.DecodeX509CertificateBytes(test.existingSecret.Data[corev1.TLSCertKey])
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.setNotified(key, cert.NotAfter, *test.alreadyNotified)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(t.Context(), key)
+			assert.Equal(t, test.wantErr, err != nil, "unexpected error: %v", err)
+
+			lock.Lock()
+			assert.Equal(t, test.wantNotifications, notifications)
+			lock.Unlock()
+
+			builder.CheckAndFinish()
+		})
+	}
+}
+
+func Test_controller_crossedThreshold(t *testing.T) {
+	c := &controller{thresholds: []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour, 0}}
+
+	tests := map[string]struct {
+		remaining     time.Duration
+		wantThreshold time.Duration
+		wantCrossed   bool
+	}{
+		"not within any threshold": {remaining: 31 * 24 * time.Hour},
+		"within the largest threshold": {
+			remaining: 10 * 24 * time.Hour, wantThreshold: 30 * 24 * time.Hour, wantCrossed: true,
+		},
+		"exactly on a threshold": {
+			remaining: 7 * 24 * time.Hour, wantThreshold: 7 * 24 * time.Hour, wantCrossed: true,
+		},
+		"within the smallest threshold": {
+			remaining: time.Hour, wantThreshold: 24 * time.Hour, wantCrossed: true,
+		},
+		"expired": {
+			remaining: -time.Hour, wantThreshold: 0, wantCrossed: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			threshold, crossed := c.crossedThreshold(test.remaining)
+			assert.Equal(t, test.wantThreshold, threshold)
+			assert.Equal(t, test.wantCrossed, crossed)
+		})
+	}
+}
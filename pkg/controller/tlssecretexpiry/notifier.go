@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlssecretexpiry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// notification is the JSON body POSTed to the notification webhook.
+type notification struct {
+	// Namespace is the namespace of the Secret.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Secret.
+	Name string `json:"name"`
+	// CommonName is the subject common name of the certificate in the Secret.
+	CommonName string `json:"commonName,omitempty"`
+	// DNSNames are the DNS names of the certificate in the Secret.
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// NotAfter is the time at which the certificate expires.
+	NotAfter time.Time `json:"notAfter"`
+	// Threshold is the threshold which was crossed, for example "168h0m0s".
+	Threshold string `json:"threshold"`
+	// Expired is true if the certificate has expired.
+	Expired bool `json:"expired"`
+}
+
+type notifier interface {
+	Notify(ctx context.Context, n notification) error
+}
+
+// webhookNotifier POSTs notifications as JSON to a URL.
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{
+		url: url,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
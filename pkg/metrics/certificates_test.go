@@ -17,6 +17,7 @@ limitations under the License.
 package metrics
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -24,13 +25,20 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/clock"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
 	"github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
@@ -257,7 +265,7 @@ func TestCertificateMetrics(t *testing.T) {
 			err := certsInformer.Informer().GetIndexer().Add(test.crt)
 			assert.NoError(t, err)
 
-			m.SetupCertificateCollector(certsInformer.Lister())
+			require.NoError(t, m.SetupCertificateCollector(certsInformer.Lister(), nil, nil, false))
 
 			if err := testutil.CollectAndCompare(m.certificateCollector,
 				strings.NewReader(notAfterMetadata+test.expectedNotAfter),
@@ -378,7 +386,7 @@ func TestCertificateCache(t *testing.T) {
 	err = certsInformer.Informer().GetIndexer().Add(crt3)
 	assert.NoError(t, err)
 
-	m.SetupCertificateCollector(certsInformer.Lister())
+	require.NoError(t, m.SetupCertificateCollector(certsInformer.Lister(), nil, nil, false))
 
 	// Check all three metrics exist
 	if err := testutil.CollectAndCompare(m.certificateCollector,
@@ -511,3 +519,183 @@ func TestCertificateCache(t *testing.T) {
 		t.Errorf("unexpected collecting result")
 	}
 }
+
+func TestCertificateInfoCollector(t *testing.T) {
+	const infoMetadata = `
+	# HELP certmanager_certificate_info Information about the certificate stored in the Secret of the certificate. The value is always 1. The serial number is exposed by certmanager_certificate_serial_info, as it changes on every renewal.
+	# TYPE certmanager_certificate_info gauge
+`
+
+	crt := gen.Certificate("test-certificate",
+		gen.SetCertificateNamespace("test-ns"),
+		gen.SetCertificateUID("test-uid"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateDNSNames("example.com", "www.example.com"),
+		gen.SetCertificateIssuer(cmmeta.IssuerReference{
+			Name:  "test-issuer",
+			Kind:  "test-issuer-kind",
+			Group: "test-issuer-group",
+		}),
+		gen.SetCertificateRevision(2),
+	)
+
+	pk1 := testcrypto.MustCreatePEMPrivateKey(t)
+	pk2 := testcrypto.MustCreatePEMPrivateKey(t)
+	certPEM := testcrypto.MustCreateCert(t, pk2, crt)
+
+	secretIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, secretIndexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "test-ns", UID: "secret-uid", ResourceVersion: "1"},
+		Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+	}))
+
+	fakeClient := fake.NewClientset()
+	factory := externalversions.NewSharedInformerFactory(fakeClient, 0)
+	certsInformer := factory.Certmanager().V1().Certificates()
+	reqsInformer := factory.Certmanager().V1().CertificateRequests()
+	require.NoError(t, certsInformer.Informer().GetIndexer().Add(crt))
+	for revision, pk := range map[string][]byte{"1": pk1, "2": pk2} {
+		require.NoError(t, reqsInformer.Informer().GetIndexer().Add(gen.CertificateRequest("test-certificate-"+revision,
+			gen.SetCertificateRequestNamespace("test-ns"),
+			gen.SetCertificateRequestUID(types.UID("test-request-uid-"+revision)),
+			gen.SetCertificateRequestRevision(revision),
+			gen.SetCertificateRequestCSR(testcrypto.MustGenerateCSRImpl(t, pk, crt)),
+			gen.AddCertificateRequestOwnerReferences(*metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind("Certificate"))),
+		)))
+	}
+
+	m := New(testr.New(t), clock.RealClock{})
+	require.NoError(t, m.SetupCertificateCollector(certsInformer.Lister(), corelisters.NewSecretLister(secretIndexer), reqsInformer.Informer(), false))
+
+	expected := `
+	certmanager_certificate_info{chain_length="1",chain_signature_algorithms="SHA256-RSA",issuer_common_name="example.com",issuer_group="test-issuer-group",issuer_kind="test-issuer-kind",issuer_name="test-issuer",key_algorithm="RSA",key_rotated="true",key_size="2048",name="test-certificate",namespace="test-ns",san_count="2",signature_algorithm="SHA256-RSA"} 1
+`
+
+	if err := testutil.CollectAndCompare(m.certificateCollector,
+		strings.NewReader(infoMetadata+expected),
+		"certmanager_certificate_info",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	// Renew the Certificate reusing the private key of the previous revision
+	require.NoError(t, reqsInformer.Informer().GetIndexer().Add(gen.CertificateRequest("test-certificate-3",
+		gen.SetCertificateRequestNamespace("test-ns"),
+		gen.SetCertificateRequestUID("test-request-uid-3"),
+		gen.SetCertificateRequestRevision("3"),
+		gen.SetCertificateRequestCSR(testcrypto.MustGenerateCSRImpl(t, pk2, crt)),
+		gen.AddCertificateRequestOwnerReferences(*metav1.NewControllerRef(crt, cmapi.SchemeGroupVersion.WithKind("Certificate"))),
+	)))
+	require.NoError(t, certsInformer.Informer().GetIndexer().Update(gen.CertificateFrom(crt, gen.SetCertificateRevision(3))))
+
+	expected = `
+	certmanager_certificate_info{chain_length="1",chain_signature_algorithms="SHA256-RSA",issuer_common_name="example.com",issuer_group="test-issuer-group",issuer_kind="test-issuer-kind",issuer_name="test-issuer",key_algorithm="RSA",key_rotated="false",key_size="2048",name="test-certificate",namespace="test-ns",san_count="2",signature_algorithm="SHA256-RSA"} 1
+`
+
+	if err := testutil.CollectAndCompare(m.certificateCollector,
+		strings.NewReader(infoMetadata+expected),
+		"certmanager_certificate_info",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestCertificateSerialInfoCollector(t *testing.T) {
+	const serialInfoMetadata = `
+	# HELP certmanager_certificate_serial_info The hex encoded serial number of the certificate stored in the Secret of the certificate. The value is always 1. Only exposed if enabled, as every renewal creates a new time series.
+	# TYPE certmanager_certificate_serial_info gauge
+`
+
+	crt := gen.Certificate("test-certificate",
+		gen.SetCertificateNamespace("test-ns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateIssuer(cmmeta.IssuerReference{
+			Name:  "test-issuer",
+			Kind:  "test-issuer-kind",
+			Group: "test-issuer-group",
+		}),
+	)
+	certPEM := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), crt)
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	require.NoError(t, err)
+
+	secretIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	require.NoError(t, secretIndexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "test-ns", UID: "secret-uid", ResourceVersion: "1"},
+		Data:       map[string][]byte{corev1.TLSCertKey: certPEM},
+	}))
+
+	tests := map[string]struct {
+		enableSerialInfo bool
+		expected         string
+	}{
+		"the serial info metric is not exposed by default": {},
+		"the serial info metric is exposed if enabled": {
+			enableSerialInfo: true,
+			expected: serialInfoMetadata + fmt.Sprintf(`
+	certmanager_certificate_serial_info{issuer_group="test-issuer-group",issuer_kind="test-issuer-kind",issuer_name="test-issuer",name="test-certificate",namespace="test-ns",serial_number="%s"} 1
+`, cert.SerialNumber.Text(16)),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientset()
+			factory := externalversions.NewSharedInformerFactory(fakeClient, 0)
+			certsInformer := factory.Certmanager().V1().Certificates()
+			require.NoError(t, certsInformer.Informer().GetIndexer().Add(crt))
+
+			m := New(testr.New(t), clock.RealClock{})
+			require.NoError(t, m.SetupCertificateCollector(certsInformer.Lister(), corelisters.NewSecretLister(secretIndexer), nil, test.enableSerialInfo))
+
+			if err := testutil.CollectAndCompare(m.certificateCollector,
+				strings.NewReader(test.expected),
+				"certmanager_certificate_serial_info",
+			); err != nil {
+				t.Errorf("unexpected collecting result:\n%s", err)
+			}
+		})
+	}
+}
+
+func TestTLSSecretExpiryCollector(t *testing.T) {
+	const tlsSecretExpiryMetadata = `
+	# HELP certmanager_tls_secret_expiration_timestamp_seconds The timestamp after which the certificate in a kubernetes.io/tls Secret which is not managed by a Certificate expires, expressed in Unix Epoch Time.
+	# TYPE certmanager_tls_secret_expiration_timestamp_seconds gauge
+`
+
+	fakeClient := fake.NewClientset()
+	factory := externalversions.NewSharedInformerFactory(fakeClient, 0)
+	certsInformer := factory.Certmanager().V1().Certificates()
+
+	m := New(testr.New(t), clock.RealClock{})
+	require.NoError(t, m.SetupCertificateCollector(certsInformer.Lister(), nil, nil, false))
+
+	m.UpdateTLSSecretExpiry("test-ns", "test-secret-1", time.Unix(2208988800, 0))
+	m.UpdateTLSSecretExpiry("test-ns", "test-secret-2", time.Unix(4102444800, 0))
+
+	expected := `
+	certmanager_tls_secret_expiration_timestamp_seconds{name="test-secret-1",namespace="test-ns"} 2.2089888e+09
+	certmanager_tls_secret_expiration_timestamp_seconds{name="test-secret-2",namespace="test-ns"} 4.1024448e+09
+`
+	if err := testutil.CollectAndCompare(m.certificateCollector,
+		strings.NewReader(tlsSecretExpiryMetadata+expected),
+		"certmanager_tls_secret_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveTLSSecretExpiry("test-ns", "test-secret-1")
+
+	expected = `
+	certmanager_tls_secret_expiration_timestamp_seconds{name="test-secret-2",namespace="test-ns"} 4.1024448e+09
+`
+	if err := testutil.CollectAndCompare(m.certificateCollector,
+		strings.NewReader(tlsSecretExpiryMetadata+expected),
+		"certmanager_tls_secret_expiration_timestamp_seconds",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// Issuance failure reasons used as the value of the "reason" label of the
+// issuance_failures_total metric.
+const (
+	IssuanceFailureReasonDenied         = "Denied"
+	IssuanceFailureReasonFailed         = "Failed"
+	IssuanceFailureReasonIssuerNotReady = "IssuerNotReady"
+	IssuanceFailureReasonOrderInvalid   = "OrderInvalid"
+	IssuanceFailureReasonOrderErrored   = "OrderErrored"
+)
+
+// ObserveCertificateIssuanceDuration records the time taken between a
+// Certificate being marked as Issuing and the signed certificate being stored
+// in its Secret, or published as the next certificate of a staged private key
+// rotation.
+func (m *Metrics) ObserveCertificateIssuanceDuration(duration time.Duration, issuerRef cmmeta.IssuerReference) {
+	m.certificateIssuanceDurationSeconds.WithLabelValues(issuerRef.Name, issuerRef.Kind, issuerRef.Group).Observe(duration.Seconds())
+}
+
+// ObserveCertificateRequestSignDuration records the time taken between a
+// CertificateRequest being created and the issuer returning a signed
+// certificate.
+func (m *Metrics) ObserveCertificateRequestSignDuration(duration time.Duration, issuerRef cmmeta.IssuerReference) {
+	m.certificateRequestSignDurationSeconds.WithLabelValues(issuerRef.Name, issuerRef.Kind, issuerRef.Group).Observe(duration.Seconds())
+}
+
+// IncrementIssuanceFailure increases the issuance failure counter for the
+// given reason and issuer.
+func (m *Metrics) IncrementIssuanceFailure(reason string, issuerRef cmmeta.IssuerReference) {
+	m.issuanceFailuresTotal.WithLabelValues(reason, issuerRef.Name, issuerRef.Kind, issuerRef.Group).Inc()
+}
+
+// IncrementACMEChallengeFailure increases the ACME challenge failure counter
+// for the given challenge type, final state and issuer.
+func (m *Metrics) IncrementACMEChallengeFailure(challengeType, state string, issuerRef cmmeta.IssuerReference) {
+	m.acmeChallengeFailuresTotal.WithLabelValues(challengeType, state, issuerRef.Name, issuerRef.Kind, issuerRef.Group).Inc()
+}
+
+// IncrementCertificateSecretDrift increases the counter of modifications made
+// to a Certificate's Secret outside of cert-manager, labelled with the policy
+// check which detected the drift and the field managers which made the change.
+func (m *Metrics) IncrementCertificateSecretDrift(name, namespace, check, fieldManager string) {
+	m.certificateSecretDriftTotal.WithLabelValues(name, namespace, check, fieldManager).Inc()
+}
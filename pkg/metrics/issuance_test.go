@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestIssuanceFailureMetrics(t *testing.T) {
+	m := New(testr.New(t), fakeclock.NewFakeClock(time.Now()))
+
+	issuerRef := cmmeta.IssuerReference{Name: "ca", Kind: "ClusterIssuer", Group: "cert-manager.io"}
+	m.IncrementIssuanceFailure(IssuanceFailureReasonDenied, issuerRef)
+	m.IncrementIssuanceFailure(IssuanceFailureReasonDenied, issuerRef)
+	m.IncrementIssuanceFailure(IssuanceFailureReasonIssuerNotReady, issuerRef)
+	m.IncrementACMEChallengeFailure("HTTP-01", "invalid", cmmeta.IssuerReference{Name: "letsencrypt", Kind: "Issuer"})
+
+	assert.NoError(t, testutil.CollectAndCompare(m.issuanceFailuresTotal, strings.NewReader(`
+# HELP certmanager_issuance_failures_total Total number of certificate issuance failures. Labels: reason (Denied, Failed, IssuerNotReady, OrderInvalid, OrderErrored), issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_issuance_failures_total counter
+certmanager_issuance_failures_total{issuer_group="cert-manager.io",issuer_kind="ClusterIssuer",issuer_name="ca",reason="Denied"} 2
+certmanager_issuance_failures_total{issuer_group="cert-manager.io",issuer_kind="ClusterIssuer",issuer_name="ca",reason="IssuerNotReady"} 1
+`), "certmanager_issuance_failures_total"))
+
+	assert.NoError(t, testutil.CollectAndCompare(m.acmeChallengeFailuresTotal, strings.NewReader(`
+# HELP certmanager_acme_challenge_failures_total Total number of ACME challenges which reached a failed state. Labels: type (HTTP-01/DNS-01), state (invalid, expired, errored), issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_acme_challenge_failures_total counter
+certmanager_acme_challenge_failures_total{issuer_group="",issuer_kind="Issuer",issuer_name="letsencrypt",state="invalid",type="HTTP-01"} 1
+`), "certmanager_acme_challenge_failures_total"))
+}
+
+func TestIssuanceDurationMetrics(t *testing.T) {
+	m := New(testr.New(t), fakeclock.NewFakeClock(time.Now()))
+
+	issuerRef := cmmeta.IssuerReference{Name: "vault", Kind: "Issuer", Group: "cert-manager.io"}
+	m.ObserveCertificateIssuanceDuration(45*time.Second, issuerRef)
+	m.ObserveCertificateRequestSignDuration(2*time.Second, issuerRef)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.certificateIssuanceDurationSeconds, "certmanager_certificate_issuance_duration_seconds"))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.certificateRequestSignDurationSeconds, "certmanager_certificaterequest_sign_duration_seconds"))
+
+	assert.NoError(t, testutil.CollectAndCompare(m.certificateRequestSignDurationSeconds, strings.NewReader(`
+# HELP certmanager_certificaterequest_sign_duration_seconds Time in seconds between a CertificateRequest being created and the issuer returning a signed certificate. Labels: issuer_name, issuer_kind, issuer_group.
+# TYPE certmanager_certificaterequest_sign_duration_seconds histogram
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="0.1"} 0
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="0.5"} 0
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="1"} 0
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="5"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="10"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="30"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="60"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="120"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="300"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="600"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="1800"} 1
+certmanager_certificaterequest_sign_duration_seconds_bucket{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault",le="+Inf"} 1
+certmanager_certificaterequest_sign_duration_seconds_sum{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault"} 2
+certmanager_certificaterequest_sign_duration_seconds_count{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="vault"} 1
+`), "certmanager_certificaterequest_sign_duration_seconds"))
+}
@@ -19,14 +19,23 @@ limitations under the License.
 // certificate_expiration_timestamp_seconds{name, namespace, issuer_name, issuer_kind, issuer_group}
 // certificate_renewal_timestamp_seconds{name, namespace, issuer_name, issuer_kind, issuer_group}
 // certificate_ready_status{name, namespace, condition, issuer_name, issuer_kind, issuer_group}
+// certificate_info{name, namespace, issuer_name, issuer_kind, issuer_group, key_algorithm, key_size, signature_algorithm, chain_signature_algorithms, issuer_common_name, san_count, chain_length, key_rotated}
+// certificate_serial_info{name, namespace, issuer_name, issuer_kind, issuer_group, serial_number}
 // certificate_challenge_status{status, domain, reason, processing, id, type}
 // acme_client_request_count{"scheme", "host", "action", "method", "status"}
 // acme_client_request_duration_seconds{"scheme", "host", "action", "method", "status"}
 // venafi_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
+// certificate_issuance_duration_seconds{"issuer_name", "issuer_kind", "issuer_group"}
+// certificaterequest_sign_duration_seconds{"issuer_name", "issuer_kind", "issuer_group"}
+// issuance_failures_total{"reason", "issuer_name", "issuer_kind", "issuer_group"}
+// acme_challenge_failures_total{"type", "state", "issuer_name", "issuer_kind", "issuer_group"}
+// certificate_secret_drift_total{"name", "namespace", "check", "field_manager"}
+// tls_secret_expiration_timestamp_seconds{"name", "namespace"}
 package metrics
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"time"
@@ -35,9 +44,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/clock"
 
 	cmcollectors "github.com/cert-manager/cert-manager/internal/collectors"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 )
@@ -65,10 +76,18 @@ type Metrics struct {
 	venafiOAuthTokenRequestDurationSecs prometheus.Histogram
 	controllerSyncCallCount             *prometheus.CounterVec
 	controllerSyncErrorCount            *prometheus.CounterVec
-	challengeCollector                  prometheus.Collector
-	certificateCollector                prometheus.Collector
-	issuerCollector                     prometheus.Collector
-	clusterIssuerCollector              prometheus.Collector
+
+	certificateIssuanceDurationSeconds    *prometheus.HistogramVec
+	certificateRequestSignDurationSeconds *prometheus.HistogramVec
+	issuanceFailuresTotal                 *prometheus.CounterVec
+	acmeChallengeFailuresTotal            *prometheus.CounterVec
+	certificateSecretDriftTotal           *prometheus.CounterVec
+	tlsSecretExpiries                     *cmcollectors.TLSSecretExpiries
+
+	challengeCollector     prometheus.Collector
+	certificateCollector   prometheus.Collector
+	issuerCollector        prometheus.Collector
+	clusterIssuerCollector prometheus.Collector
 }
 
 // New creates a Metrics struct and populates it with prometheus metric types.
@@ -193,6 +212,59 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			},
 			[]string{"controller"},
 		)
+
+		certificateIssuanceDurationSeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "certificate_issuance_duration_seconds",
+				Help: "Time in seconds between a Certificate being marked as Issuing and the signed certificate being stored in its Secret, " +
+					"or published as the next certificate of a staged private key rotation. " +
+					"Labels: issuer_name, issuer_kind, issuer_group.",
+				Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+			},
+			[]string{"issuer_name", "issuer_kind", "issuer_group"},
+		)
+
+		certificateRequestSignDurationSeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "certificaterequest_sign_duration_seconds",
+				Help: "Time in seconds between a CertificateRequest being created and the issuer returning a signed certificate. " +
+					"Labels: issuer_name, issuer_kind, issuer_group.",
+				Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1800},
+			},
+			[]string{"issuer_name", "issuer_kind", "issuer_group"},
+		)
+
+		issuanceFailuresTotal = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "issuance_failures_total",
+				Help: "Total number of certificate issuance failures. " +
+					"Labels: reason (Denied, Failed, IssuerNotReady, OrderInvalid, OrderErrored), issuer_name, issuer_kind, issuer_group.",
+			},
+			[]string{"reason", "issuer_name", "issuer_kind", "issuer_group"},
+		)
+
+		acmeChallengeFailuresTotal = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "acme_challenge_failures_total",
+				Help: "Total number of ACME challenges which reached a failed state. " +
+					"Labels: type (HTTP-01/DNS-01), state (invalid, expired, errored), issuer_name, issuer_kind, issuer_group.",
+			},
+			[]string{"type", "state", "issuer_name", "issuer_kind", "issuer_group"},
+		)
+
+		certificateSecretDriftTotal = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "certificate_secret_drift_total",
+				Help: "Total number of times a Certificate's Secret was detected as modified outside of cert-manager. " +
+					"Labels: name, namespace, check (the policy check which detected the drift), field_manager (comma separated managers which changed the Secret).",
+			},
+			[]string{"name", "namespace", "check", "field_manager"},
+		)
 	)
 
 	// Create Registry and register the recommended collectors
@@ -215,6 +287,13 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 		venafiOAuthTokenRequestDurationSecs: venafiOAuthTokenRequestDurationSecs,
 		controllerSyncCallCount:             controllerSyncCallCount,
 		controllerSyncErrorCount:            controllerSyncErrorCount,
+
+		certificateIssuanceDurationSeconds:    certificateIssuanceDurationSeconds,
+		certificateRequestSignDurationSeconds: certificateRequestSignDurationSeconds,
+		issuanceFailuresTotal:                 issuanceFailuresTotal,
+		acmeChallengeFailuresTotal:            acmeChallengeFailuresTotal,
+		certificateSecretDriftTotal:           certificateSecretDriftTotal,
+		tlsSecretExpiries:                     cmcollectors.NewTLSSecretExpiries(),
 	}
 
 	return m
@@ -224,8 +303,26 @@ func (m *Metrics) SetupACMECollector(acmeInformers cmacmelisters.ChallengeLister
 	m.challengeCollector = cmcollectors.NewACMECollector(acmeInformers)
 }
 
-func (m *Metrics) SetupCertificateCollector(certLister cmlisters.CertificateLister) {
-	m.certificateCollector = cmcollectors.NewCertificateCollector(certLister)
+// SetupCertificateCollector configures the Certificate collector. The
+// secretLister and certificateRequestInformer are optional and are used to
+// expose the certificate info metric. An index of CertificateRequests by owner
+// and revision is added to the certificateRequestInformer.
+// The collector also exposes the expiry of the TLS Secrets recorded with
+// UpdateTLSSecretExpiry. The certificate serial info metric is only exposed if
+// enableSerialInfo is true.
+func (m *Metrics) SetupCertificateCollector(certLister cmlisters.CertificateLister, secretLister internalinformers.SecretLister, certificateRequestInformer cache.SharedIndexInformer, enableSerialInfo bool) error {
+	var certificateRequestIndexer cache.Indexer
+	if certificateRequestInformer != nil {
+		if err := certificateRequestInformer.AddIndexers(cache.Indexers{
+			cmcollectors.CertificateRequestOwnerRevisionIndex: cmcollectors.CertificateRequestOwnerRevisionIndexFunc,
+		}); err != nil {
+			return fmt.Errorf("error adding CertificateRequest indexer: %w", err)
+		}
+		certificateRequestIndexer = certificateRequestInformer.GetIndexer()
+	}
+
+	m.certificateCollector = cmcollectors.NewCertificateCollector(certLister, secretLister, certificateRequestIndexer, m.tlsSecretExpiries, enableSerialInfo)
+	return nil
 }
 
 func (m *Metrics) SetupIssuerCollector(issuerLister cmlisters.IssuerLister) {
@@ -251,6 +348,11 @@ func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	m.registry.MustRegister(m.acmeClientRequestCount)
 	m.registry.MustRegister(m.controllerSyncCallCount)
 	m.registry.MustRegister(m.controllerSyncErrorCount)
+	m.registry.MustRegister(m.certificateIssuanceDurationSeconds)
+	m.registry.MustRegister(m.certificateRequestSignDurationSeconds)
+	m.registry.MustRegister(m.issuanceFailuresTotal)
+	m.registry.MustRegister(m.acmeChallengeFailuresTotal)
+	m.registry.MustRegister(m.certificateSecretDriftTotal)
 
 	if m.challengeCollector != nil {
 		m.registry.MustRegister(m.challengeCollector)
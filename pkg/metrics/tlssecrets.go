@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// UpdateTLSSecretExpiry sets the expiry time of the certificate stored in a
+// kubernetes.io/tls Secret which is not managed by a Certificate. It is
+// exposed by the Certificate collector.
+func (m *Metrics) UpdateTLSSecretExpiry(namespace, name string, notAfter time.Time) {
+	m.tlsSecretExpiries.Set(types.NamespacedName{Namespace: namespace, Name: name}, notAfter)
+}
+
+// RemoveTLSSecretExpiry removes the expiry metric for the given Secret.
+func (m *Metrics) RemoveTLSSecretExpiry(namespace, name string) {
+	m.tlsSecretExpiries.Delete(types.NamespacedName{Namespace: namespace, Name: name})
+}
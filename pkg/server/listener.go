@@ -76,6 +76,18 @@ func WithCertificateSource(certificateSource servertls.CertificateSource) Listen
 	}
 }
 
+// WithClientCertificateVerification requires clients to present a certificate
+// signed by one of the CAs provided by the given source. Client certificates are
+// not requested when the source is nil.
+func WithClientCertificateVerification(source servertls.CertPoolSource) ListenerOption {
+	return func(config *ListenerConfig) error {
+		if source != nil {
+			servertls.RequireClientCertificates(&config.TLSConfig, source)
+		}
+		return nil
+	}
+}
+
 // WithTLSCipherSuites specifies the allowed cipher suites, when an empty/nil array is passed
 // the go defaults are used
 func WithTLSCipherSuites(suites []string) ListenerOption {
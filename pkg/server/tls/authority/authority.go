@@ -84,6 +84,10 @@ type DynamicAuthority struct {
 
 	// PEM-encoded CA certificate and private key bytes
 	currentCertData, currentPrivateKeyData []byte
+	// certPool contains the current and the previous CA certificate
+	certPool *x509.CertPool
+	// PEM-encoded CA certificate bytes before the last rotation
+	previousCertData []byte
 	// signMutex gates access to the certificate and private key data
 	signMutex sync.Mutex
 	// ensureMutex gates the 'ensureCA' method
@@ -233,6 +237,19 @@ func (d *DynamicAuthority) Sign(template *x509.Certificate) (*x509.Certificate,
 	return cert, nil
 }
 
+// CertPool returns a pool containing the current CA certificate, and the CA
+// certificate it replaced when it was last rotated, so that certificates signed
+// shortly before a rotation keep being trusted until they expire.
+// It returns nil if no CA certificate is available yet.
+// The returned pool can be used to verify client certificates which were signed
+// by the managed CA, e.g. by a CA Issuer referencing the CA Secret.
+func (d *DynamicAuthority) CertPool() *x509.CertPool {
+	d.signMutex.Lock()
+	defer d.signMutex.Unlock()
+
+	return d.certPool
+}
+
 // WatchRotation will return a channel that fires notifications if the CA
 // certificate is rotated/updated.
 // This can be used to automatically trigger rotation of leaf certificates
@@ -289,8 +306,18 @@ func (d *DynamicAuthority) notifyWatches(newCertData, newPrivateKeyData []byte)
 	func() {
 		d.signMutex.Lock()
 		defer d.signMutex.Unlock()
+		if d.currentCertData != nil && !bytes.Equal(d.currentCertData, newCertData) {
+			d.previousCertData = d.currentCertData
+		}
 		d.currentCertData = newCertData
 		d.currentPrivateKeyData = newPrivateKeyData
+
+		d.certPool = x509.NewCertPool()
+		for _, certData := range [][]byte{newCertData, d.previousCertData} {
+			if certData != nil && !d.certPool.AppendCertsFromPEM(certData) {
+				d.log.V(logf.WarnLevel).Info("Failed to add CA certificate to the pool of trusted CAs")
+			}
+		}
 	}()
 
 	func() {
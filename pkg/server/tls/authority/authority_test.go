@@ -17,6 +17,8 @@ limitations under the License.
 package authority
 
 import (
+	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -30,6 +32,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
@@ -181,6 +184,54 @@ func TestDynamicAuthorityMulti(t *testing.T) {
 	waitForRotationAndSign()
 }
 
+func TestDynamicAuthorityCertPool(t *testing.T) {
+	fake := kubefake.NewClientset()
+
+	da := testAuthority(t, "authority", fake)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// signClientCertificate waits until a certificate which was not signed by
+	// the given CA key can be signed
+	signClientCertificate := func(previousAuthorityKeyID []byte) *x509.Certificate {
+		var cert *x509.Certificate
+		err := wait.PollUntilContextTimeout(t.Context(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+			var err error
+			cert, err = da.Sign(&x509.Certificate{
+				PublicKey:   privateKey.Public(),
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			})
+			return err == nil && !bytes.Equal(cert.AuthorityKeyId, previousAuthorityKeyID), nil
+		})
+		if err != nil {
+			t.Fatal("Timeout waiting for the CA to be rotated")
+		}
+		return cert
+	}
+
+	verify := func(cert *x509.Certificate) error {
+		_, err := cert.Verify(x509.VerifyOptions{
+			Roots:     da.CertPool(),
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		return err
+	}
+
+	initialCert := signClientCertificate(nil)
+	assert.NoError(t, verify(initialCert))
+
+	// Rotate the CA, certificates signed by the previous CA must still be trusted
+	err = fake.CoreV1().Secrets(da.SecretNamespace).Delete(t.Context(), da.SecretName, metav1.DeleteOptions{})
+	assert.NoError(t, err)
+
+	rotatedCert := signClientCertificate(initialCert.AuthorityKeyId)
+	assert.NoError(t, verify(rotatedCert))
+	assert.NoError(t, verify(initialCert))
+}
+
 func Test__caRequiresRegeneration(t *testing.T) {
 	generateSecretData := func(mod func(*x509.Certificate)) map[string][]byte {
 		// Generate a certificate and private key pair
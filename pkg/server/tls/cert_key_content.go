@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tls
+
+import (
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+)
+
+// CertKeyContentProvider adapts a DynamicSource so that it can be used as the
+// serving certificate of a Kubernetes generic API server.
+// The DynamicSource must be started separately. Until it has generated a
+// certificate, the API server refuses TLS connections.
+type CertKeyContentProvider struct {
+	name   string
+	source *DynamicSource
+}
+
+var _ dynamiccertificates.CertKeyContentProvider = &CertKeyContentProvider{}
+
+// NewCertKeyContentProvider returns a CertKeyContentProvider for the given
+// DynamicSource.
+func NewCertKeyContentProvider(name string, source *DynamicSource) *CertKeyContentProvider {
+	return &CertKeyContentProvider{
+		name:   name,
+		source: source,
+	}
+}
+
+// Name returns the name of the provider.
+func (p *CertKeyContentProvider) Name() string {
+	return p.name
+}
+
+// CurrentCertKeyContent returns the PEM-encoded serving certificate and
+// private key, or nil if no certificate has been generated yet.
+func (p *CertKeyContentProvider) CurrentCertKeyContent() ([]byte, []byte) {
+	return p.source.currentCertKeyContent()
+}
+
+// AddListener adds a listener which is notified when the serving certificate
+// is rotated.
+func (p *CertKeyContentProvider) AddListener(listener dynamiccertificates.Listener) {
+	p.source.addListener(listener.Enqueue)
+}
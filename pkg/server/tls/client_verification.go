@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// CertPoolSource provides the CA certificates which client certificates are
+// verified against.
+type CertPoolSource interface {
+	// CertPool returns the CA certificates which are currently trusted, or nil
+	// if none are available yet.
+	CertPool() *x509.CertPool
+}
+
+// RequireClientCertificates configures the TLS config to require clients to
+// present a certificate signed by one of the CAs of the given source.
+// The CAs are looked up for every connection, so that rotated CAs are trusted
+// as soon as the source provides them.
+func RequireClientCertificates(cfg *tls.Config, source CertPoolSource) {
+	cfg.ClientAuth = tls.RequireAnyClientCert
+	// Disable session ticket resumption to ensure VerifyPeerCertificate is called for
+	// every connection, not just full TLS handshakes.
+	cfg.SessionTicketsDisabled = true
+	cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		return verifyClientCertificate(rawCerts, source.CertPool())
+	}
+}
+
+func verifyClientCertificate(rawCerts [][]byte, roots *x509.CertPool) error {
+	if roots == nil {
+		return errors.New("no CA is available to verify client certificates")
+	}
+	if len(rawCerts) == 0 {
+		return errors.New("no client certificate provided")
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return fmt.Errorf("failed to parse client certificate: %w", err)
+		}
+		certs[i] = cert
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("failed to verify client certificate: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticCertPool struct {
+	pool *x509.CertPool
+}
+
+func (s staticCertPool) CertPool() *x509.CertPool {
+	return s.pool
+}
+
+func createTestCertificate(t *testing.T, template *x509.Certificate, parent *x509.Certificate, signer crypto.Signer) (*x509.Certificate, crypto.Signer) {
+	t.Helper()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template.SerialNumber = big.NewInt(1)
+	template.NotBefore = time.Now().Add(-time.Minute)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, signer = template, pk
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pk.Public(), signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, pk
+}
+
+func createTestCA(t *testing.T, commonName string) (*x509.Certificate, crypto.Signer) {
+	return createTestCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+}
+
+func TestRequireClientCertificates(t *testing.T) {
+	ca, caKey := createTestCA(t, "test-ca")
+	otherCA, otherCAKey := createTestCA(t, "other-ca")
+
+	clientCert := func(ca *x509.Certificate, caKey crypto.Signer, usage x509.ExtKeyUsage) [][]byte {
+		cert, _ := createTestCertificate(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: "client"},
+			KeyUsage:    x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{usage},
+		}, ca, caKey)
+		return [][]byte{cert.Raw}
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+
+	tests := map[string]struct {
+		pool        *x509.CertPool
+		rawCerts    [][]byte
+		expectedErr string
+	}{
+		"a client certificate signed by the CA is accepted": {
+			pool:     pool,
+			rawCerts: clientCert(ca, caKey, x509.ExtKeyUsageClientAuth),
+		},
+		"a client certificate signed by another CA is rejected": {
+			pool:        pool,
+			rawCerts:    clientCert(otherCA, otherCAKey, x509.ExtKeyUsageClientAuth),
+			expectedErr: "failed to verify client certificate: x509: certificate signed by unknown authority",
+		},
+		"a certificate without the client auth usage is rejected": {
+			pool:        pool,
+			rawCerts:    clientCert(ca, caKey, x509.ExtKeyUsageServerAuth),
+			expectedErr: "failed to verify client certificate: x509: certificate specifies an incompatible key usage",
+		},
+		"a connection without client certificate is rejected": {
+			pool:        pool,
+			expectedErr: "no client certificate provided",
+		},
+		"all connections are rejected if no CA is available": {
+			rawCerts:    clientCert(ca, caKey, x509.ExtKeyUsageClientAuth),
+			expectedErr: "no CA is available to verify client certificates",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := &tls.Config{}
+			RequireClientCertificates(cfg, staticCertPool{pool: test.pool})
+
+			assert.Equal(t, tls.RequireAnyClientCert, cfg.ClientAuth)
+			assert.True(t, cfg.SessionTicketsDisabled)
+
+			err := cfg.VerifyPeerCertificate(test.rawCerts, nil)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
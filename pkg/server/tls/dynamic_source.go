@@ -68,10 +68,15 @@ type DynamicSource struct {
 	log logr.Logger
 
 	cachedCertificate *tls.Certificate
-	lock              sync.Mutex
+	// PEM-encoded data of the cached certificate and private key
+	cachedCertData, cachedKeyData []byte
+	// listeners are notified when the cached certificate is updated
+	listeners []func()
+	lock      sync.Mutex
 }
 
 var _ CertificateSource = &DynamicSource{}
+var _ CertPoolSource = &DynamicSource{}
 
 // renewalStaleAfter is the grace period after the scheduled renewal moment
 // beyond which the renewal is considered to have been missed, e.g. because
@@ -214,6 +219,16 @@ func (f *DynamicSource) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate,
 	return f.cachedCertificate, nil
 }
 
+// CertPool returns the CA certificates of the authority, if the authority
+// implements CertPoolSource. It can be used to verify client certificates
+// signed by the same authority as the serving certificates of this source.
+func (f *DynamicSource) CertPool() *x509.CertPool {
+	if source, ok := f.Authority.(CertPoolSource); ok {
+		return source.CertPool()
+	}
+	return nil
+}
+
 func (f *DynamicSource) Healthy() bool {
 	f.lock.Lock()
 	defer f.lock.Unlock()
@@ -285,6 +300,10 @@ func (f *DynamicSource) updateCertificate(ctx context.Context, pk crypto.Signer,
 	}
 
 	f.cachedCertificate = &bundle
+	f.cachedCertData, f.cachedKeyData = certData, pkData
+	for _, notify := range f.listeners {
+		notify()
+	}
 	certDuration := cert.NotAfter.Sub(cert.NotBefore)
 	// renew the certificate 1/3 of the time before its expiry
 	renewMoment := cert.NotAfter.Add(certDuration / -3)
@@ -365,3 +384,19 @@ func (f *DynamicSource) startRenewalWatcher(ctx context.Context, renewalAt time.
 
 	return renewalChan
 }
+
+// currentCertKeyContent returns the PEM-encoded data of the cached certificate
+// and private key.
+func (f *DynamicSource) currentCertKeyContent() ([]byte, []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.cachedCertData, f.cachedKeyData
+}
+
+// addListener registers a function which is called whenever the cached
+// certificate is updated. The function must not block.
+func (f *DynamicSource) addListener(notify func()) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.listeners = append(f.listeners, notify)
+}
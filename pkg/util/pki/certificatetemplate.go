@@ -21,6 +21,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 	"time"
@@ -156,6 +157,7 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 	}
 
 	// Start by copying all extensions from the CSR
+	var ocspNoCheck *pkix.Extension
 	extractExtensions := func(template *x509.Certificate, val pkix.Extension) error {
 		// Check the CSR for the X.509 BasicConstraints (RFC 5280, 4.2.1.9)
 		// extension and append to template if necessary
@@ -220,6 +222,13 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 			template.ExtraExtensions = append(template.ExtraExtensions, val)
 		}
 
+		// The id-pkix-ocsp-nocheck extension has no corresponding field in
+		// x509.Certificate, so it is copied as-is once all extensions have
+		// been read.
+		if val.Id.Equal(OIDExtensionOCSPNoCheck) {
+			ocspNoCheck = &val
+		}
+
 		return nil
 	}
 
@@ -235,6 +244,13 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 		}
 	}
 
+	// id-pkix-ocsp-nocheck tells relying parties not to check the revocation
+	// status of the certificate (RFC 6960, 4.2.2.2.1), so it is only
+	// honoured for delegated OCSP responder certificates.
+	if ocspNoCheck != nil && slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageOCSPSigning) {
+		cert.ExtraExtensions = append(cert.ExtraExtensions, *ocspNoCheck)
+	}
+
 	cert.Extensions = csr.Extensions
 
 	for _, validatorMutator := range validatorMutators {
@@ -291,7 +307,7 @@ func CertificateTemplateFromCertificate(crt *v1.Certificate) (*x509.Certificate,
 	}
 
 	certDuration := apiutil.DefaultCertDuration(crt.Spec.Duration)
-	keyUsage, extKeyUsage, err := KeyUsagesForCertificateOrCertificateRequest(crt.Spec.Usages, crt.Spec.IsCA)
+	keyUsage, extKeyUsage, err := KeyUsagesForCertificateOrCertificateRequest(apiutil.CertificateUsages(&crt.Spec), crt.Spec.IsCA)
 	if err != nil {
 		return nil, err
 	}
@@ -343,3 +359,32 @@ func CertificateTemplateFromCertificateSigningRequest(csr *certificatesv1.Certif
 		CertificateTemplateValidateAndOverrideKeyUsages(ku, eku),          // Override the key usages, but make sure they match the usages in the CSR if present
 	)
 }
+
+// SetCertificateTemplateURIs sets the URI SANs of the certificate template.
+// If the template carries the SAN extension of the CSR in its
+// ExtraExtensions, which takes precedence over the SAN fields of the template
+// when signing, the URIs in that extension are replaced as well, keeping its
+// other SANs and its criticality.
+func SetCertificateTemplateURIs(template *x509.Certificate, uris []*url.URL) error {
+	template.URIs = uris
+
+	for i, ext := range template.ExtraExtensions {
+		if !ext.Id.Equal(oidExtensionSubjectAltName) {
+			continue
+		}
+
+		gns, err := UnmarshalSANs(ext.Value)
+		if err != nil {
+			return fmt.Errorf("failed to parse the subject alternative names: %w", err)
+		}
+		gns.UniformResourceIdentifiers = URLsToString(uris)
+
+		sans, err := MarshalSANs(gns, !ext.Critical)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the subject alternative names: %w", err)
+		}
+		template.ExtraExtensions[i] = sans
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"net/url"
 	"reflect"
 	"testing"
 )
@@ -46,6 +47,14 @@ func TestCertificateTemplateFromCSR(t *testing.T) {
 		}
 	}
 
+	extKeyUsageGenerator := func(t *testing.T, usages ...x509.ExtKeyUsage) pkix.Extension {
+		ext, err := MarshalExtKeyUsage(usages, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ext
+	}
+
 	testCases := []struct {
 		name     string
 		csr      *x509.CertificateRequest
@@ -143,6 +152,31 @@ func TestCertificateTemplateFromCSR(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should copy the OCSP no-check extension for OCSP signing certificates",
+			csr: &x509.CertificateRequest{
+				ExtraExtensions: []pkix.Extension{
+					MarshalOCSPNoCheck(),
+					extKeyUsageGenerator(t, x509.ExtKeyUsageOCSPSigning),
+				},
+			},
+			expected: &x509.Certificate{
+				ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+				ExtraExtensions: []pkix.Extension{MarshalOCSPNoCheck()},
+			},
+		},
+		{
+			name: "should drop the OCSP no-check extension for other certificates",
+			csr: &x509.CertificateRequest{
+				ExtraExtensions: []pkix.Extension{
+					MarshalOCSPNoCheck(),
+					extKeyUsageGenerator(t, x509.ExtKeyUsageServerAuth),
+				},
+			},
+			expected: &x509.Certificate{
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -158,3 +192,66 @@ func TestCertificateTemplateFromCSR(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCertificateTemplateURIs(t *testing.T) {
+	uris := func(t *testing.T, raw ...string) []*url.URL {
+		var parsed []*url.URL
+		for _, r := range raw {
+			u, err := url.Parse(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed = append(parsed, u)
+		}
+		return parsed
+	}
+	sans := func(t *testing.T, gns GeneralNames, hasSubject bool) pkix.Extension {
+		ext, err := MarshalSANs(gns, hasSubject)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ext
+	}
+
+	tests := map[string]struct {
+		template *x509.Certificate
+		expected *x509.Certificate
+	}{
+		"the URIs are set on a template without a SAN extension": {
+			template: &x509.Certificate{DNSNames: []string{"example.com"}},
+			expected: &x509.Certificate{
+				DNSNames: []string{"example.com"},
+				URIs:     uris(t, "spiffe://cluster.local/ns/app/sa/workload"),
+			},
+		},
+		"the URIs of the SAN extension are replaced, keeping its other SANs and criticality": {
+			template: &x509.Certificate{
+				ExtraExtensions: []pkix.Extension{
+					sans(t, GeneralNames{
+						DNSNames:                   []string{"example.com"},
+						UniformResourceIdentifiers: []string{"https://example.com"},
+					}, false),
+				},
+			},
+			expected: &x509.Certificate{
+				URIs: uris(t, "spiffe://cluster.local/ns/app/sa/workload"),
+				ExtraExtensions: []pkix.Extension{
+					sans(t, GeneralNames{
+						DNSNames:                   []string{"example.com"},
+						UniformResourceIdentifiers: []string{"spiffe://cluster.local/ns/app/sa/workload"},
+					}, false),
+				},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := SetCertificateTemplateURIs(test.template, uris(t, "spiffe://cluster.local/ns/app/sa/workload")); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.template, test.expected) {
+				t.Errorf("unexpected template: got %#v, want %#v", test.template, test.expected)
+			}
+		})
+	}
+}
@@ -256,7 +256,7 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 	}
 
 	if crt.Spec.EncodeUsagesInRequest == nil || *crt.Spec.EncodeUsagesInRequest {
-		ku, ekus, err := KeyUsagesForCertificateOrCertificateRequest(crt.Spec.Usages, crt.Spec.IsCA)
+		ku, ekus, err := KeyUsagesForCertificateOrCertificateRequest(apiutil.CertificateUsages(&crt.Spec), crt.Spec.IsCA)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build key usages: %w", err)
 		}
@@ -322,6 +322,10 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 		}
 	}
 
+	if settings, ok := apiutil.CertificateProfileSettingsFor(crt.Spec.Profile); ok && settings.OCSPNoCheck {
+		extraExtensions = append(extraExtensions, MarshalOCSPNoCheck())
+	}
+
 	cr := &x509.CertificateRequest{
 		// Version 0 is the only one defined in the PKCS#10 standard, RFC2986.
 		// This value isn't used by Go at the time of writing.
@@ -524,7 +528,7 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 	switch pubKeyAlgo {
 	case x509.RSA:
 		if specKeySize == 0 {
-			sigAlgoArg = MinRSAKeySize
+			sigAlgoArg = apiutil.DefaultRSAKeySize(&crt.Spec)
 		} else {
 			sigAlgoArg = specKeySize
 		}
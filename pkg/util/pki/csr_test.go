@@ -1214,3 +1214,77 @@ func Test_SignCertificate_Signatures(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateCSRWithProfile(t *testing.T) {
+	tests := map[string]struct {
+		spec                cmapi.CertificateSpec
+		expectedKeyUsage    x509.KeyUsage
+		expectedExtKeyUsage []x509.ExtKeyUsage
+		expectedRSAKeySize  int
+		expectOCSPNoCheck   bool
+	}{
+		"codeSigning requests code signing and 3072 bit RSA keys": {
+			spec:                cmapi.CertificateSpec{Profile: cmapi.CodeSigningCertificateProfile, CommonName: "Example Code Signing"},
+			expectedKeyUsage:    x509.KeyUsageDigitalSignature,
+			expectedExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+			expectedRSAKeySize:  3072,
+		},
+		"ocspSigning requests OCSP signing and the no-check extension": {
+			spec:                cmapi.CertificateSpec{Profile: cmapi.OCSPSigningCertificateProfile, CommonName: "Example OCSP Responder"},
+			expectedKeyUsage:    x509.KeyUsageDigitalSignature,
+			expectedExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageOCSPSigning},
+			expectedRSAKeySize:  2048,
+			expectOCSPNoCheck:   true,
+		},
+		"explicit usages take precedence over the profile's": {
+			spec: cmapi.CertificateSpec{
+				Profile:  cmapi.ServerTLSCertificateProfile,
+				DNSNames: []string{"example.com"},
+				Usages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth, cmapi.UsageClientAuth},
+			},
+			expectedKeyUsage:    x509.KeyUsageDigitalSignature,
+			expectedExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			expectedRSAKeySize:  2048,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: test.spec}
+
+			pk, err := GeneratePrivateKeyForCertificate(crt)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRSAKeySize, pk.(*rsa.PrivateKey).N.BitLen())
+
+			template, err := GenerateCSR(crt)
+			require.NoError(t, err)
+			csrDER, err := x509.CreateCertificateRequest(rand.Reader, template, pk)
+			require.NoError(t, err)
+			csr, err := x509.ParseCertificateRequest(csrDER)
+			require.NoError(t, err)
+
+			certTemplate, err := CertificateTemplateFromCSR(csr)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedKeyUsage, certTemplate.KeyUsage)
+			assert.Equal(t, test.expectedExtKeyUsage, certTemplate.ExtKeyUsage)
+
+			hasOCSPNoCheck := false
+			for _, ext := range certTemplate.ExtraExtensions {
+				if ext.Id.Equal(OIDExtensionOCSPNoCheck) {
+					hasOCSPNoCheck = true
+				}
+			}
+			assert.Equal(t, test.expectOCSPNoCheck, hasOCSPNoCheck)
+
+			violations, err := CertificateMatchesSpec(&x509.Certificate{
+				Subject:     csr.Subject,
+				DNSNames:    csr.DNSNames,
+				KeyUsage:    certTemplate.KeyUsage,
+				ExtKeyUsage: certTemplate.ExtKeyUsage,
+			}, test.spec)
+			require.NoError(t, err)
+			assert.Empty(t, violations)
+			assert.Empty(t, PrivateKeyMatchesSpec(pk, test.spec))
+		})
+	}
+}
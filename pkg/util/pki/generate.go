@@ -27,6 +27,7 @@ import (
 	"encoding/pem"
 	"fmt"
 
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -58,7 +59,7 @@ func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error
 	}
 	switch crt.Spec.PrivateKey.Algorithm {
 	case v1.PrivateKeyAlgorithm(""), v1.RSAKeyAlgorithm:
-		keySize := MinRSAKeySize
+		keySize := apiutil.DefaultRSAKeySize(&crt.Spec)
 
 		if crt.Spec.PrivateKey.Size > 0 {
 			keySize = crt.Spec.PrivateKey.Size
@@ -27,6 +27,7 @@ import (
 	"encoding/asn1"
 	"fmt"
 	"net"
+	"net/url"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -70,8 +71,9 @@ func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec)
 	//  defaulting performed within the Kubernetes apiserver here.
 	//  This requires careful handling in order to not interrupt users upgrading
 	//  from older versions.
-	// The default RSA keySize is set to 2048.
-	keySize := MinRSAKeySize
+	// The default RSA keySize is set to 2048, or the minimum key size of the
+	// Certificate's profile.
+	keySize := apiutil.DefaultRSAKeySize(&spec)
 	if spec.PrivateKey.Size > 0 {
 		keySize = spec.PrivateKey.Size
 	}
@@ -131,6 +133,92 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 		return nil, err
 	}
 
+	violations, err := namesMatchSpec(x509req.Subject, x509req.RawSubject, x509req.DNSNames, x509req.IPAddresses, x509req.URIs, x509req.EmailAddresses, x509req.Extensions, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Spec.IsCA != spec.IsCA {
+		violations = append(violations, "spec.isCA")
+	}
+	if !util.EqualKeyUsagesUnsorted(req.Spec.Usages, apiutil.CertificateUsages(&spec)) {
+		violations = append(violations, "spec.usages")
+	}
+	if req.Spec.Duration != nil && spec.Duration != nil &&
+		req.Spec.Duration.Duration != spec.Duration.Duration {
+		violations = append(violations, "spec.duration")
+	}
+	// RequestMatchesSpec compares the IssuerRef in the CertificateRequest and
+	// CertificateSpec, regardless of any differences which are solely due to
+	// the presence or absence of default group (cert-manager.io) and kind (Issuer).
+	//
+	// We do not want to re-issue the Certificate if the user explicitly adds
+	// the default issuer group and kind.
+	// Nor do we want to re-issue if the user removes the default issuer group and kind.
+	//
+	// And we want to avoid re-issuing if a future version of the cert-manager
+	// CRDs introduces API defaults for issuerRef group and kind. Specifically,
+	// we want to gracefully handle a situation where the platform admin
+	// upgrades the CRDs to a version that has defaults, but not the controller.
+	// In that situation, when the CRDs are upgraded, the controller
+	// re-establishes its watches and refreshes its caches with updated Certificates
+	// and CertificateRequests, containing the new API defaults. But this
+	// doesn't happen transactionally, so the updated Certificates may start
+	// being reconciled before the cached CertificateRequests have been updated
+	// and there will be a mis-match if the Certificate has the default
+	// group/kind set but the CertificateRequest does not.
+	if req.Spec.IssuerRef.Name != spec.IssuerRef.Name ||
+		!apiutil.IssuerKindsEqual(req.Spec.IssuerRef.Kind, spec.IssuerRef.Kind) ||
+		!apiutil.IssuerGroupsEqual(req.Spec.IssuerRef.Group, spec.IssuerRef.Group) {
+		violations = append(violations, "spec.issuerRef")
+	}
+
+	// TODO: check spec.EncodeBasicConstraintsInRequest and spec.EncodeUsagesInRequest
+
+	return violations, nil
+}
+
+// CertificateMatchesSpec compares an X.509 certificate with a CertificateSpec
+// and returns a list of field names on the Certificate that do not match their
+// counterpart fields on the X.509 certificate.
+// The subject and subject alternative names are compared in the same way as
+// RequestMatchesSpec. As the key usages of a signed certificate may be
+// extended by the issuer, the certificate is only required to have all of the
+// key usages requested by the spec. The duration and issuerRef are not
+// compared as they cannot be reliably determined from the certificate.
+func CertificateMatchesSpec(x509cert *x509.Certificate, spec cmapi.CertificateSpec) ([]string, error) {
+	violations, err := namesMatchSpec(x509cert.Subject, x509cert.RawSubject, x509cert.DNSNames, x509cert.IPAddresses, x509cert.URIs, x509cert.EmailAddresses, x509cert.Extensions, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if x509cert.IsCA != spec.IsCA {
+		violations = append(violations, "spec.isCA")
+	}
+
+	ku, ekus, err := KeyUsagesForCertificateOrCertificateRequest(apiutil.CertificateUsages(&spec), spec.IsCA)
+	if err != nil {
+		return nil, err
+	}
+	if x509cert.KeyUsage&ku != ku || !extKeyUsagesContain(x509cert.ExtKeyUsage, ekus) {
+		violations = append(violations, "spec.usages")
+	}
+
+	return violations, nil
+}
+
+// extKeyUsagesContain returns true if all the wanted extended key usages are
+// present in the given extended key usages, or if they contain the 'any'
+// extended key usage.
+func extKeyUsagesContain(ekus []x509.ExtKeyUsage, wanted []x509.ExtKeyUsage) bool {
+	have := sets.New(ekus...)
+	return have.Has(x509.ExtKeyUsageAny) || have.HasAll(wanted...)
+}
+
+// namesMatchSpec compares the subject and subject alternative names of a
+// certificate or certificate request with a CertificateSpec and returns a list
+// of field names on the Certificate that do not match.
+func namesMatchSpec(subject pkix.Name, rawSubject []byte, dnsNames []string, ipAddresses []net.IP, uris []*url.URL, emailAddresses []string, extensions []pkix.Extension, spec cmapi.CertificateSpec) ([]string, error) {
 	// It is safe to mutate top-level fields in `spec` as it is not a pointer
 	// meaning changes will not affect the caller.
 	if spec.Subject == nil {
@@ -139,24 +227,24 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 
 	var violations []string
 
-	if !ipSlicesMatch(x509req.IPAddresses, spec.IPAddresses) {
+	if !ipSlicesMatch(ipAddresses, spec.IPAddresses) {
 		violations = append(violations, "spec.ipAddresses")
 	}
 
-	if !util.EqualUnsorted(URLsToString(x509req.URIs), spec.URIs) {
+	if !util.EqualUnsorted(URLsToString(uris), spec.URIs) {
 		violations = append(violations, "spec.uris")
 	}
 
-	if !util.EqualUnsorted(x509req.EmailAddresses, spec.EmailAddresses) {
+	if !util.EqualUnsorted(emailAddresses, spec.EmailAddresses) {
 		violations = append(violations, "spec.emailAddresses")
 	}
 
-	if !util.EqualUnsorted(x509req.DNSNames, spec.DNSNames) {
+	if !util.EqualUnsorted(dnsNames, spec.DNSNames) {
 		violations = append(violations, "spec.dnsNames")
 	}
 
 	if spec.OtherNames != nil {
-		matched, err := matchOtherNames(x509req.Extensions, spec.OtherNames)
+		matched, err := matchOtherNames(extensions, spec.OtherNames)
 		if err != nil {
 			return nil, err
 		}
@@ -167,31 +255,31 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 
 	if spec.LiteralSubject == "" {
 		// Comparing Subject fields
-		if x509req.Subject.CommonName != spec.CommonName {
+		if subject.CommonName != spec.CommonName {
 			violations = append(violations, "spec.commonName")
 		}
-		if x509req.Subject.SerialNumber != spec.Subject.SerialNumber {
+		if subject.SerialNumber != spec.Subject.SerialNumber {
 			violations = append(violations, "spec.subject.serialNumber")
 		}
-		if !util.EqualUnsorted(x509req.Subject.Organization, spec.Subject.Organizations) {
+		if !util.EqualUnsorted(subject.Organization, spec.Subject.Organizations) {
 			violations = append(violations, "spec.subject.organizations")
 		}
-		if !util.EqualUnsorted(x509req.Subject.Country, spec.Subject.Countries) {
+		if !util.EqualUnsorted(subject.Country, spec.Subject.Countries) {
 			violations = append(violations, "spec.subject.countries")
 		}
-		if !util.EqualUnsorted(x509req.Subject.Locality, spec.Subject.Localities) {
+		if !util.EqualUnsorted(subject.Locality, spec.Subject.Localities) {
 			violations = append(violations, "spec.subject.localities")
 		}
-		if !util.EqualUnsorted(x509req.Subject.OrganizationalUnit, spec.Subject.OrganizationalUnits) {
+		if !util.EqualUnsorted(subject.OrganizationalUnit, spec.Subject.OrganizationalUnits) {
 			violations = append(violations, "spec.subject.organizationalUnits")
 		}
-		if !util.EqualUnsorted(x509req.Subject.PostalCode, spec.Subject.PostalCodes) {
+		if !util.EqualUnsorted(subject.PostalCode, spec.Subject.PostalCodes) {
 			violations = append(violations, "spec.subject.postCodes")
 		}
-		if !util.EqualUnsorted(x509req.Subject.Province, spec.Subject.Provinces) {
+		if !util.EqualUnsorted(subject.Province, spec.Subject.Provinces) {
 			violations = append(violations, "spec.subject.provinces")
 		}
-		if !util.EqualUnsorted(x509req.Subject.StreetAddress, spec.Subject.StreetAddresses) {
+		if !util.EqualUnsorted(subject.StreetAddress, spec.Subject.StreetAddresses) {
 			violations = append(violations, "spec.subject.streetAddresses")
 		}
 
@@ -209,48 +297,11 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 			return nil, err
 		}
 
-		if !bytes.Equal(x509req.RawSubject, asn1Sequence) {
+		if !bytes.Equal(rawSubject, asn1Sequence) {
 			violations = append(violations, "spec.literalSubject")
 		}
 	}
 
-	if req.Spec.IsCA != spec.IsCA {
-		violations = append(violations, "spec.isCA")
-	}
-	if !util.EqualKeyUsagesUnsorted(req.Spec.Usages, spec.Usages) {
-		violations = append(violations, "spec.usages")
-	}
-	if req.Spec.Duration != nil && spec.Duration != nil &&
-		req.Spec.Duration.Duration != spec.Duration.Duration {
-		violations = append(violations, "spec.duration")
-	}
-	// RequestMatchesSpec compares the IssuerRef in the CertificateRequest and
-	// CertificateSpec, regardless of any differences which are solely due to
-	// the presence or absence of default group (cert-manager.io) and kind (Issuer).
-	//
-	// We do not want to re-issue the Certificate if the user explicitly adds
-	// the default issuer group and kind.
-	// Nor do we want to re-issue if the user removes the default issuer group and kind.
-	//
-	// And we want to avoid re-issuing if a future version of the cert-manager
-	// CRDs introduces API defaults for issuerRef group and kind. Specifically,
-	// we want to gracefully handle a situation where the platform admin
-	// upgrades the CRDs to a version that has defaults, but not the controller.
-	// In that situation, when the CRDs are upgraded, the controller
-	// re-establishes its watches and refreshes its caches with updated Certificates
-	// and CertificateRequests, containing the new API defaults. But this
-	// doesn't happen transactionally, so the updated Certificates may start
-	// being reconciled before the cached CertificateRequests have been updated
-	// and there will be a mis-match if the Certificate has the default
-	// group/kind set but the CertificateRequest does not.
-	if req.Spec.IssuerRef.Name != spec.IssuerRef.Name ||
-		!apiutil.IssuerKindsEqual(req.Spec.IssuerRef.Kind, spec.IssuerRef.Kind) ||
-		!apiutil.IssuerGroupsEqual(req.Spec.IssuerRef.Group, spec.IssuerRef.Group) {
-		violations = append(violations, "spec.issuerRef")
-	}
-
-	// TODO: check spec.EncodeBasicConstraintsInRequest and spec.EncodeUsagesInRequest
-
 	return violations, nil
 }
 
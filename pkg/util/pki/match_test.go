@@ -625,6 +625,85 @@ func TestFuzzyX509AltNamesMatchSpec(t *testing.T) {
 	}
 }
 
+func TestCertificateMatchesSpec(t *testing.T) {
+	tests := map[string]struct {
+		x509       *x509.Certificate
+		spec       cmapi.CertificateSpec
+		violations []string
+	}{
+		"should match if names and usages are equal": {
+			spec: cmapi.CertificateSpec{
+				CommonName: "cn",
+				DNSNames:   []string{"a", "b"},
+				Usages:     []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+			},
+			x509: selfSignCertificate(t, cmapi.CertificateSpec{
+				CommonName: "cn",
+				DNSNames:   []string{"b", "a"},
+				Usages:     []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+			}),
+		},
+		"should match if the certificate has additional usages": {
+			spec: cmapi.CertificateSpec{
+				DNSNames: []string{"a"},
+				Usages:   []cmapi.KeyUsage{cmapi.UsageServerAuth},
+			},
+			x509: selfSignCertificate(t, cmapi.CertificateSpec{
+				DNSNames: []string{"a"},
+				Usages:   []cmapi.KeyUsage{cmapi.UsageServerAuth, cmapi.UsageClientAuth},
+			}),
+		},
+		"should not match if commonName has been moved to dnsNames": {
+			spec: cmapi.CertificateSpec{
+				CommonName: "cn",
+				DNSNames:   []string{"a"},
+			},
+			x509: selfSignCertificate(t, cmapi.CertificateSpec{
+				DNSNames: []string{"cn", "a"},
+			}),
+			violations: []string{"spec.dnsNames", "spec.commonName"},
+		},
+		"should not match if the subject differs": {
+			spec: cmapi.CertificateSpec{
+				DNSNames: []string{"a"},
+				Subject:  &cmapi.X509Subject{Organizations: []string{"org"}},
+			},
+			x509: selfSignCertificate(t, cmapi.CertificateSpec{
+				DNSNames: []string{"a"},
+			}),
+			violations: []string{"spec.subject.organizations"},
+		},
+		"should not match if a requested usage is missing": {
+			spec: cmapi.CertificateSpec{
+				DNSNames: []string{"a"},
+				Usages:   []cmapi.KeyUsage{cmapi.UsageServerAuth, cmapi.UsageClientAuth},
+			},
+			x509: selfSignCertificate(t, cmapi.CertificateSpec{
+				DNSNames: []string{"a"},
+				Usages:   []cmapi.KeyUsage{cmapi.UsageServerAuth},
+			}),
+			violations: []string{"spec.usages"},
+		},
+		"should not match if isCA differs": {
+			spec: cmapi.CertificateSpec{
+				CommonName: "ca",
+				IsCA:       true,
+			},
+			x509: selfSignCertificate(t, cmapi.CertificateSpec{
+				CommonName: "ca",
+			}),
+			violations: []string{"spec.isCA", "spec.usages"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := pki.CertificateMatchesSpec(test.x509, test.spec)
+			require.NoError(t, err)
+			assert.Equal(t, test.violations, violations)
+		})
+	}
+}
+
 func selfSignCertificate(t *testing.T, spec cmapi.CertificateSpec) *x509.Certificate {
 	template, err := pki.CertificateTemplateFromCertificate(&cmapi.Certificate{Spec: spec})
 	if err != nil {
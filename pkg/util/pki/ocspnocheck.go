@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+)
+
+// OIDExtensionOCSPNoCheck is the id-pkix-ocsp-nocheck extension, which tells
+// OCSP clients not to check the revocation status of a delegated OCSP
+// responder's certificate (RFC 6960, 4.2.2.2.1).
+var OIDExtensionOCSPNoCheck = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 48, 1, 5}
+
+// MarshalOCSPNoCheck returns the id-pkix-ocsp-nocheck extension, whose value
+// is always NULL.
+func MarshalOCSPNoCheck() pkix.Extension {
+	return pkix.Extension{Id: OIDExtensionOCSPNoCheck, Value: asn1.NullBytes}
+}
@@ -19,6 +19,7 @@ package pki
 import (
 	"crypto/rand"
 	"fmt"
+	"hash/fnv"
 	"math/big"
 	"time"
 
@@ -31,6 +32,9 @@ import (
 
 type RenewalOptions struct {
 	ariInfo *acmeapi.RenewalInfoResponse
+
+	jitterSeed string
+	maxJitter  time.Duration
 }
 
 type RenewalTimeOptions func(*RenewalOptions)
@@ -41,6 +45,19 @@ func WithARIInfo(ariInfo *acmeapi.RenewalInfoResponse) RenewalTimeOptions {
 	}
 }
 
+// WithRenewalJitter brings the renewal time forward by a deterministic
+// duration of up to maxJitter, derived from the given seed. This spreads the
+// renewal of certificates which were issued at the same time, while keeping
+// the renewal time of each certificate stable between calls.
+// The jitter is never more than half of the time between notBefore and the
+// renewal time, and is not applied to renewal times based on ARI.
+func WithRenewalJitter(seed string, maxJitter time.Duration) RenewalTimeOptions {
+	return func(o *RenewalOptions) {
+		o.jitterSeed = seed
+		o.maxJitter = maxJitter
+	}
+}
+
 // RenewalTimeFunc is a custom function type for calculating renewal time of a certificate.
 type RenewalTimeFunc func(time.Time, time.Time, *metav1.Duration, *int32, *apiv1.CertificateRenewal, ...RenewalTimeOptions) (*metav1.Time, error)
 
@@ -81,6 +98,11 @@ func RenewalTime(notBefore, notAfter time.Time, renewBefore *metav1.Duration, re
 		// causing Certificates to not be automatically renewed. See
 		// https://github.com/cert-manager/cert-manager/pull/4399.
 		rt = metav1.NewTime(notAfter.Add(-1 * actualRenewBefore).Truncate(time.Second))
+
+		// 1.3: Bring the renewal time forward by the jitter, if configured.
+		if o.maxJitter > 0 {
+			rt = metav1.NewTime(rt.Add(-1 * renewalJitter(o.jitterSeed, o.maxJitter, rt.Sub(notBefore)/2)).Truncate(time.Second))
+		}
 	}
 
 	// 2. If there is no renewal spec then just return the desired renewal time calculated above.
@@ -98,6 +120,16 @@ func RenewalTime(notBefore, notAfter time.Time, renewBefore *metav1.Duration, re
 	}
 }
 
+// renewalJitter returns a duration in the range [0, maxJitter) derived from the
+// FNV-1a hash of seed, capped at limit.
+func renewalJitter(seed string, maxJitter, limit time.Duration) time.Duration {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	jitter := time.Duration(h.Sum64() % uint64(maxJitter))
+
+	return max(min(jitter, limit), 0)
+}
+
 func selectRandTimeInARIWindow(start, end time.Time) time.Time {
 	if !start.Before(end) {
 		return start
@@ -119,6 +119,46 @@ func TestRenewalTime(t *testing.T) {
 	}
 }
 
+func TestRenewalTimeWithJitter(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	notBefore, notAfter := now, now.Add(time.Hour*90*24)
+	withoutJitter, err := RenewalTime(notBefore, notAfter, nil, nil, nil)
+	assert.NoError(t, err)
+
+	t.Run("jitter is deterministic for the same seed", func(t *testing.T) {
+		first, err := RenewalTime(notBefore, notAfter, nil, nil, nil, WithRenewalJitter("ns/cert-1", 24*time.Hour))
+		assert.NoError(t, err)
+		second, err := RenewalTime(notBefore, notAfter, nil, nil, nil, WithRenewalJitter("ns/cert-1", 24*time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("jitter brings the renewal time forward by less than the maximum", func(t *testing.T) {
+		for i := range 100 {
+			renewalTime, err := RenewalTime(notBefore, notAfter, nil, nil, nil, WithRenewalJitter(fmt.Sprintf("ns/cert-%d", i), 24*time.Hour))
+			assert.NoError(t, err)
+			assert.False(t, renewalTime.After(withoutJitter.Time), "renewal time %v is after %v", renewalTime, withoutJitter)
+			assert.True(t, renewalTime.After(withoutJitter.Add(-24*time.Hour)), "renewal time %v is more than 24h before %v", renewalTime, withoutJitter)
+		}
+	})
+
+	t.Run("jitter is spread across certificates", func(t *testing.T) {
+		seen := map[time.Time]struct{}{}
+		for i := range 100 {
+			renewalTime, err := RenewalTime(notBefore, notAfter, nil, nil, nil, WithRenewalJitter(fmt.Sprintf("ns/cert-%d", i), 24*time.Hour))
+			assert.NoError(t, err)
+			seen[renewalTime.Time] = struct{}{}
+		}
+		assert.Greater(t, len(seen), 90)
+	})
+
+	t.Run("jitter is capped at half of the time until renewal", func(t *testing.T) {
+		renewalTime, err := RenewalTime(now, now.Add(3*time.Hour), nil, nil, nil, WithRenewalJitter("ns/cert-1", 1000*time.Hour))
+		assert.NoError(t, err)
+		assert.False(t, renewalTime.Time.Before(now.Add(time.Hour)), "renewal time %v is before %v", renewalTime, now.Add(time.Hour))
+	})
+}
+
 func TestRenewBefore(t *testing.T) {
 	const defaultDuration = time.Hour * 3
 
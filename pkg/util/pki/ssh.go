@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// DefaultSSHUserExtensions returns the extensions set by OpenSSH's ssh-keygen
+// on user certificates when none are requested.
+func DefaultSSHUserExtensions() map[string]string {
+	return map[string]string{
+		"permit-X11-forwarding":   "",
+		"permit-agent-forwarding": "",
+		"permit-port-forwarding":  "",
+		"permit-pty":              "",
+		"permit-user-rc":          "",
+	}
+}
+
+// ParseSSHCertificate parses an SSH certificate in the OpenSSH
+// authorized_keys format.
+func ParseSSHCertificate(data []byte) (*ssh.Certificate, error) {
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(data)
+	if err != nil {
+		return nil, err
+	}
+	cert, ok := pub.(*ssh.Certificate)
+	if !ok {
+		return nil, errors.New("public key is not an SSH certificate")
+	}
+	return cert, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseSSHCertificate(t *testing.T) {
+	caKey, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	caSigner, err := ssh.NewSignerFromSigner(caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := GenerateEd25519PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := &ssh.Certificate{
+		Key:             pub,
+		CertType:        ssh.UserCert,
+		KeyId:           "test",
+		ValidPrincipals: []string{"alice"},
+	}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		data    []byte
+		wantErr bool
+	}{
+		"an SSH certificate is parsed": {
+			data: ssh.MarshalAuthorizedKey(cert),
+		},
+		"a plain public key is rejected": {
+			data:    ssh.MarshalAuthorizedKey(pub),
+			wantErr: true,
+		},
+		"invalid data is rejected": {
+			data:    []byte("not an SSH certificate"),
+			wantErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			parsed, err := ParseSSHCertificate(test.data)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err == nil && parsed.KeyId != cert.KeyId {
+				t.Errorf("unexpected key ID %q", parsed.KeyId)
+			}
+		})
+	}
+}
@@ -28,6 +28,14 @@ func CertificateSecretName(name string) Func[*cmapi.Certificate] {
 	}
 }
 
+// CertificateTrustConfigMapName returns a predicate that used to filter
+// Certificates to only those with the given 'spec.trustConfigMap.name'.
+func CertificateTrustConfigMapName(name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		return crt.Spec.TrustConfigMap != nil && crt.Spec.TrustConfigMap.Name == name
+	}
+}
+
 // CertificateNextPrivateKeySecretName returns a predicate that used to filter Certificates
 // to only those with the given 'status.nextPrivateKeySecretName'.
 // It is not possible to select Certificates with a 'nil' secret name using
@@ -40,3 +48,39 @@ func CertificateNextPrivateKeySecretName(name string) Func[*cmapi.Certificate] {
 		return *crt.Status.NextPrivateKeySecretName == name
 	}
 }
+
+// CertificateExternalCSRSecretName returns a predicate that used to filter
+// Certificates to only those with a 'spec.externalCSR' referencing the Secret
+// with the given name.
+func CertificateExternalCSRSecretName(name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		return crt.Spec.ExternalCSR != nil && crt.Spec.ExternalCSR.Name == name &&
+			(crt.Spec.ExternalCSR.Kind == "" || crt.Spec.ExternalCSR.Kind == "Secret")
+	}
+}
+
+// CertificateExternalCSRConfigMapName returns a predicate that used to filter
+// Certificates to only those with a 'spec.externalCSR' referencing the
+// ConfigMap with the given name.
+func CertificateExternalCSRConfigMapName(name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		return crt.Spec.ExternalCSR != nil && crt.Spec.ExternalCSR.Name == name &&
+			crt.Spec.ExternalCSR.Kind == "ConfigMap"
+	}
+}
+
+// CertificateKeystorePasswordSecretName returns a predicate that used to
+// filter Certificates to only those with a keystore whose 'passwordSecretRef',
+// or 'keyPasswordSecretRef' for PKCS12, references the Secret with the given
+// name.
+func CertificateKeystorePasswordSecretName(name string) Func[*cmapi.Certificate] {
+	return func(crt *cmapi.Certificate) bool {
+		keystores := crt.Spec.Keystores
+		if keystores == nil {
+			return false
+		}
+		return (keystores.JKS != nil && keystores.JKS.PasswordSecretRef.Name == name) ||
+			(keystores.PKCS12 != nil && keystores.PKCS12.PasswordSecretRef.Name == name) ||
+			(keystores.PKCS12 != nil && keystores.PKCS12.KeyPasswordSecretRef != nil && keystores.PKCS12.KeyPasswordSecretRef.Name == name)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertificateSecretName(t *testing.T) {
@@ -54,6 +55,152 @@ func TestCertificateSecretName(t *testing.T) {
 	}
 }
 
+func TestCertificateTrustConfigMapName(t *testing.T) {
+	certWithTrustConfigMap := func(trustConfigMap *cmapi.CertificateTrustConfigMap) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{TrustConfigMap: trustConfigMap},
+		}
+	}
+	tests := map[string]struct {
+		configMapName string
+		cert          *cmapi.Certificate
+		expected      bool
+	}{
+		"returns true if configmap name matches": {
+			configMapName: "abc",
+			cert:          certWithTrustConfigMap(&cmapi.CertificateTrustConfigMap{Name: "abc"}),
+			expected:      true,
+		},
+		"returns false if configmap name does not match": {
+			configMapName: "abc",
+			cert:          certWithTrustConfigMap(&cmapi.CertificateTrustConfigMap{Name: "abcd"}),
+			expected:      false,
+		},
+		"returns false if trust configmap is nil": {
+			configMapName: "",
+			cert:          certWithTrustConfigMap(nil),
+			expected:      false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateTrustConfigMapName(test.configMapName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateKeystorePasswordSecretName(t *testing.T) {
+	certWithKeystores := func(keystores *cmapi.CertificateKeystores) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{Keystores: keystores},
+		}
+	}
+	passwordSecretRef := func(name string) cmmeta.SecretKeySelector {
+		return cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: name}, Key: "password"}
+	}
+	tests := map[string]struct {
+		secretName string
+		cert       *cmapi.Certificate
+		expected   bool
+	}{
+		"returns true if JKS password secret name matches": {
+			secretName: "abc",
+			cert:       certWithKeystores(&cmapi.CertificateKeystores{JKS: &cmapi.JKSKeystore{PasswordSecretRef: passwordSecretRef("abc")}}),
+			expected:   true,
+		},
+		"returns true if PKCS12 password secret name matches": {
+			secretName: "abc",
+			cert:       certWithKeystores(&cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{PasswordSecretRef: passwordSecretRef("abc")}}),
+			expected:   true,
+		},
+		"returns true if PKCS12 key password secret name matches": {
+			secretName: "abc",
+			cert: certWithKeystores(&cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{
+				PasswordSecretRef:    passwordSecretRef("abcd"),
+				KeyPasswordSecretRef: new(passwordSecretRef("abc")),
+			}}),
+			expected: true,
+		},
+		"returns false if password secret name does not match": {
+			secretName: "abc",
+			cert: certWithKeystores(&cmapi.CertificateKeystores{
+				JKS:    &cmapi.JKSKeystore{PasswordSecretRef: passwordSecretRef("abcd")},
+				PKCS12: &cmapi.PKCS12Keystore{PasswordSecretRef: passwordSecretRef("abcd")},
+			}),
+			expected: false,
+		},
+		"returns false if keystores is nil": {
+			secretName: "abc",
+			cert:       certWithKeystores(nil),
+			expected:   false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateKeystorePasswordSecretName(test.secretName)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestCertificateExternalCSRName(t *testing.T) {
+	certWithExternalCSR := func(externalCSR *cmapi.CertificateExternalCSR) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{ExternalCSR: externalCSR},
+		}
+	}
+	tests := map[string]struct {
+		predicate func(string) Func[*cmapi.Certificate]
+		name      string
+		cert      *cmapi.Certificate
+		expected  bool
+	}{
+		"returns true if configmap name matches": {
+			predicate: CertificateExternalCSRConfigMapName,
+			name:      "abc",
+			cert:      certWithExternalCSR(&cmapi.CertificateExternalCSR{Kind: "ConfigMap", Name: "abc"}),
+			expected:  true,
+		},
+		"returns true if secret name matches and kind is not set": {
+			predicate: CertificateExternalCSRSecretName,
+			name:      "abc",
+			cert:      certWithExternalCSR(&cmapi.CertificateExternalCSR{Name: "abc"}),
+			expected:  true,
+		},
+		"returns false if kind does not match": {
+			predicate: CertificateExternalCSRConfigMapName,
+			name:      "abc",
+			cert:      certWithExternalCSR(&cmapi.CertificateExternalCSR{Name: "abc"}),
+			expected:  false,
+		},
+		"returns false if name does not match": {
+			predicate: CertificateExternalCSRSecretName,
+			name:      "abc",
+			cert:      certWithExternalCSR(&cmapi.CertificateExternalCSR{Kind: "Secret", Name: "abcd"}),
+			expected:  false,
+		},
+		"returns false if external CSR is nil": {
+			predicate: CertificateExternalCSRSecretName,
+			name:      "",
+			cert:      certWithExternalCSR(nil),
+			expected:  false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.predicate(test.name)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
+
 func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 	certWithSecretName := func(s *string) *cmapi.Certificate {
 		return &cmapi.Certificate{
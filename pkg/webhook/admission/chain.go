@@ -18,6 +18,7 @@ package admission
 
 import (
 	"context"
+	"sync/atomic"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -69,3 +70,38 @@ func (pc PluginChain) Mutate(ctx context.Context, request admissionv1.AdmissionR
 	}
 	return nil
 }
+
+// DynamicPluginChain is a PluginChain which can be replaced while the webhook
+// is serving requests, for example when the webhook configuration is
+// reloaded.
+type DynamicPluginChain struct {
+	chain atomic.Pointer[PluginChain]
+}
+
+var _ Interface = &DynamicPluginChain{}
+var _ ValidationInterface = &DynamicPluginChain{}
+var _ MutationInterface = &DynamicPluginChain{}
+
+func NewDynamicPluginChain(chain PluginChain) *DynamicPluginChain {
+	c := &DynamicPluginChain{}
+	c.Set(chain)
+	return c
+}
+
+// Set replaces the plugin chain. Requests which are being admitted when the
+// chain is replaced may be handled by either chain.
+func (c *DynamicPluginChain) Set(chain PluginChain) {
+	c.chain.Store(&chain)
+}
+
+func (c *DynamicPluginChain) Handles(operation admissionv1.Operation) bool {
+	return c.chain.Load().Handles(operation)
+}
+
+func (c *DynamicPluginChain) Validate(ctx context.Context, request admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+	return c.chain.Load().Validate(ctx, request, oldObj, obj)
+}
+
+func (c *DynamicPluginChain) Mutate(ctx context.Context, request admissionv1.AdmissionRequest, obj *unstructured.Unstructured) error {
+	return c.chain.Load().Mutate(ctx, request, obj)
+}
@@ -172,3 +172,34 @@ func TestChainMutate_Fails(t *testing.T) {
 		t.Errorf("expected error but got none")
 	}
 }
+
+func TestDynamicPluginChain(t *testing.T) {
+	var called []string
+	validator := func(name string) admission.Interface {
+		return validatingImplementation{
+			handles: handles(true).Handles,
+			validate: func(ctx context.Context, request admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+				called = append(called, name)
+				return nil, nil
+			},
+		}
+	}
+
+	dc := admission.NewDynamicPluginChain(admission.PluginChain{validator("first")})
+	if _, err := dc.Validate(context.Background(), admissionv1.AdmissionRequest{}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dc.Set(admission.PluginChain{validator("second")})
+	if _, err := dc.Validate(context.Background(), admissionv1.AdmissionRequest{}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(called, []string{"first", "second"}) {
+		t.Errorf("expected the replaced chain to be called, got calls %v", called)
+	}
+
+	dc.Set(nil)
+	if dc.Handles(admissionv1.Create) {
+		t.Errorf("expected an empty chain to not handle requests")
+	}
+}
@@ -95,6 +95,8 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.WebhookConfiguration) {
 			"Possible values: "+strings.Join(tlsPossibleVersions, ", "))
 	fs.Var(cliflag.NewMapStringBool(&c.FeatureGates), "feature-gates", "A set of key=value pairs that describe feature gates for alpha/experimental features. "+
 		"Options are:\n"+strings.Join(utilfeature.DefaultFeatureGate.KnownFeatures(), "\n"))
+	fs.Var(cliflag.NewMapStringBool(&c.AdmissionPlugins), "admission-plugins", "A set of key=value pairs that enable or disable admission plugins. "+
+		"All admission plugins are enabled by default. The admission plugins that can be disabled are CertificateDefaults, CertificateRenewalRequest and CELValidation.")
 
 	logf.AddFlags(&c.Logging, fs)
 
@@ -106,6 +108,7 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.WebhookConfiguration) {
 	fs.StringVar(&c.MetricsTLSConfig.Dynamic.SecretNamespace, "metrics-dynamic-serving-ca-secret-namespace", c.MetricsTLSConfig.Dynamic.SecretNamespace, "namespace of the secret used to store the CA that signs metrics serving certificates")
 	fs.StringVar(&c.MetricsTLSConfig.Dynamic.SecretName, "metrics-dynamic-serving-ca-secret-name", c.MetricsTLSConfig.Dynamic.SecretName, "name of the secret used to store the CA that signs serving certificates")
 	fs.StringSliceVar(&c.MetricsTLSConfig.Dynamic.DNSNames, "metrics-dynamic-serving-dns-names", c.MetricsTLSConfig.Dynamic.DNSNames, "DNS names that should be present on certificates generated by the metrics dynamic serving CA")
+	fs.BoolVar(&c.MetricsTLSConfig.Dynamic.VerifyClientCertificates, "metrics-dynamic-serving-verify-client-certificates", c.MetricsTLSConfig.Dynamic.VerifyClientCertificates, "require clients of the metrics server to present a certificate signed by the CA that signs metrics serving certificates")
 	fs.StringSliceVar(&c.MetricsTLSConfig.CipherSuites, "metrics-tls-cipher-suites", c.MetricsTLSConfig.CipherSuites,
 		"Comma-separated list of cipher suites for the metrics server. "+
 			"If omitted, the default Go cipher suites will be used.  "+
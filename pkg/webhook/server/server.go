@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"os"
 	"slices"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -97,6 +98,10 @@ type Server struct {
 	// provided by this CertificateSource.
 	MetricsCertificateSource servertls.CertificateSource
 
+	// If specified, clients of the metrics server must present a certificate
+	// signed by one of the CAs provided by this CertPoolSource.
+	MetricsClientCAs servertls.CertPoolSource
+
 	// MetricsCipherSuites is the list of allowed cipher suites for the server.
 	// Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants).
 	MetricsCipherSuites []string
@@ -119,6 +124,13 @@ type Server struct {
 	// empty, the server will only verify that the client certificate chains to
 	// the provided ClientCAPath and will not enforce specific subject names.
 	ClientCertificateSubjects []string
+
+	// Runnables are started and stopped together with the server, e.g. the
+	// informers whose caches are read by admission plugins.
+	Runnables []manager.Runnable
+
+	// tlsSettings are the current TLS settings of the webhook listener.
+	tlsSettings atomic.Pointer[tlsSettings]
 }
 
 func (s *Server) Run(ctx context.Context) error {
@@ -128,13 +140,21 @@ func (s *Server) Run(ctx context.Context) error {
 
 	log := logf.FromContext(ctx)
 
-	cipherSuites, err := ciphers.TLSCipherSuites(s.CipherSuites)
-	if err != nil {
-		return err
+	if s.EnableClientVerification && s.ClientCAPath == "" {
+		return fmt.Errorf("error: when --enable-client-verification is true, you must also provide --client-ca-path")
 	}
-	minVersion, err := ciphers.TLSVersion(s.MinTLSVersion)
-	if err != nil {
-		return err
+	// The TLS settings may already have been updated if the configuration
+	// was reloaded before the server started.
+	if s.tlsSettings.Load() == nil {
+		if err := s.UpdateTLSSettings(TLSSettings{
+			CipherSuites:              s.CipherSuites,
+			MinTLSVersion:             s.MinTLSVersion,
+			EnableClientVerification:  s.EnableClientVerification,
+			ClientCAPath:              s.ClientCAPath,
+			ClientCertificateSubjects: s.ClientCertificateSubjects,
+		}); err != nil {
+			return err
+		}
 	}
 
 	metricsCipherSuites, err := ciphers.TLSCipherSuites(s.MetricsCipherSuites)
@@ -159,28 +179,17 @@ func (s *Server) Run(ctx context.Context) error {
 		Port: s.ListenAddr,
 		TLSOpts: []func(*tls.Config){
 			func(cfg *tls.Config) {
-				cfg.CipherSuites = cipherSuites
-				cfg.MinVersion = minVersion
+				base := cfg.Clone()
 				cfg.GetCertificate = s.CertificateSource.GetCertificate
+				// The TLS settings are read for each connection, so that they
+				// can be updated while the server is running.
+				cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+					return s.tlsSettings.Load().apply(base.Clone(), s.CertificateSource.GetCertificate), nil
+				}
 			},
 		},
 	}
 
-	if s.EnableClientVerification {
-		if s.ClientCAPath == "" {
-			return fmt.Errorf("error: when --enable-client-verification is true, you must also provide --client-ca-path")
-		}
-		caCert, err := loadClientCA(s.ClientCAPath)
-		if err != nil {
-			return err
-		}
-		webhookOpts.TLSOpts = append(webhookOpts.TLSOpts, func(cfg *tls.Config) {
-			cfg.ClientCAs = caCert
-			cfg.ClientAuth = tls.RequireAndVerifyClientCert
-		})
-		webhookOpts.TLSOpts = append(webhookOpts.TLSOpts, s.setVerifyPeerCertificate)
-	}
-
 	mgr, err := ctrl.NewManager(
 		&rest.Config{}, // controller-runtime does not need to talk to the API server
 		ctrl.Options{
@@ -195,6 +204,9 @@ func (s *Server) Run(ctx context.Context) error {
 						cfg.CipherSuites = metricsCipherSuites
 						cfg.MinVersion = metricsMinVersion
 						cfg.GetCertificate = s.MetricsCertificateSource.GetCertificate
+						if s.MetricsClientCAs != nil {
+							servertls.RequireClientCertificates(cfg, s.MetricsClientCAs)
+						}
 					},
 				},
 			},
@@ -214,6 +226,12 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}
 
+	for _, runnable := range s.Runnables {
+		if err := mgr.Add(runnable); err != nil {
+			return err
+		}
+	}
+
 	// if a HealthzAddr is provided, start the healthz listener
 	if s.HealthzAddr != nil {
 		lc := net.ListenConfig{}
@@ -347,35 +365,112 @@ func (s *Server) handleLivez(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
-func (s *Server) setVerifyPeerCertificate(cfg *tls.Config) {
-	// Disable session ticket resumption to ensure VerifyPeerCertificate is called for
-	// every connection, not just full TLS handshakes.
-	cfg.SessionTicketsDisabled = true
-	cfg.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
-		// avoid impersonation of apiserver client by verifying the CN name if provided
-		if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
-			return fmt.Errorf("no verified chains")
+// TLSSettings are the TLS settings of the webhook listener which can be
+// updated while the server is running.
+type TLSSettings struct {
+	// CipherSuites is the list of allowed cipher suites for the server.
+	// Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants).
+	CipherSuites []string
+
+	// MinTLSVersion is the minimum TLS version supported.
+	// Values are from tls package constants (https://golang.org/pkg/crypto/tls/#pkg-constants).
+	MinTLSVersion string
+
+	// EnableClientVerification turns on client verification of requests
+	// made to the webhook server
+	EnableClientVerification bool
+
+	// ClientCAPath is the CA certificate name which server used to verify remote(client)'s certificate.
+	ClientCAPath string
+
+	// ClientCertificateSubjects is a list of expected subject names for client
+	// certificates used by callers (for example, the apiserver).
+	ClientCertificateSubjects []string
+}
+
+// UpdateTLSSettings validates and applies TLS settings to the webhook
+// listener. The settings are used for all new connections; existing
+// connections are not affected.
+func (s *Server) UpdateTLSSettings(settings TLSSettings) error {
+	cipherSuites, err := ciphers.TLSCipherSuites(settings.CipherSuites)
+	if err != nil {
+		return err
+	}
+	minVersion, err := ciphers.TLSVersion(settings.MinTLSVersion)
+	if err != nil {
+		return err
+	}
+
+	parsed := &tlsSettings{
+		cipherSuites: cipherSuites,
+		minVersion:   minVersion,
+	}
+	if settings.EnableClientVerification {
+		if settings.ClientCAPath == "" {
+			return fmt.Errorf("a client CA path must be provided when client verification is enabled")
 		}
-		// if no specific names are configured, skip subject matching and accept
-		// any client certificate that verifies against the configured ClientCAs.
-		if len(s.ClientCertificateSubjects) == 0 {
-			return nil
+		parsed.clientCAs, err = loadClientCA(settings.ClientCAPath)
+		if err != nil {
+			return err
 		}
+		parsed.clientCertificateSubjects = slices.Clone(settings.ClientCertificateSubjects)
+	}
 
-		cert := verifiedChains[0][0]
-		// match against CommonName
-		if slices.Contains(s.ClientCertificateSubjects, cert.Subject.CommonName) {
-			return nil
-		}
+	s.tlsSettings.Store(parsed)
+	return nil
+}
 
-		// match against DNS SANs
-		for _, dns := range cert.DNSNames {
-			if slices.Contains(s.ClientCertificateSubjects, dns) {
-				return nil
-			}
+// tlsSettings are the parsed TLSSettings of the webhook listener.
+type tlsSettings struct {
+	cipherSuites []uint16
+	minVersion   uint16
+	// clientCAs is nil if client verification is disabled.
+	clientCAs                 *x509.CertPool
+	clientCertificateSubjects []string
+}
+
+// apply applies the TLS settings to the TLS config of a connection.
+func (t *tlsSettings) apply(cfg *tls.Config, getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)) *tls.Config {
+	cfg.GetCertificate = getCertificate
+	cfg.CipherSuites = t.cipherSuites
+	cfg.MinVersion = t.minVersion
+
+	if t.clientCAs != nil {
+		cfg.ClientCAs = t.clientCAs
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		// Disable session ticket resumption to ensure VerifyPeerCertificate is called for
+		// every connection, not just full TLS handshakes.
+		cfg.SessionTicketsDisabled = true
+		cfg.VerifyPeerCertificate = t.verifyPeerCertificate
+	}
+
+	return cfg
+}
+
+func (t *tlsSettings) verifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	// avoid impersonation of apiserver client by verifying the CN name if provided
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return fmt.Errorf("no verified chains")
+	}
+	// if no specific names are configured, skip subject matching and accept
+	// any client certificate that verifies against the configured ClientCAs.
+	if len(t.clientCertificateSubjects) == 0 {
+		return nil
+	}
+
+	cert := verifiedChains[0][0]
+	// match against CommonName
+	if slices.Contains(t.clientCertificateSubjects, cert.Subject.CommonName) {
+		return nil
+	}
+
+	// match against DNS SANs
+	for _, dns := range cert.DNSNames {
+		if slices.Contains(t.clientCertificateSubjects, dns) {
+			return nil
 		}
-		return fmt.Errorf("unauthorized client certificate: CN=%s DNS=%v", cert.Subject.CommonName, cert.DNSNames)
 	}
+	return fmt.Errorf("unauthorized client certificate: CN=%s DNS=%v", cert.Subject.CommonName, cert.DNSNames)
 }
 
 // loadClientCA loads the client CA from given path
@@ -21,9 +21,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
-	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	certificatesv1 "k8s.io/api/certificates/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -63,8 +61,6 @@ var _ = framework.CertManagerDescribe("CertificateSigningRequests SelfSigned Sec
 	})
 
 	It("Issuer: the private key Secret is created after the request is created should still be signed", func(testingCtx context.Context) {
-		framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ExperimentalCertificateSigningRequestControllers)
-
 		var err error
 		issuer, err = f.CertManagerClientSet.CertmanagerV1().Issuers(f.Namespace.Name).Create(testingCtx, &cmapi.Issuer{
 			ObjectMeta: metav1.ObjectMeta{GenerateName: "selfsigned-", Namespace: f.Namespace.Name},
@@ -125,8 +121,6 @@ var _ = framework.CertManagerDescribe("CertificateSigningRequests SelfSigned Sec
 	})
 
 	It("Issuer: private key Secret is updated with a valid private key after the request is created should still be signed", func(testingCtx context.Context) {
-		framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ExperimentalCertificateSigningRequestControllers)
-
 		var err error
 		By("creating Secret with missing private key")
 		secret, err = f.KubeClientSet.CoreV1().Secrets(f.Namespace.Name).Create(testingCtx, &corev1.Secret{
@@ -190,8 +184,6 @@ var _ = framework.CertManagerDescribe("CertificateSigningRequests SelfSigned Sec
 	})
 
 	It("ClusterIssuer: the private key Secret is created after the request is created should still be signed", func(testingCtx context.Context) {
-		framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ExperimentalCertificateSigningRequestControllers)
-
 		var err error
 		issuer, err = f.CertManagerClientSet.CertmanagerV1().ClusterIssuers().Create(testingCtx, &cmapi.ClusterIssuer{
 			ObjectMeta: metav1.ObjectMeta{GenerateName: "selfsigned-"},
@@ -252,8 +244,6 @@ var _ = framework.CertManagerDescribe("CertificateSigningRequests SelfSigned Sec
 	})
 
 	It("ClusterIssuer: private key Secret is updated with a valid private key after the request is created should still be signed", func(testingCtx context.Context) {
-		framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ExperimentalCertificateSigningRequestControllers)
-
 		var err error
 		By("creating Secret with missing private key")
 		secret, err = f.KubeClientSet.CoreV1().Secrets("cert-manager").Create(testingCtx, &corev1.Secret{
@@ -147,8 +147,6 @@ func (s *Suite) it(f *framework.Framework, name string, fn func(context.Context,
 		return
 	}
 	It(name, func(ctx context.Context) {
-		framework.RequireFeatureGate(utilfeature.DefaultFeatureGate, feature.ExperimentalCertificateSigningRequestControllers)
-
 		By("Creating an issuer resource")
 		signerName := s.CreateIssuerFunc(ctx, f)
 		defer func() {
@@ -44,9 +44,8 @@ import (
 // If Complete has not been called on this Suite before Define, it will be
 // automatically called.
 // The tests in this file require that the CertificateSigningRequest
-// controllers are active
-// (--feature-gates=ExperimentalCertificateSigningRequestControllers=true). If
-// they are not active, these tests will fail.
+// controllers are active, which they are by default. If they have been
+// disabled, these tests will fail.
 func (s *Suite) Define() {
 	Describe("CertificateSigningRequest with issuer type "+s.Name, func() {
 		f := framework.NewDefaultFramework("certificatesigningrequests")
@@ -90,7 +90,9 @@ func TestMetricsController(t *testing.T) {
 	challengesInformer := cmFactory.Acme().V1().Challenges()
 	certsInformer := cmFactory.Certmanager().V1().Certificates()
 	metricsHandler.SetupACMECollector(challengesInformer.Lister())
-	metricsHandler.SetupCertificateCollector(certsInformer.Lister())
+	if err := metricsHandler.SetupCertificateCollector(certsInformer.Lister(), nil, nil, false); err != nil {
+		t.Fatal(err)
+	}
 
 	server := metricsHandler.NewServer(ln)
 
@@ -64,7 +64,7 @@ func TestTriggerController(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	shouldReissue := policies.NewTriggerPolicyChain(fakeClock).Evaluate
+	shouldReissue := policies.NewTriggerPolicyChain(fakeClock, 0).Evaluate
 	controllerContext := &controllerpkg.Context{
 		Scheme:                    scheme,
 		Client:                    kubeClient,
@@ -115,7 +115,7 @@ func TestTriggerController_RenewNearExpiry(t *testing.T) {
 	// Only use the 'current certificate nearing expiry' policy chain during the
 	// test as we want to test the very specific cases of triggering/not
 	// triggering depending on whether a renewal is required.
-	shouldReissue := policies.Chain{policies.CurrentCertificateNearingExpiry(fakeClock)}.Evaluate
+	shouldReissue := policies.Chain{policies.CurrentCertificateNearingExpiry(fakeClock, 0)}.Evaluate
 	// Build, instantiate and run the trigger controller.
 	kubeClient, factory, cmCl, cmFactory, scheme := framework.NewClients(t, config)
 
@@ -236,7 +236,7 @@ func TestTriggerController_ExpBackoff(t *testing.T) {
 	// Issuing condition will be applied because SecretDoesNotExist policy
 	// will evaluate to true. However, this is not what we are testing in
 	// this test.
-	shouldReissue := policies.NewTriggerPolicyChain(fakeClock).Evaluate
+	shouldReissue := policies.NewTriggerPolicyChain(fakeClock, 0).Evaluate
 	// Build, instantiate and run the trigger controller.
 	kubeClient, factory, cmCl, cmFactory, scheme := framework.NewClients(t, config)
 
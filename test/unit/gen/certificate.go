@@ -18,6 +18,7 @@ package gen
 
 import (
 	"maps"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -101,6 +102,12 @@ func SetCertificateIsCA(isCA bool) CertificateModifier {
 	}
 }
 
+func SetCertificateNameConstraints(nameConstraints *v1.NameConstraints) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.NameConstraints = nameConstraints
+	}
+}
+
 func SetCertificateKeyAlgorithm(keyAlgorithm v1.PrivateKeyAlgorithm) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.PrivateKey.Algorithm = keyAlgorithm
@@ -119,6 +126,15 @@ func SetCertificateKeyEncoding(keyEncoding v1.PrivateKeyEncoding) CertificateMod
 	}
 }
 
+func SetCertificateKeyRotationOverlap(overlap time.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		if crt.Spec.PrivateKey == nil {
+			crt.Spec.PrivateKey = &v1.CertificatePrivateKey{}
+		}
+		crt.Spec.PrivateKey.RotationOverlap = &metav1.Duration{Duration: overlap}
+	}
+}
+
 func SetCertificateSecretName(secretName string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.SecretName = secretName
@@ -153,6 +169,30 @@ func SetCertificateNextPrivateKeySecretName(name string) CertificateModifier {
 	}
 }
 
+func SetCertificateRenewalRequest(request v1.CertificateRenewalRequest) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RenewalRequest = &request
+	}
+}
+
+func SetCertificateExternalCSR(externalCSR v1.CertificateExternalCSR) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.ExternalCSR = &externalCSR
+	}
+}
+
+func SetCertificateLastRenewalRequest(request v1.CertificateRenewalRequestStatus) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.LastRenewalRequest = &request
+	}
+}
+
+func SetCertificateNextPrivateKeyPublishedTime(t metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Status.NextPrivateKeyPublishedTime = &t
+	}
+}
+
 func SetCertificateStatusCondition(c v1.CertificateCondition) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		if len(crt.Status.Conditions) == 0 {
@@ -20,6 +20,7 @@ import (
 	"maps"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	internalv1 "github.com/cert-manager/cert-manager/internal/apis/certmanager/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -67,6 +68,12 @@ func SetCertificateRequestIsCA(isCA bool) CertificateRequestModifier {
 	}
 }
 
+func SetCertificateRequestCreationTimestamp(creationTimestamp metav1.Time) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.ObjectMeta.CreationTimestamp = creationTimestamp
+	}
+}
+
 func SetCertificateRequestDuration(duration *metav1.Duration) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.Spec.Duration = duration
@@ -113,6 +120,12 @@ func SetCertificateRequestNamespace(namespace string) CertificateRequestModifier
 	}
 }
 
+func SetCertificateRequestUID(uid types.UID) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.UID = uid
+	}
+}
+
 func SetCertificateRequestName(name string) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.ObjectMeta.Name = name
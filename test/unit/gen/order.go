@@ -139,6 +139,12 @@ func SetOrderProfile(profile string) OrderModifier {
 	}
 }
 
+func SetOrderPreferredChain(preferredChain string) OrderModifier {
+	return func(order *cmacme.Order) {
+		order.Spec.PreferredChain = preferredChain
+	}
+}
+
 func SetOrderReplacesID(id string) OrderModifier {
 	return func(order *cmacme.Order) {
 		order.Spec.Replaces = id
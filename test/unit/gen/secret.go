@@ -20,6 +20,7 @@ import (
 	"maps"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type SecretModifier func(*corev1.Secret)
@@ -55,9 +56,27 @@ func SetSecretAnnotations(an map[string]string) SecretModifier {
 	}
 }
 
+func SetSecretLabels(labels map[string]string) SecretModifier {
+	return func(sec *corev1.Secret) {
+		sec.Labels = labels
+	}
+}
+
 func SetSecretData(data map[string][]byte) SecretModifier {
 	return func(sec *corev1.Secret) {
 		sec.Data = make(map[string][]byte)
 		maps.Copy(sec.Data, data)
 	}
 }
+
+func SetSecretManagedFields(managedFields []metav1.ManagedFieldsEntry) SecretModifier {
+	return func(sec *corev1.Secret) {
+		sec.ManagedFields = managedFields
+	}
+}
+
+func SetSecretType(secretType corev1.SecretType) SecretModifier {
+	return func(sec *corev1.Secret) {
+		sec.Type = secretType
+	}
+}